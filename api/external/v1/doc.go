@@ -0,0 +1,26 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalv1 holds the generated gRPC API implemented by external
+// scaling algorithm services. Services written in any language implement
+// ScalingAlgorithm from algorithm.proto and are registered with the
+// controller's --external-algorithms flag. External optimizers implement
+// Optimizer from optimizer.proto and are registered with --optimizer-address.
+//
+// Regenerate with hack/update-proto.sh after changing a .proto file.
+package externalv1
+
+//go:generate ../../../hack/update-proto.sh
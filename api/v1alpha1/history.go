@@ -0,0 +1,61 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NewMetricHistory encodes values, oldest first, as the history of the named
+// metric, rounding each to the given number of decimal places
+func NewMetricHistory(name string, decimals int32, values []float64) MetricHistory {
+	scale := math.Pow10(int(decimals))
+	var samples strings.Builder
+	var previous int64
+	for i, value := range values {
+		current := int64(math.Round(value * scale))
+		if i > 0 {
+			samples.WriteByte(',')
+		}
+		samples.WriteString(strconv.FormatInt(current-previous, 10))
+		previous = current
+	}
+	return MetricHistory{Name: name, Samples: samples.String(), Decimals: decimals}
+}
+
+// Values decodes the samples of the history, oldest first
+func (h *MetricHistory) Values() ([]float64, error) {
+	if h.Samples == "" {
+		return nil, nil
+	}
+	scale := math.Pow10(int(h.Decimals))
+	fields := strings.Split(h.Samples, ",")
+	values := make([]float64, len(fields))
+	var current int64
+	for i, field := range fields {
+		delta, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("sample %d of %s: %w", i, h.Name, err)
+		}
+		current += delta
+		values[i] = float64(current) / scale
+	}
+	return values, nil
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricHistoryEncoding(t *testing.T) {
+	history := NewMetricHistory("gpuUtilizationPercent", 0, []float64{70, 72, 67, 67})
+	assert.Equal(t, "70,2,-5,0", history.Samples)
+	values, err := history.Values()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{70, 72, 67, 67}, values)
+
+	history = NewMetricHistory("arrivalRate", 3, []float64{1.5, 1.2504, 0})
+	assert.Equal(t, "1500,-250,-1250", history.Samples)
+	values, err = history.Values()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 1.25, 0}, values)
+
+	empty := NewMetricHistory("inFlightRequests", 0, nil)
+	values, err = empty.Values()
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = (&MetricHistory{Name: "x", Samples: "1,a"}).Values()
+	assert.ErrorContains(t, err, "sample 1 of x")
+}
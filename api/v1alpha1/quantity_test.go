@@ -0,0 +1,42 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantityTargets(t *testing.T) {
+	// Objects written when targets were integers decode unchanged
+	var latency LatencyMetric
+	require.NoError(t, json.Unmarshal([]byte(`{"enabled":true,"targetP99Ms":500}`), &latency))
+	assert.Equal(t, 500.0, QuantityFloat64(latency.TargetP99Ms))
+	assert.Nil(t, latency.TargetP95Ms)
+
+	// Fractional targets are written as decimal strings or with an SI suffix
+	var inFlight InFlightRequestsMetric
+	require.NoError(t, json.Unmarshal([]byte(`{"enabled":true,"targetPerReplica":"500m"}`), &inFlight))
+	assert.Equal(t, 0.5, QuantityFloat64(inFlight.TargetPerReplica))
+	require.NoError(t, json.Unmarshal([]byte(`{"enabled":true,"targetPerReplica":"0.25"}`), &inFlight))
+	assert.Equal(t, 0.25, QuantityFloat64(inFlight.TargetPerReplica))
+
+	assert.Zero(t, QuantityFloat64(nil))
+}
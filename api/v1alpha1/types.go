@@ -17,15 +17,22 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=aiap;aipolicy,categories=all;autoscaling
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetRef.name`
 // +kubebuilder:printcolumn:name="Min",type=integer,JSONPath=`.spec.minReplicas`
 // +kubebuilder:printcolumn:name="Max",type=integer,JSONPath=`.spec.maxReplicas`
 // +kubebuilder:printcolumn:name="Current",type=integer,JSONPath=`.status.currentReplicas`
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.status.desiredReplicas`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Algorithm",type=string,JSONPath=`.status.lastAlgorithm`
+// +kubebuilder:printcolumn:name="Reason",type=string,priority=1,JSONPath=`.status.lastScaleReason`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // AIInferenceAutoscalerPolicy defines autoscaling rules for AI inference workloads
@@ -39,13 +46,16 @@ type AIInferenceAutoscalerPolicy struct {
 
 // AIInferenceAutoscalerPolicySpec defines the desired state
 type AIInferenceAutoscalerPolicySpec struct {
-	// TargetRef references the target Deployment or StatefulSet
+	// TargetRef references the workload to scale through its scale subresource
 	TargetRef TargetRef `json:"targetRef"`
 
-	// MinReplicas is the minimum number of replicas
+	// MinReplicas is the minimum number of replicas. Setting it to 0 lets an idle
+	// target scale to zero; requests sent through the activator while no replica
+	// is running are held until the target has scaled back up.
 	// +kubebuilder:default=1
-	// +kubebuilder:validation:Minimum=1
-	MinReplicas int32 `json:"minReplicas,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
 
 	// MaxReplicas is the maximum number of replicas
 	// +kubebuilder:validation:Minimum=1
@@ -56,6 +66,15 @@ type AIInferenceAutoscalerPolicySpec struct {
 	// +kubebuilder:validation:Minimum=0
 	CooldownPeriod int32 `json:"cooldownPeriod,omitempty"`
 
+	// PollingInterval is how often, in seconds, metrics are fetched and a
+	// scaling decision is made. The rate and quantile window of the default
+	// queries, and of $__window in custom queries, is derived from it.
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	PollingInterval int32 `json:"pollingInterval,omitempty"`
+
 	// Metrics configuration for scaling decisions
 	Metrics MetricsSpec `json:"metrics"`
 
@@ -70,14 +89,314 @@ type AIInferenceAutoscalerPolicySpec struct {
 	// ScaleDown behavior configuration
 	// +optional
 	ScaleDown *ScaleBehavior `json:"scaleDown,omitempty"`
+
+	// CapacityProbe discovers the per-replica capacity reported by the inference runtime
+	// +optional
+	CapacityProbe *CapacityProbeSpec `json:"capacityProbe,omitempty"`
+
+	// TargetsPerGPU interprets requestQueueDepth.targetDepth,
+	// tokensPerSecond.targetPerReplica and inFlightRequests.targetPerReplica as
+	// per-GPU targets. They are multiplied by the nvidia.com/gpu count each
+	// replica requests, read from the target's pod template, so multi-GPU
+	// replicas are sized by their GPU count.
+	// +optional
+	TargetsPerGPU bool `json:"targetsPerGPU,omitempty"`
+
+	// DryRun submits replica changes to the API server with dryRun=All so they
+	// pass full admission and validation without being persisted
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Suspend stops the policy from changing its target while metrics are still
+	// fetched and the status still updated, like suspend on a CronJob
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// EventMetadata is added as annotations to every Kubernetes event and to
+	// the data of every CloudEvent of the policy, such as its team or cost
+	// center, so downstream systems can route them without a lookup
+	// +optional
+	EventMetadata map[string]string `json:"eventMetadata,omitempty"`
+
+	// Prometheus overrides the controller-wide Prometheus endpoint for this policy,
+	// e.g. to read from both replicas of an HA pair
+	// +optional
+	Prometheus *PrometheusSpec `json:"prometheus,omitempty"`
+
+	// TargetModulation adjusts metric targets during recurring time windows,
+	// e.g. a looser latency target overnight. Replica bounds are not affected.
+	// The first matching entry wins.
+	// +listType=atomic
+	// +optional
+	TargetModulation []TargetModulation `json:"targetModulation,omitempty"`
+
+	// EvictionProtection marks the target's pods as not safe to evict for the
+	// cluster autoscaler while the policy is scaling up under load, so node
+	// consolidation does not remove hot replicas mid-surge. The protection is
+	// lifted once the recommendation drops below the current replicas.
+	// +optional
+	EvictionProtection bool `json:"evictionProtection,omitempty"`
+
+	// ScaleToZero tunes how an idle target with minReplicas 0 is scaled to zero
+	// +optional
+	ScaleToZero *ScaleToZeroSpec `json:"scaleToZero,omitempty"`
+
+	// Smoothing smooths the algorithm's replica recommendations and caps the
+	// change per reconcile, for any algorithm
+	// +optional
+	Smoothing *SmoothingSpec `json:"smoothing,omitempty"`
+
+	// ShardParity keeps the replicas compatible with a cache the inference
+	// server shards across replicas by replica count
+	// +optional
+	ShardParity *ShardParitySpec `json:"shardParity,omitempty"`
+
+	// PartitionAware coordinates scaling of a StatefulSet target with its
+	// partitioned rolling updates, e.g. of sharded model index servers.
+	// Scale-down never removes ordinals below the update partition, nor the
+	// freshly updated ordinals while a rollout is in progress, and scale-up
+	// waits until the rollout has updated the existing ordinals.
+	// +optional
+	PartitionAware bool `json:"partitionAware,omitempty"`
+
+	// Backpressure publishes a load shedding signal for inference gateways
+	// while the policy is pinned at maxReplicas with metrics above target
+	// +optional
+	Backpressure *BackpressureSpec `json:"backpressure,omitempty"`
+
+	// DecisionSnapshot writes a compact, size-capped JSON snapshot of each
+	// scaling decision, with its inputs and the constraints that changed it,
+	// to status.lastDecision for audit tooling
+	// +optional
+	DecisionSnapshot bool `json:"decisionSnapshot,omitempty"`
+
+	// Fallback sets the replicas used while metrics cannot be fetched. Without
+	// it the current replicas are held.
+	// +optional
+	Fallback *FallbackSpec `json:"fallback,omitempty"`
+
+	// DrainGuard protects the target's capacity while nodes running its pods
+	// are cordoned or drained, e.g. during a cluster upgrade
+	// +optional
+	DrainGuard *DrainGuardSpec `json:"drainGuard,omitempty"`
+
+	// PodMonitor has the controller manage a Prometheus Operator PodMonitor
+	// scraping the target's pods, so the metrics the policy scales on are
+	// collected. It requires the ManagedPodMonitors feature gate.
+	// +optional
+	PodMonitor *PodMonitorSpec `json:"podMonitor,omitempty"`
+}
+
+// PodMonitorSpec configures the PodMonitor managed for the target. It is
+// named after the policy, selects the target's pods and is removed with the
+// policy, or once no enabled metric is served by the target's pods.
+type PodMonitorSpec struct {
+	// Port is the name of the container port serving metrics
+	// +kubebuilder:validation:MinLength=1
+	Port string `json:"port"`
+
+	// Path is the HTTP path metrics are served on
+	// +kubebuilder:default="/metrics"
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// IntervalSeconds is how often the pods are scraped. Defaults to the
+	// polling interval, so every reconcile sees a fresh sample.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// Labels are set on the PodMonitor so that the podMonitorSelector of the
+	// Prometheus instance the policy queries selects it
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DrainGuardSpec configures scaling while target pods run on cordoned nodes
+// or are being evicted, so planned maintenance and a scale-down do not take
+// capacity away at the same time
+type DrainGuardSpec struct {
+	// Enabled holds scale-down while any target pod is on a cordoned node or
+	// being evicted
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// CompensateScaleUp adds a replica for every draining pod, up to
+	// maxReplicas, so the replicas outside the drained nodes keep up with the
+	// recommendation while the evicted pods are rescheduled
+	// +optional
+	CompensateScaleUp bool `json:"compensateScaleUp,omitempty"`
+}
+
+// FallbackSpec configures the replicas of a target whose metrics cannot be
+// fetched, so SLO-critical workloads are not left undersized through a
+// metrics outage
+type FallbackSpec struct {
+	// Behavior is applied once FailureThreshold consecutive fetches have
+	// failed: Hold keeps the current replicas, ScaleToMin and ScaleToMax move
+	// to minReplicas or maxReplicas, and ScaleToFixed moves to Replicas
+	// +kubebuilder:validation:Enum=Hold;ScaleToMin;ScaleToFixed;ScaleToMax
+	// +kubebuilder:default="Hold"
+	// +optional
+	Behavior string `json:"behavior,omitempty"`
+
+	// Replicas is the replica count for ScaleToFixed, kept within minReplicas
+	// and maxReplicas
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// FailureThreshold is how many consecutive reconciles must fail to fetch
+	// metrics before the behavior is applied
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// BackpressureSpec configures the load shedding signal published while the
+// policy wants more than maxReplicas and metrics remain above target. The
+// signal is the ratio of the most overloaded metric to its target, such as
+// "1.35"; a gateway that sheds or queues 1 - 1/ratio of the incoming requests
+// brings the target back within its targets. The ratio is always exported as
+// the kubeai_autoscaler_backpressure_ratio gauge and is additionally written
+// to the target annotation and ConfigMap that are set.
+type BackpressureSpec struct {
+	// Annotation is set on the target workload to the ratio while the signal
+	// is published and removed once it is cleared
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+
+	// ConfigMapName names a ConfigMap in the policy's namespace whose key holds
+	// the ratio while the signal is published and "0" once it is withdrawn. It
+	// is created if missing.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// Key is the ConfigMap data key holding the ratio
+	// +kubebuilder:default="backpressure"
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// DelaySeconds is how long the policy must stay saturated before the
+	// signal is published, so that brief bursts during a scale-up are absorbed
+	// by the replicas that are still starting
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DelaySeconds int32 `json:"delaySeconds,omitempty"`
+}
+
+// ShardParitySpec configures scaling a target whose replicas shard a KV or
+// embedding cache by replica count. The shard count is read from a ConfigMap;
+// the replicas only move to multiples or divisors of it, so every shard is
+// split or merged whole, and the ConfigMap is updated to the new replica count
+// once the target has rolled out.
+type ShardParitySpec struct {
+	// ConfigMapName names the ConfigMap in the policy's namespace holding the shard count
+	// +kubebuilder:validation:MinLength=1
+	ConfigMapName string `json:"configMapName"`
+
+	// Key is the ConfigMap data key holding the shard count
+	// +kubebuilder:default="shardCount"
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// ScaleToZeroSpec configures scaling an idle target to zero replicas
+type ScaleToZeroSpec struct {
+	// IdlePeriodSeconds is how long every enabled metric must report no load
+	// before the target is scaled to zero
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IdlePeriodSeconds int32 `json:"idlePeriodSeconds,omitempty"`
+}
+
+// SmoothingSpec configures smoothing of replica recommendations
+type SmoothingSpec struct {
+	// Factor is the weight of the newest recommendation in the exponential
+	// moving average; lower values react more slowly
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:ExclusiveMinimum=true
+	// +kubebuilder:validation:Maximum=1
+	// +kubebuilder:default=0.3
+	// +optional
+	Factor float64 `json:"factor,omitempty"`
+
+	// MaxUpPercent caps the increase per reconcile as a percentage of the
+	// current replicas (0 disables the cap)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxUpPercent int32 `json:"maxUpPercent,omitempty"`
+
+	// MaxDownPercent caps the decrease per reconcile as a percentage of the
+	// current replicas (0 disables the cap)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxDownPercent int32 `json:"maxDownPercent,omitempty"`
+}
+
+// TargetModulation overrides metric targets during a recurring time window
+type TargetModulation struct {
+	// Name identifies the window in status
+	Name string `json:"name"`
+
+	// Start of the window as HH:MM
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End of the window as HH:MM. A window ending before it starts runs past midnight.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// DaysOfWeek the window starts on (Mon through Sun); every day when empty
+	// +kubebuilder:validation:items:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	// +listType=atomic
+	// +optional
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+
+	// TimeZone is the IANA time zone Start and End are interpreted in (defaults to UTC)
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Targets replace the corresponding spec.metrics targets while the window is active.
+	// Zero values keep the configured target.
+	Targets MetricTargets `json:"targets"`
+}
+
+// MetricTargets holds per-metric scaling targets
+type MetricTargets struct {
+	// LatencyP99Ms is the P99 latency target in milliseconds
+	// +optional
+	LatencyP99Ms *resource.Quantity `json:"latencyP99Ms,omitempty"`
+
+	// LatencyP95Ms is the P95 latency target in milliseconds
+	// +optional
+	LatencyP95Ms *resource.Quantity `json:"latencyP95Ms,omitempty"`
+
+	// GPUUtilizationPercent is the GPU utilization target percentage
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	GPUUtilizationPercent int32 `json:"gpuUtilizationPercent,omitempty"`
+
+	// RequestQueueDepth is the per-replica queue depth target
+	// +optional
+	RequestQueueDepth *resource.Quantity `json:"requestQueueDepth,omitempty"`
 }
 
 // AlgorithmSpec defines the scaling algorithm configuration
 type AlgorithmSpec struct {
-	// Name is the algorithm name (built-in: MaxRatio, AverageRatio, WeightedRatio, or custom)
+	// Name is the algorithm name (built-in: MaxRatio, AverageRatio, WeightedRatio, Predictive, LittlesLaw, or custom)
 	// +kubebuilder:default="MaxRatio"
 	Name string `json:"name"`
 
+	// Fallback is the algorithm used when Name is not registered or does not
+	// complete in time, e.g. a conservative algorithm that holds replicas.
+	// Defaults to the controller's --fallback-algorithm.
+	// +optional
+	Fallback string `json:"fallback,omitempty"`
+
 	// Tolerance is the percentage tolerance before scaling (e.g., 0.1 = 10%)
 	// +kubebuilder:default=0.1
 	// +kubebuilder:validation:Minimum=0
@@ -86,8 +405,71 @@ type AlgorithmSpec struct {
 	Tolerance float64 `json:"tolerance,omitempty"`
 
 	// Weights for WeightedRatio algorithm (optional, only used by WeightedRatio)
+	// +listType=atomic
 	// +optional
 	Weights []float64 `json:"weights,omitempty"`
+
+	// ScaleDownEnabled lets MaxRatio recommend fewer replicas when every metric
+	// is below target (optional, only used by MaxRatio). By default MaxRatio
+	// floors the maximum ratio at 1.0 and never scales down.
+	// +optional
+	ScaleDownEnabled bool `json:"scaleDownEnabled,omitempty"`
+
+	// Parameters configures algorithms that declare parameters, such as plugins,
+	// per policy. Values are strings checked against the type the algorithm
+	// declares for each parameter.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// ForecastHorizonSeconds is how far ahead the Predictive algorithm forecasts
+	// load (optional, only used by Predictive; defaults to 300)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ForecastHorizonSeconds int32 `json:"forecastHorizonSeconds,omitempty"`
+
+	// SeasonalityPeriodSeconds is the length of a recurring load pattern the
+	// Predictive algorithm learns, e.g. 86400 for a daily cycle (optional, only
+	// used by Predictive; 0 disables seasonality)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SeasonalityPeriodSeconds int32 `json:"seasonalityPeriodSeconds,omitempty"`
+}
+
+// CapacityProbeSpec configures discovery of per-replica serving capacity
+type CapacityProbeSpec struct {
+	// Source is where capacity metadata is read from (Annotation or HTTP)
+	// +kubebuilder:validation:Enum=Annotation;HTTP
+	// +kubebuilder:default="Annotation"
+	Source string `json:"source,omitempty"`
+
+	// AnnotationKey is the pod template annotation holding the capacity
+	// (defaults to kubeai.io/max-concurrency)
+	// +optional
+	AnnotationKey string `json:"annotationKey,omitempty"`
+
+	// URL is the endpoint reporting capacity metadata when Source is HTTP
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Format of the HTTP response (Triton model config or JSON with max_num_seqs)
+	// +kubebuilder:validation:Enum=Triton;JSON
+	// +optional
+	Format string `json:"format,omitempty"`
+}
+
+// PrometheusSpec configures the Prometheus servers a policy reads metrics from
+type PrometheusSpec struct {
+	// Addresses of the Prometheus servers to query concurrently
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Addresses []string `json:"addresses"`
+
+	// Merge is how results from several servers are combined (FirstSuccess, Max or Avg).
+	// A query fails only when every server fails.
+	// +kubebuilder:validation:Enum=FirstSuccess;Max;Avg
+	// +kubebuilder:default="FirstSuccess"
+	// +optional
+	Merge string `json:"merge,omitempty"`
 }
 
 // TargetRef references the target resource to scale
@@ -95,8 +477,9 @@ type TargetRef struct {
 	// APIVersion of the target resource
 	APIVersion string `json:"apiVersion"`
 
-	// Kind of the target resource (Deployment or StatefulSet)
-	// +kubebuilder:validation:Enum=Deployment;StatefulSet
+	// Kind of the target resource. Any kind exposing the scale subresource is
+	// supported, e.g. Deployment, StatefulSet, an Argo Rollout or a KServe InferenceService.
+	// +kubebuilder:validation:MinLength=1
 	Kind string `json:"kind"`
 
 	// Name of the target resource
@@ -113,9 +496,241 @@ type MetricsSpec struct {
 	// +optional
 	GPUUtilization *GPUUtilizationMetric `json:"gpuUtilization,omitempty"`
 
+	// CPUUtilization scales on the CPU usage of the target's pods relative to
+	// their requests, read from metrics-server, for CPU-bound models such as
+	// embedding or reranking servers
+	// +optional
+	CPUUtilization *ResourceUtilizationMetric `json:"cpuUtilization,omitempty"`
+
+	// MemoryUtilization scales on the memory usage of the target's pods
+	// relative to their requests, read from metrics-server
+	// +optional
+	MemoryUtilization *ResourceUtilizationMetric `json:"memoryUtilization,omitempty"`
+
 	// Request queue depth-based scaling configuration
 	// +optional
 	RequestQueueDepth *QueueDepthMetric `json:"requestQueueDepth,omitempty"`
+
+	// Token throughput-based scaling configuration
+	// +optional
+	TokensPerSecond *TokensPerSecondMetric `json:"tokensPerSecond,omitempty"`
+
+	// In-flight request (concurrency)-based scaling configuration
+	// +optional
+	InFlightRequests *InFlightRequestsMetric `json:"inFlightRequests,omitempty"`
+
+	// SyntheticProbe scales on the end-to-end latency of a synthetic request
+	// sent to the inference endpoint on an interval
+	// +optional
+	SyntheticProbe *SyntheticProbeMetric `json:"syntheticProbe,omitempty"`
+
+	// Queueing fetches the request arrival rate and mean service time used by
+	// the LittlesLaw algorithm
+	// +optional
+	Queueing *QueueingMetric `json:"queueing,omitempty"`
+
+	// CustomMetrics scales on arbitrary queries, such as tokens per second or
+	// cache hit rate, alongside the built-in metrics
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	CustomMetrics []CustomMetric `json:"customMetrics,omitempty"`
+
+	// Preset fills in the queries of the enabled built-in metrics for a serving
+	// runtime, restricted to the target's pods. Queries set on a metric take
+	// precedence. Needs a PromQL source and kube-state-metrics.
+	// +kubebuilder:validation:Enum=vLLM;Triton;TGI
+	// +optional
+	Preset string `json:"preset,omitempty"`
+
+	// PresetModel restricts the preset's queries to the models whose name
+	// matches this regular expression, for runtimes serving several models.
+	// Not supported by TGI, whose series carry no model.
+	// +optional
+	PresetModel string `json:"presetModel,omitempty"`
+
+	// Sources lists metric sources in priority order. Each reconcile uses the
+	// first healthy source; when empty, spec.prometheus or the controller
+	// default is used.
+	// +listType=atomic
+	// +optional
+	Sources []MetricSource `json:"sources,omitempty"`
+}
+
+// CustomMetric scales on the value of an arbitrary query
+type CustomMetric struct {
+	// Name identifies the metric in status
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Query returning the metric; PromQL for Prometheus sources, a metric name for PodScrape
+	// and OTLP, a metric math expression for CloudWatch, PromQL or MQL for CloudMonitoring, a
+	// custom or external metric for MetricsAPI
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+
+	// TargetValue is the desired value of the aggregated metric
+	TargetValue float64 `json:"targetValue"`
+
+	// TargetType is how TargetValue is compared, as in an autoscaling/v2
+	// MetricTarget: Value compares the aggregated metric with it, AverageValue
+	// compares the aggregated metric divided by the current replicas
+	// +kubebuilder:validation:Enum=Value;AverageValue
+	// +kubebuilder:default="Value"
+	// +optional
+	TargetType string `json:"targetType,omitempty"`
+
+	// Aggregation combines the samples returned by the query (Average, Sum, Max or Min)
+	// +kubebuilder:validation:Enum=Average;Sum;Max;Min
+	// +kubebuilder:default="Average"
+	// +optional
+	Aggregation string `json:"aggregation,omitempty"`
+
+	// Transform corrects the units of the query result
+	// +optional
+	Transform *MetricTransform `json:"transform,omitempty"`
+
+	// Window evaluates the query over a range of recent samples instead of
+	// the latest one
+	// +optional
+	Window *MetricWindow `json:"window,omitempty"`
+
+	// OnMissing is what happens when the query fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
+}
+
+// MetricTransform adapts a query result to the units the controller expects,
+// for exporters reporting latencies in microseconds or utilization as a
+// fraction. The raw value is multiplied by Multiplier, Offset is added and the
+// result is clamped to [ClampMin, ClampMax] before it is compared with the target.
+type MetricTransform struct {
+	// Multiplier scales the raw value, e.g. 0.000001 for latencies in microseconds
+	// or 100 for utilization reported as a fraction. Defaults to 1.
+	// +optional
+	Multiplier *float64 `json:"multiplier,omitempty"`
+
+	// Offset is added to the scaled value
+	// +optional
+	Offset float64 `json:"offset,omitempty"`
+
+	// ClampMin is the lowest value passed on
+	// +optional
+	ClampMin *float64 `json:"clampMin,omitempty"`
+
+	// ClampMax is the highest value passed on
+	// +optional
+	ClampMax *float64 `json:"clampMax,omitempty"`
+}
+
+// MetricWindow evaluates a metric query as a range query over the last
+// Seconds and combines each series' samples with Aggregation, so a single
+// noisy sample does not drive a scaling decision
+type MetricWindow struct {
+	// Seconds is the length of the window ending at the time of the query
+	// +kubebuilder:validation:Minimum=1
+	Seconds int32 `json:"seconds"`
+
+	// StepSeconds is the resolution of the range query. Defaults to 15.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StepSeconds int32 `json:"stepSeconds,omitempty"`
+
+	// Aggregation combines the samples in the window (Avg, Max or P90)
+	// +kubebuilder:validation:Enum=Avg;Max;P90
+	// +kubebuilder:default="Avg"
+	// +optional
+	Aggregation string `json:"aggregation,omitempty"`
+}
+
+// MetricSource is one entry in a metric source failover chain
+type MetricSource struct {
+	// Name identifies the source in status and events
+	Name string `json:"name"`
+
+	// Type of the source (Prometheus, PodScrape, CloudWatch, CloudMonitoring, SQS,
+	// RabbitMQ, Kafka, MetricsAPI or OTLP)
+	// +kubebuilder:validation:Enum=Prometheus;PodScrape;CloudWatch;CloudMonitoring;SQS;RabbitMQ;Kafka;MetricsAPI;OTLP
+	Type string `json:"type"`
+
+	// Address of the Prometheus-compatible server (Prometheus, Thanos) when Type is
+	// Prometheus; for CloudWatch and CloudMonitoring, an endpoint replacing the
+	// public one; the management API when Type is RabbitMQ and the REST Proxy
+	// when Type is Kafka
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Brokers are the host:port bootstrap addresses of a Kafka cluster whose
+	// consumer lag is read over the Kafka protocol when Type is Kafka, instead
+	// of through a REST Proxy at Address
+	// +listType=atomic
+	// +optional
+	Brokers []string `json:"brokers,omitempty"`
+
+	// TLS connects to the Brokers over TLS, verified against the controller's
+	// trusted roots, when Type is Kafka. It is required with CredentialsSecret,
+	// so that credentials are never sent to the brokers in the clear.
+	// +optional
+	TLS bool `json:"tls,omitempty"`
+
+	// Region of CloudWatch or SQS when Type is CloudWatch or SQS
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// RoleARN is an IAM role assumed, with the controller's own credentials,
+	// to read CloudWatch or SQS when Type is CloudWatch or SQS
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// Project is the ID of the Google Cloud project whose metrics are read when
+	// Type is CloudMonitoring
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// QueryLanguage of the queries when Type is CloudMonitoring: PromQL, which
+	// also reads Google Cloud Managed Service for Prometheus, or MQL
+	// +kubebuilder:validation:Enum=PromQL;MQL
+	// +kubebuilder:default="PromQL"
+	// +optional
+	QueryLanguage string `json:"queryLanguage,omitempty"`
+
+	// Queue whose backlog is the requestQueueDepth metric when Type is SQS (the
+	// queue URL), RabbitMQ (the queue name, prefixed with "vhost/" outside the
+	// default virtual host) or Kafka (the consumer group)
+	// +optional
+	Queue string `json:"queue,omitempty"`
+
+	// Topic restricts the lag of the consumer group to one topic when Type is
+	// Kafka; by default the lag of every topic the group consumes is summed
+	// +optional
+	Topic string `json:"topic,omitempty"`
+
+	// CredentialsSecret names a Secret in the policy's namespace with the
+	// username and password keys the source authenticates with when Type is
+	// RabbitMQ or Kafka
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// Port on the target pods serving metrics when Type is PodScrape
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Path of the metrics endpoint on the target pods when Type is PodScrape
+	// +kubebuilder:default="/metrics"
+	// +optional
+	Path string `json:"path,omitempty"`
 }
 
 // LatencyMetric defines latency-based scaling
@@ -124,17 +739,40 @@ type LatencyMetric struct {
 	// +kubebuilder:default=true
 	Enabled bool `json:"enabled,omitempty"`
 
-	// TargetP99Ms is the target P99 latency in milliseconds
+	// TargetP99Ms is the target P99 latency in milliseconds, such as 250 or
+	// "0.5" for sub-millisecond targets
 	// +optional
-	TargetP99Ms int32 `json:"targetP99Ms,omitempty"`
+	TargetP99Ms *resource.Quantity `json:"targetP99Ms,omitempty"`
 
 	// TargetP95Ms is the target P95 latency in milliseconds
 	// +optional
-	TargetP95Ms int32 `json:"targetP95Ms,omitempty"`
+	TargetP95Ms *resource.Quantity `json:"targetP95Ms,omitempty"`
 
 	// PrometheusQuery is a custom Prometheus query for latency metric
 	// +optional
 	PrometheusQuery string `json:"prometheusQuery,omitempty"`
+
+	// Transform corrects the units of the query result
+	// +optional
+	Transform *MetricTransform `json:"transform,omitempty"`
+
+	// Window evaluates the query over a range of recent samples instead of
+	// the latest one
+	// +optional
+	Window *MetricWindow `json:"window,omitempty"`
+
+	// OnMissing is what happens when the query fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
 }
 
 // GPUUtilizationMetric defines GPU utilization-based scaling
@@ -151,6 +789,62 @@ type GPUUtilizationMetric struct {
 	// PrometheusQuery is a custom Prometheus query for GPU utilization
 	// +optional
 	PrometheusQuery string `json:"prometheusQuery,omitempty"`
+
+	// ScopeToTarget averages DCGM_FI_DEV_GPU_UTIL over the GPUs of the target
+	// pods only, by joining the exported_namespace and exported_pod labels of the
+	// DCGM exporter with kube_pod_labels from kube-state-metrics. It takes
+	// precedence over the preset and is ignored when PrometheusQuery is set.
+	// +optional
+	ScopeToTarget bool `json:"scopeToTarget,omitempty"`
+
+	// Transform corrects the units of the query result
+	// +optional
+	Transform *MetricTransform `json:"transform,omitempty"`
+
+	// Window evaluates the query over a range of recent samples instead of
+	// the latest one
+	// +optional
+	Window *MetricWindow `json:"window,omitempty"`
+
+	// OnMissing is what happens when the query fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
+}
+
+// ResourceUtilizationMetric scales on the mean usage of a resource across the
+// target's pods as a percentage of their requests, like the targetAverageUtilization
+// of a HorizontalPodAutoscaler. Every container of the pods must request the resource.
+type ResourceUtilizationMetric struct {
+	// Enabled indicates if scaling on the resource is enabled
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// TargetAverageUtilization is the target mean usage as a percentage of
+	// the requests; it may exceed 100 for pods whose limits allow bursting
+	// +kubebuilder:validation:Minimum=1
+	TargetAverageUtilization int32 `json:"targetAverageUtilization,omitempty"`
+
+	// OnMissing is what happens when the usage cannot be read (Ignore,
+	// FailClosed or UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
 }
 
 // QueueDepthMetric defines queue depth-based scaling
@@ -160,35 +854,298 @@ type QueueDepthMetric struct {
 	Enabled bool `json:"enabled,omitempty"`
 
 	// TargetDepth is the target queue depth per replica
-	// +kubebuilder:validation:Minimum=0
-	TargetDepth int32 `json:"targetDepth,omitempty"`
+	// +optional
+	TargetDepth *resource.Quantity `json:"targetDepth,omitempty"`
 
 	// PrometheusQuery is a custom Prometheus query for queue depth
 	// +optional
 	PrometheusQuery string `json:"prometheusQuery,omitempty"`
+
+	// Transform corrects the units of the query result
+	// +optional
+	Transform *MetricTransform `json:"transform,omitempty"`
+
+	// Window evaluates the query over a range of recent samples instead of
+	// the latest one
+	// +optional
+	Window *MetricWindow `json:"window,omitempty"`
+
+	// OnMissing is what happens when the query fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
+}
+
+// TokensPerSecondMetric defines token throughput-based scaling
+type TokensPerSecondMetric struct {
+	// Enabled indicates if token throughput-based scaling is enabled
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// TargetPerReplica is the target generated tokens per second per replica
+	// +optional
+	TargetPerReplica *resource.Quantity `json:"targetPerReplica,omitempty"`
+
+	// Preset selects the default query for the serving runtime
+	// +kubebuilder:validation:Enum=vLLM;TGI
+	// +kubebuilder:default="vLLM"
+	// +optional
+	Preset string `json:"preset,omitempty"`
+
+	// PrometheusQuery is a custom Prometheus query for tokens per second,
+	// overriding the preset
+	// +optional
+	PrometheusQuery string `json:"prometheusQuery,omitempty"`
+
+	// Transform corrects the units of the query result
+	// +optional
+	Transform *MetricTransform `json:"transform,omitempty"`
+
+	// Window evaluates the query over a range of recent samples instead of
+	// the latest one
+	// +optional
+	Window *MetricWindow `json:"window,omitempty"`
+
+	// OnMissing is what happens when the query fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
+}
+
+// InFlightRequestsMetric defines concurrency-based scaling, like Knative's
+// KPA: replicas are sized so each serves about TargetPerReplica requests at once
+type InFlightRequestsMetric struct {
+	// Enabled indicates if in-flight request-based scaling is enabled
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// TargetPerReplica is the target number of concurrent requests per
+	// replica, such as "0.5" for replicas that serve one request in two
+	// +optional
+	TargetPerReplica *resource.Quantity `json:"targetPerReplica,omitempty"`
+
+	// PrometheusQuery is a custom Prometheus query for in-flight requests
+	// +optional
+	PrometheusQuery string `json:"prometheusQuery,omitempty"`
+
+	// Transform corrects the units of the query result
+	// +optional
+	Transform *MetricTransform `json:"transform,omitempty"`
+
+	// Window evaluates the query over a range of recent samples instead of
+	// the latest one
+	// +optional
+	Window *MetricWindow `json:"window,omitempty"`
+
+	// OnMissing is what happens when the query fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
+}
+
+// SyntheticProbeMetric sends a synthetic inference request every interval and
+// scales on its end-to-end latency, which includes what server-side metrics
+// miss, such as time spent in gateways, load balancers and connection setup
+type SyntheticProbeMetric struct {
+	// Enabled indicates if synthetic probe-based scaling is enabled
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Endpoint is the URL the request is sent to, such as the model's completions API
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Method of the request
+	// +kubebuilder:validation:Enum=GET;POST
+	// +kubebuilder:default="POST"
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// PayloadRef selects the request body from a ConfigMap in the policy's
+	// namespace; without it the request has no body
+	// +optional
+	PayloadRef *PayloadReference `json:"payloadRef,omitempty"`
+
+	// ContentType of the payload. Defaults to application/json.
+	// +optional
+	ContentType string `json:"contentType,omitempty"`
+
+	// TimeoutSeconds bounds a request; one that times out counts as taking the
+	// whole timeout. Defaults to 30.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// IntervalSeconds is how often a request is sent; reconciles in between
+	// use the latency of the latest one. Defaults to 60.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// TargetLatencyMs is the desired end-to-end latency of the request in milliseconds
+	// +optional
+	TargetLatencyMs *resource.Quantity `json:"targetLatencyMs,omitempty"`
+
+	// OnMissing is what happens when the request fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
+}
+
+// PayloadReference selects a key of a ConfigMap
+type PayloadReference struct {
+	// Name of the ConfigMap
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key of the ConfigMap holding the payload
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// QueueingMetric configures the inputs of queueing-based scaling. By Little's
+// Law the requests in service equal the arrival rate times the mean service
+// time; replicas are sized so those requests plus the queued ones fit within
+// TargetUtilization of each replica's concurrency.
+type QueueingMetric struct {
+	// Enabled indicates if the arrival rate and service time are fetched
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// ArrivalRateQuery is a custom Prometheus query returning requests per
+	// second arriving across all replicas
+	// +optional
+	ArrivalRateQuery string `json:"arrivalRateQuery,omitempty"`
+
+	// ServiceTimeQuery is a custom Prometheus query returning the mean time in
+	// seconds a request takes to serve
+	// +optional
+	ServiceTimeQuery string `json:"serviceTimeQuery,omitempty"`
+
+	// ArrivalRateTransform corrects the units of the arrival rate query result
+	// +optional
+	ArrivalRateTransform *MetricTransform `json:"arrivalRateTransform,omitempty"`
+
+	// ServiceTimeTransform corrects the units of the service time query result
+	// +optional
+	ServiceTimeTransform *MetricTransform `json:"serviceTimeTransform,omitempty"`
+
+	// Window evaluates both queries over a range of recent samples instead of
+	// the latest one
+	// +optional
+	Window *MetricWindow `json:"window,omitempty"`
+
+	// ConcurrencyPerReplica is the number of requests a replica serves at once.
+	// Defaults to status.discoveredCapacity, or 1 when no capacity was discovered.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ConcurrencyPerReplica int32 `json:"concurrencyPerReplica,omitempty"`
+
+	// TargetUtilization is the percentage of each replica's concurrency to plan for
+	// +kubebuilder:default=80
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TargetUtilization int32 `json:"targetUtilization,omitempty"`
+
+	// OnMissing is what happens when the query fails (Ignore, FailClosed or
+	// UseLastValue)
+	// +kubebuilder:validation:Enum=Ignore;FailClosed;UseLastValue
+	// +kubebuilder:default="Ignore"
+	// +optional
+	OnMissing string `json:"onMissing,omitempty"`
+
+	// MaxStalenessSeconds is how old a value UseLastValue may reuse.
+	// Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxStalenessSeconds int32 `json:"maxStalenessSeconds,omitempty"`
 }
 
 // ScaleBehavior defines scaling behavior
 type ScaleBehavior struct {
-	// StabilizationWindowSeconds is the stabilization window
+	// Disabled stops the controller from scaling in this direction. Recommendations
+	// are still computed and reported in status.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Algorithm replaces spec.algorithm.name when its recommendation moves the
+	// replicas in this direction, e.g. an aggressive algorithm for scale-up and
+	// a conservative one for scale-down. Tolerance and parameters are shared
+	// with spec.algorithm.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// StabilizationWindowSeconds is how far back recommendations are considered.
+	// Scale-up uses the lowest recommendation within the window and scale-down the
+	// highest, smoothing out flapping metrics.
 	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
 	StabilizationWindowSeconds int32 `json:"stabilizationWindowSeconds,omitempty"`
 
-	// Policies is a list of scaling policies
+	// Policies limit how much the replicas may change per period. When several
+	// apply, selectPolicy picks the one used.
+	// +listType=atomic
 	// +optional
 	Policies []ScalingPolicy `json:"policies,omitempty"`
+
+	// SelectPolicy picks the policy used when several apply, as on a
+	// HorizontalPodAutoscaler: Max, the one allowing the largest change, Min,
+	// the one allowing the smallest, or Disabled, which disables scaling in
+	// this direction like disabled. Defaults to Max.
+	// +kubebuilder:validation:Enum=Max;Min;Disabled
+	// +optional
+	SelectPolicy string `json:"selectPolicy,omitempty"`
 }
 
-// ScalingPolicy defines a scaling policy
+// ScalingPolicy limits the replica change within a period
 type ScalingPolicy struct {
 	// Type is the type of scaling policy (Pods or Percent)
 	// +kubebuilder:validation:Enum=Pods;Percent
 	Type string `json:"type"`
 
-	// Value is the value for the policy
+	// Value is the number of replicas (Pods) or the percentage of the replicas at
+	// the start of the period (Percent) the change is limited to
+	// +kubebuilder:validation:Minimum=1
 	Value int32 `json:"value"`
 
-	// PeriodSeconds is the period for the policy
+	// PeriodSeconds is the length of the period the limit applies to
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1800
 	PeriodSeconds int32 `json:"periodSeconds"`
 }
 
@@ -200,14 +1157,32 @@ type AIInferenceAutoscalerPolicyStatus struct {
 	// DesiredReplicas is the desired number of replicas
 	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
 
+	// RecommendedReplicas is the replica count recommended by the algorithm before
+	// behavior limits were applied
+	// +optional
+	RecommendedReplicas int32 `json:"recommendedReplicas,omitempty"`
+
 	// LastScaleTime is the last time the policy scaled the target
 	// +optional
 	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
 
+	// CooldownRemainingSeconds is how long the cooldown period held back the
+	// last scaling decision for. Zero when no cooldown is in effect.
+	// +optional
+	CooldownRemainingSeconds int32 `json:"cooldownRemainingSeconds,omitempty"`
+
 	// CurrentMetrics contains the current metric values
 	// +optional
 	CurrentMetrics *CurrentMetrics `json:"currentMetrics,omitempty"`
 
+	// MetricHistory holds the last samples of each enabled metric, so trends
+	// can be shown without querying Prometheus. Recorded only while the
+	// MetricHistory feature gate of the controller is enabled.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	MetricHistory []MetricHistory `json:"metricHistory,omitempty"`
+
 	// LastAlgorithm is the algorithm used for the last scaling decision
 	// +optional
 	LastAlgorithm string `json:"lastAlgorithm,omitempty"`
@@ -216,24 +1191,185 @@ type AIInferenceAutoscalerPolicyStatus struct {
 	// +optional
 	LastScaleReason string `json:"lastScaleReason,omitempty"`
 
+	// LastDrivingMetric is the metric the algorithm reported as determining
+	// the last scaling decision
+	// +optional
+	LastDrivingMetric string `json:"lastDrivingMetric,omitempty"`
+
+	// LastUnclampedReplicas is the replica count the algorithm recommended
+	// before minReplicas and maxReplicas were applied
+	// +optional
+	LastUnclampedReplicas int32 `json:"lastUnclampedReplicas,omitempty"`
+
+	// LastConfidence is how much the algorithm trusted the last scaling
+	// decision, from 0 to 1. Unset when the algorithm does not report one.
+	// +optional
+	LastConfidence float64 `json:"lastConfidence,omitempty"`
+
+	// LastDecision is the JSON snapshot of the last scaling decision, written
+	// when spec.decisionSnapshot is set. Its "version" field names the schema.
+	// +optional
+	LastDecision string `json:"lastDecision,omitempty"`
+
+	// DecisionConstraints lists, in order, each constraint that moved the last
+	// decision away from the algorithm's raw recommendation, so tuning can
+	// target the binding one. Empty when the raw recommendation was applied.
+	// +listType=atomic
+	// +optional
+	DecisionConstraints []DecisionConstraint `json:"decisionConstraints,omitempty"`
+
+	// DiscoveredCapacity is the per-replica capacity reported by the capacity probe
+	// +optional
+	DiscoveredCapacity int32 `json:"discoveredCapacity,omitempty"`
+
+	// GPUsPerReplica is the nvidia.com/gpu count requested by each target
+	// replica, read when spec.targetsPerGPU is set
+	// +optional
+	GPUsPerReplica int32 `json:"gpusPerReplica,omitempty"`
+
+	// SaturatedSince is when the policy started wanting maxReplicas while metrics stayed above target
+	// +optional
+	SaturatedSince *metav1.Time `json:"saturatedSince,omitempty"`
+
+	// MetricsSource is the name of the metric source used by the last reconcile
+	// +optional
+	MetricsSource string `json:"metricsSource,omitempty"`
+
+	// EffectiveTargets are the metric targets used by the last scaling decision
+	// +optional
+	EffectiveTargets *MetricTargets `json:"effectiveTargets,omitempty"`
+
+	// ActiveTargetModulation is the name of the targetModulation window applied
+	// to the last scaling decision
+	// +optional
+	ActiveTargetModulation string `json:"activeTargetModulation,omitempty"`
+
+	// ModelProfile is the model profile whose defaults apply to the policy,
+	// selected from the model annotations of the target
+	// +optional
+	ModelProfile string `json:"modelProfile,omitempty"`
+
+	// IdleSince is when the target, running with minReplicas 0, stopped reporting load
+	// +optional
+	IdleSince *metav1.Time `json:"idleSince,omitempty"`
+
+	// PreferredGPUPool is the GPU pool the external optimizer last advised
+	// the target to run on
+	// +optional
+	PreferredGPUPool string `json:"preferredGPUPool,omitempty"`
+
+	// MetricsFailureCount is how many consecutive reconciles failed to fetch
+	// metrics. It is reset by the next successful fetch.
+	// +optional
+	MetricsFailureCount int32 `json:"metricsFailureCount,omitempty"`
+
 	// Conditions represent the latest available observations
+	// +listType=map
+	// +listMapKey=type
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // CurrentMetrics contains current metric values
 type CurrentMetrics struct {
-	// LatencyP99Ms is the current P99 latency in milliseconds
-	LatencyP99Ms int32 `json:"latencyP99Ms,omitempty"`
+	// LatencyP99Ms is the current P99 latency in milliseconds, to the microsecond
+	LatencyP99Ms float64 `json:"latencyP99Ms,omitempty"`
 
-	// LatencyP95Ms is the current P95 latency in milliseconds
-	LatencyP95Ms int32 `json:"latencyP95Ms,omitempty"`
+	// LatencyP95Ms is the current P95 latency in milliseconds, to the microsecond
+	LatencyP95Ms float64 `json:"latencyP95Ms,omitempty"`
 
 	// GPUUtilizationPercent is the current GPU utilization percentage
 	GPUUtilizationPercent int32 `json:"gpuUtilizationPercent,omitempty"`
 
+	// CPUUtilizationPercent is the current mean CPU usage of the target's pods
+	// as a percentage of their requests
+	// +optional
+	CPUUtilizationPercent int32 `json:"cpuUtilizationPercent,omitempty"`
+
+	// MemoryUtilizationPercent is the current mean memory usage of the
+	// target's pods as a percentage of their requests
+	// +optional
+	MemoryUtilizationPercent int32 `json:"memoryUtilizationPercent,omitempty"`
+
 	// RequestQueueDepth is the current request queue depth
 	RequestQueueDepth int32 `json:"requestQueueDepth,omitempty"`
+
+	// TokensPerSecond is the current generated tokens per second across all replicas
+	TokensPerSecond int32 `json:"tokensPerSecond,omitempty"`
+
+	// InFlightRequests is the current number of requests being served across all replicas
+	InFlightRequests int32 `json:"inFlightRequests,omitempty"`
+
+	// SyntheticLatencyMs is the end-to-end latency of the latest synthetic
+	// request in milliseconds
+	// +optional
+	SyntheticLatencyMs int32 `json:"syntheticLatencyMs,omitempty"`
+
+	// ArrivalRate is the current requests per second arriving across all
+	// replicas; unset when it was not reported, zero when no requests arrive
+	// +optional
+	ArrivalRate *float64 `json:"arrivalRate,omitempty"`
+
+	// ServiceTimeMs is the current mean time to serve a request in
+	// milliseconds, to the microsecond; unset when it was not reported
+	// +optional
+	ServiceTimeMs *float64 `json:"serviceTimeMs,omitempty"`
+
+	// CostPerReplicaHour is the realized cost of running one replica for an
+	// hour, reported when the controller is connected to OpenCost
+	// +optional
+	CostPerReplicaHour float64 `json:"costPerReplicaHour,omitempty"`
+
+	// CostPer1kRequests is the realized cost of serving 1000 requests at the
+	// current arrival rate, reported when queueing metrics are enabled
+	// +optional
+	CostPer1kRequests float64 `json:"costPer1kRequests,omitempty"`
+
+	// Custom holds the current values of spec.metrics.customMetrics
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Custom []CustomMetricValue `json:"custom,omitempty"`
+}
+
+// CustomMetricValue is the current value of a custom metric
+type CustomMetricValue struct {
+	// Name of the custom metric
+	Name string `json:"name"`
+
+	// Value is the aggregated value returned by the query
+	Value float64 `json:"value"`
+}
+
+// DecisionConstraint is a step of a scaling decision that changed the replicas
+type DecisionConstraint struct {
+	// Name of the constraint, such as maxReplicas, scalingPolicy or shardParity
+	Name string `json:"name"`
+
+	// From is the replica count before the constraint applied
+	From int32 `json:"from"`
+
+	// To is the replica count the constraint changed it to
+	To int32 `json:"to"`
+
+	// Delta is the change made by the constraint, To minus From
+	Delta int32 `json:"delta"`
+}
+
+// MetricHistory is a compact history of one metric's values
+type MetricHistory struct {
+	// Name of the metric as reported in currentMetrics, or of the custom metric
+	Name string `json:"name"`
+
+	// Samples are the last values, oldest first, delta encoded as
+	// comma-separated integers: the first is a value and each following one
+	// the change from the value before, e.g. "70,2,-5,0" for 70, 72, 67, 67
+	Samples string `json:"samples"`
+
+	// Decimals is the number of decimal places of the integers: with 3,
+	// "1500" is 1.5
+	// +optional
+	Decimals int32 `json:"decimals,omitempty"`
 }
 
 // +kubebuilder:object:root=true
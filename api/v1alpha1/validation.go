@@ -18,6 +18,14 @@ package v1alpha1
 
 import (
 	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Validate validates the AIInferenceAutoscalerPolicy
@@ -34,19 +42,101 @@ func (s *AIInferenceAutoscalerPolicySpec) Validate() error {
 	if s.TargetRef.Name == "" {
 		return fmt.Errorf("targetRef.name is required")
 	}
-	if s.TargetRef.Kind != "Deployment" && s.TargetRef.Kind != "StatefulSet" {
-		return fmt.Errorf("targetRef.kind must be Deployment or StatefulSet")
+	if s.TargetRef.Kind == "" {
+		return fmt.Errorf("targetRef.kind is required")
+	}
+	if s.TargetRef.APIVersion == "" && !isAppsKind(s.TargetRef.Kind) {
+		return fmt.Errorf("targetRef.apiVersion is required for kind %s", s.TargetRef.Kind)
 	}
 
 	// Validate replicas
 	if s.MaxReplicas <= 0 {
 		return fmt.Errorf("maxReplicas must be greater than 0")
 	}
-	if s.MinReplicas < 0 {
-		return fmt.Errorf("minReplicas cannot be negative")
+	if s.MinReplicas != nil {
+		if *s.MinReplicas < 0 {
+			return fmt.Errorf("minReplicas cannot be negative")
+		}
+		if *s.MinReplicas > s.MaxReplicas {
+			return fmt.Errorf("minReplicas cannot be greater than maxReplicas")
+		}
+	}
+
+	if s.PollingInterval < 0 || s.PollingInterval > 3600 {
+		return fmt.Errorf("pollingInterval must be between 1 and 3600")
+	}
+
+	// Validate scale-to-zero
+	if s.ScaleToZero != nil {
+		if s.MinReplicas == nil || *s.MinReplicas != 0 {
+			return fmt.Errorf("scaleToZero requires minReplicas 0")
+		}
+		if s.ScaleToZero.IdlePeriodSeconds < 0 {
+			return fmt.Errorf("scaleToZero.idlePeriodSeconds cannot be negative")
+		}
+	}
+
+	// Validate scaling behavior
+	if s.ScaleUp != nil {
+		if err := s.ScaleUp.Validate(); err != nil {
+			return fmt.Errorf("scaleUp validation failed: %w", err)
+		}
+	}
+	if s.ScaleDown != nil {
+		if err := s.ScaleDown.Validate(); err != nil {
+			return fmt.Errorf("scaleDown validation failed: %w", err)
+		}
+	}
+
+	// Validate algorithm parameters
+	if s.Algorithm != nil {
+		if err := s.Algorithm.Validate(); err != nil {
+			return fmt.Errorf("algorithm validation failed: %w", err)
+		}
+	}
+
+	// Validate recommendation smoothing
+	if s.Smoothing != nil {
+		if err := s.Smoothing.Validate(); err != nil {
+			return fmt.Errorf("smoothing validation failed: %w", err)
+		}
+	}
+
+	for key := range s.EventMetadata {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("eventMetadata key %q is not a valid annotation key: %s", key, strings.Join(errs, "; "))
+		}
+	}
+
+	// Validate cache shard parity
+	if s.ShardParity != nil && s.ShardParity.ConfigMapName == "" {
+		return fmt.Errorf("shardParity.configMapName is required")
+	}
+
+	// Partition-aware scaling follows the rolling update of a StatefulSet
+	if s.PartitionAware && s.TargetRef.Kind != "StatefulSet" {
+		return fmt.Errorf("partitionAware requires a StatefulSet target, got %s", s.TargetRef.Kind)
+	}
+
+	// Validate the load shedding signal
+	if s.Backpressure != nil {
+		if err := s.Backpressure.Validate(); err != nil {
+			return fmt.Errorf("backpressure validation failed: %w", err)
+		}
+	}
+
+	// Validate the managed PodMonitor
+	if s.PodMonitor != nil {
+		if err := s.PodMonitor.Validate(); err != nil {
+			return fmt.Errorf("podMonitor validation failed: %w", err)
+		}
 	}
-	if s.MinReplicas > s.MaxReplicas {
-		return fmt.Errorf("minReplicas cannot be greater than maxReplicas")
+
+	// Validate the metrics outage fallback
+	if s.Fallback != nil {
+		if err := s.Fallback.Validate(s.MinReplicas, s.MaxReplicas); err != nil {
+			return fmt.Errorf("fallback validation failed: %w", err)
+		}
 	}
 
 	// Validate metrics
@@ -54,6 +144,59 @@ func (s *AIInferenceAutoscalerPolicySpec) Validate() error {
 		return fmt.Errorf("metrics validation failed: %w", err)
 	}
 
+	// Validate capacity probe
+	if s.CapacityProbe != nil {
+		if err := s.CapacityProbe.Validate(); err != nil {
+			return fmt.Errorf("capacityProbe validation failed: %w", err)
+		}
+	}
+
+	// Validate Prometheus endpoints
+	if s.Prometheus != nil {
+		if err := s.Prometheus.Validate(); err != nil {
+			return fmt.Errorf("prometheus validation failed: %w", err)
+		}
+	}
+
+	// Validate target modulation windows
+	names := make(map[string]bool, len(s.TargetModulation))
+	for i := range s.TargetModulation {
+		m := &s.TargetModulation[i]
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("targetModulation[%d] validation failed: %w", i, err)
+		}
+		if names[m.Name] {
+			return fmt.Errorf("targetModulation[%d] validation failed: duplicate name %q", i, m.Name)
+		}
+		names[m.Name] = true
+	}
+
+	return nil
+}
+
+// Validate validates the ScaleBehavior
+func (b *ScaleBehavior) Validate() error {
+	if b.StabilizationWindowSeconds < 0 || b.StabilizationWindowSeconds > 3600 {
+		return fmt.Errorf("stabilizationWindowSeconds must be between 0 and 3600")
+	}
+	for i, p := range b.Policies {
+		switch p.Type {
+		case "Pods", "Percent":
+		default:
+			return fmt.Errorf("policies[%d].type must be Pods or Percent", i)
+		}
+		if p.Value <= 0 {
+			return fmt.Errorf("policies[%d].value must be greater than 0", i)
+		}
+		if p.PeriodSeconds <= 0 || p.PeriodSeconds > 1800 {
+			return fmt.Errorf("policies[%d].periodSeconds must be between 1 and 1800", i)
+		}
+	}
+	switch b.SelectPolicy {
+	case "", "Max", "Min", "Disabled":
+	default:
+		return fmt.Errorf("selectPolicy must be Max, Min or Disabled")
+	}
 	return nil
 }
 
@@ -63,9 +206,24 @@ func (m *MetricsSpec) Validate() error {
 
 	if m.Latency != nil && m.Latency.Enabled {
 		hasEnabledMetric = true
-		if m.Latency.TargetP99Ms <= 0 && m.Latency.TargetP95Ms <= 0 {
+		if m.Latency.TargetP99Ms == nil && m.Latency.TargetP95Ms == nil {
 			return fmt.Errorf("latency metric enabled but no target specified")
 		}
+		if err := validateTarget("latency.targetP99Ms", m.Latency.TargetP99Ms); err != nil {
+			return err
+		}
+		if err := validateTarget("latency.targetP95Ms", m.Latency.TargetP95Ms); err != nil {
+			return err
+		}
+		if err := m.Latency.Transform.Validate(); err != nil {
+			return fmt.Errorf("latency.transform: %w", err)
+		}
+		if err := m.Latency.Window.Validate(); err != nil {
+			return fmt.Errorf("latency.window: %w", err)
+		}
+		if err := validateOnMissing(m.Latency.OnMissing, m.Latency.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("latency: %w", err)
+		}
 	}
 
 	if m.GPUUtilization != nil && m.GPUUtilization.Enabled {
@@ -73,31 +231,536 @@ func (m *MetricsSpec) Validate() error {
 		if m.GPUUtilization.TargetPercentage <= 0 || m.GPUUtilization.TargetPercentage > 100 {
 			return fmt.Errorf("gpuUtilization.targetPercentage must be between 1 and 100")
 		}
+		if err := m.GPUUtilization.Transform.Validate(); err != nil {
+			return fmt.Errorf("gpuUtilization.transform: %w", err)
+		}
+		if err := m.GPUUtilization.Window.Validate(); err != nil {
+			return fmt.Errorf("gpuUtilization.window: %w", err)
+		}
+		if err := validateOnMissing(m.GPUUtilization.OnMissing, m.GPUUtilization.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("gpuUtilization: %w", err)
+		}
+		if m.GPUUtilization.ScopeToTarget {
+			for i := range m.Sources {
+				if !m.Sources[i].readsPromQL() {
+					return fmt.Errorf("gpuUtilization.scopeToTarget needs PromQL sources; sources[%d] is of type %s", i, m.Sources[i].Type)
+				}
+			}
+		}
+	}
+
+	resources := []struct {
+		name   string
+		metric *ResourceUtilizationMetric
+	}{{"cpuUtilization", m.CPUUtilization}, {"memoryUtilization", m.MemoryUtilization}}
+	for _, resource := range resources {
+		if resource.metric == nil || !resource.metric.Enabled {
+			continue
+		}
+		hasEnabledMetric = true
+		if resource.metric.TargetAverageUtilization <= 0 {
+			return fmt.Errorf("%s.targetAverageUtilization must be greater than 0", resource.name)
+		}
+		if err := validateOnMissing(resource.metric.OnMissing, resource.metric.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("%s: %w", resource.name, err)
+		}
 	}
 
 	if m.RequestQueueDepth != nil && m.RequestQueueDepth.Enabled {
 		hasEnabledMetric = true
-		if m.RequestQueueDepth.TargetDepth < 0 {
-			return fmt.Errorf("requestQueueDepth.targetDepth cannot be negative")
+		if err := validateTarget("requestQueueDepth.targetDepth", m.RequestQueueDepth.TargetDepth); err != nil {
+			return err
+		}
+		if err := m.RequestQueueDepth.Transform.Validate(); err != nil {
+			return fmt.Errorf("requestQueueDepth.transform: %w", err)
+		}
+		if err := m.RequestQueueDepth.Window.Validate(); err != nil {
+			return fmt.Errorf("requestQueueDepth.window: %w", err)
+		}
+		if err := validateOnMissing(m.RequestQueueDepth.OnMissing, m.RequestQueueDepth.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("requestQueueDepth: %w", err)
+		}
+	}
+
+	if m.TokensPerSecond != nil && m.TokensPerSecond.Enabled {
+		hasEnabledMetric = true
+		if QuantityFloat64(m.TokensPerSecond.TargetPerReplica) <= 0 {
+			return fmt.Errorf("tokensPerSecond.targetPerReplica must be greater than 0")
+		}
+		switch m.TokensPerSecond.Preset {
+		case "", "vLLM", "TGI":
+		default:
+			return fmt.Errorf("tokensPerSecond.preset must be vLLM or TGI")
+		}
+		if err := m.TokensPerSecond.Transform.Validate(); err != nil {
+			return fmt.Errorf("tokensPerSecond.transform: %w", err)
+		}
+		if err := m.TokensPerSecond.Window.Validate(); err != nil {
+			return fmt.Errorf("tokensPerSecond.window: %w", err)
+		}
+		if err := validateOnMissing(m.TokensPerSecond.OnMissing, m.TokensPerSecond.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("tokensPerSecond: %w", err)
+		}
+	}
+
+	if m.InFlightRequests != nil && m.InFlightRequests.Enabled {
+		hasEnabledMetric = true
+		if QuantityFloat64(m.InFlightRequests.TargetPerReplica) <= 0 {
+			return fmt.Errorf("inFlightRequests.targetPerReplica must be greater than 0")
+		}
+		if err := m.InFlightRequests.Transform.Validate(); err != nil {
+			return fmt.Errorf("inFlightRequests.transform: %w", err)
+		}
+		if err := m.InFlightRequests.Window.Validate(); err != nil {
+			return fmt.Errorf("inFlightRequests.window: %w", err)
+		}
+		if err := validateOnMissing(m.InFlightRequests.OnMissing, m.InFlightRequests.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("inFlightRequests: %w", err)
+		}
+	}
+
+	if m.SyntheticProbe != nil && m.SyntheticProbe.Enabled {
+		hasEnabledMetric = true
+		probe := m.SyntheticProbe
+		if !strings.HasPrefix(probe.Endpoint, "http://") && !strings.HasPrefix(probe.Endpoint, "https://") {
+			return fmt.Errorf("syntheticProbe.endpoint must be an http or https URL")
+		}
+		if QuantityFloat64(probe.TargetLatencyMs) <= 0 {
+			return fmt.Errorf("syntheticProbe.targetLatencyMs must be greater than 0")
+		}
+		if probe.TimeoutSeconds < 0 || probe.TimeoutSeconds > 300 {
+			return fmt.Errorf("syntheticProbe.timeoutSeconds must be between 1 and 300")
+		}
+		if probe.IntervalSeconds < 0 {
+			return fmt.Errorf("syntheticProbe.intervalSeconds cannot be negative")
+		}
+		if ref := probe.PayloadRef; ref != nil && (ref.Name == "" || ref.Key == "") {
+			return fmt.Errorf("syntheticProbe.payloadRef requires a name and a key")
+		}
+		if err := validateOnMissing(probe.OnMissing, probe.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("syntheticProbe: %w", err)
+		}
+	}
+
+	if m.Queueing != nil && m.Queueing.Enabled {
+		hasEnabledMetric = true
+		if m.Queueing.ConcurrencyPerReplica < 0 {
+			return fmt.Errorf("queueing.concurrencyPerReplica cannot be negative")
+		}
+		if m.Queueing.TargetUtilization < 0 || m.Queueing.TargetUtilization > 100 {
+			return fmt.Errorf("queueing.targetUtilization must be between 1 and 100")
+		}
+		if err := m.Queueing.ArrivalRateTransform.Validate(); err != nil {
+			return fmt.Errorf("queueing.arrivalRateTransform: %w", err)
+		}
+		if err := m.Queueing.ServiceTimeTransform.Validate(); err != nil {
+			return fmt.Errorf("queueing.serviceTimeTransform: %w", err)
+		}
+		if err := m.Queueing.Window.Validate(); err != nil {
+			return fmt.Errorf("queueing.window: %w", err)
+		}
+		if err := validateOnMissing(m.Queueing.OnMissing, m.Queueing.MaxStalenessSeconds); err != nil {
+			return fmt.Errorf("queueing: %w", err)
+		}
+	}
+
+	customNames := make(map[string]bool, len(m.CustomMetrics))
+	for i := range m.CustomMetrics {
+		metric := &m.CustomMetrics[i]
+		hasEnabledMetric = true
+		if err := metric.Validate(); err != nil {
+			return fmt.Errorf("customMetrics[%d]: %w", i, err)
+		}
+		if customNames[metric.Name] {
+			return fmt.Errorf("customMetrics[%d]: duplicate name %q", i, metric.Name)
 		}
+		customNames[metric.Name] = true
 	}
 
 	if !hasEnabledMetric {
 		return fmt.Errorf("at least one metric must be enabled")
 	}
 
+	switch m.Preset {
+	case "":
+	case "vLLM", "Triton", "TGI":
+		for i := range m.Sources {
+			if !m.Sources[i].readsPromQL() {
+				return fmt.Errorf("preset needs PromQL sources; sources[%d] is of type %s", i, m.Sources[i].Type)
+			}
+		}
+	default:
+		return fmt.Errorf("preset must be vLLM, Triton or TGI")
+	}
+	if m.PresetModel != "" {
+		switch m.Preset {
+		case "":
+			return fmt.Errorf("presetModel requires a preset")
+		case "TGI":
+			return fmt.Errorf("presetModel is not supported by the TGI preset")
+		}
+		if _, err := regexp.Compile(m.PresetModel); err != nil {
+			return fmt.Errorf("presetModel is not a valid regular expression: %w", err)
+		}
+	}
+
+	names := make(map[string]bool, len(m.Sources))
+	for i := range m.Sources {
+		source := &m.Sources[i]
+		if err := source.Validate(); err != nil {
+			return fmt.Errorf("sources[%d]: %w", i, err)
+		}
+		if names[source.Name] {
+			return fmt.Errorf("sources[%d]: duplicate name %q", i, source.Name)
+		}
+		names[source.Name] = true
+	}
+
+	return nil
+}
+
+// validateTarget rejects a quantity target that is set but not greater than 0
+func validateTarget(name string, target *resource.Quantity) error {
+	if target != nil && target.Sign() <= 0 {
+		return fmt.Errorf("%s must be greater than 0", name)
+	}
+	return nil
+}
+
+// Validate validates the AlgorithmSpec
+func (a *AlgorithmSpec) Validate() error {
+	if a.ForecastHorizonSeconds < 0 {
+		return fmt.Errorf("forecastHorizonSeconds cannot be negative")
+	}
+	if a.SeasonalityPeriodSeconds < 0 {
+		return fmt.Errorf("seasonalityPeriodSeconds cannot be negative")
+	}
+	return nil
+}
+
+// Validate validates the PodMonitorSpec
+func (p *PodMonitorSpec) Validate() error {
+	if p.Port == "" {
+		return fmt.Errorf("port is required")
+	}
+	if p.Path != "" && !strings.HasPrefix(p.Path, "/") {
+		return fmt.Errorf("path %q must start with /", p.Path)
+	}
+	if p.IntervalSeconds < 0 {
+		return fmt.Errorf("intervalSeconds cannot be negative")
+	}
+	for key, value := range p.Labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("label %q is not a valid label key: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("label %q has an invalid value: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// Validate validates the BackpressureSpec
+func (b *BackpressureSpec) Validate() error {
+	if b.Annotation != "" {
+		if errs := validation.IsQualifiedName(b.Annotation); len(errs) > 0 {
+			return fmt.Errorf("annotation %q is not a valid annotation key: %s", b.Annotation, strings.Join(errs, "; "))
+		}
+	}
+	if b.Key != "" && b.ConfigMapName == "" {
+		return fmt.Errorf("key requires configMapName")
+	}
+	if b.DelaySeconds < 0 {
+		return fmt.Errorf("delaySeconds cannot be negative")
+	}
+	return nil
+}
+
+// Validate validates the FallbackSpec against the policy's replica bounds
+func (f *FallbackSpec) Validate(minReplicas *int32, maxReplicas int32) error {
+	switch f.Behavior {
+	case "", "Hold", "ScaleToMin", "ScaleToMax":
+		if f.Replicas != 0 {
+			return fmt.Errorf("replicas is only used with behavior ScaleToFixed")
+		}
+	case "ScaleToFixed":
+		if f.Replicas <= 0 {
+			return fmt.Errorf("replicas must be greater than 0 for behavior ScaleToFixed")
+		}
+		if f.Replicas > maxReplicas || (minReplicas != nil && f.Replicas < *minReplicas) {
+			return fmt.Errorf("replicas must be between minReplicas and maxReplicas")
+		}
+	default:
+		return fmt.Errorf("behavior must be Hold, ScaleToMin, ScaleToFixed or ScaleToMax")
+	}
+	if f.FailureThreshold < 0 {
+		return fmt.Errorf("failureThreshold cannot be negative")
+	}
+	return nil
+}
+
+// Validate validates the SmoothingSpec
+func (s *SmoothingSpec) Validate() error {
+	if s.Factor < 0 || s.Factor > 1 {
+		return fmt.Errorf("factor must be between 0 and 1")
+	}
+	if s.MaxUpPercent < 0 {
+		return fmt.Errorf("maxUpPercent cannot be negative")
+	}
+	if s.MaxDownPercent < 0 || s.MaxDownPercent > 100 {
+		return fmt.Errorf("maxDownPercent must be between 0 and 100")
+	}
+	return nil
+}
+
+// Validate validates the CustomMetric
+func (c *CustomMetric) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if c.Query == "" {
+		return fmt.Errorf("query is required")
+	}
+	if c.TargetValue <= 0 {
+		return fmt.Errorf("targetValue must be greater than 0")
+	}
+	switch c.TargetType {
+	case "", "Value", "AverageValue":
+	default:
+		return fmt.Errorf("targetType must be Value or AverageValue")
+	}
+	switch c.Aggregation {
+	case "", "Average", "Sum", "Max", "Min":
+	default:
+		return fmt.Errorf("aggregation must be Average, Sum, Max or Min")
+	}
+	if err := c.Transform.Validate(); err != nil {
+		return fmt.Errorf("transform: %w", err)
+	}
+	if err := c.Window.Validate(); err != nil {
+		return fmt.Errorf("window: %w", err)
+	}
+	return validateOnMissing(c.OnMissing, c.MaxStalenessSeconds)
+}
+
+// validateOnMissing validates the missing-data policy of a metric
+func validateOnMissing(onMissing string, maxStalenessSeconds int32) error {
+	switch onMissing {
+	case "", "Ignore", "FailClosed", "UseLastValue":
+	default:
+		return fmt.Errorf("onMissing must be Ignore, FailClosed or UseLastValue")
+	}
+	if maxStalenessSeconds < 0 {
+		return fmt.Errorf("maxStalenessSeconds cannot be negative")
+	}
+	return nil
+}
+
+// Validate validates the MetricTransform; a nil transform is valid
+func (t *MetricTransform) Validate() error {
+	if t == nil {
+		return nil
+	}
+	if t.Multiplier != nil && (*t.Multiplier == 0 || math.IsNaN(*t.Multiplier) || math.IsInf(*t.Multiplier, 0)) {
+		return fmt.Errorf("multiplier must be a non-zero finite number")
+	}
+	if math.IsNaN(t.Offset) || math.IsInf(t.Offset, 0) {
+		return fmt.Errorf("offset must be a finite number")
+	}
+	if t.ClampMin != nil && t.ClampMax != nil && *t.ClampMin > *t.ClampMax {
+		return fmt.Errorf("clampMin cannot be greater than clampMax")
+	}
+	return nil
+}
+
+// Validate validates the MetricWindow; a nil window is valid
+func (w *MetricWindow) Validate() error {
+	if w == nil {
+		return nil
+	}
+	if w.Seconds <= 0 {
+		return fmt.Errorf("seconds must be greater than 0")
+	}
+	if w.StepSeconds < 0 {
+		return fmt.Errorf("stepSeconds cannot be negative")
+	}
+	if w.StepSeconds > w.Seconds {
+		return fmt.Errorf("stepSeconds cannot be greater than seconds")
+	}
+	switch w.Aggregation {
+	case "", "Avg", "Max", "P90":
+	default:
+		return fmt.Errorf("aggregation must be Avg, Max or P90")
+	}
+	return nil
+}
+
+// Validate validates the MetricSource
+func (s *MetricSource) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch s.Type {
+	case "Prometheus":
+		if s.Address == "" {
+			return fmt.Errorf("address is required when type is Prometheus")
+		}
+	case "PodScrape":
+		if s.Port <= 0 || s.Port > 65535 {
+			return fmt.Errorf("port must be between 1 and 65535 when type is PodScrape")
+		}
+	case "CloudWatch":
+		if s.Region == "" {
+			return fmt.Errorf("region is required when type is CloudWatch")
+		}
+	case "CloudMonitoring":
+		if s.Project == "" {
+			return fmt.Errorf("project is required when type is CloudMonitoring")
+		}
+		if s.QueryLanguage != "" && s.QueryLanguage != "PromQL" && s.QueryLanguage != "MQL" {
+			return fmt.Errorf("queryLanguage must be PromQL or MQL")
+		}
+	case "SQS":
+		if s.Region == "" || s.Queue == "" {
+			return fmt.Errorf("region and queue are required when type is SQS")
+		}
+	case "RabbitMQ":
+		if s.Address == "" || s.Queue == "" {
+			return fmt.Errorf("address and queue are required when type is RabbitMQ")
+		}
+	case "Kafka":
+		if s.Queue == "" {
+			return fmt.Errorf("queue is required when type is Kafka")
+		}
+		if (s.Address == "") == (len(s.Brokers) == 0) {
+			return fmt.Errorf("exactly one of address and brokers is required when type is Kafka")
+		}
+		for i, broker := range s.Brokers {
+			if _, _, err := net.SplitHostPort(broker); err != nil {
+				return fmt.Errorf("brokers[%d]: %w", i, err)
+			}
+		}
+		if len(s.Brokers) > 0 && s.CredentialsSecret != "" && !s.TLS {
+			return fmt.Errorf("tls is required with credentialsSecret when brokers are set")
+		}
+		if s.TLS && len(s.Brokers) == 0 {
+			return fmt.Errorf("tls requires brokers; a REST Proxy address uses TLS with an https scheme")
+		}
+	case "MetricsAPI", "OTLP":
+	default:
+		return fmt.Errorf("type must be Prometheus, PodScrape, CloudWatch, CloudMonitoring, SQS, RabbitMQ, Kafka, MetricsAPI or OTLP")
+	}
+	return nil
+}
+
+// readsPromQL reports whether queries of the source are PromQL
+func (s *MetricSource) readsPromQL() bool {
+	return s.Type == "Prometheus" || (s.Type == "CloudMonitoring" && s.QueryLanguage != "MQL")
+}
+
+// Validate validates the CapacityProbeSpec
+func (c *CapacityProbeSpec) Validate() error {
+	switch c.Source {
+	case "", "Annotation":
+	case "HTTP":
+		if c.URL == "" {
+			return fmt.Errorf("url is required when source is HTTP")
+		}
+		if c.Format != "" && c.Format != "Triton" && c.Format != "JSON" {
+			return fmt.Errorf("format must be Triton or JSON")
+		}
+	default:
+		return fmt.Errorf("source must be Annotation or HTTP")
+	}
+	return nil
+}
+
+// Validate validates the PrometheusSpec
+func (p *PrometheusSpec) Validate() error {
+	if len(p.Addresses) == 0 {
+		return fmt.Errorf("at least one address is required")
+	}
+	for i, address := range p.Addresses {
+		if address == "" {
+			return fmt.Errorf("addresses[%d] cannot be empty", i)
+		}
+	}
+	switch p.Merge {
+	case "", "FirstSuccess", "Max", "Avg":
+	default:
+		return fmt.Errorf("merge must be FirstSuccess, Max or Avg")
+	}
+	return nil
+}
+
+// Weekdays accepted in TargetModulation.DaysOfWeek
+var Weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// Validate validates the TargetModulation
+func (m *TargetModulation) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	start, err := time.Parse("15:04", m.Start)
+	if err != nil {
+		return fmt.Errorf("start must be HH:MM")
+	}
+	end, err := time.Parse("15:04", m.End)
+	if err != nil {
+		return fmt.Errorf("end must be HH:MM")
+	}
+	if start.Equal(end) {
+		return fmt.Errorf("start and end must differ")
+	}
+	for _, day := range m.DaysOfWeek {
+		if _, ok := Weekdays[day]; !ok {
+			return fmt.Errorf("unknown day %q, expected Mon through Sun", day)
+		}
+	}
+	if m.TimeZone != "" {
+		if _, err := time.LoadLocation(m.TimeZone); err != nil {
+			return fmt.Errorf("unknown timeZone %q", m.TimeZone)
+		}
+	}
+
+	t := m.Targets
+	p99, p95, queueDepth := QuantityFloat64(t.LatencyP99Ms), QuantityFloat64(t.LatencyP95Ms), QuantityFloat64(t.RequestQueueDepth)
+	if p99 < 0 || p95 < 0 || t.GPUUtilizationPercent < 0 || queueDepth < 0 {
+		return fmt.Errorf("targets cannot be negative")
+	}
+	if t.GPUUtilizationPercent > 100 {
+		return fmt.Errorf("targets.gpuUtilizationPercent must be between 1 and 100")
+	}
+	if p99 == 0 && p95 == 0 && t.GPUUtilizationPercent == 0 && queueDepth == 0 {
+		return fmt.Errorf("at least one target is required")
+	}
 	return nil
 }
 
 // SetDefaults sets default values for the policy
 func (p *AIInferenceAutoscalerPolicy) SetDefaults() {
-	if p.Spec.MinReplicas == 0 {
-		p.Spec.MinReplicas = 1
+	if p.Spec.MinReplicas == nil {
+		minReplicas := int32(1)
+		p.Spec.MinReplicas = &minReplicas
 	}
 	if p.Spec.CooldownPeriod == 0 {
 		p.Spec.CooldownPeriod = 300
 	}
-	if p.Spec.TargetRef.APIVersion == "" {
+	if p.Spec.TargetRef.APIVersion == "" && isAppsKind(p.Spec.TargetRef.Kind) {
 		p.Spec.TargetRef.APIVersion = "apps/v1"
 	}
 }
+
+// isAppsKind reports whether kind is a built-in apps/v1 workload, for which the
+// API version may be omitted
+func isAppsKind(kind string) bool {
+	return kind == "Deployment" || kind == "StatefulSet"
+}
@@ -17,10 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestValidation(t *testing.T) {
@@ -43,12 +46,12 @@ func TestValidation(t *testing.T) {
 						Kind:       "Deployment",
 						Name:       "test-deployment",
 					},
-					MinReplicas: 1,
+					MinReplicas: ptr.To[int32](1),
 					MaxReplicas: 10,
 					Metrics: MetricsSpec{
 						Latency: &LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 500,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
 						},
 					},
 				},
@@ -67,7 +70,7 @@ func TestValidation(t *testing.T) {
 					Metrics: MetricsSpec{
 						Latency: &LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 500,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
 						},
 					},
 				},
@@ -76,24 +79,24 @@ func TestValidation(t *testing.T) {
 			errorMsg:    "targetRef.name is required",
 		},
 		{
-			name: "invalid target kind",
+			name: "custom target kind without apiVersion",
 			policy: &AIInferenceAutoscalerPolicy{
 				Spec: AIInferenceAutoscalerPolicySpec{
 					TargetRef: TargetRef{
-						Kind: "DaemonSet",
+						Kind: "Rollout",
 						Name: "test",
 					},
 					MaxReplicas: 10,
 					Metrics: MetricsSpec{
 						Latency: &LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 500,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
 						},
 					},
 				},
 			},
 			expectError: true,
-			errorMsg:    "targetRef.kind must be Deployment or StatefulSet",
+			errorMsg:    "targetRef.apiVersion is required for kind Rollout",
 		},
 		{
 			name: "maxReplicas zero",
@@ -107,7 +110,7 @@ func TestValidation(t *testing.T) {
 					Metrics: MetricsSpec{
 						Latency: &LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 500,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
 						},
 					},
 				},
@@ -123,12 +126,12 @@ func TestValidation(t *testing.T) {
 						Kind: "Deployment",
 						Name: "test",
 					},
-					MinReplicas: 10,
+					MinReplicas: ptr.To[int32](10),
 					MaxReplicas: 5,
 					Metrics: MetricsSpec{
 						Latency: &LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 500,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
 						},
 					},
 				},
@@ -170,25 +173,1647 @@ func TestValidation(t *testing.T) {
 			expectError: true,
 			errorMsg:    "latency metric enabled but no target specified",
 		},
+		{
+			name: "negative latency p99 target with a p95 target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("-500")), TargetP95Ms: ptr.To(resource.MustParse("300"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "latency.targetP99Ms must be greater than 0",
+		},
+		{
+			name: "zero latency p99 target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("0"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "latency.targetP99Ms must be greater than 0",
+		},
+		{
+			name: "negative latency p95 target with a p99 target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500")), TargetP95Ms: ptr.To(resource.MustParse("-300"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "latency.targetP95Ms must be greater than 0",
+		},
+		{
+			name: "zero latency p95 target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP95Ms: ptr.To(resource.MustParse("0"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "latency.targetP95Ms must be greater than 0",
+		},
+		{
+			name: "negative queue depth target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("-10"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "requestQueueDepth.targetDepth must be greater than 0",
+		},
+		{
+			name: "zero queue depth target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("0"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "requestQueueDepth.targetDepth must be greater than 0",
+		},
+		{
+			name: "negative tokens per second target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						TokensPerSecond: &TokensPerSecondMetric{Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("-1500"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "tokensPerSecond.targetPerReplica must be greater than 0",
+		},
+		{
+			name: "zero tokens per second target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						TokensPerSecond: &TokensPerSecondMetric{Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("0"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "tokensPerSecond.targetPerReplica must be greater than 0",
+		},
+		{
+			name: "negative in-flight requests target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						InFlightRequests: &InFlightRequestsMetric{Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("-8"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "inFlightRequests.targetPerReplica must be greater than 0",
+		},
+		{
+			name: "zero in-flight requests target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						InFlightRequests: &InFlightRequestsMetric{Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("0"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "inFlightRequests.targetPerReplica must be greater than 0",
+		},
+		{
+			name: "negative synthetic probe latency target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						SyntheticProbe: &SyntheticProbeMetric{Enabled: true, Endpoint: "http://llama.models:8000/v1/completions", TargetLatencyMs: ptr.To(resource.MustParse("-2000"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "syntheticProbe.targetLatencyMs must be greater than 0",
+		},
+		{
+			name: "zero synthetic probe latency target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						SyntheticProbe: &SyntheticProbeMetric{Enabled: true, Endpoint: "http://llama.models:8000/v1/completions", TargetLatencyMs: ptr.To(resource.MustParse("0"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "syntheticProbe.targetLatencyMs must be greater than 0",
+		},
 		{
 			name: "GPU utilization out of range",
 			policy: &AIInferenceAutoscalerPolicy{
 				Spec: AIInferenceAutoscalerPolicySpec{
-					TargetRef: TargetRef{
-						Kind: "Deployment",
-						Name: "test",
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						GPUUtilization: &GPUUtilizationMetric{
+							Enabled:          true,
+							TargetPercentage: 150,
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "gpuUtilization.targetPercentage must be between 1 and 100",
+		},
+		{
+			name: "HTTP capacity probe without url",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+					},
+					CapacityProbe: &CapacityProbeSpec{
+						Source: "HTTP",
+						Format: "Triton",
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "url is required when source is HTTP",
+		},
+		{
+			name: "prometheus with unknown merge strategy",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+					},
+					Prometheus: &PrometheusSpec{
+						Addresses: []string{"http://prometheus-0:9090", "http://prometheus-1:9090"},
+						Merge:     "Median",
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "merge must be FirstSuccess, Max or Avg",
+		},
+		{
+			name: "valid metric source chain",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "primary", Type: "Prometheus", Address: "http://prometheus:9090"},
+							{Name: "thanos", Type: "Prometheus", Address: "http://thanos-query:9090"},
+							{Name: "pods", Type: "PodScrape", Port: 8000},
+							{Name: "cloudwatch", Type: "CloudWatch", Region: "us-east-1"},
+							{Name: "gmp", Type: "CloudMonitoring", Project: "llm-prod"},
+							{Name: "mql", Type: "CloudMonitoring", Project: "llm-prod", QueryLanguage: "MQL"},
+							{Name: "sqs", Type: "SQS", Region: "us-east-1", Queue: "https://sqs.us-east-1.amazonaws.com/123456789012/requests"},
+							{Name: "rabbitmq", Type: "RabbitMQ", Address: "http://rabbitmq:15672", Queue: "requests"},
+							{Name: "kafka", Type: "Kafka", Address: "http://kafka-rest:8082", Queue: "workers"},
+							{Name: "brokers", Type: "Kafka", Brokers: []string{"kafka-0.kafka:9092", "kafka-1.kafka:9092"}, Queue: "workers", Topic: "requests"},
+							{Name: "sasl", Type: "Kafka", Brokers: []string{"kafka-0.kafka:9093"}, Queue: "workers", TLS: true, CredentialsSecret: "kafka"},
+							{Name: "adapter", Type: "MetricsAPI"},
+							{Name: "push", Type: "OTLP"},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "pod scrape source without port",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "pods", Type: "PodScrape"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "port must be between 1 and 65535 when type is PodScrape",
+		},
+		{
+			name: "cloudwatch source without region",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "cloudwatch", Type: "CloudWatch"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "region is required when type is CloudWatch",
+		},
+		{
+			name: "cloud monitoring source without project",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "gmp", Type: "CloudMonitoring"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "project is required when type is CloudMonitoring",
+		},
+		{
+			name: "kafka source without consumer group",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "kafka", Type: "Kafka", Address: "http://kafka-rest:8082"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "queue is required when type is Kafka",
+		},
+		{
+			name: "kafka source with both a REST Proxy and brokers",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "kafka", Type: "Kafka", Address: "http://kafka-rest:8082", Brokers: []string{"kafka:9092"}, Queue: "workers"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "exactly one of address and brokers is required when type is Kafka",
+		},
+		{
+			name: "kafka broker without a port",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "kafka", Type: "Kafka", Brokers: []string{"kafka"}, Queue: "workers"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "brokers[0]: address kafka: missing port in address",
+		},
+		{
+			name: "kafka broker credentials without tls",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "kafka", Type: "Kafka", Brokers: []string{"kafka:9092"}, Queue: "workers", CredentialsSecret: "kafka"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "tls is required with credentialsSecret when brokers are set",
+		},
+		{
+			name: "kafka tls with a REST Proxy",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "kafka", Type: "Kafka", Address: "https://kafka-rest:8082", Queue: "workers", TLS: true},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "tls requires brokers; a REST Proxy address uses TLS with an https scheme",
+		},
+		{
+			name: "duplicate metric source names",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Sources: []MetricSource{
+							{Name: "primary", Type: "Prometheus", Address: "http://prometheus:9090"},
+							{Name: "primary", Type: "Prometheus", Address: "http://thanos-query:9090"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "duplicate name",
+		},
+		{
+			name: "valid vLLM preset",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Preset: "vLLM",
+						Sources: []MetricSource{
+							{Name: "primary", Type: "Prometheus", Address: "http://prometheus:9090"},
+							{Name: "gmp", Type: "CloudMonitoring", Project: "llm-prod"},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "preset with a source that does not run PromQL",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Preset: "vLLM",
+						Sources: []MetricSource{
+							{Name: "primary", Type: "Prometheus", Address: "http://prometheus:9090"},
+							{Name: "pods", Type: "PodScrape", Port: 8000},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "preset needs PromQL sources; sources[1] is of type PodScrape",
+		},
+		{
+			name: "Triton preset restricted to a model",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Preset:      "Triton",
+						PresetModel: "resnet50|densenet.*",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "preset model that is not a regular expression",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Preset:      "Triton",
+						PresetModel: "resnet(50",
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "presetModel is not a valid regular expression",
+		},
+		{
+			name: "preset model without a preset",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						PresetModel: "resnet50",
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "presetModel requires a preset",
+		},
+		{
+			name: "preset model with the TGI preset",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+						Preset:      "TGI",
+						PresetModel: "llama-3",
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "presetModel is not supported by the TGI preset",
+		},
+		{
+			name: "GPU utilization scoped to the target with a source that does not run PromQL",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						GPUUtilization: &GPUUtilizationMetric{
+							Enabled:          true,
+							TargetPercentage: 80,
+							ScopeToTarget:    true,
+						},
+						Sources: []MetricSource{
+							{Name: "cloudwatch", Type: "CloudWatch", Region: "us-east-1"},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "gpuUtilization.scopeToTarget needs PromQL sources; sources[0] is of type CloudWatch",
+		},
+		{
+			name: "event metadata key that is not an annotation key",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+					},
+					EventMetadata: map[string]string{"example.com/team": "search", "cost center": "cc-42"},
+				},
+			},
+			expectError: true,
+			errorMsg:    `eventMetadata key "cost center" is not a valid annotation key`,
+		},
+		{
+			name: "valid target modulation",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{
+							Enabled:     true,
+							TargetP99Ms: ptr.To(resource.MustParse("400")),
+						},
+					},
+					TargetModulation: []TargetModulation{
+						{
+							Name:     "overnight",
+							Start:    "20:00",
+							End:      "08:00",
+							TimeZone: "America/New_York",
+							Targets:  MetricTargets{LatencyP99Ms: ptr.To(resource.MustParse("700"))},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "target modulation with unknown day",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{
+							Enabled:     true,
+							TargetP99Ms: ptr.To(resource.MustParse("400")),
+						},
+					},
+					TargetModulation: []TargetModulation{
+						{
+							Name:       "weekend",
+							Start:      "00:00",
+							End:        "23:59",
+							DaysOfWeek: []string{"Saturday"},
+							Targets:    MetricTargets{LatencyP99Ms: ptr.To(resource.MustParse("700"))},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "unknown day",
+		},
+		{
+			name: "target modulation without targets",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{
+							Enabled:     true,
+							TargetP99Ms: ptr.To(resource.MustParse("400")),
+						},
+					},
+					TargetModulation: []TargetModulation{
+						{Name: "overnight", Start: "20:00", End: "08:00"},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "at least one target is required",
+		},
+		{
+			name: "custom target kind with scale subresource",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						APIVersion: "serving.kserve.io/v1beta1",
+						Kind:       "InferenceService",
+						Name:       "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{
+							Enabled:     true,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "missing target kind",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{
+							Enabled:     true,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "targetRef.kind is required",
+		},
+		{
+			name: "scale to zero",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ScaleToZero: &ScaleToZeroSpec{IdlePeriodSeconds: 600},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "scaleToZero without minReplicas 0",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](1),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ScaleToZero: &ScaleToZeroSpec{IdlePeriodSeconds: 600},
+				},
+			},
+			expectError: true,
+			errorMsg:    "scaleToZero requires minReplicas 0",
+		},
+		{
+			name: "negative scale to zero idle period",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ScaleToZero: &ScaleToZeroSpec{IdlePeriodSeconds: -1},
+				},
+			},
+			expectError: true,
+			errorMsg:    "scaleToZero.idlePeriodSeconds cannot be negative",
+		},
+		{
+			name: "scale behavior policies",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ScaleUp: &ScaleBehavior{
+						StabilizationWindowSeconds: 60,
+						Policies:                   []ScalingPolicy{{Type: "Pods", Value: 4, PeriodSeconds: 60}},
+					},
+					ScaleDown: &ScaleBehavior{
+						StabilizationWindowSeconds: 300,
+						Policies:                   []ScalingPolicy{{Type: "Percent", Value: 50, PeriodSeconds: 120}},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "scale behavior period out of range",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ScaleDown: &ScaleBehavior{
+						Policies: []ScalingPolicy{{Type: "Pods", Value: 1, PeriodSeconds: 3600}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "scaleDown validation failed: policies[0].periodSeconds must be between 1 and 1800",
+		},
+		{
+			name: "scale behavior with unknown select policy",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ScaleUp: &ScaleBehavior{SelectPolicy: "Average"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "scaleUp validation failed: selectPolicy must be Max, Min or Disabled",
+		},
+		{
+			name: "scale behavior zero value",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ScaleUp: &ScaleBehavior{
+						Policies: []ScalingPolicy{{Type: "Percent", Value: 0, PeriodSeconds: 60}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "scaleUp validation failed: policies[0].value must be greater than 0",
+		},
+		{
+			name: "custom metrics only",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{
+							{Name: "tokens-per-second", Query: "sum(rate(vllm:generation_tokens_total[1m]))", TargetValue: 2000, Aggregation: "Sum"},
+							{Name: "kv-cache", Query: "vllm:gpu_cache_usage_perc", TargetValue: 0.8},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "custom metric without target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{{Name: "tokens-per-second", Query: "tokens"}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: customMetrics[0]: targetValue must be greater than 0",
+		},
+		{
+			name: "custom metric duplicate name",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{
+							{Name: "tokens", Query: "a", TargetValue: 1},
+							{Name: "tokens", Query: "b", TargetValue: 1},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: customMetrics[1]: duplicate name \"tokens\"",
+		},
+		{
+			name: "custom metric unknown target type",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{{Name: "tokens", Query: "a", TargetValue: 1, TargetType: "Utilization"}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: customMetrics[0]: targetType must be Value or AverageValue",
+		},
+		{
+			name: "custom metric unknown aggregation",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{{Name: "tokens", Query: "a", TargetValue: 1, Aggregation: "P99"}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: customMetrics[0]: aggregation must be Average, Sum, Max or Min",
+		},
+		{
+			name: "smoothing",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Smoothing: &SmoothingSpec{Factor: 0.3, MaxUpPercent: 200, MaxDownPercent: 25},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "smoothing factor out of range",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Smoothing: &SmoothingSpec{Factor: 1.5},
+				},
+			},
+			expectError: true,
+			errorMsg:    "smoothing validation failed: factor must be between 0 and 1",
+		},
+		{
+			name: "smoothing max down percent out of range",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Smoothing: &SmoothingSpec{MaxDownPercent: 150},
+				},
+			},
+			expectError: true,
+			errorMsg:    "smoothing validation failed: maxDownPercent must be between 0 and 100",
+		},
+		{
+			name: "valid tokens per second metric",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						TokensPerSecond: &TokensPerSecondMetric{
+							Enabled:          true,
+							TargetPerReplica: ptr.To(resource.MustParse("1500")),
+							Preset:           "TGI",
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "tokens per second metric without target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						TokensPerSecond: &TokensPerSecondMetric{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "tokensPerSecond.targetPerReplica must be greater than 0",
+		},
+		{
+			name: "tokens per second metric with unknown preset",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						TokensPerSecond: &TokensPerSecondMetric{
+							Enabled:          true,
+							TargetPerReplica: ptr.To(resource.MustParse("1500")),
+							Preset:           "Triton",
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "tokensPerSecond.preset must be vLLM or TGI",
+		},
+		{
+			name: "polling interval out of range",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas:     10,
+					PollingInterval: 7200,
+					Metrics: MetricsSpec{
+						RequestQueueDepth: &QueueDepthMetric{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "pollingInterval must be between 1 and 3600",
+		},
+		{
+			name: "in-flight requests metric without target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						InFlightRequests: &InFlightRequestsMetric{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "inFlightRequests.targetPerReplica must be greater than 0",
+		},
+		{
+			name: "in-flight requests metric alone",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef: TargetRef{
+						Kind: "Deployment",
+						Name: "test",
+					},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						InFlightRequests: &InFlightRequestsMetric{
+							Enabled:          true,
+							TargetPerReplica: ptr.To(resource.MustParse("16")),
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "synthetic probe alone",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						SyntheticProbe: &SyntheticProbeMetric{
+							Enabled:         true,
+							Endpoint:        "http://llama.models:8000/v1/completions",
+							PayloadRef:      &PayloadReference{Name: "probe", Key: "request.json"},
+							TargetLatencyMs: ptr.To(resource.MustParse("2000")),
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "synthetic probe without endpoint",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						SyntheticProbe: &SyntheticProbeMetric{Enabled: true, TargetLatencyMs: ptr.To(resource.MustParse("2000"))},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "syntheticProbe.endpoint must be an http or https URL",
+		},
+		{
+			name: "synthetic probe payload without key",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						SyntheticProbe: &SyntheticProbeMetric{
+							Enabled:         true,
+							Endpoint:        "https://llama.example.com/v1/completions",
+							PayloadRef:      &PayloadReference{Name: "probe"},
+							TargetLatencyMs: ptr.To(resource.MustParse("2000")),
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "syntheticProbe.payloadRef requires a name and a key",
+		},
+		{
+			name: "predictive forecast parameters",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Algorithm: &AlgorithmSpec{Name: "Predictive", ForecastHorizonSeconds: 600, SeasonalityPeriodSeconds: 86400},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "negative forecast horizon",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Algorithm: &AlgorithmSpec{Name: "Predictive", ForecastHorizonSeconds: -1},
+				},
+			},
+			expectError: true,
+			errorMsg:    "algorithm validation failed: forecastHorizonSeconds cannot be negative",
+		},
+		{
+			name: "valid queueing metric",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Queueing: &QueueingMetric{Enabled: true, ConcurrencyPerReplica: 8, TargetUtilization: 80},
+					},
+					Algorithm: &AlgorithmSpec{Name: "LittlesLaw"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "queueing target utilization out of range",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Queueing: &QueueingMetric{Enabled: true, TargetUtilization: 120},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: queueing.targetUtilization must be between 1 and 100",
+		},
+		{
+			name: "shardParity without configMapName",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					ShardParity: &ShardParitySpec{Key: "shards"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "shardParity.configMapName is required",
+		},
+		{
+			name: "partitionAware on a Deployment",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
 					},
+					PartitionAware: true,
+				},
+			},
+			expectError: true,
+			errorMsg:    "partitionAware requires a StatefulSet target, got Deployment",
+		},
+		{
+			name: "valid metric transform",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
 					MaxReplicas: 10,
 					Metrics: MetricsSpec{
-						GPUUtilization: &GPUUtilizationMetric{
-							Enabled:          true,
-							TargetPercentage: 150,
-						},
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500")),
+							Transform: &MetricTransform{Multiplier: ptr.To(0.000001), ClampMin: ptr.To(0.0)}},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "metric transform with zero multiplier",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						GPUUtilization: &GPUUtilizationMetric{Enabled: true, TargetPercentage: 70,
+							Transform: &MetricTransform{Multiplier: ptr.To(0.0)}},
 					},
 				},
 			},
 			expectError: true,
-			errorMsg:    "gpuUtilization.targetPercentage must be between 1 and 100",
+			errorMsg:    "metrics validation failed: gpuUtilization.transform: multiplier must be a non-zero finite number",
+		},
+		{
+			name: "metric transform with inverted clamp",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{{Name: "kv_cache", Query: "q", TargetValue: 80,
+							Transform: &MetricTransform{ClampMin: ptr.To(100.0), ClampMax: ptr.To(0.0)}}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: customMetrics[0]: transform: clampMin cannot be greater than clampMax",
+		},
+		{
+			name: "queueing service time transform with infinite offset",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Queueing: &QueueingMetric{Enabled: true, TargetUtilization: 80,
+							ServiceTimeTransform: &MetricTransform{Offset: math.Inf(1)}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: queueing.serviceTimeTransform: offset must be a finite number",
+		},
+		{
+			name: "valid metric window",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						GPUUtilization: &GPUUtilizationMetric{Enabled: true, TargetPercentage: 70,
+							Window: &MetricWindow{Seconds: 300, StepSeconds: 30, Aggregation: "P90"}},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "metric window with step longer than the window",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500")),
+							Window: &MetricWindow{Seconds: 60, StepSeconds: 120}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: latency.window: stepSeconds cannot be greater than seconds",
+		},
+		{
+			name: "custom metric window with unknown aggregation",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{{Name: "kv_cache", Query: "q", TargetValue: 80,
+							Window: &MetricWindow{Seconds: 300, Aggregation: "P50"}}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: customMetrics[0]: window: aggregation must be Avg, Max or P90",
+		},
+		{
+			name: "valid onMissing",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						GPUUtilization: &GPUUtilizationMetric{Enabled: true, TargetPercentage: 70,
+							OnMissing: "UseLastValue", MaxStalenessSeconds: 120},
+						CustomMetrics: []CustomMetric{{Name: "kv_cache", Query: "q", TargetValue: 80, OnMissing: "FailClosed"}},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown onMissing",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500")), OnMissing: "Zero"},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: latency: onMissing must be Ignore, FailClosed or UseLastValue",
+		},
+		{
+			name: "negative maxStalenessSeconds",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CustomMetrics: []CustomMetric{{Name: "kv_cache", Query: "q", TargetValue: 80,
+							OnMissing: "UseLastValue", MaxStalenessSeconds: -1}},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: customMetrics[0]: maxStalenessSeconds cannot be negative",
+		},
+		{
+			name: "valid backpressure",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Backpressure: &BackpressureSpec{Annotation: "gateway.example.com/backpressure", ConfigMapName: "llm-backpressure", DelaySeconds: 30},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "backpressure with invalid annotation",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Backpressure: &BackpressureSpec{Annotation: "not a key"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "backpressure validation failed: annotation \"not a key\" is not a valid annotation key",
+		},
+		{
+			name: "backpressure key without configMapName",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Backpressure: &BackpressureSpec{Key: "shed"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "backpressure validation failed: key requires configMapName",
+		},
+		{
+			name: "valid cpu utilization",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						CPUUtilization: &ResourceUtilizationMetric{Enabled: true, TargetAverageUtilization: 70},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "memory utilization without target",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						MemoryUtilization: &ResourceUtilizationMetric{Enabled: true},
+					},
+				},
+			},
+			expectError: true,
+			errorMsg:    "metrics validation failed: memoryUtilization.targetAverageUtilization must be greater than 0",
+		},
+		{
+			name: "valid pod monitor",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					PodMonitor: &PodMonitorSpec{Port: "metrics", Path: "/metrics", Labels: map[string]string{"release": "prometheus"}},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "pod monitor without port",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					PodMonitor: &PodMonitorSpec{Path: "/metrics"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "podMonitor validation failed: port is required",
+		},
+		{
+			name: "pod monitor with relative path",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					PodMonitor: &PodMonitorSpec{Port: "metrics", Path: "metrics"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "podMonitor validation failed: path \"metrics\" must start with /",
+		},
+		{
+			name: "pod monitor with invalid label",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					PodMonitor: &PodMonitorSpec{Port: "metrics", Labels: map[string]string{"not a key": "x"}},
+				},
+			},
+			expectError: true,
+			errorMsg:    "podMonitor validation failed: label \"not a key\" is not a valid label key",
+		},
+		{
+			name: "valid fixed fallback",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](2),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Fallback: &FallbackSpec{Behavior: "ScaleToFixed", Replicas: 4, FailureThreshold: 3},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "fixed fallback without replicas",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](2),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Fallback: &FallbackSpec{Behavior: "ScaleToFixed"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "fallback validation failed: replicas must be greater than 0 for behavior ScaleToFixed",
+		},
+		{
+			name: "fixed fallback below minReplicas",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](2),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Fallback: &FallbackSpec{Behavior: "ScaleToFixed", Replicas: 1},
+				},
+			},
+			expectError: true,
+			errorMsg:    "fallback validation failed: replicas must be between minReplicas and maxReplicas",
+		},
+		{
+			name: "fallback replicas without ScaleToFixed",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](2),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Fallback: &FallbackSpec{Behavior: "ScaleToMax", Replicas: 4},
+				},
+			},
+			expectError: true,
+			errorMsg:    "fallback validation failed: replicas is only used with behavior ScaleToFixed",
+		},
+		{
+			name: "unknown fallback behavior",
+			policy: &AIInferenceAutoscalerPolicy{
+				Spec: AIInferenceAutoscalerPolicySpec{
+					TargetRef:   TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "test"},
+					MinReplicas: ptr.To[int32](2),
+					MaxReplicas: 10,
+					Metrics: MetricsSpec{
+						Latency: &LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+					},
+					Fallback: &FallbackSpec{Behavior: "ScaleUp"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "fallback validation failed: behavior must be Hold, ScaleToMin, ScaleToFixed or ScaleToMax",
 		},
 	}
 
@@ -218,7 +1843,12 @@ func TestSetDefaults(t *testing.T) {
 
 	policy.SetDefaults()
 
-	assert.Equal(t, int32(1), policy.Spec.MinReplicas)
+	assert.Equal(t, ptr.To[int32](1), policy.Spec.MinReplicas)
 	assert.Equal(t, int32(300), policy.Spec.CooldownPeriod)
 	assert.Equal(t, "apps/v1", policy.Spec.TargetRef.APIVersion)
+
+	// An explicit zero enables scale-to-zero and is kept
+	policy.Spec.MinReplicas = ptr.To[int32](0)
+	policy.SetDefaults()
+	assert.Equal(t, ptr.To[int32](0), policy.Spec.MinReplicas)
 }
@@ -86,6 +86,11 @@ func (in *AIInferenceAutoscalerPolicyList) DeepCopyObject() runtime.Object {
 func (in *AIInferenceAutoscalerPolicySpec) DeepCopyInto(out *AIInferenceAutoscalerPolicySpec) {
 	*out = *in
 	out.TargetRef = in.TargetRef
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	in.Metrics.DeepCopyInto(&out.Metrics)
 	if in.Algorithm != nil {
 		in, out := &in.Algorithm, &out.Algorithm
@@ -102,6 +107,65 @@ func (in *AIInferenceAutoscalerPolicySpec) DeepCopyInto(out *AIInferenceAutoscal
 		*out = new(ScaleBehavior)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityProbe != nil {
+		in, out := &in.CapacityProbe, &out.CapacityProbe
+		*out = new(CapacityProbeSpec)
+		**out = **in
+	}
+	if in.EventMetadata != nil {
+		in, out := &in.EventMetadata, &out.EventMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetModulation != nil {
+		in, out := &in.TargetModulation, &out.TargetModulation
+		*out = make([]TargetModulation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(ScaleToZeroSpec)
+		**out = **in
+	}
+	if in.Smoothing != nil {
+		in, out := &in.Smoothing, &out.Smoothing
+		*out = new(SmoothingSpec)
+		**out = **in
+	}
+	if in.ShardParity != nil {
+		in, out := &in.ShardParity, &out.ShardParity
+		*out = new(ShardParitySpec)
+		**out = **in
+	}
+	if in.Backpressure != nil {
+		in, out := &in.Backpressure, &out.Backpressure
+		*out = new(BackpressureSpec)
+		**out = **in
+	}
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(FallbackSpec)
+		**out = **in
+	}
+	if in.DrainGuard != nil {
+		in, out := &in.DrainGuard, &out.DrainGuard
+		*out = new(DrainGuardSpec)
+		**out = **in
+	}
+	if in.PodMonitor != nil {
+		in, out := &in.PodMonitor, &out.PodMonitor
+		*out = new(PodMonitorSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function
@@ -124,7 +188,30 @@ func (in *AIInferenceAutoscalerPolicyStatus) DeepCopyInto(out *AIInferenceAutosc
 	if in.CurrentMetrics != nil {
 		in, out := &in.CurrentMetrics, &out.CurrentMetrics
 		*out = new(CurrentMetrics)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricHistory != nil {
+		in, out := &in.MetricHistory, &out.MetricHistory
+		*out = make([]MetricHistory, len(*in))
+		copy(*out, *in)
+	}
+	if in.SaturatedSince != nil {
+		in, out := &in.SaturatedSince, &out.SaturatedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.EffectiveTargets != nil {
+		in, out := &in.EffectiveTargets, &out.EffectiveTargets
+		*out = new(MetricTargets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleSince != nil {
+		in, out := &in.IdleSince, &out.IdleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.DecisionConstraints != nil {
+		in, out := &in.DecisionConstraints, &out.DecisionConstraints
+		*out = make([]DecisionConstraint, len(*in))
+		copy(*out, *in)
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -153,6 +240,13 @@ func (in *AlgorithmSpec) DeepCopyInto(out *AlgorithmSpec) {
 		*out = make([]float64, len(*in))
 		copy(*out, *in)
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function
@@ -165,9 +259,54 @@ func (in *AlgorithmSpec) DeepCopy() *AlgorithmSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *BackpressureSpec) DeepCopyInto(out *BackpressureSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *BackpressureSpec) DeepCopy() *BackpressureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackpressureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *CapacityProbeSpec) DeepCopyInto(out *CapacityProbeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *CapacityProbeSpec) DeepCopy() *CapacityProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *CurrentMetrics) DeepCopyInto(out *CurrentMetrics) {
 	*out = *in
+	if in.ArrivalRate != nil {
+		in, out := &in.ArrivalRate, &out.ArrivalRate
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ServiceTimeMs != nil {
+		in, out := &in.ServiceTimeMs, &out.ServiceTimeMs
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = make([]CustomMetricValue, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function
@@ -180,9 +319,104 @@ func (in *CurrentMetrics) DeepCopy() *CurrentMetrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *CustomMetric) DeepCopyInto(out *CustomMetric) {
+	*out = *in
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MetricWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *CustomMetric) DeepCopy() *CustomMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *CustomMetricValue) DeepCopyInto(out *CustomMetricValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *CustomMetricValue) DeepCopy() *CustomMetricValue {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMetricValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *DecisionConstraint) DeepCopyInto(out *DecisionConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *DecisionConstraint) DeepCopy() *DecisionConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(DecisionConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *DrainGuardSpec) DeepCopyInto(out *DrainGuardSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *DrainGuardSpec) DeepCopy() *DrainGuardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainGuardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *FallbackSpec) DeepCopyInto(out *FallbackSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *FallbackSpec) DeepCopy() *FallbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *GPUUtilizationMetric) DeepCopyInto(out *GPUUtilizationMetric) {
 	*out = *in
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MetricWindow)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function
@@ -195,9 +429,59 @@ func (in *GPUUtilizationMetric) DeepCopy() *GPUUtilizationMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *InFlightRequestsMetric) DeepCopyInto(out *InFlightRequestsMetric) {
+	*out = *in
+	if in.TargetPerReplica != nil {
+		in, out := &in.TargetPerReplica, &out.TargetPerReplica
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MetricWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *InFlightRequestsMetric) DeepCopy() *InFlightRequestsMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(InFlightRequestsMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *LatencyMetric) DeepCopyInto(out *LatencyMetric) {
 	*out = *in
+	if in.TargetP99Ms != nil {
+		in, out := &in.TargetP99Ms, &out.TargetP99Ms
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TargetP95Ms != nil {
+		in, out := &in.TargetP95Ms, &out.TargetP95Ms
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MetricWindow)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function
@@ -210,23 +494,177 @@ func (in *LatencyMetric) DeepCopy() *LatencyMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *MetricHistory) DeepCopyInto(out *MetricHistory) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *MetricHistory) DeepCopy() *MetricHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *MetricSource) DeepCopyInto(out *MetricSource) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *MetricSource) DeepCopy() *MetricSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *MetricTransform) DeepCopyInto(out *MetricTransform) {
+	*out = *in
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClampMin != nil {
+		in, out := &in.ClampMin, &out.ClampMin
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ClampMax != nil {
+		in, out := &in.ClampMax, &out.ClampMax
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *MetricTransform) DeepCopy() *MetricTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *MetricWindow) DeepCopyInto(out *MetricWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *MetricWindow) DeepCopy() *MetricWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *MetricTargets) DeepCopyInto(out *MetricTargets) {
+	*out = *in
+	if in.LatencyP99Ms != nil {
+		in, out := &in.LatencyP99Ms, &out.LatencyP99Ms
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LatencyP95Ms != nil {
+		in, out := &in.LatencyP95Ms, &out.LatencyP95Ms
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RequestQueueDepth != nil {
+		in, out := &in.RequestQueueDepth, &out.RequestQueueDepth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *MetricTargets) DeepCopy() *MetricTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *MetricsSpec) DeepCopyInto(out *MetricsSpec) {
 	*out = *in
 	if in.Latency != nil {
 		in, out := &in.Latency, &out.Latency
 		*out = new(LatencyMetric)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GPUUtilization != nil {
 		in, out := &in.GPUUtilization, &out.GPUUtilization
 		*out = new(GPUUtilizationMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(ResourceUtilizationMetric)
+		**out = **in
+	}
+	if in.MemoryUtilization != nil {
+		in, out := &in.MemoryUtilization, &out.MemoryUtilization
+		*out = new(ResourceUtilizationMetric)
 		**out = **in
 	}
 	if in.RequestQueueDepth != nil {
 		in, out := &in.RequestQueueDepth, &out.RequestQueueDepth
 		*out = new(QueueDepthMetric)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokensPerSecond != nil {
+		in, out := &in.TokensPerSecond, &out.TokensPerSecond
+		*out = new(TokensPerSecondMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InFlightRequests != nil {
+		in, out := &in.InFlightRequests, &out.InFlightRequests
+		*out = new(InFlightRequestsMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyntheticProbe != nil {
+		in, out := &in.SyntheticProbe, &out.SyntheticProbe
+		*out = new(SyntheticProbeMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Queueing != nil {
+		in, out := &in.Queueing, &out.Queueing
+		*out = new(QueueingMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomMetrics != nil {
+		in, out := &in.CustomMetrics, &out.CustomMetrics
+		*out = make([]CustomMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]MetricSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -240,9 +678,81 @@ func (in *MetricsSpec) DeepCopy() *MetricsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *PayloadReference) DeepCopyInto(out *PayloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *PayloadReference) DeepCopy() *PayloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PayloadReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *PodMonitorSpec) DeepCopyInto(out *PodMonitorSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *PodMonitorSpec) DeepCopy() *PodMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *PrometheusSpec) DeepCopy() *PrometheusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *QueueDepthMetric) DeepCopyInto(out *QueueDepthMetric) {
 	*out = *in
+	if in.TargetDepth != nil {
+		in, out := &in.TargetDepth, &out.TargetDepth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MetricWindow)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function
@@ -255,6 +765,51 @@ func (in *QueueDepthMetric) DeepCopy() *QueueDepthMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *QueueingMetric) DeepCopyInto(out *QueueingMetric) {
+	*out = *in
+	if in.ArrivalRateTransform != nil {
+		in, out := &in.ArrivalRateTransform, &out.ArrivalRateTransform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceTimeTransform != nil {
+		in, out := &in.ServiceTimeTransform, &out.ServiceTimeTransform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MetricWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *QueueingMetric) DeepCopy() *QueueingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *ResourceUtilizationMetric) DeepCopyInto(out *ResourceUtilizationMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *ResourceUtilizationMetric) DeepCopy() *ResourceUtilizationMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUtilizationMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *ScaleBehavior) DeepCopyInto(out *ScaleBehavior) {
 	*out = *in
@@ -275,6 +830,21 @@ func (in *ScaleBehavior) DeepCopy() *ScaleBehavior {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *ScaleToZeroSpec) DeepCopyInto(out *ScaleToZeroSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *ScaleToZeroSpec) DeepCopy() *ScaleToZeroSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleToZeroSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *ScalingPolicy) DeepCopyInto(out *ScalingPolicy) {
 	*out = *in
@@ -290,6 +860,82 @@ func (in *ScalingPolicy) DeepCopy() *ScalingPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *ShardParitySpec) DeepCopyInto(out *ShardParitySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *ShardParitySpec) DeepCopy() *ShardParitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardParitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *SmoothingSpec) DeepCopyInto(out *SmoothingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *SmoothingSpec) DeepCopy() *SmoothingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SmoothingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *SyntheticProbeMetric) DeepCopyInto(out *SyntheticProbeMetric) {
+	*out = *in
+	if in.PayloadRef != nil {
+		in, out := &in.PayloadRef, &out.PayloadRef
+		*out = new(PayloadReference)
+		**out = **in
+	}
+	if in.TargetLatencyMs != nil {
+		in, out := &in.TargetLatencyMs, &out.TargetLatencyMs
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *SyntheticProbeMetric) DeepCopy() *SyntheticProbeMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticProbeMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *TargetModulation) DeepCopyInto(out *TargetModulation) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Targets.DeepCopyInto(&out.Targets)
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *TargetModulation) DeepCopy() *TargetModulation {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetModulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function
 func (in *TargetRef) DeepCopyInto(out *TargetRef) {
 	*out = *in
@@ -304,3 +950,33 @@ func (in *TargetRef) DeepCopy() *TargetRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function
+func (in *TokensPerSecondMetric) DeepCopyInto(out *TokensPerSecondMetric) {
+	*out = *in
+	if in.TargetPerReplica != nil {
+		in, out := &in.TargetPerReplica, &out.TargetPerReplica
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(MetricTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MetricWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function
+func (in *TokensPerSecondMetric) DeepCopy() *TokensPerSecondMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(TokensPerSecondMetric)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main is the entry point for the kubeai-autoscaler activator, which
+// holds requests for a target scaled to zero until it has scaled back up.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/activator"
+	"github.com/pmady/kubeai-autoscaler/pkg/controller"
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(kubeaiv1alpha1.AddToScheme(scheme))
+}
+
+// parsePolicy parses a namespace/name policy reference
+func parsePolicy(s string) (types.NamespacedName, error) {
+	namespace, name, ok := strings.Cut(s, "/")
+	if !ok || namespace == "" || name == "" {
+		return types.NamespacedName{}, fmt.Errorf("policy must be namespace/name, got %q", s)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+func main() {
+	var listenAddr string
+	var metricsAddr string
+	var probeAddr string
+	var policyRef string
+	var service string
+	var upstream string
+	var timeout time.Duration
+	var maxPending int64
+	var emergencyStopNamespace string
+	var emergencyStopConfigMap string
+
+	flag.StringVar(&listenAddr, "listen-address", ":8000", "The address the activator proxy binds to.")
+	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&policyRef, "policy", "", "The AIInferenceAutoscalerPolicy whose target is woken, as namespace/name.")
+	flag.StringVar(&service, "service", "", "The Service in front of the target, in the policy's namespace.")
+	flag.StringVar(&upstream, "upstream", "",
+		"URL requests are forwarded to once the target is ready (default http://<service>.<namespace>.svc).")
+	flag.DurationVar(&timeout, "timeout", activator.DefaultTimeout,
+		"How long a request is held while the target scales up before 503 is returned.")
+	flag.Int64Var(&maxPending, "max-pending", 0, "Maximum number of requests held while the target scales up (0 means no limit).")
+	flag.StringVar(&emergencyStopNamespace, "emergency-stop-namespace", "kubeai-system",
+		"The namespace of the controller, holding its emergency stop ConfigMap.")
+	flag.StringVar(&emergencyStopConfigMap, "emergency-stop-configmap", controller.DefaultEmergencyStopConfigMap,
+		"The controller's emergency stop ConfigMap; while it exists the target is not woken. "+
+			"Empty ignores the emergency stop.")
+
+	opts := zap.Options{
+		Development: true,
+	}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+
+	policy, err := parsePolicy(policyRef)
+	if err != nil {
+		setupLog.Error(err, "invalid --policy")
+		os.Exit(1)
+	}
+	if service == "" {
+		setupLog.Error(errors.New("--service is required"), "invalid flags")
+		os.Exit(1)
+	}
+	if upstream == "" {
+		upstream = fmt.Sprintf("http://%s.%s.svc", service, policy.Namespace)
+	}
+	upstreamURL, err := url.Parse(upstream)
+	if err != nil {
+		setupLog.Error(err, "invalid --upstream")
+		os.Exit(1)
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: metricsAddr},
+		HealthProbeBindAddress: probeAddr,
+		// Only the policy's namespace is watched; the emergency stop and the
+		// namespace are read when a request wakes the target
+		Cache: cache.Options{DefaultNamespaces: map[string]cache.Config{policy.Namespace: {}}},
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.ConfigMap{}, &corev1.Namespace{}}},
+		},
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
+		os.Exit(1)
+	}
+
+	var emergencyStop *controller.EmergencyStop
+	if emergencyStopConfigMap != "" {
+		emergencyStop = controller.NewEmergencyStop(types.NamespacedName{Namespace: emergencyStopNamespace, Name: emergencyStopConfigMap})
+		emergencyStop.Client = mgr.GetClient()
+	}
+
+	act := activator.New(upstreamURL, controller.NewActivatorBackend(mgr.GetClient(), mgr.GetScheme(), policy, service, emergencyStop))
+	act.Timeout = timeout
+	act.MaxPending = maxPending
+
+	// Held requests can drive a requestQueueDepth query so the controller sees the demand too
+	ctrlmetrics.Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "kubeai_activator_pending_requests",
+		Help:        "Number of requests held while the target scales up from zero",
+		ConstLabels: prometheus.Labels{"namespace": policy.Namespace, "policy": policy.Name},
+	}, func() float64 { return float64(act.Pending()) }))
+
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		server := &http.Server{Addr: listenAddr, Handler: act, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			_ = server.Shutdown(shutdownCtx)
+		}()
+		setupLog.Info("serving activator", "address", listenAddr, "upstream", upstreamURL.String())
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})); err != nil {
+		setupLog.Error(err, "unable to add activator server")
+		os.Exit(1)
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up health check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		os.Exit(1)
+	}
+
+	setupLog.Info("starting activator", "policy", policy, "service", service)
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		setupLog.Error(err, "problem running activator")
+		os.Exit(1)
+	}
+}
@@ -18,21 +18,39 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
+	"net/http"
 	"os"
+	"strings"
+	"time"
+	// Embed the time zone database for targetModulation windows; the distroless image ships none
+	_ "time/tzdata"
 
+	uzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/cloudevents"
 	"github.com/pmady/kubeai-autoscaler/pkg/controller"
+	"github.com/pmady/kubeai-autoscaler/pkg/dashboard"
+	"github.com/pmady/kubeai-autoscaler/pkg/features"
 	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
 	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+	"github.com/pmady/kubeai-autoscaler/pkg/tracing"
 )
 
 var (
@@ -43,6 +61,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(kubeaiv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 }
 
 // stringListDiff returns elements in 'after' that are not in 'before' (set difference).
@@ -61,20 +80,230 @@ func stringListDiff(before, after []string) []string {
 	return diff
 }
 
+// splitList splits a comma-separated list, dropping empty entries
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// controllerNamespace returns the namespace the controller runs in, falling
+// back to kubeai-system outside a cluster
+func controllerNamespace() string {
+	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
+		return ns
+	}
+	if data, err := os.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace"); err == nil {
+		if ns := strings.TrimSpace(string(data)); ns != "" {
+			return ns
+		}
+	}
+	return "kubeai-system"
+}
+
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
 	var prometheusAddr string
+	var prometheusMerge string
+	var prometheusRetry metrics.RetryConfig
+	var prometheusBreaker metrics.BreakerConfig
+	var queryCacheTTL time.Duration
 	var pluginDir string
+	var watchPlugins bool
+	var allowedAlgorithms string
+	var deniedAlgorithms string
+	var convergenceRequeueInterval time.Duration
+	var convergenceRequeueCount int
+	var algorithmTimeout time.Duration
+	var decisionTimeout time.Duration
+	var metricsFetchTimeout time.Duration
+	var saturationThreshold time.Duration
+	var tracingEndpoint string
+	var tracingSampleRatio float64
+	var scaleLockDuration time.Duration
+	var localDev bool
+	var localMetricsFile string
+	var stubAlgorithms string
+	var externalAlgorithms string
+	var externalAlgorithmTimeout time.Duration
+	var externalAlgorithmFallback string
+	var fallbackAlgorithm string
+	var externalAlgorithmTLS bool
+	var optimizerAddress string
+	var optimizerTimeout time.Duration
+	var optimizerTLS bool
+	var opencostAddress string
+	var costWindow time.Duration
+	var costRefreshInterval time.Duration
+	var cloudEventsSink string
+	var cloudEventsKafkaTopic string
+	var adminEndpoints bool
+	var dashboardAddr string
+	var otlpAddr string
+	var otlpGRPCAddr string
+	var storageVersionCheck bool
+	var featureGates string
+	var mode string
+	var shutdownGracePeriod time.Duration
+	var stateConfigMap string
+	var stateFile string
+	var stateCheckpointInterval time.Duration
+	var forecastWarmupWindow time.Duration
+	var modelProfilesConfigMap string
+	var emergencyStopConfigMap string
+	var eventBurst int
+	var eventWindow time.Duration
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
-	flag.StringVar(&prometheusAddr, "prometheus-address", "http://prometheus:9090", "The address of the Prometheus server.")
-	flag.StringVar(&pluginDir, "plugin-dir", "", "Directory containing custom algorithm plugins (.so files)")
+	flag.StringVar(&prometheusAddr, "prometheus-address", "http://prometheus:9090",
+		"The address of the Prometheus server. A comma-separated list queries every server (e.g. an HA pair).")
+	flag.StringVar(&prometheusMerge, "prometheus-merge", metrics.MergeFirstSuccess,
+		"How results from several Prometheus servers are combined: FirstSuccess, Max or Avg.")
+	flag.IntVar(&prometheusRetry.Retries, "prometheus-query-retries", metrics.DefaultQueryRetries,
+		"How many times a Prometheus query failing with a network error, timeout or server error is retried. "+
+			"0 disables retries.")
+	flag.DurationVar(&prometheusRetry.InitialBackoff, "prometheus-retry-backoff", metrics.DefaultRetryBackoff,
+		"Wait before the first retry of a Prometheus query; it doubles, with jitter, on every retry.")
+	flag.DurationVar(&prometheusRetry.MaxBackoff, "prometheus-retry-max-backoff", metrics.DefaultMaxRetryBackoff,
+		"Maximum wait between retries of a Prometheus query.")
+	flag.DurationVar(&prometheusRetry.AttemptTimeout, "prometheus-query-timeout", metrics.DefaultQueryTimeout,
+		"Deadline of a single Prometheus query attempt, so a hung request leaves time to retry. 0 disables it.")
+	flag.IntVar(&prometheusBreaker.FailureThreshold, "metrics-circuit-failure-threshold", metrics.DefaultBreakerFailureThreshold,
+		"Consecutive failed queries after which a Prometheus endpoint is no longer queried until it recovers. "+
+			"0 disables the circuit breaker.")
+	flag.DurationVar(&prometheusBreaker.OpenDuration, "metrics-circuit-open-duration", metrics.DefaultBreakerOpenDuration,
+		"How long queries to a failing Prometheus endpoint are short-circuited before a probe query is let through.")
+	flag.DurationVar(&queryCacheTTL, "metrics-cache-ttl", metrics.DefaultQueryCacheTTL,
+		"How long a Prometheus query result is shared between policies sending the same query. 0 disables the cache.")
+	flag.StringVar(&pluginDir, "plugin-dir", "", "Directory containing custom algorithm plugins (.so and .wasm files)")
+	flag.BoolVar(&watchPlugins, "watch-plugins", false,
+		"Watch --plugin-dir and load new or updated plugins without restarting the controller.")
+	flag.StringVar(&allowedAlgorithms, "allowed-algorithms", "",
+		"Comma-separated algorithms policies may use; a trailing * matches by prefix. Empty allows every registered algorithm.")
+	flag.StringVar(&deniedAlgorithms, "denied-algorithms", "",
+		"Comma-separated algorithms policies may not use, such as experimental-*. Takes precedence over --allowed-algorithms.")
+	flag.DurationVar(&algorithmTimeout, "algorithm-timeout", scaling.DefaultComputeTimeout,
+		"Deadline for a single scaling algorithm computation before falling back to the default algorithm.")
+	flag.DurationVar(&decisionTimeout, "decision-timeout", controller.DefaultDecisionTimeout,
+		"Budget for reading the target, fetching metrics, computing and writing one scaling decision before "+
+			"the reconcile is abandoned and requeued (0 disables). Keep it above --algorithm-timeout.")
+	flag.DurationVar(&metricsFetchTimeout, "metrics-fetch-timeout", controller.DefaultMetricsFetchTimeout,
+		"Deadline for the concurrent metric queries of one reconcile; queries still running are handled as missing "+
+			"metrics (0 disables). Keep it below --decision-timeout.")
+	flag.DurationVar(&saturationThreshold, "saturation-threshold", controller.DefaultSaturationThreshold,
+		"How long a policy may stay at maxReplicas with metrics above target before SaturatedAtMax is reported.")
+	flag.DurationVar(&convergenceRequeueInterval, "convergence-requeue-interval", controller.DefaultConvergenceRequeueInterval,
+		"Requeue interval used right after a scale change to track convergence.")
+	flag.IntVar(&convergenceRequeueCount, "convergence-requeue-count", controller.DefaultConvergenceRequeueCount,
+		"Number of short requeues after a scale change before returning to the normal interval (0 disables).")
+	flag.DurationVar(&scaleLockDuration, "scale-lock-duration", controller.DefaultScaleLockDuration,
+		"How long the per-target scale lock blocks other controllers and policies after a replica change (0 disables).")
+	flag.DurationVar(&shutdownGracePeriod, "shutdown-grace-period", controller.DefaultShutdownGracePeriod,
+		"How long shutdown waits for in-flight reconciles and the algorithm state write before exiting.")
+	flag.StringVar(&stateConfigMap, "state-configmap", controller.DefaultStateConfigMap,
+		"ConfigMap in the controller namespace that keeps algorithm state across restarts. Empty disables persistence.")
+	flag.StringVar(&stateFile, "state-file", "",
+		"File, e.g. on a PersistentVolume, that keeps algorithm state across restarts instead of --state-configmap.")
+	flag.DurationVar(&stateCheckpointInterval, "state-checkpoint-interval", controller.DefaultStateCheckpointInterval,
+		"How often algorithm state is saved while leading, so a crash loses at most this much learning. "+
+			"0 saves only on shutdown.")
+	flag.DurationVar(&forecastWarmupWindow, "forecast-warmup-window", 0,
+		"How far back the recommendations of Predictive policies without a saved forecast are replayed from "+
+			"Prometheus on startup, e.g. 168h for a week of daily patterns. 0 disables the replay.")
+	flag.StringVar(&modelProfilesConfigMap, "model-profiles-configmap", "",
+		"ConfigMap in the controller namespace whose "+controller.ModelProfilesKey+" key holds the model profile table "+
+			"selected by the kubeai.io/model-* annotations of targets. Empty uses the built-in profiles.")
+	flag.StringVar(&emergencyStopConfigMap, "emergency-stop-configmap", controller.DefaultEmergencyStopConfigMap,
+		"ConfigMap in the controller namespace whose existence halts the target changes of every policy. "+
+			"With --admin-endpoints it is managed through /admin/emergency-stop. Empty disables the emergency stop.")
+	flag.IntVar(&eventBurst, "event-burst", controller.DefaultEventBurst,
+		"Events with the same reason emitted per object within --event-window before repeats are suppressed (0 disables).")
+	flag.DurationVar(&eventWindow, "event-window", controller.DefaultEventWindow,
+		"Window over which --event-burst is counted.")
+
+	flag.StringVar(&tracingEndpoint, "tracing-endpoint", "",
+		"OTLP/HTTP endpoint to export reconcile traces to (e.g. http://otel-collector:4318). Empty disables tracing.")
+	flag.Float64Var(&tracingSampleRatio, "tracing-sample-ratio", 1.0, "Fraction of reconciles to trace when tracing is enabled.")
+
+	flag.BoolVar(&localDev, "local-dev", false,
+		"Run against the cluster in the current kubeconfig with metrics read from --local-metrics-file "+
+			"instead of Prometheus. Leader election is disabled.")
+	flag.StringVar(&localMetricsFile, "local-metrics-file", "hack/local-metrics.yaml",
+		"YAML file with the metric values served in --local-dev mode; edits are picked up without a restart.")
+	flag.StringVar(&stubAlgorithms, "stub-algorithms", "",
+		"Comma-separated algorithm names to register as stubs of "+controller.DefaultAlgorithmName+
+			" when no plugin provides them, so policies using plugin algorithms reconcile where plugins cannot load.")
+	flag.StringVar(&externalAlgorithms, "external-algorithms", "",
+		"Comma-separated name=address pairs of ScalingAlgorithm gRPC services to register as algorithms.")
+	flag.DurationVar(&externalAlgorithmTimeout, "external-algorithm-timeout", scaling.DefaultExternalTimeout,
+		"How long an external algorithm service has to answer before the fallback algorithm is used. "+
+			"Keep it below --algorithm-timeout.")
+	flag.StringVar(&externalAlgorithmFallback, "external-algorithm-fallback", controller.DefaultAlgorithmName,
+		"Algorithm used when an external algorithm service fails or times out.")
+	flag.StringVar(&fallbackAlgorithm, "fallback-algorithm", controller.DefaultAlgorithmName,
+		"Algorithm used when a policy's algorithm is not registered or does not complete and the policy "+
+			"sets no spec.algorithm.fallback.")
+	flag.BoolVar(&externalAlgorithmTLS, "external-algorithm-tls", false,
+		"Connect to external algorithm services with TLS verified against the system roots instead of plaintext.")
+	flag.StringVar(&optimizerAddress, "optimizer-address", "",
+		"Address of an Optimizer gRPC service asked for advisory constraints, such as a preferred GPU pool or a "+
+			"replica budget, on every scaling decision. Empty disables the optimizer.")
+	flag.DurationVar(&optimizerTimeout, "optimizer-timeout", scaling.DefaultOptimizerTimeout,
+		"How long the optimizer has to answer before the decision proceeds with its last unexpired advice or none.")
+	flag.BoolVar(&optimizerTLS, "optimizer-tls", false,
+		"Connect to the optimizer with TLS verified against the system roots instead of plaintext.")
+	flag.StringVar(&opencostAddress, "opencost-address", "",
+		"Base URL of the OpenCost or Kubecost allocation API, such as http://opencost.opencost:9003 or "+
+			"http://kubecost-cost-analyzer.kubecost:9090/model, used to report the realized cost of each target. "+
+			"Empty disables cost reporting.")
+	flag.DurationVar(&costWindow, "cost-window", controller.DefaultCostWindow,
+		"Period the realized cost of a target is averaged over.")
+	flag.DurationVar(&costRefreshInterval, "cost-refresh-interval", controller.DefaultCostRefreshInterval,
+		"How long the realized cost of a target is reused before OpenCost is queried again.")
+	flag.StringVar(&cloudEventsSink, "cloudevents-sink", "",
+		"HTTP endpoint, such as a Knative broker, that receives a CloudEvent for every scaling decision and its outcome. "+
+			"With --cloudevents-kafka-topic it is the address of a Kafka HTTP bridge. Empty disables CloudEvents.")
+	flag.StringVar(&cloudEventsKafkaTopic, "cloudevents-kafka-topic", "",
+		"Kafka topic the CloudEvents are produced to through the Kafka HTTP bridge at --cloudevents-sink.")
+
+	flag.BoolVar(&adminEndpoints, "admin-endpoints", false,
+		"Serve /debug/log-level and /debug/decision-logging on the metrics server to change the log level and log "+
+			"every decision input of a policy at runtime, /debug/algorithms and /debug/algorithm-state to inspect "+
+			"the algorithms and their state, and /admin/emergency-stop. Requests need a bearer token "+
+			"allowed to update every policy, checked with TokenReview and SubjectAccessReview.")
+
+	flag.StringVar(&dashboardAddr, "dashboard-bind-address", "",
+		"The address the read-only web dashboard of the policies binds to. Requests need a Kubernetes bearer token "+
+			"whose user may list policies, checked with TokenReview and SubjectAccessReview. Empty disables the dashboard.")
+
+	flag.StringVar(&otlpAddr, "otlp-bind-address", "",
+		"The address OTLP/HTTP metrics are received on, at "+metrics.OTLPMetricsPath+", for policies with OTLP metric "+
+			"sources. Empty disables the receiver.")
+	flag.StringVar(&otlpGRPCAddr, "otlp-grpc-bind-address", "",
+		"The address OTLP/gRPC metrics are received on when --otlp-bind-address is set. Empty receives OTLP over HTTP only.")
+
+	flag.BoolVar(&storageVersionCheck, "storage-version-check", true,
+		"Set the StorageVersionOutdated condition on policies that may still be stored at an old CRD version "+
+			"until kubectl kubeai migrate-storage rewrites them. Needs get on customresourcedefinitions.")
+
+	flag.StringVar(&featureGates, "feature-gates", "",
+		"Comma-separated Name=true|false pairs turning features on or off. Known gates: "+
+			strings.Join(features.Known(), ", ")+" (MetricHistory records the last samples of each metric in policy status; "+
+			"ManagedPodMonitors creates the PodMonitors requested by spec.podMonitor).")
+
+	flag.StringVar(&mode, "mode", controller.ModeEnforce,
+		"Enforce scales targets. Recommend computes every decision without writing anything and exports how often it "+
+			"agrees with the active controller, for running a new version alongside the current one before switching it to Enforce.")
 
 	opts := zap.Options{
 		Development: true,
@@ -82,27 +311,117 @@ func main() {
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	// Keep the log level switchable at runtime; --zap-log-level sets an atomic level
+	logLevel, ok := opts.Level.(uzap.AtomicLevel)
+	if !ok {
+		logLevel = uzap.NewAtomicLevelAt(zapcore.DebugLevel)
+		opts.Level = logLevel
+	}
+
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	// Export reconcile traces; metrics carry their trace IDs as exemplars
+	shutdownTracing := func(context.Context) error { return nil }
+	if tracingEndpoint != "" {
+		shutdown, err := tracing.Setup(context.Background(), tracingEndpoint, tracingSampleRatio)
+		if err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+		shutdownTracing = shutdown
+		setupLog.Info("tracing enabled", "endpoint", tracingEndpoint, "sampleRatio", tracingSampleRatio)
+	}
+
+	gates, err := features.Parse(featureGates)
+	if err != nil {
+		setupLog.Error(err, "invalid --feature-gates")
+		os.Exit(1)
+	}
+
+	if mode != controller.ModeEnforce && mode != controller.ModeRecommend {
+		setupLog.Error(nil, "invalid --mode, must be Enforce or Recommend", "mode", mode)
+		os.Exit(1)
+	}
+	// A Recommend mode controller leads separately so it runs next to the active controller
+	leaderElectionID := "kubeai-autoscaler.kubeai.io"
+	if mode == controller.ModeRecommend {
+		leaderElectionID = "kubeai-autoscaler-recommend.kubeai.io"
+	}
+
+	if localDev {
+		enableLeaderElection = false
+		stateConfigMap = ""
+		stateFile = ""
+		setupLog.Info("local development mode", "metricsFile", localMetricsFile)
+	}
+
+	debugHandlers := map[string]http.Handler{
+		"/openmetrics": metrics.OpenMetricsHandler(),
+	}
+	// Changing the controller at runtime takes the right to update every policy;
+	// the client is set once the manager exists
+	adminAuthenticator := &dashboard.APIServerAuthenticator{Verb: "update"}
+	var decisionLogging *controller.DecisionLogging
+	if adminEndpoints {
+		// Algorithm state reveals the load of every policy, so it is served to
+		// the same tokens as the endpoints changing the controller
+		debugHandlers["/debug/algorithm-state"] = controller.RequireToken(adminAuthenticator, scaling.StateHandler(scaling.DefaultStateStore))
+		debugHandlers["/debug/algorithms"] = controller.RequireToken(adminAuthenticator, scaling.AlgorithmsHandler(scaling.DefaultRegistry))
+		decisionLogging = controller.NewDecisionLogging()
+		debugHandlers["/debug/log-level"] = controller.RequireToken(adminAuthenticator, controller.LogLevelHandler(logLevel))
+		debugHandlers["/debug/decision-logging"] = controller.RequireToken(adminAuthenticator, decisionLogging.Handler())
+	}
+	var emergencyStop *controller.EmergencyStop
+	if emergencyStopConfigMap != "" {
+		emergencyStop = controller.NewEmergencyStop(types.NamespacedName{Namespace: controllerNamespace(), Name: emergencyStopConfigMap})
+		emergencyStop.Authenticator = adminAuthenticator
+		if adminEndpoints {
+			debugHandlers["/admin/emergency-stop"] = emergencyStop.Handler()
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
 		Metrics: metricsserver.Options{
-			BindAddress: metricsAddr,
+			BindAddress:   metricsAddr,
+			ExtraHandlers: debugHandlers,
+		},
+		// Scale locks and cache shard counts must be read from the API server, not a
+		// possibly stale cache, and watching every ConfigMap, Event or Secret is not needed
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&coordinationv1.Lease{}, &corev1.ConfigMap{}, &corev1.Event{}, &corev1.Secret{}}},
 		},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "kubeai-autoscaler.kubeai.io",
+		LeaderElectionID:       leaderElectionID,
+		// Hand leadership over as soon as in-flight work is done rather than
+		// making the next controller wait out the lease
+		LeaderElectionReleaseOnCancel: true,
+		GracefulShutdownTimeout:       &shutdownGracePeriod,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
+	adminAuthenticator.Client = mgr.GetClient()
+	if emergencyStop != nil {
+		emergencyStop.Client = mgr.GetClient()
+	}
 
 	// Load custom algorithm plugins
 	if pluginDir != "" {
 		setupLog.Info("loading custom algorithm plugins", "directory", pluginDir)
 		algorithmsBefore := scaling.List()
-		if err := scaling.LoadAndRegisterPlugins(pluginDir, scaling.DefaultRegistry); err != nil {
+		if watchPlugins {
+			watcher := &controller.PluginWatcher{Dir: pluginDir, Registry: scaling.DefaultRegistry}
+			if _, err := watcher.LoadAll(); err != nil {
+				setupLog.Error(err, "failed to load some plugins, continuing with available algorithms")
+			}
+			if err := mgr.Add(watcher); err != nil {
+				setupLog.Error(err, "unable to add plugin watcher")
+				os.Exit(1)
+			}
+		} else if err := scaling.LoadAndRegisterPlugins(pluginDir, scaling.DefaultRegistry); err != nil {
 			setupLog.Error(err, "failed to load some plugins, continuing with available algorithms")
 		}
 		algorithmsAfter := scaling.List()
@@ -111,23 +430,197 @@ func main() {
 		setupLog.Info("registered algorithms", "algorithms", algorithmsAfter)
 	}
 
+	if endpoints := splitList(externalAlgorithms); len(endpoints) > 0 {
+		err := scaling.RegisterExternalAlgorithms(scaling.DefaultRegistry, endpoints, scaling.ExternalOptions{
+			Timeout:  externalAlgorithmTimeout,
+			Fallback: externalAlgorithmFallback,
+			TLS:      externalAlgorithmTLS,
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to register external algorithms")
+			os.Exit(1)
+		}
+		setupLog.Info("registered algorithms", "algorithms", scaling.List())
+	}
+
+	if stubs := splitList(stubAlgorithms); len(stubs) > 0 {
+		if err := scaling.RegisterStubs(scaling.DefaultRegistry, stubs, controller.DefaultAlgorithmName); err != nil {
+			setupLog.Error(err, "unable to register algorithm stubs")
+			os.Exit(1)
+		}
+		setupLog.Info("registered algorithms", "algorithms", scaling.List())
+	}
+
+	if _, err := scaling.DefaultRegistry.Get(fallbackAlgorithm); err != nil {
+		setupLog.Error(err, "unknown --fallback-algorithm", "algorithm", fallbackAlgorithm, "available", scaling.List())
+		os.Exit(1)
+	}
+
 	// Create Prometheus metrics client
 	var metricsClient metrics.Client
-	if prometheusAddr != "" {
-		metricsClient, err = metrics.NewPrometheusClient(prometheusAddr)
+	if localDev {
+		fileClient, err := metrics.NewFileClient(localMetricsFile)
+		if err != nil {
+			setupLog.Error(err, "unable to read local metrics file")
+			os.Exit(1)
+		}
+		metricsClient = fileClient
+	} else if addresses := splitList(prometheusAddr); len(addresses) == 1 {
+		metricsClient, err = metrics.NewPrometheusClient(addresses[0], prometheusRetry)
 		if err != nil {
 			setupLog.Error(err, "unable to create Prometheus client, continuing without metrics")
+		} else {
+			metricsClient = controller.WrapMetricsClient(metricsClient, addresses, prometheusBreaker, queryCacheTTL)
+		}
+	} else if len(addresses) > 1 {
+		metricsClient, err = metrics.NewMultiPrometheusClient(addresses, prometheusMerge, prometheusRetry)
+		if err != nil {
+			setupLog.Error(err, "unable to create Prometheus client, continuing without metrics")
+		} else {
+			metricsClient = controller.WrapMetricsClient(metricsClient, addresses, prometheusBreaker, queryCacheTTL)
 		}
 	}
 
-	// Setup reconciler
-	eventRecorder := controller.NewEventRecorder(mgr.GetEventRecorderFor("kubeai-autoscaler"))
-	reconciler := controller.NewReconciler(mgr.GetClient(), mgr.GetScheme(), metricsClient, scaling.DefaultRegistry, eventRecorder)
+	// Setup reconciler. In Recommend mode nothing is written and no events are
+	// emitted; the active controller owns the targets and policy status.
+	writeClient := mgr.GetClient()
+	eventRecorder := controller.NewEventRecorder(controller.NewAggregatingRecorder(
+		mgr.GetEventRecorderFor("kubeai-autoscaler"), eventBurst, eventWindow))
+	if mode == controller.ModeRecommend {
+		writeClient = controller.NewReadOnlyClient(writeClient)
+		eventRecorder = nil
+		setupLog.Info("running in Recommend mode, targets will not be scaled")
+	}
+	reconciler := controller.NewReconciler(writeClient, mgr.GetScheme(), metricsClient, scaling.DefaultRegistry, eventRecorder)
+	reconciler.Mode = mode
+	reconciler.AlgorithmTimeout = algorithmTimeout
+	reconciler.FallbackAlgorithm = fallbackAlgorithm
+	reconciler.DecisionLogging = decisionLogging
+	reconciler.EmergencyStop = emergencyStop
+	reconciler.NewMetricsClient = controller.PrometheusClientFactory(prometheusRetry, prometheusBreaker, queryCacheTTL)
+	reconciler.AlgorithmFilter = scaling.NewAlgorithmFilter(allowedAlgorithms, deniedAlgorithms)
+	reconciler.DecisionTimeout = decisionTimeout
+	reconciler.MetricsFetchTimeout = metricsFetchTimeout
+	if storageVersionCheck {
+		reconciler.StorageVersions = controller.NewStorageVersionChecker(mgr.GetAPIReader())
+	}
+	reconciler.Features = gates
+	if modelProfilesConfigMap != "" {
+		reconciler.ModelProfilesConfigMap = types.NamespacedName{Namespace: controllerNamespace(), Name: modelProfilesConfigMap}
+	}
+	reconciler.SaturationThreshold = saturationThreshold
+	reconciler.ConvergenceRequeueInterval = convergenceRequeueInterval
+	reconciler.ConvergenceRequeueCount = convergenceRequeueCount
+	reconciler.ScaleLockDuration = scaleLockDuration
+	reconciler.RESTConfig = mgr.GetConfig()
+	if reconciler.ResourceMetrics, err = metrics.NewResourceMetricsClient(mgr.GetConfig().Host, mgr.GetHTTPClient()); err != nil {
+		setupLog.Error(err, "unable to create resource metrics client, continuing without CPU and memory metrics")
+	}
+	if otlpAddr != "" {
+		reconciler.OTLPReceiver = metrics.NewOTLPReceiver()
+		server := &controller.OTLPServer{Receiver: reconciler.OTLPReceiver, HTTPAddress: otlpAddr, GRPCAddress: otlpGRPCAddr}
+		if err := mgr.Add(server); err != nil {
+			setupLog.Error(err, "unable to set up the OTLP receiver")
+			os.Exit(1)
+		}
+		setupLog.Info("OTLP receiver enabled", "address", otlpAddr, "grpcAddress", otlpGRPCAddr)
+	}
+	// Share state with plugins so all of it is served by /debug/algorithm-state and dropped with the policy
+	reconciler.AlgorithmState = scaling.DefaultStateStore
+	if localDev {
+		reconciler.MetricsOverride = metricsClient
+	}
+	if optimizerAddress != "" {
+		optimizer, err := scaling.DialExternalOptimizer(optimizerAddress, optimizerTimeout, optimizerTLS)
+		if err != nil {
+			setupLog.Error(err, "unable to set up optimizer")
+			os.Exit(1)
+		}
+		reconciler.Optimizer = optimizer
+		setupLog.Info("optimizer enabled", "address", optimizerAddress)
+	}
+	if opencostAddress != "" {
+		reconciler.CostClient = metrics.NewOpenCostClient(opencostAddress)
+		reconciler.CostWindow = costWindow
+		reconciler.CostRefreshInterval = costRefreshInterval
+		setupLog.Info("realized cost reporting enabled", "address", opencostAddress)
+	}
+	if cloudEventsSink != "" {
+		sink, err := cloudevents.NewSink(cloudEventsSink, cloudEventsKafkaTopic)
+		if err != nil {
+			setupLog.Error(err, "invalid --cloudevents-sink")
+			os.Exit(1)
+		}
+		publisher := cloudevents.NewAsyncPublisher(sink, cloudevents.DefaultBufferSize)
+		if err := mgr.Add(publisher); err != nil {
+			setupLog.Error(err, "unable to set up CloudEvents publishing")
+			os.Exit(1)
+		}
+		reconciler.CloudEvents = publisher
+		setupLog.Info("CloudEvents enabled", "sink", cloudEventsSink, "kafkaTopic", cloudEventsKafkaTopic)
+	} else if cloudEventsKafkaTopic != "" {
+		setupLog.Error(nil, "--cloudevents-kafka-topic requires --cloudevents-sink")
+		os.Exit(1)
+	}
 	if err = reconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AIInferenceAutoscalerPolicy")
 		os.Exit(1)
 	}
 
+	// Keep learned algorithm state across restarts and leader changes. In Recommend
+	// mode the state is loaded from the active controller but never saved.
+	var stateBackend controller.StateBackend
+	if stateFile != "" {
+		stateBackend = &controller.FileStateBackend{Path: stateFile}
+	} else if stateConfigMap != "" {
+		stateBackend = &controller.ConfigMapStateBackend{
+			Client: writeClient,
+			Reader: mgr.GetAPIReader(),
+			Key:    types.NamespacedName{Namespace: controllerNamespace(), Name: stateConfigMap},
+		}
+	}
+	if stateBackend != nil {
+		persister := &controller.StatePersister{
+			Backend:            stateBackend,
+			Store:              scaling.DefaultStateStore,
+			Reconciler:         reconciler,
+			GracePeriod:        shutdownGracePeriod,
+			CheckpointInterval: stateCheckpointInterval,
+			ReadOnly:           mode == controller.ModeRecommend,
+		}
+		if addresses := splitList(prometheusAddr); forecastWarmupWindow > 0 && len(addresses) > 0 {
+			history, err := metrics.NewPrometheusClient(addresses[0], prometheusRetry)
+			if err != nil {
+				setupLog.Error(err, "unable to create Prometheus client for forecast history")
+				os.Exit(1)
+			}
+			persister.Warmup = &controller.ForecastWarmup{
+				Reader:     mgr.GetAPIReader(),
+				History:    history,
+				Window:     forecastWarmupWindow,
+				Reconciler: reconciler,
+			}
+		}
+		if err := mgr.Add(persister); err != nil {
+			setupLog.Error(err, "unable to set up algorithm state persistence")
+			os.Exit(1)
+		}
+	}
+
+	// Export per-namespace and per-algorithm rollups computed from the policy cache
+	ctrlmetrics.Registry.MustRegister(metrics.NewRollupCollector(controller.PolicySummaries(mgr.GetClient())))
+
+	// Reviews are created with the manager's client: they are not stored, so
+	// they are allowed in Recommend mode too
+	if dashboardAddr != "" {
+		authenticator := &dashboard.APIServerAuthenticator{Client: mgr.GetClient()}
+		if err := mgr.Add(dashboard.NewServer(dashboardAddr, mgr.GetClient(), authenticator)); err != nil {
+			setupLog.Error(err, "unable to set up the dashboard")
+			os.Exit(1)
+		}
+		setupLog.Info("dashboard enabled", "address", dashboardAddr)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -138,7 +631,11 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(ctrl.SetupSignalHandler())
+	if shutdownErr := shutdownTracing(context.Background()); shutdownErr != nil {
+		setupLog.Error(shutdownErr, "failed to flush traces")
+	}
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main is the entry point for kubectl-kubeai, a kubectl plugin that
+// manages AIInferenceAutoscalerPolicies in bulk and simulates changes to them.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/cli"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/migration"
+)
+
+const usage = `Usage: kubectl kubeai <command> [flags] [policy...]
+
+Commands:
+  pause              Suspend the selected policies
+  resume             Resume the selected policies
+  set-max <replicas> Set maxReplicas of the selected policies
+  set-mode <mode>    Set the selected policies to Enforce or DryRun
+  migrate-storage    Rewrite every policy at the CRD storage version and
+                     remove deprecated fields
+  fleet              Replay the recorded metrics of the selected policies
+                     through their current spec and the spec in --proposed,
+                     and report the change in GPU-hours and time over target
+
+Select policies by name, with --all, or with --selector; fleet selects every
+policy in the namespace by default. Changes are printed and applied after
+confirmation; --dry-run only prints them.
+
+Flags:
+`
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(kubeaiv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+}
+
+// parseInterspersed parses flags that may appear before, between or after the
+// positional arguments, which the flag package alone stops at
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("kubectl kubeai", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	if len(args) == 0 {
+		fs.Usage()
+		return fmt.Errorf("no command given")
+	}
+	command := args[0]
+
+	opts := cli.BulkOptions{In: os.Stdin, Out: os.Stdout}
+	var kubeconfig, kubeContext string
+	fs.StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file.")
+	fs.StringVar(&kubeContext, "context", "", "The kubeconfig context to use.")
+	fs.StringVar(&opts.Namespace, "namespace", "", "Namespace of the policies (defaults to the kubeconfig namespace).")
+	fs.StringVar(&opts.Namespace, "n", "", "Shorthand for --namespace.")
+	fs.BoolVar(&opts.AllNamespaces, "all-namespaces", false, "Select policies in every namespace.")
+	fs.BoolVar(&opts.AllNamespaces, "A", false, "Shorthand for --all-namespaces.")
+	fs.BoolVar(&opts.All, "all", false, "Select every policy in the namespace.")
+	fs.StringVar(&opts.Selector, "selector", "", "Label selector of the policies, e.g. team=search.")
+	fs.StringVar(&opts.Selector, "l", "", "Shorthand for --selector.")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "Print the changes without applying them.")
+	fs.BoolVar(&opts.Yes, "yes", false, "Apply the changes without asking for confirmation.")
+	fs.BoolVar(&opts.Yes, "y", false, "Shorthand for --yes.")
+	fleet := cli.FleetOptions{}
+	var prometheusAddress string
+	fs.StringVar(&fleet.Proposed, "proposed", "", "Directory of the proposed policy manifests, for fleet.")
+	fs.StringVar(&prometheusAddress, "prometheus-address", "http://localhost:9090", "Prometheus holding the controller's metrics, for fleet.")
+	fs.DurationVar(&fleet.Window, "window", 24*time.Hour, "How far back fleet replays metrics.")
+	fs.DurationVar(&fleet.Step, "step", time.Minute, "Time between the samples fleet replays.")
+
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		return err
+	}
+
+	var mutate cli.Mutation
+	switch command {
+	case "pause":
+		mutate = cli.Pause()
+	case "resume":
+		mutate = cli.Resume()
+	case "set-max":
+		if len(positional) == 0 {
+			return fmt.Errorf("set-max needs the maximum replicas")
+		}
+		max, err := strconv.ParseInt(positional[0], 10, 32)
+		if err != nil || max < 1 {
+			return fmt.Errorf("maximum replicas must be a positive integer, got %q", positional[0])
+		}
+		mutate = cli.SetMax(int32(max))
+		positional = positional[1:]
+	case "set-mode":
+		if len(positional) == 0 {
+			return fmt.Errorf("set-mode needs %s or %s", cli.ModeEnforce, cli.ModeDryRun)
+		}
+		mode, err := cli.ParseMode(positional[0])
+		if err != nil {
+			return err
+		}
+		mutate = cli.SetMode(mode)
+		positional = positional[1:]
+	case "migrate-storage":
+		if len(positional) > 0 {
+			return fmt.Errorf("migrate-storage rewrites every policy and takes no policy names")
+		}
+	case "fleet":
+		opts.All = len(positional) == 0 && opts.Selector == ""
+	case "help", "-h", "--help":
+		fs.SetOutput(os.Stdout)
+		fs.Usage()
+		return nil
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+	opts.Names = positional
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext})
+	if opts.Namespace == "" {
+		namespace, _, err := clientConfig.Namespace()
+		if err != nil {
+			return fmt.Errorf("failed to read the kubeconfig namespace: %w", err)
+		}
+		opts.Namespace = namespace
+	}
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if command == "migrate-storage" {
+		return cli.RunMigrateStorage(ctx, c, opts, migration.DeprecatedFields)
+	}
+	if command == "fleet" {
+		history, err := metrics.NewPrometheusClient(prometheusAddress, metrics.RetryConfig{})
+		if err != nil {
+			return fmt.Errorf("failed to create Prometheus client: %w", err)
+		}
+		// Keep the logs of the simulated reconciles out of the report
+		log.SetLogger(logr.Discard())
+		return cli.RunFleet(ctx, c, history, opts, fleet)
+	}
+	return cli.RunBulk(ctx, c, opts, mutate)
+}
@@ -78,7 +78,7 @@ func main() {
 	var metricsClient metrics.Client
 	if prometheusAddr != "" {
 		var clientErr error
-		metricsClient, clientErr = metrics.NewPrometheusClient(prometheusAddr)
+		metricsClient, clientErr = metrics.NewPrometheusClient(prometheusAddr, metrics.DefaultRetryConfig())
 		if clientErr != nil {
 			setupLog.Error(clientErr, "unable to create Prometheus client, continuing without metrics")
 		} else {
@@ -154,20 +154,20 @@ func (r *AIInferenceAutoscalerPolicyReconciler) fetchMetrics(
 	// Fetch latency metrics
 	if policy.Spec.Metrics.Latency != nil && policy.Spec.Metrics.Latency.Enabled {
 		query := policy.Spec.Metrics.Latency.PrometheusQuery
-		if policy.Spec.Metrics.Latency.TargetP99Ms > 0 {
-			latencyP99, err := r.MetricsClient.GetLatencyP99(ctx, query)
+		if kubeaiv1alpha1.QuantityFloat64(policy.Spec.Metrics.Latency.TargetP99Ms) > 0 {
+			latencyP99, err := metrics.GetLatencyP99(ctx, r.MetricsClient, query)
 			if err != nil {
 				logger.Error(err, "Failed to fetch P99 latency")
 			} else {
-				currentMetrics.LatencyP99Ms = int32(latencyP99 * 1000) // Convert to ms
+				currentMetrics.LatencyP99Ms = math.Round(latencyP99*1e6) / 1000 // Convert to ms
 			}
 		}
-		if policy.Spec.Metrics.Latency.TargetP95Ms > 0 {
-			latencyP95, err := r.MetricsClient.GetLatencyP95(ctx, query)
+		if kubeaiv1alpha1.QuantityFloat64(policy.Spec.Metrics.Latency.TargetP95Ms) > 0 {
+			latencyP95, err := metrics.GetLatencyP95(ctx, r.MetricsClient, query)
 			if err != nil {
 				logger.Error(err, "Failed to fetch P95 latency")
 			} else {
-				currentMetrics.LatencyP95Ms = int32(latencyP95 * 1000) // Convert to ms
+				currentMetrics.LatencyP95Ms = math.Round(latencyP95*1e6) / 1000 // Convert to ms
 			}
 		}
 	}
@@ -175,7 +175,7 @@ func (r *AIInferenceAutoscalerPolicyReconciler) fetchMetrics(
 	// Fetch GPU utilization
 	if policy.Spec.Metrics.GPUUtilization != nil && policy.Spec.Metrics.GPUUtilization.Enabled {
 		query := policy.Spec.Metrics.GPUUtilization.PrometheusQuery
-		gpuUtil, err := r.MetricsClient.GetGPUUtilization(ctx, query)
+		gpuUtil, err := metrics.GetGPUUtilization(ctx, r.MetricsClient, query)
 		if err != nil {
 			logger.Error(err, "Failed to fetch GPU utilization")
 		} else {
@@ -186,7 +186,7 @@ func (r *AIInferenceAutoscalerPolicyReconciler) fetchMetrics(
 	// Fetch queue depth
 	if policy.Spec.Metrics.RequestQueueDepth != nil && policy.Spec.Metrics.RequestQueueDepth.Enabled {
 		query := policy.Spec.Metrics.RequestQueueDepth.PrometheusQuery
-		queueDepth, err := r.MetricsClient.GetQueueDepth(ctx, query)
+		queueDepth, err := metrics.GetQueueDepth(ctx, r.MetricsClient, query)
 		if err != nil {
 			logger.Error(err, "Failed to fetch queue depth")
 		} else {
@@ -214,17 +214,19 @@ func (r *AIInferenceAutoscalerPolicyReconciler) calculateDesiredReplicas(
 
 	// Calculate latency-based scaling ratio
 	if policy.Spec.Metrics.Latency != nil && policy.Spec.Metrics.Latency.Enabled {
-		if policy.Spec.Metrics.Latency.TargetP99Ms > 0 && currentMetrics.LatencyP99Ms > 0 {
-			latencyRatio := float64(currentMetrics.LatencyP99Ms) / float64(policy.Spec.Metrics.Latency.TargetP99Ms)
+		targetP99 := kubeaiv1alpha1.QuantityFloat64(policy.Spec.Metrics.Latency.TargetP99Ms)
+		if targetP99 > 0 && currentMetrics.LatencyP99Ms > 0 {
+			latencyRatio := currentMetrics.LatencyP99Ms / targetP99
 			maxRatio = math.Max(maxRatio, latencyRatio)
 			logger.V(1).Info("Latency P99 ratio", "current", currentMetrics.LatencyP99Ms,
-				"target", policy.Spec.Metrics.Latency.TargetP99Ms, "ratio", latencyRatio)
+				"target", targetP99, "ratio", latencyRatio)
 		}
-		if policy.Spec.Metrics.Latency.TargetP95Ms > 0 && currentMetrics.LatencyP95Ms > 0 {
-			latencyRatio := float64(currentMetrics.LatencyP95Ms) / float64(policy.Spec.Metrics.Latency.TargetP95Ms)
+		targetP95 := kubeaiv1alpha1.QuantityFloat64(policy.Spec.Metrics.Latency.TargetP95Ms)
+		if targetP95 > 0 && currentMetrics.LatencyP95Ms > 0 {
+			latencyRatio := currentMetrics.LatencyP95Ms / targetP95
 			maxRatio = math.Max(maxRatio, latencyRatio)
 			logger.V(1).Info("Latency P95 ratio", "current", currentMetrics.LatencyP95Ms,
-				"target", policy.Spec.Metrics.Latency.TargetP95Ms, "ratio", latencyRatio)
+				"target", targetP95, "ratio", latencyRatio)
 		}
 	}
 
@@ -240,11 +242,12 @@ func (r *AIInferenceAutoscalerPolicyReconciler) calculateDesiredReplicas(
 
 	// Calculate queue depth-based scaling ratio
 	if policy.Spec.Metrics.RequestQueueDepth != nil && policy.Spec.Metrics.RequestQueueDepth.Enabled {
-		if policy.Spec.Metrics.RequestQueueDepth.TargetDepth > 0 && currentMetrics.RequestQueueDepth > 0 {
-			queueRatio := float64(currentMetrics.RequestQueueDepth) / float64(policy.Spec.Metrics.RequestQueueDepth.TargetDepth*currentReplicas)
+		targetDepth := kubeaiv1alpha1.QuantityFloat64(policy.Spec.Metrics.RequestQueueDepth.TargetDepth)
+		if targetDepth > 0 && currentMetrics.RequestQueueDepth > 0 {
+			queueRatio := float64(currentMetrics.RequestQueueDepth) / (targetDepth * float64(currentReplicas))
 			maxRatio = math.Max(maxRatio, queueRatio)
 			logger.V(1).Info("Queue depth ratio", "current", currentMetrics.RequestQueueDepth,
-				"targetPerReplica", policy.Spec.Metrics.RequestQueueDepth.TargetDepth, "ratio", queueRatio)
+				"targetPerReplica", targetDepth, "ratio", queueRatio)
 		}
 	}
 
@@ -252,9 +255,9 @@ func (r *AIInferenceAutoscalerPolicyReconciler) calculateDesiredReplicas(
 	desiredReplicas := int32(math.Ceil(float64(currentReplicas) * maxRatio))
 
 	// Clamp to min/max
-	minReplicas := policy.Spec.MinReplicas
-	if minReplicas == 0 {
-		minReplicas = 1
+	minReplicas := int32(1)
+	if policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas > 0 {
+		minReplicas = *policy.Spec.MinReplicas
 	}
 	maxReplicas := policy.Spec.MaxReplicas
 
@@ -27,10 +27,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -51,12 +53,12 @@ func TestCalculateDesiredReplicasReconciler(t *testing.T) {
 			currentReplicas: 2,
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: ptr.To[int32](1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -71,7 +73,7 @@ func TestCalculateDesiredReplicasReconciler(t *testing.T) {
 			currentReplicas: 3,
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: ptr.To[int32](1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
@@ -91,12 +93,12 @@ func TestCalculateDesiredReplicasReconciler(t *testing.T) {
 			currentReplicas: 8,
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: ptr.To[int32](1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -111,12 +113,12 @@ func TestCalculateDesiredReplicasReconciler(t *testing.T) {
 			currentReplicas: 2,
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 3,
+					MinReplicas: ptr.To[int32](3),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -131,12 +133,12 @@ func TestCalculateDesiredReplicasReconciler(t *testing.T) {
 			currentReplicas: 5,
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: ptr.To[int32](1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -149,12 +151,12 @@ func TestCalculateDesiredReplicasReconciler(t *testing.T) {
 			currentReplicas: 2,
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: ptr.To[int32](1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 						GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
 							Enabled:          true,
@@ -311,7 +313,7 @@ func TestFetchMetrics(t *testing.T) {
 			Metrics: kubeaiv1alpha1.MetricsSpec{
 				Latency: &kubeaiv1alpha1.LatencyMetric{
 					Enabled:     true,
-					TargetP99Ms: 100,
+					TargetP99Ms: ptr.To(resource.MustParse("100")),
 				},
 				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
 					Enabled:          true,
@@ -319,7 +321,7 @@ func TestFetchMetrics(t *testing.T) {
 				},
 				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{
 					Enabled:     true,
-					TargetDepth: 5,
+					TargetDepth: ptr.To(resource.MustParse("5")),
 				},
 			},
 		},
@@ -328,7 +330,7 @@ func TestFetchMetrics(t *testing.T) {
 	currentMetrics, err := reconciler.fetchMetrics(context.Background(), policy)
 	require.NoError(t, err)
 
-	assert.Equal(t, int32(500), currentMetrics.LatencyP99Ms)
+	assert.Equal(t, 500.0, currentMetrics.LatencyP99Ms)
 	assert.Equal(t, int32(85), currentMetrics.GPUUtilizationPercent)
 	assert.Equal(t, int32(10), currentMetrics.RequestQueueDepth)
 }
@@ -19,6 +19,9 @@ limitations under the License.
 // 1. Uses exponential smoothing to reduce noise in metric values
 // 2. Caps the maximum scaling change per reconcile cycle
 //
+// Each policy can tune the algorithm through spec.algorithm.parameters; see
+// Parameters for the accepted keys.
+//
 // Build this plugin with:
 //
 //	go build -buildmode=plugin -o capped_smooth_ratio.so smoothed_ratio.go
@@ -26,6 +29,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"sync"
 
@@ -48,10 +52,72 @@ type CappedSmoothRatioAlgorithm struct {
 	// Tolerance is the scaling tolerance
 	Tolerance float64
 
-	// mu protects the smoothed values
-	mu sync.RWMutex
-	// smoothedRatios stores the exponentially smoothed ratio for each policy
-	smoothedRatios map[string]float64
+	// state is shared by the copies WithParameters returns so smoothing
+	// survives per-policy configuration
+	state *smoothingState
+}
+
+// smoothingState stores the exponentially smoothed ratio for each policy
+type smoothingState struct {
+	mu     sync.Mutex
+	ratios map[string]float64
+}
+
+// Parameters declares the parameters accepted in spec.algorithm.parameters
+func (a *CappedSmoothRatioAlgorithm) Parameters() []scaling.ParameterSpec {
+	return []scaling.ParameterSpec{
+		{
+			Name:        "smoothingFactor",
+			Type:        scaling.ParameterFloat,
+			Default:     fmt.Sprint(a.SmoothingFactor),
+			Description: "Weight given to new ratios (0-1]",
+		},
+		{
+			Name:        "maxScaleUpPercent",
+			Type:        scaling.ParameterFloat,
+			Default:     fmt.Sprint(a.MaxScaleUpPercent),
+			Description: "Maximum fractional increase per cycle, e.g. 0.5 = 50%",
+		},
+		{
+			Name:        "maxScaleDownPercent",
+			Type:        scaling.ParameterFloat,
+			Default:     fmt.Sprint(a.MaxScaleDownPercent),
+			Description: "Maximum fractional decrease per cycle (0-1]",
+		},
+	}
+}
+
+// WithParameters returns a copy of the algorithm configured for one policy
+func (a *CappedSmoothRatioAlgorithm) WithParameters(params scaling.Parameters) (scaling.ScalingAlgorithm, error) {
+	smoothingFactor, err := params.Float("smoothingFactor", a.SmoothingFactor)
+	if err != nil {
+		return nil, err
+	}
+	if smoothingFactor <= 0 || smoothingFactor > 1 {
+		return nil, fmt.Errorf("smoothingFactor must be in (0, 1], got %v", smoothingFactor)
+	}
+	maxScaleUp, err := params.Float("maxScaleUpPercent", a.MaxScaleUpPercent)
+	if err != nil {
+		return nil, err
+	}
+	if maxScaleUp <= 0 {
+		return nil, fmt.Errorf("maxScaleUpPercent must be positive, got %v", maxScaleUp)
+	}
+	maxScaleDown, err := params.Float("maxScaleDownPercent", a.MaxScaleDownPercent)
+	if err != nil {
+		return nil, err
+	}
+	if maxScaleDown <= 0 || maxScaleDown > 1 {
+		return nil, fmt.Errorf("maxScaleDownPercent must be in (0, 1], got %v", maxScaleDown)
+	}
+
+	return &CappedSmoothRatioAlgorithm{
+		SmoothingFactor:     smoothingFactor,
+		MaxScaleUpPercent:   maxScaleUp,
+		MaxScaleDownPercent: maxScaleDown,
+		Tolerance:           a.Tolerance,
+		state:               a.state,
+	}, nil
 }
 
 // Name returns the algorithm name
@@ -59,6 +125,12 @@ func (a *CappedSmoothRatioAlgorithm) Name() string {
 	return "CappedSmoothRatio"
 }
 
+// Description summarizes the algorithm on the controller's /debug/algorithms endpoint
+func (a *CappedSmoothRatioAlgorithm) Description() string {
+	return "Scales by the exponentially smoothed maximum metric ratio, " +
+		"capping the change per reconcile at maxScaleUpPercent and maxScaleDownPercent."
+}
+
 // ComputeScale implements the ScalingAlgorithm interface
 func (a *CappedSmoothRatioAlgorithm) ComputeScale(ctx context.Context, input scaling.ScalingInput) (scaling.ScalingResult, error) {
 	tolerance := input.Tolerance
@@ -82,21 +154,18 @@ func (a *CappedSmoothRatioAlgorithm) ComputeScale(ctx context.Context, input sca
 	}
 
 	// Apply exponential smoothing
-	a.mu.Lock()
-	if a.smoothedRatios == nil {
-		a.smoothedRatios = make(map[string]float64)
-	}
+	a.state.mu.Lock()
 	// Use a key based on input parameters to track smoothing per policy
 	key := policyKey(input)
-	smoothedRatio, exists := a.smoothedRatios[key]
+	smoothedRatio, exists := a.state.ratios[key]
 	if !exists {
 		smoothedRatio = currentMaxRatio
 	} else {
 		// Exponential smoothing: new_value = alpha * current + (1 - alpha) * previous
 		smoothedRatio = a.SmoothingFactor*currentMaxRatio + (1-a.SmoothingFactor)*smoothedRatio
 	}
-	a.smoothedRatios[key] = smoothedRatio
-	a.mu.Unlock()
+	a.state.ratios[key] = smoothedRatio
+	a.state.mu.Unlock()
 
 	// Check if within tolerance
 	if smoothedRatio >= (1-tolerance) && smoothedRatio <= (1+tolerance) {
@@ -153,4 +222,5 @@ var Algorithm scaling.ScalingAlgorithm = &CappedSmoothRatioAlgorithm{
 	MaxScaleUpPercent:   0.5,  // Max 50% increase per cycle
 	MaxScaleDownPercent: 0.25, // Max 25% decrease per cycle
 	Tolerance:           0.1,  // 10% tolerance
+	state:               &smoothingState{ratios: make(map[string]float64)},
 }
@@ -0,0 +1,3 @@
+module github.com/pmady/kubeai-autoscaler/examples/wasm-algorithm
+
+go 1.25.0
@@ -0,0 +1,130 @@
+//go:build wasip1
+
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main is a scaling algorithm compiled to WebAssembly. It implements
+// version 1 of the KubeAI Autoscaler WASM ABI and does not import the
+// autoscaler, so it builds with any Go toolchain that targets wasip1.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+const (
+	algorithmName = "HeadroomRatio"
+	// headroom is the share of each target kept free for bursts
+	headroom = 0.2
+)
+
+// scalingInput is the JSON document passed to kubeai_compute_scale
+type scalingInput struct {
+	CurrentReplicas int32              `json:"currentReplicas"`
+	MinReplicas     int32              `json:"minReplicas"`
+	MaxReplicas     int32              `json:"maxReplicas"`
+	MetricRatios    []float64          `json:"metricRatios"`
+	Tolerance       float64            `json:"tolerance"`
+	PolicyName      string             `json:"policyName"`
+	PolicyNamespace string             `json:"policyNamespace"`
+	Metrics         map[string]float64 `json:"metrics"`
+}
+
+// scalingResult is the JSON document returned by kubeai_compute_scale
+type scalingResult struct {
+	DesiredReplicas int32    `json:"desiredReplicas"`
+	Reason          string   `json:"reason,omitempty"`
+	Error           string   `json:"error,omitempty"`
+	ErrorType       string   `json:"errorType,omitempty"`
+	Missing         []string `json:"missing,omitempty"`
+}
+
+// buffers keeps memory handed to the host reachable until the host frees it
+var buffers = map[unsafe.Pointer][]byte{}
+
+func main() {}
+
+//go:wasmexport kubeai_abi_version
+func abiVersion() int32 {
+	return 1
+}
+
+//go:wasmexport kubeai_alloc
+func alloc(size int32) unsafe.Pointer {
+	buf := make([]byte, max(size, 1))
+	ptr := unsafe.Pointer(unsafe.SliceData(buf))
+	buffers[ptr] = buf
+	return ptr
+}
+
+//go:wasmexport kubeai_free
+func free(ptr unsafe.Pointer, _ int32) {
+	delete(buffers, ptr)
+}
+
+//go:wasmexport kubeai_name
+func name() int64 {
+	return pack([]byte(algorithmName))
+}
+
+//go:wasmexport kubeai_compute_scale
+func computeScale(ptr unsafe.Pointer, size int32) int64 {
+	var input scalingInput
+	if err := json.Unmarshal(unsafe.Slice((*byte)(ptr), size), &input); err != nil {
+		return respond(scalingResult{Error: err.Error()})
+	}
+	return respond(compute(input))
+}
+
+// compute scales so the busiest metric sits headroom below its target
+func compute(input scalingInput) scalingResult {
+	if len(input.MetricRatios) == 0 {
+		return scalingResult{Error: "no metric ratios", ErrorType: "insufficient_metrics"}
+	}
+	ratio := 0.0
+	for _, r := range input.MetricRatios {
+		ratio = max(ratio, r)
+	}
+	ratio /= 1 - headroom
+	if math.Abs(ratio-1) <= input.Tolerance {
+		return scalingResult{DesiredReplicas: input.CurrentReplicas, Reason: "within tolerance"}
+	}
+	desired := int32(math.Ceil(float64(input.CurrentReplicas) * ratio))
+	desired = min(max(desired, input.MinReplicas), input.MaxReplicas)
+	return scalingResult{
+		DesiredReplicas: desired,
+		Reason:          fmt.Sprintf("scaled to keep %.0f%% headroom", headroom*100),
+	}
+}
+
+func respond(result scalingResult) int64 {
+	out, err := json.Marshal(result)
+	if err != nil {
+		out = []byte(`{"error":"cannot encode result"}`)
+	}
+	return pack(out)
+}
+
+// pack copies data into a new buffer and returns its address and length as
+// the high and low 32 bits of one value
+func pack(data []byte) int64 {
+	ptr := alloc(int32(len(data)))
+	copy(buffers[ptr], data)
+	return int64(uintptr(ptr))<<32 | int64(len(data))
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command hpa-compat prints the table relating policy fields to the fields of
+// the autoscaling/v2 HorizontalPodAutoscaler. Both sides of every row are
+// resolved against the Go types, and spec fields without a row are listed as
+// policy-only, so the table fails to generate rather than drift:
+//
+//	go run ./hack/hpa-compat > docs/hpa-compatibility.md
+package main
+
+//go:generate sh -c "go run . > ../../docs/hpa-compatibility.md"
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// Compatibility of a policy field with its HorizontalPodAutoscaler counterpart
+const (
+	// same fields have the name, type and meaning of the HPA field
+	same = "Same"
+	// similar fields carry the same setting under another name, shape or default
+	similar = "Similar"
+)
+
+// mapping relates a policy field to a HorizontalPodAutoscaler field. Paths are
+// JSON field names separated by dots; [] steps into list items.
+type mapping struct {
+	policy        string
+	hpa           string
+	compatibility string
+	notes         string
+}
+
+var mappings = []mapping{
+	{"spec.targetRef", "spec.scaleTargetRef", similar, "Same `apiVersion`, `kind` and `name`"},
+	{"spec.minReplicas", "spec.minReplicas", same, "`0` scales an idle target to zero without the `HPAScaleToZero` feature gate"},
+	{"spec.maxReplicas", "spec.maxReplicas", same, ""},
+	{"spec.scaleUp", "spec.behavior.scaleUp", similar, "At the top of the spec rather than under `behavior`; an omitted block neither stabilizes nor rate limits"},
+	{"spec.scaleUp.stabilizationWindowSeconds", "spec.behavior.scaleUp.stabilizationWindowSeconds", similar, "Defaults to 60 rather than 0"},
+	{"spec.scaleUp.policies", "spec.behavior.scaleUp.policies", same, ""},
+	{"spec.scaleUp.policies[].type", "spec.behavior.scaleUp.policies[].type", same, "`Pods` or `Percent`"},
+	{"spec.scaleUp.policies[].value", "spec.behavior.scaleUp.policies[].value", same, ""},
+	{"spec.scaleUp.policies[].periodSeconds", "spec.behavior.scaleUp.policies[].periodSeconds", same, ""},
+	{"spec.scaleUp.selectPolicy", "spec.behavior.scaleUp.selectPolicy", same, "`Max`, `Min` or `Disabled`; defaults to `Max`"},
+	{"spec.scaleUp.disabled", "spec.behavior.scaleUp.selectPolicy", similar, "`true` is `selectPolicy: Disabled`"},
+	{"spec.scaleDown", "spec.behavior.scaleDown", similar, "At the top of the spec rather than under `behavior`; an omitted block neither stabilizes nor rate limits"},
+	{"spec.scaleDown.stabilizationWindowSeconds", "spec.behavior.scaleDown.stabilizationWindowSeconds", same, "Defaults to 300"},
+	{"spec.scaleDown.policies", "spec.behavior.scaleDown.policies", same, ""},
+	{"spec.scaleDown.selectPolicy", "spec.behavior.scaleDown.selectPolicy", same, "`Max`, `Min` or `Disabled`; defaults to `Max`"},
+	{"spec.scaleDown.disabled", "spec.behavior.scaleDown.selectPolicy", similar, "`true` is `selectPolicy: Disabled`"},
+	{"spec.algorithm.tolerance", "spec.behavior.scaleUp.tolerance", similar, "A fraction shared by both directions rather than a quantity per direction; defaults to 0.1 like the HPA controller"},
+	{"spec.metrics.cpuUtilization.targetAverageUtilization", "spec.metrics[].resource.target.averageUtilization", similar, "A `cpu` Resource metric with a `Utilization` target"},
+	{"spec.metrics.memoryUtilization.targetAverageUtilization", "spec.metrics[].resource.target.averageUtilization", similar, "A `memory` Resource metric with a `Utilization` target"},
+	{"spec.metrics.gpuUtilization.targetPercentage", "spec.metrics[].pods.target.averageValue", similar, "A Pods metric with an `AverageValue` target, in percent"},
+	{"spec.metrics.requestQueueDepth.targetDepth", "spec.metrics[].pods.target.averageValue", similar, "An `AverageValue` target: the total is divided by the replicas"},
+	{"spec.metrics.tokensPerSecond.targetPerReplica", "spec.metrics[].pods.target.averageValue", similar, "An `AverageValue` target: the total is divided by the replicas"},
+	{"spec.metrics.inFlightRequests.targetPerReplica", "spec.metrics[].pods.target.averageValue", similar, "An `AverageValue` target: the total is divided by the replicas"},
+	{"spec.metrics.latency.targetP99Ms", "spec.metrics[].object.target.value", similar, "A `Value` target on a quantile across all replicas, in milliseconds"},
+	{"spec.metrics.customMetrics[].targetValue", "spec.metrics[].external.target.value", similar, "The target of the query aggregated by `aggregation`; with `targetType: AverageValue` it is the HPA's `averageValue`"},
+	{"spec.metrics.customMetrics[].targetType", "spec.metrics[].external.target.type", similar, "`Value` (the default) or `AverageValue`; `Utilization` only applies to the resource metrics"},
+	{"spec.metrics.customMetrics[].query", "spec.metrics[].external.metric.name", similar, "With a `MetricsAPI` source, `external/<metric>` reads the same external metric"},
+	{"status.currentReplicas", "status.currentReplicas", same, ""},
+	{"status.desiredReplicas", "status.desiredReplicas", same, "After behavior limits; `status.recommendedReplicas` holds the value before them"},
+	{"status.lastScaleTime", "status.lastScaleTime", same, ""},
+	{"status.currentMetrics", "status.currentMetrics", similar, "An object with a field per metric rather than a list of metric statuses"},
+	{"status.conditions", "status.conditions", similar, "`Ready`, `Scaling` and `ScalingLimited` rather than `AbleToScale`, `ScalingActive` and `ScalingLimited`"},
+}
+
+func main() {
+	if err := render(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// render writes the compatibility table as Markdown
+func render(w io.Writer) error {
+	policyType := reflect.TypeOf(kubeaiv1alpha1.AIInferenceAutoscalerPolicy{})
+	hpaType := reflect.TypeOf(autoscalingv2.HorizontalPodAutoscaler{})
+
+	var b strings.Builder
+	b.WriteString("<!-- Generated by hack/hpa-compat from the Go types; do not edit. -->\n\n")
+	b.WriteString("# HorizontalPodAutoscaler Compatibility\n\n")
+	b.WriteString("Policy fields that carry the same setting as a field of the `autoscaling/v2`\n")
+	b.WriteString("HorizontalPodAutoscaler, for moving HPA configurations and tooling to policies.\n")
+	b.WriteString("Regenerate with `make generate` after changing the API.\n\n")
+	b.WriteString("| Policy field | Type | HPA field | Type | Compatibility | Notes |\n")
+	b.WriteString("|--------------|------|-----------|------|---------------|-------|\n")
+	mapped := map[string]bool{}
+	for _, m := range mappings {
+		policyField, err := resolve(policyType, m.policy)
+		if err != nil {
+			return fmt.Errorf("policy field %s: %w", m.policy, err)
+		}
+		hpaField, err := resolve(hpaType, m.hpa)
+		if err != nil {
+			return fmt.Errorf("HPA field %s: %w", m.hpa, err)
+		}
+		fmt.Fprintf(&b, "| `%s` | `%s` | `%s` | `%s` | %s | %s |\n",
+			m.policy, typeName(policyField), m.hpa, typeName(hpaField), m.compatibility, m.notes)
+		mapped[topLevel(m.policy)] = true
+	}
+
+	b.WriteString("\n## Policy-Only Fields\n\n")
+	b.WriteString("These spec fields have no HorizontalPodAutoscaler counterpart.\n\n")
+	specType, err := resolve(policyType, "spec")
+	if err != nil {
+		return err
+	}
+	for i := 0; i < specType.NumField(); i++ {
+		name := jsonName(specType.Field(i))
+		if name == "" || mapped["spec."+name] {
+			continue
+		}
+		fmt.Fprintf(&b, "- `spec.%s`\n", name)
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// resolve returns the type of the field at path, following pointers and list items
+func resolve(t reflect.Type, path string) (reflect.Type, error) {
+	for _, step := range strings.Split(path, ".") {
+		name, list := strings.CutSuffix(step, "[]")
+		t = deref(t)
+		if t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%s is not an object", step)
+		}
+		field, ok := fieldByJSONName(t, name)
+		if !ok {
+			return nil, fmt.Errorf("no field %s in %s", name, t.Name())
+		}
+		t = field.Type
+		if list {
+			if t.Kind() != reflect.Slice {
+				return nil, fmt.Errorf("%s is not a list", name)
+			}
+			t = t.Elem()
+		}
+	}
+	return t, nil
+}
+
+// fieldByJSONName finds a field by its JSON name, looking into inlined fields
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			if found, ok := fieldByJSONName(deref(field.Type), name); ok {
+				return found, true
+			}
+			continue
+		}
+		if jsonName(field) == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// jsonName returns the JSON name of a field; empty for inlined or skipped fields
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// deref returns the type a pointer type points to
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// typeName describes a field type the way the API reference does
+func typeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + typeName(t.Elem())
+	}
+	return t.Name()
+}
+
+// topLevel returns the first two steps of a path, such as spec.scaleUp
+func topLevel(path string) string {
+	steps := strings.SplitN(path, ".", 3)
+	if len(steps) < 2 {
+		return path
+	}
+	return strings.TrimSuffix(steps[0]+"."+steps[1], "[]")
+}
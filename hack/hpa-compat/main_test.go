@@ -0,0 +1,49 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+func TestTableIsCurrent(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, render(&b))
+	doc, err := os.ReadFile("../../docs/hpa-compatibility.md")
+	require.NoError(t, err)
+	assert.Equal(t, string(doc), b.String(), "regenerate with make generate")
+}
+
+func TestResolve(t *testing.T) {
+	policyType := reflect.TypeOf(kubeaiv1alpha1.AIInferenceAutoscalerPolicy{})
+	field, err := resolve(policyType, "spec.scaleUp.policies[].periodSeconds")
+	require.NoError(t, err)
+	assert.Equal(t, "int32", typeName(field))
+
+	for _, path := range []string{"spec.scaleUp.policies[].nope", "spec.maxReplicas.value", "spec.targetRef[]"} {
+		_, err := resolve(policyType, path)
+		assert.Error(t, err, path)
+	}
+}
@@ -0,0 +1,75 @@
+#!/usr/bin/env bash
+# Regenerates the OpenAPI definitions, apply configurations, typed clientset,
+# listers and informers under pkg/client.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+CODEGEN_VERSION=${CODEGEN_VERSION:-v0.35.0}
+KUBE_OPENAPI_VERSION=${KUBE_OPENAPI_VERSION:-v0.0.0-20250910181357-589584f1c912}
+GOBIN=${GOBIN:-$(go env GOPATH)/bin}
+
+MODULE=github.com/pmady/kubeai-autoscaler
+API_PKG=${MODULE}/api/v1alpha1
+OUTPUT_PKG=${MODULE}/pkg/client
+OUTPUT_DIR=${SCRIPT_ROOT}/pkg/client
+BOILERPLATE=${SCRIPT_ROOT}/hack/boilerplate.go.txt
+
+for gen in applyconfiguration-gen client-gen lister-gen informer-gen; do
+  if [[ ! -x "${GOBIN}/${gen}" ]]; then
+    GOBIN=${GOBIN} go install "k8s.io/code-generator/cmd/${gen}@${CODEGEN_VERSION}"
+  fi
+done
+if [[ ! -x "${GOBIN}/openapi-gen" ]]; then
+  GOBIN=${GOBIN} go install "k8s.io/kube-openapi/cmd/openapi-gen@${KUBE_OPENAPI_VERSION}"
+fi
+
+cd "${SCRIPT_ROOT}"
+rm -rf "${OUTPUT_DIR}/applyconfiguration" "${OUTPUT_DIR}/clientset" "${OUTPUT_DIR}/listers" "${OUTPUT_DIR}/informers"
+
+"${GOBIN}/openapi-gen" \
+  --go-header-file "${BOILERPLATE}" \
+  --output-pkg "${OUTPUT_PKG}/openapi" \
+  --output-dir "${OUTPUT_DIR}/openapi" \
+  --output-file zz_generated.openapi.go \
+  --report-filename /dev/null \
+  k8s.io/apimachinery/pkg/apis/meta/v1 \
+  k8s.io/apimachinery/pkg/runtime \
+  k8s.io/apimachinery/pkg/version \
+  "${API_PKG}"
+
+SCHEMA=$(mktemp)
+trap 'rm -f "${SCHEMA}"' EXIT
+go run ./hack/models-schema > "${SCHEMA}"
+
+"${GOBIN}/applyconfiguration-gen" \
+  --go-header-file "${BOILERPLATE}" \
+  --openapi-schema "${SCHEMA}" \
+  --output-pkg "${OUTPUT_PKG}/applyconfiguration" \
+  --output-dir "${OUTPUT_DIR}/applyconfiguration" \
+  "${API_PKG}"
+
+"${GOBIN}/client-gen" \
+  --go-header-file "${BOILERPLATE}" \
+  --clientset-name versioned \
+  --input-base "" \
+  --input "${API_PKG}" \
+  --apply-configuration-package "${OUTPUT_PKG}/applyconfiguration" \
+  --output-pkg "${OUTPUT_PKG}/clientset" \
+  --output-dir "${OUTPUT_DIR}/clientset"
+
+"${GOBIN}/lister-gen" \
+  --go-header-file "${BOILERPLATE}" \
+  --output-pkg "${OUTPUT_PKG}/listers" \
+  --output-dir "${OUTPUT_DIR}/listers" \
+  "${API_PKG}"
+
+"${GOBIN}/informer-gen" \
+  --go-header-file "${BOILERPLATE}" \
+  --versioned-clientset-package "${OUTPUT_PKG}/clientset/versioned" \
+  --listers-package "${OUTPUT_PKG}/listers" \
+  --output-pkg "${OUTPUT_PKG}/informers" \
+  --output-dir "${OUTPUT_DIR}/informers" \
+  "${API_PKG}"
@@ -0,0 +1,28 @@
+#!/usr/bin/env bash
+# Regenerates the Go code for the external algorithm and optimizer gRPC APIs
+# under api/external. Requires protoc on the PATH.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+PROTOC_GEN_GO_VERSION=${PROTOC_GEN_GO_VERSION:-v1.36.10}
+PROTOC_GEN_GO_GRPC_VERSION=${PROTOC_GEN_GO_GRPC_VERSION:-v1.5.1}
+GOBIN=${GOBIN:-$(go env GOPATH)/bin}
+
+if [[ ! -x "${GOBIN}/protoc-gen-go" ]]; then
+  GOBIN=${GOBIN} go install "google.golang.org/protobuf/cmd/protoc-gen-go@${PROTOC_GEN_GO_VERSION}"
+fi
+if [[ ! -x "${GOBIN}/protoc-gen-go-grpc" ]]; then
+  GOBIN=${GOBIN} go install "google.golang.org/grpc/cmd/protoc-gen-go-grpc@${PROTOC_GEN_GO_GRPC_VERSION}"
+fi
+
+cd "${SCRIPT_ROOT}"
+protoc \
+  --plugin=protoc-gen-go="${GOBIN}/protoc-gen-go" \
+  --plugin=protoc-gen-go-grpc="${GOBIN}/protoc-gen-go-grpc" \
+  --go_out=. --go_opt=paths=source_relative \
+  --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+  api/external/v1/algorithm.proto \
+  api/external/v1/optimizer.proto
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package activator holds inference requests while a target scaled to zero
+// wakes up, and forwards them once a replica is ready to serve.
+package activator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// DefaultTimeout bounds how long a request is held while the target wakes;
+	// loading a large model onto a GPU can take minutes
+	DefaultTimeout = 5 * time.Minute
+	// DefaultPollInterval is how often readiness is checked while the target wakes
+	DefaultPollInterval = time.Second
+)
+
+// Backend wakes the target and reports whether it can serve
+type Backend interface {
+	// Ready reports whether at least one replica can serve requests
+	Ready(ctx context.Context) (bool, error)
+	// Activate scales the target up from zero
+	Activate(ctx context.Context) error
+}
+
+// wakeup is a single attempt to bring the target up, shared by every request
+// that arrives while it is in progress
+type wakeup struct {
+	done chan struct{}
+	err  error
+}
+
+// Activator is an HTTP handler in front of a scale-to-zero target. Requests are
+// proxied straight through while the target is ready. Otherwise the first request
+// activates the target and every request is held until a replica is ready, the
+// request is cancelled, or Timeout elapses.
+type Activator struct {
+	backend Backend
+	proxy   http.Handler
+
+	// Timeout bounds how long a request is held while the target wakes
+	Timeout time.Duration
+	// PollInterval is how often readiness is checked while the target wakes
+	PollInterval time.Duration
+	// MaxPending caps the number of held requests; further requests are
+	// rejected with 503. Zero means no limit.
+	MaxPending int64
+
+	pending atomic.Int64
+	mu      sync.Mutex
+	current *wakeup
+}
+
+// New returns an activator proxying to upstream and waking the target through backend
+func New(upstream *url.URL, backend Backend) *Activator {
+	return &Activator{
+		backend:      backend,
+		proxy:        httputil.NewSingleHostReverseProxy(upstream),
+		Timeout:      DefaultTimeout,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// Pending returns the number of requests currently held
+func (a *Activator) Pending() int64 {
+	return a.pending.Load()
+}
+
+// ServeHTTP implements http.Handler
+func (a *Activator) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if ready, err := a.backend.Ready(req.Context()); err == nil && ready {
+		a.proxy.ServeHTTP(w, req)
+		return
+	}
+
+	if held := a.pending.Add(1); a.MaxPending > 0 && held > a.MaxPending {
+		a.pending.Add(-1)
+		http.Error(w, "too many requests waiting for the target to scale up", http.StatusServiceUnavailable)
+		return
+	}
+	defer a.pending.Add(-1)
+
+	ctx, cancel := context.WithTimeout(req.Context(), a.Timeout)
+	defer cancel()
+	attempt := a.wake()
+	select {
+	case <-attempt.done:
+		if attempt.err != nil {
+			http.Error(w, fmt.Sprintf("target did not scale up: %v", attempt.err), http.StatusServiceUnavailable)
+			return
+		}
+	case <-ctx.Done():
+		http.Error(w, "timed out waiting for the target to scale up", http.StatusServiceUnavailable)
+		return
+	}
+	a.proxy.ServeHTTP(w, req)
+}
+
+// wake returns the wakeup in progress, starting one if there is none
+func (a *Activator) wake() *wakeup {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.current != nil {
+		return a.current
+	}
+
+	w := &wakeup{done: make(chan struct{})}
+	a.current = w
+	go func() {
+		// Not tied to any single request, so a cancelled client does not abort the wakeup
+		ctx, cancel := context.WithTimeout(context.Background(), a.Timeout)
+		defer cancel()
+		w.err = a.activate(ctx)
+
+		a.mu.Lock()
+		a.current = nil
+		a.mu.Unlock()
+		close(w.done)
+	}()
+	return w
+}
+
+// activate scales the target up and waits until it is ready
+func (a *Activator) activate(ctx context.Context) error {
+	if err := a.backend.Activate(ctx); err != nil {
+		return fmt.Errorf("failed to activate target: %w", err)
+	}
+	return wait.PollUntilContextCancel(ctx, a.PollInterval, true, func(ctx context.Context) (bool, error) {
+		// Readiness errors are transient while replicas start; keep polling
+		ready, err := a.backend.Ready(ctx)
+		return err == nil && ready, nil
+	})
+}
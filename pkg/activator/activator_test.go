@@ -0,0 +1,148 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBackend becomes ready a number of readiness checks after activation
+type fakeBackend struct {
+	ready       atomic.Bool
+	activations atomic.Int32
+	readyAfter  int32
+	checks      atomic.Int32
+	activateErr error
+}
+
+func (b *fakeBackend) Ready(_ context.Context) (bool, error) {
+	if b.activations.Load() > 0 && b.checks.Add(1) > b.readyAfter {
+		b.ready.Store(true)
+	}
+	return b.ready.Load(), nil
+}
+
+func (b *fakeBackend) Activate(_ context.Context) error {
+	b.activations.Add(1)
+	return b.activateErr
+}
+
+func newTestActivator(t *testing.T, backend Backend) *Activator {
+	t.Helper()
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("served"))
+	}))
+	t.Cleanup(upstream.Close)
+	u, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+
+	a := New(u, backend)
+	a.PollInterval = time.Millisecond
+	return a
+}
+
+func TestActivatorProxiesWhenReady(t *testing.T) {
+	backend := &fakeBackend{}
+	backend.ready.Store(true)
+	a := newTestActivator(t, backend)
+
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/completions", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "served", rec.Body.String())
+	assert.Zero(t, backend.activations.Load())
+}
+
+func TestActivatorHoldsRequestsUntilReady(t *testing.T) {
+	backend := &fakeBackend{readyAfter: 5}
+	a := newTestActivator(t, backend)
+
+	var wg sync.WaitGroup
+	codes := make([]int, 10)
+	for i := range codes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			a.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/completions", nil))
+			codes[i] = rec.Code
+		}()
+	}
+	wg.Wait()
+
+	for _, code := range codes {
+		assert.Equal(t, http.StatusOK, code)
+	}
+	// Concurrent requests share a single wakeup
+	assert.Equal(t, int32(1), backend.activations.Load())
+	assert.Zero(t, a.Pending())
+}
+
+func TestActivatorTimeout(t *testing.T) {
+	backend := &fakeBackend{readyAfter: 1 << 30}
+	a := newTestActivator(t, backend)
+	a.Timeout = 20 * time.Millisecond
+
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "timed out")
+}
+
+func TestActivatorActivateError(t *testing.T) {
+	backend := &fakeBackend{activateErr: errors.New("forbidden")}
+	a := newTestActivator(t, backend)
+
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "forbidden")
+}
+
+func TestActivatorMaxPending(t *testing.T) {
+	backend := &fakeBackend{readyAfter: 1 << 30}
+	a := newTestActivator(t, backend)
+	a.MaxPending = 1
+	a.Timeout = 200 * time.Millisecond
+
+	held := make(chan struct{})
+	go func() {
+		defer close(held)
+		a.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	}()
+	require.Eventually(t, func() bool { return a.Pending() == 1 }, time.Second, time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "too many requests")
+	<-held
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capacity discovers the per-replica serving capacity reported by
+// inference runtimes such as vLLM and Triton.
+package capacity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAnnotationKey is the pod annotation read when no key is configured
+	DefaultAnnotationKey = "kubeai.io/max-concurrency"
+
+	// FormatTriton parses a Triton model configuration (GET /v2/models/<model>/config)
+	FormatTriton = "Triton"
+	// FormatJSON parses a flat JSON document with a max_num_seqs or max_concurrency field,
+	// e.g. vLLM engine arguments exposed by a launcher or sidecar
+	FormatJSON = "JSON"
+
+	// DefaultCacheTTL is how long a probed capacity is reused before probing again
+	DefaultCacheTTL = 5 * time.Minute
+
+	// maxResponseBytes caps the size of a capacity response body
+	maxResponseBytes = 1 << 20
+)
+
+// FromAnnotations reads the per-replica capacity from the given annotation key
+func FromAnnotations(annotations map[string]string, key string) (int32, error) {
+	if key == "" {
+		key = DefaultAnnotationKey
+	}
+	raw, ok := annotations[key]
+	if !ok {
+		return 0, fmt.Errorf("annotation %q not found", key)
+	}
+	value, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("annotation %q is not an integer: %w", key, err)
+	}
+	if value <= 0 {
+		return 0, fmt.Errorf("annotation %q must be positive, got %d", key, value)
+	}
+	return int32(value), nil
+}
+
+// tritonModelConfig is the subset of a Triton model configuration used for capacity
+type tritonModelConfig struct {
+	MaxBatchSize  int64 `json:"max_batch_size"`
+	InstanceGroup []struct {
+		Count int64 `json:"count"`
+	} `json:"instance_group"`
+}
+
+// jsonCapacity is the flat document accepted by FormatJSON
+type jsonCapacity struct {
+	MaxNumSeqs     int64 `json:"max_num_seqs"`
+	MaxConcurrency int64 `json:"max_concurrency"`
+}
+
+// Parse derives the per-replica capacity from a runtime response body
+func Parse(format string, body []byte) (int32, error) {
+	var value int64
+	switch format {
+	case FormatTriton:
+		var cfg tritonModelConfig
+		if err := json.Unmarshal(body, &cfg); err != nil {
+			return 0, fmt.Errorf("failed to decode Triton model config: %w", err)
+		}
+		batch := cfg.MaxBatchSize
+		if batch <= 0 {
+			// Models without batching serve one request per instance
+			batch = 1
+		}
+		instances := int64(0)
+		for _, group := range cfg.InstanceGroup {
+			instances += group.Count
+		}
+		if instances <= 0 {
+			instances = 1
+		}
+		value = batch * instances
+
+	case FormatJSON, "":
+		var doc jsonCapacity
+		if err := json.Unmarshal(body, &doc); err != nil {
+			return 0, fmt.Errorf("failed to decode capacity document: %w", err)
+		}
+		value = doc.MaxNumSeqs
+		if value <= 0 {
+			value = doc.MaxConcurrency
+		}
+
+	default:
+		return 0, fmt.Errorf("unsupported capacity format: %s", format)
+	}
+
+	if value <= 0 {
+		return 0, fmt.Errorf("no positive capacity found in %s response", format)
+	}
+	if value > int64(^uint32(0)>>1) {
+		return 0, fmt.Errorf("capacity %d overflows int32", value)
+	}
+	return int32(value), nil
+}
+
+type cacheEntry struct {
+	capacity  int32
+	expiresAt time.Time
+}
+
+// Prober fetches capacity metadata over HTTP and caches results per endpoint
+type Prober struct {
+	HTTPClient *http.Client
+	TTL        time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// NewProber creates a new Prober with the default timeout and cache TTL
+func NewProber() *Prober {
+	return &Prober{
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		TTL:        DefaultCacheTTL,
+		cache:      make(map[string]cacheEntry),
+	}
+}
+
+// Probe returns the per-replica capacity reported by the endpoint at url
+func (p *Prober) Probe(ctx context.Context, url, format string) (int32, error) {
+	key := format + "|" + url
+
+	p.mu.Lock()
+	if p.cache == nil {
+		p.cache = make(map[string]cacheEntry)
+	}
+	if entry, ok := p.cache[key]; ok && time.Now().Before(entry.expiresAt) {
+		p.mu.Unlock()
+		return entry.capacity, nil
+	}
+	// Drop expired entries so endpoints no longer probed, such as those of
+	// deleted policies, are not cached forever
+	now := time.Now()
+	for k, entry := range p.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(p.cache, k)
+		}
+	}
+	p.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build capacity request: %w", err)
+	}
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("capacity probe failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("capacity probe returned status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read capacity response: %w", err)
+	}
+
+	capacity, err := Parse(format, body)
+	if err != nil {
+		return 0, err
+	}
+
+	ttl := p.TTL
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	p.mu.Lock()
+	p.cache[key] = cacheEntry{capacity: capacity, expiresAt: time.Now().Add(ttl)}
+	p.mu.Unlock()
+
+	return capacity, nil
+}
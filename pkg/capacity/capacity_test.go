@@ -0,0 +1,200 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		key         string
+		expected    int32
+		wantErr     bool
+	}{
+		{
+			name:        "default key",
+			annotations: map[string]string{DefaultAnnotationKey: "64"},
+			expected:    64,
+		},
+		{
+			name:        "custom key",
+			annotations: map[string]string{"vllm.ai/max-num-seqs": " 256 "},
+			key:         "vllm.ai/max-num-seqs",
+			expected:    256,
+		},
+		{
+			name:        "missing annotation",
+			annotations: map[string]string{},
+			wantErr:     true,
+		},
+		{
+			name:        "not a number",
+			annotations: map[string]string{DefaultAnnotationKey: "lots"},
+			wantErr:     true,
+		},
+		{
+			name:        "zero",
+			annotations: map[string]string{DefaultAnnotationKey: "0"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromAnnotations(tt.annotations, tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		body     string
+		expected int32
+		wantErr  bool
+	}{
+		{
+			name:     "triton batch times instances",
+			format:   FormatTriton,
+			body:     `{"name":"llama","max_batch_size":8,"instance_group":[{"count":2,"kind":"KIND_GPU"},{"count":1}]}`,
+			expected: 24,
+		},
+		{
+			name:     "triton without batching",
+			format:   FormatTriton,
+			body:     `{"name":"llama","max_batch_size":0,"instance_group":[{"count":4}]}`,
+			expected: 4,
+		},
+		{
+			name:     "json max_num_seqs",
+			format:   FormatJSON,
+			body:     `{"max_num_seqs":256}`,
+			expected: 256,
+		},
+		{
+			name:     "json max_concurrency",
+			format:   FormatJSON,
+			body:     `{"max_concurrency":32}`,
+			expected: 32,
+		},
+		{
+			name:    "json without capacity",
+			format:  FormatJSON,
+			body:    `{"model":"llama"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			format:  FormatTriton,
+			body:    `not json`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown format",
+			format:  "Unknown",
+			body:    `{}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.format, []byte(tt.body))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestProberCachesResults(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"max_num_seqs":128}`))
+	}))
+	defer server.Close()
+
+	p := NewProber()
+	ctx := context.Background()
+
+	got, err := p.Probe(ctx, server.URL, FormatJSON)
+	require.NoError(t, err)
+	assert.Equal(t, int32(128), got)
+
+	got, err = p.Probe(ctx, server.URL, FormatJSON)
+	require.NoError(t, err)
+	assert.Equal(t, int32(128), got)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestProberErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := NewProber().Probe(context.Background(), server.URL, FormatTriton)
+	assert.Error(t, err)
+}
+
+func TestProberEvictsExpiredResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"max_num_seqs":128}`))
+	}))
+	defer server.Close()
+
+	p := NewProber()
+	ctx := context.Background()
+	_, err := p.Probe(ctx, server.URL+"/a", FormatJSON)
+	require.NoError(t, err)
+	_, err = p.Probe(ctx, server.URL+"/b", FormatJSON)
+	require.NoError(t, err)
+	require.Len(t, p.cache, 2)
+
+	// Once expired, the entry of an endpoint no longer probed is dropped by
+	// the next probe instead of being kept forever
+	for key, entry := range p.cache {
+		entry.expiresAt = time.Now().Add(-time.Second)
+		p.cache[key] = entry
+	}
+	_, err = p.Probe(ctx, server.URL+"/b", FormatJSON)
+	require.NoError(t, err)
+	assert.Len(t, p.cache, 1)
+	assert.Contains(t, p.cache, FormatJSON+"|"+server.URL+"/b")
+}
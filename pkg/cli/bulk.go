@@ -0,0 +1,283 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cli implements the kubectl-kubeai plugin commands
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// Policy modes set by set-mode
+const (
+	// ModeEnforce lets the policy scale its target
+	ModeEnforce = "Enforce"
+	// ModeDryRun submits the policy's replica changes with dryRun=All, so they
+	// are validated but never persisted
+	ModeDryRun = "DryRun"
+)
+
+// Mutation changes the spec of one policy. It returns a description of the
+// change, or an empty string when the policy already has the desired spec.
+type Mutation func(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (string, error)
+
+// Pause suspends the policy, so it stops changing its target
+func Pause() Mutation {
+	return func(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (string, error) {
+		if policy.Spec.Suspend {
+			return "", nil
+		}
+		policy.Spec.Suspend = true
+		return "suspend: false -> true", nil
+	}
+}
+
+// Resume lifts the suspension of the policy
+func Resume() Mutation {
+	return func(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (string, error) {
+		if !policy.Spec.Suspend {
+			return "", nil
+		}
+		policy.Spec.Suspend = false
+		return "suspend: true -> false", nil
+	}
+}
+
+// SetMax sets the maximum replicas of the policy. A policy whose minReplicas
+// is above max is not changed.
+func SetMax(max int32) Mutation {
+	return func(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (string, error) {
+		if policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas > max {
+			return "", fmt.Errorf("minReplicas %d is above %d", *policy.Spec.MinReplicas, max)
+		}
+		if policy.Spec.MaxReplicas == max {
+			return "", nil
+		}
+		change := fmt.Sprintf("maxReplicas: %d -> %d", policy.Spec.MaxReplicas, max)
+		policy.Spec.MaxReplicas = max
+		return change, nil
+	}
+}
+
+// SetMode switches the policy between ModeEnforce and ModeDryRun
+func SetMode(mode string) Mutation {
+	return func(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (string, error) {
+		current := policyMode(policy)
+		if current == mode {
+			return "", nil
+		}
+		policy.Spec.DryRun = mode == ModeDryRun
+		return fmt.Sprintf("mode: %s -> %s", current, mode), nil
+	}
+}
+
+// ParseMode returns the policy mode named by s, case-insensitively
+func ParseMode(s string) (string, error) {
+	for _, mode := range []string{ModeEnforce, ModeDryRun} {
+		if strings.EqualFold(s, mode) {
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("unknown mode %q, must be %s or %s", s, ModeEnforce, ModeDryRun)
+}
+
+// policyMode returns the mode the policy runs in
+func policyMode(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) string {
+	if policy.Spec.DryRun {
+		return ModeDryRun
+	}
+	return ModeEnforce
+}
+
+// BulkOptions selects the policies a bulk command changes and how it confirms
+type BulkOptions struct {
+	// Namespace holds the policies; empty with AllNamespaces selects every namespace
+	Namespace string
+	// AllNamespaces selects policies in every namespace
+	AllNamespaces bool
+	// Names selects policies by name
+	Names []string
+	// All selects every policy in the namespace
+	All bool
+	// Selector selects policies by label
+	Selector string
+	// DryRun prints the changes without applying them
+	DryRun bool
+	// Yes applies the changes without asking for confirmation
+	Yes bool
+
+	// In is read for the confirmation answer
+	In io.Reader
+	// Out receives the planned changes and results
+	Out io.Writer
+}
+
+// plannedChange is the change a bulk command makes to one policy
+type plannedChange struct {
+	original *kubeaiv1alpha1.AIInferenceAutoscalerPolicy
+	updated  *kubeaiv1alpha1.AIInferenceAutoscalerPolicy
+	change   string
+}
+
+// RunBulk applies mutate to the selected policies. The planned changes are
+// printed first; with DryRun nothing is applied, and otherwise the changes
+// are applied once confirmed, or right away with Yes. Policies the mutation
+// rejects are reported and left unchanged.
+func RunBulk(ctx context.Context, c client.Client, opts BulkOptions, mutate Mutation) error {
+	policies, err := selectPolicies(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		_, _ = fmt.Fprintln(opts.Out, "No policies selected")
+		return nil
+	}
+
+	var planned []plannedChange
+	var rejected int
+	for i := range policies {
+		policy := &policies[i]
+		updated := policy.DeepCopy()
+		change, err := mutate(updated)
+		key := policy.Namespace + "/" + policy.Name
+		switch {
+		case err != nil:
+			rejected++
+			_, _ = fmt.Fprintf(opts.Out, "%s: skipped: %v\n", key, err)
+		case change == "":
+			_, _ = fmt.Fprintf(opts.Out, "%s: unchanged\n", key)
+		default:
+			planned = append(planned, plannedChange{original: policy, updated: updated, change: change})
+			_, _ = fmt.Fprintf(opts.Out, "%s: %s\n", key, change)
+		}
+	}
+
+	if len(planned) == 0 {
+		return rejectedError(rejected)
+	}
+	if opts.DryRun {
+		_, _ = fmt.Fprintf(opts.Out, "Dry run: %d policies would be changed\n", len(planned))
+		return rejectedError(rejected)
+	}
+	if !opts.Yes {
+		confirmed, err := confirm(opts, len(planned))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			_, _ = fmt.Fprintln(opts.Out, "Aborted")
+			return nil
+		}
+	}
+
+	var failed int
+	for _, p := range planned {
+		key := p.original.Namespace + "/" + p.original.Name
+		if err := c.Patch(ctx, p.updated, client.MergeFrom(p.original)); err != nil {
+			failed++
+			_, _ = fmt.Fprintf(opts.Out, "%s: failed: %v\n", key, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(opts.Out, "%s: patched\n", key)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d policies could not be patched", failed, len(planned))
+	}
+	return rejectedError(rejected)
+}
+
+// selectPolicies returns the policies selected by name, label or --all
+func selectPolicies(ctx context.Context, c client.Client, opts BulkOptions) ([]kubeaiv1alpha1.AIInferenceAutoscalerPolicy, error) {
+	selectors := 0
+	if len(opts.Names) > 0 {
+		selectors++
+	}
+	if opts.All {
+		selectors++
+	}
+	if opts.Selector != "" {
+		selectors++
+	}
+	if selectors != 1 {
+		return nil, errors.New("select policies with exactly one of policy names, --all or --selector")
+	}
+	if opts.AllNamespaces && len(opts.Names) > 0 {
+		return nil, errors.New("policy names cannot be combined with --all-namespaces")
+	}
+
+	if len(opts.Names) > 0 {
+		policies := make([]kubeaiv1alpha1.AIInferenceAutoscalerPolicy, 0, len(opts.Names))
+		for _, name := range opts.Names {
+			policy := kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+			if err := c.Get(ctx, types.NamespacedName{Namespace: opts.Namespace, Name: name}, &policy); err != nil {
+				return nil, fmt.Errorf("failed to get policy %s/%s: %w", opts.Namespace, name, err)
+			}
+			policies = append(policies, policy)
+		}
+		return policies, nil
+	}
+
+	var listOpts []client.ListOption
+	if !opts.AllNamespaces {
+		listOpts = append(listOpts, client.InNamespace(opts.Namespace))
+	}
+	if opts.Selector != "" {
+		selector, err := labels.Parse(opts.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %w", opts.Selector, err)
+		}
+		listOpts = append(listOpts, client.MatchingLabelsSelector{Selector: selector})
+	}
+	list := &kubeaiv1alpha1.AIInferenceAutoscalerPolicyList{}
+	if err := c.List(ctx, list, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	return list.Items, nil
+}
+
+// confirm asks whether to apply the planned changes
+func confirm(opts BulkOptions, count int) (bool, error) {
+	if opts.In == nil {
+		return false, errors.New("confirmation needed: pass --yes or --dry-run")
+	}
+	_, _ = fmt.Fprintf(opts.Out, "Apply changes to %d policies? [y/N]: ", count)
+	answer, err := bufio.NewReader(opts.In).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// rejectedError reports policies the mutation left unchanged because it did
+// not apply to them
+func rejectedError(rejected int) error {
+	if rejected == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d policies were skipped", rejected)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func testPolicy(namespace, name string, labels map[string]string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	return &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			MinReplicas: int32Ptr(2),
+			MaxReplicas: 10,
+		},
+	}
+}
+
+func newTestClient(t *testing.T) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kubeaiv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		testPolicy("search", "ranker", map[string]string{"team": "search"}),
+		testPolicy("search", "embedder", map[string]string{"team": "search"}),
+		testPolicy("search", "chat", map[string]string{"team": "chat"}),
+		testPolicy("other", "ranker", map[string]string{"team": "search"}),
+	).Build()
+}
+
+func getPolicy(t *testing.T, c client.Client, namespace, name string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, policy))
+	return policy
+}
+
+func TestRunBulkPause(t *testing.T) {
+	c := newTestClient(t)
+	out := &bytes.Buffer{}
+
+	err := RunBulk(context.Background(), c, BulkOptions{Namespace: "search", All: true, Yes: true, Out: out}, Pause())
+	require.NoError(t, err)
+	assert.True(t, getPolicy(t, c, "search", "ranker").Spec.Suspend)
+	assert.True(t, getPolicy(t, c, "search", "chat").Spec.Suspend)
+	assert.False(t, getPolicy(t, c, "other", "ranker").Spec.Suspend)
+	assert.Contains(t, out.String(), "search/ranker: suspend: false -> true")
+
+	// Resuming by name only touches the named policy
+	out.Reset()
+	err = RunBulk(context.Background(), c, BulkOptions{Namespace: "search", Names: []string{"chat"}, Yes: true, Out: out}, Resume())
+	require.NoError(t, err)
+	assert.False(t, getPolicy(t, c, "search", "chat").Spec.Suspend)
+	assert.True(t, getPolicy(t, c, "search", "ranker").Spec.Suspend)
+}
+
+func TestRunBulkSelector(t *testing.T) {
+	c := newTestClient(t)
+	out := &bytes.Buffer{}
+
+	err := RunBulk(context.Background(), c, BulkOptions{AllNamespaces: true, Selector: "team=search", Yes: true, Out: out}, SetMax(4))
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), getPolicy(t, c, "search", "ranker").Spec.MaxReplicas)
+	assert.Equal(t, int32(4), getPolicy(t, c, "other", "ranker").Spec.MaxReplicas)
+	assert.Equal(t, int32(10), getPolicy(t, c, "search", "chat").Spec.MaxReplicas)
+}
+
+func TestRunBulkDryRun(t *testing.T) {
+	c := newTestClient(t)
+	out := &bytes.Buffer{}
+
+	err := RunBulk(context.Background(), c, BulkOptions{Namespace: "search", All: true, DryRun: true, Out: out}, SetMode(ModeDryRun))
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "search/chat: mode: Enforce -> DryRun")
+	assert.Contains(t, out.String(), "Dry run: 3 policies would be changed")
+	assert.False(t, getPolicy(t, c, "search", "chat").Spec.DryRun)
+}
+
+func TestRunBulkConfirm(t *testing.T) {
+	tests := []struct {
+		name      string
+		answer    string
+		wantApply bool
+	}{
+		{name: "yes", answer: "y\n", wantApply: true},
+		{name: "no", answer: "n\n"},
+		{name: "empty", answer: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t)
+			out := &bytes.Buffer{}
+
+			opts := BulkOptions{Namespace: "search", Selector: "team=chat", In: strings.NewReader(tt.answer), Out: out}
+			require.NoError(t, RunBulk(context.Background(), c, opts, Pause()))
+			assert.Contains(t, out.String(), "Apply changes to 1 policies? [y/N]")
+			assert.Equal(t, tt.wantApply, getPolicy(t, c, "search", "chat").Spec.Suspend)
+		})
+	}
+}
+
+func TestRunBulkSkipsRejectedPolicies(t *testing.T) {
+	c := newTestClient(t)
+	ranker := getPolicy(t, c, "search", "ranker")
+	ranker.Spec.MinReplicas = int32Ptr(6)
+	require.NoError(t, c.Update(context.Background(), ranker))
+	out := &bytes.Buffer{}
+
+	err := RunBulk(context.Background(), c, BulkOptions{Namespace: "search", All: true, Yes: true, Out: out}, SetMax(5))
+	assert.EqualError(t, err, "1 policies were skipped")
+	assert.Contains(t, out.String(), "search/ranker: skipped: minReplicas 6 is above 5")
+	assert.Equal(t, int32(10), getPolicy(t, c, "search", "ranker").Spec.MaxReplicas)
+	assert.Equal(t, int32(5), getPolicy(t, c, "search", "chat").Spec.MaxReplicas)
+}
+
+func TestRunBulkSelection(t *testing.T) {
+	c := newTestClient(t)
+	out := &bytes.Buffer{}
+
+	err := RunBulk(context.Background(), c, BulkOptions{Namespace: "search", Out: out}, Pause())
+	assert.ErrorContains(t, err, "exactly one of")
+
+	err = RunBulk(context.Background(), c, BulkOptions{Namespace: "search", All: true, Selector: "team=chat", Out: out}, Pause())
+	assert.ErrorContains(t, err, "exactly one of")
+
+	err = RunBulk(context.Background(), c, BulkOptions{Namespace: "search", Selector: "team in (", Out: out}, Pause())
+	assert.ErrorContains(t, err, "invalid selector")
+
+	err = RunBulk(context.Background(), c, BulkOptions{Namespace: "search", All: true, Out: out}, Pause())
+	assert.ErrorContains(t, err, "pass --yes or --dry-run")
+}
+
+func TestParseMode(t *testing.T) {
+	mode, err := ParseMode("dryrun")
+	require.NoError(t, err)
+	assert.Equal(t, ModeDryRun, mode)
+
+	_, err = ParseMode("Recommend")
+	assert.Error(t, err)
+}
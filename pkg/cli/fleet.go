@@ -0,0 +1,304 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/controller"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// replicasHistoryQuery selects the replicas of a policy's target, as
+	// exported by the controller, by namespace and name
+	replicasHistoryQuery = `max(kubeai_autoscaler_current_replicas{namespace=%q,policy=%q})`
+	// metricHistoryQuery selects one metric of a policy, as exported by the
+	// controller, by namespace, name and metric name
+	metricHistoryQuery = `max(kubeai_autoscaler_metric_value{namespace=%q,policy=%q,metric_type=%q})`
+	// maxFleetSamples bounds the samples replayed per series, below the 11,000
+	// points Prometheus returns per series
+	maxFleetSamples = 10000
+)
+
+// FleetOptions configures the replay of a fleet simulation
+type FleetOptions struct {
+	// Proposed is the directory of policy manifests holding the proposed changes
+	Proposed string
+	// Window is how far back metrics are replayed
+	Window time.Duration
+	// Step is the time between replayed samples
+	Step time.Duration
+
+	now func() time.Time
+}
+
+// fleetResult sums up the simulation of one policy
+type fleetResult struct {
+	gpuHours   float64
+	overTarget time.Duration
+}
+
+// RunFleet replays the metrics the controller recorded for the selected
+// policies over the last window through their current spec and through the
+// proposed spec of the same namespace and name, and reports the GPU-hours
+// each would have used and how long a metric would have been over its target.
+// Policies without a proposed change are replayed once and count towards the
+// totals unchanged. The selection and output of opts are used as in RunBulk.
+func RunFleet(ctx context.Context, c client.Client, history metrics.HistoryReader, opts BulkOptions, fleet FleetOptions) error {
+	if fleet.Window <= 0 || fleet.Step <= 0 {
+		return errors.New("the window and step must be positive")
+	}
+	if fleet.Window/fleet.Step > maxFleetSamples {
+		return fmt.Errorf("a window of %s at a step of %s replays more than %d samples; use a larger step", fleet.Window, fleet.Step, maxFleetSamples)
+	}
+	proposed, err := loadProposedPolicies(fleet.Proposed, opts.Namespace)
+	if err != nil {
+		return err
+	}
+	policies, err := selectPolicies(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		_, _ = fmt.Fprintln(opts.Out, "No policies selected")
+		return nil
+	}
+
+	now := time.Now
+	if fleet.now != nil {
+		now = fleet.now
+	}
+	end := now()
+	start := end.Add(-fleet.Window)
+
+	var current, changed fleetResult
+	var replayed, skipped int
+	for i := range policies {
+		live := &policies[i]
+		key := live.Namespace + "/" + live.Name
+		change := proposed[key]
+		delete(proposed, key)
+
+		steps, replicas, err := replaySteps(ctx, history, live, change, start, end, fleet.Step)
+		if errors.Is(err, metrics.ErrNoData) {
+			skipped++
+			_, _ = fmt.Fprintf(opts.Out, "%s: skipped: no recorded replicas\n", key)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read the history of %s: %w", key, err)
+		}
+
+		before, err := simulateFleetPolicy(ctx, live, replicas, steps, fleet.Step)
+		if err != nil {
+			return fmt.Errorf("failed to simulate %s: %w", key, err)
+		}
+		replayed++
+		current.add(before)
+		if change == nil || equality.Semantic.DeepEqual(live.Spec, change.Spec) {
+			changed.add(before)
+			_, _ = fmt.Fprintf(opts.Out, "%s: unchanged: GPU-hours %.1f, over target %s\n", key, before.gpuHours, before.overTarget)
+			continue
+		}
+		// The proposed spec runs against the target the live policy discovered
+		change.Status = live.Status
+		after, err := simulateFleetPolicy(ctx, change, replicas, steps, fleet.Step)
+		if err != nil {
+			return fmt.Errorf("failed to simulate the proposed %s: %w", key, err)
+		}
+		changed.add(after)
+		_, _ = fmt.Fprintf(opts.Out, "%s: %s\n", key, compareFleetResults(before, after))
+	}
+	for _, key := range sortedKeys(proposed) {
+		_, _ = fmt.Fprintf(opts.Out, "%s: skipped: no selected live policy to replay\n", key)
+	}
+
+	_, _ = fmt.Fprintf(opts.Out, "Total over %s of %d policies: %s\n", fleet.Window, replayed, compareFleetResults(current, changed))
+	if skipped > 0 {
+		return fmt.Errorf("%d policies have no recorded replicas to replay", skipped)
+	}
+	return nil
+}
+
+// add sums up another result
+func (r *fleetResult) add(other fleetResult) {
+	r.gpuHours += other.gpuHours
+	r.overTarget += other.overTarget
+}
+
+// compareFleetResults describes the change from the current to the proposed result
+func compareFleetResults(current, proposed fleetResult) string {
+	overTarget := proposed.overTarget - current.overTarget
+	sign := "+"
+	if overTarget < 0 {
+		sign = "-"
+		overTarget = -overTarget
+	}
+	return fmt.Sprintf("GPU-hours %.1f -> %.1f (%+.1f), over target %s -> %s (%s%s)",
+		current.gpuHours, proposed.gpuHours, proposed.gpuHours-current.gpuHours,
+		current.overTarget, proposed.overTarget, sign, overTarget)
+}
+
+// replaySteps reads the replicas and metrics recorded for the live policy
+// between start and end into simulation steps, one per recorded replica
+// count, and returns them with the replicas the target started at. The
+// metrics of both the live and the proposed spec are read.
+func replaySteps(ctx context.Context, history metrics.HistoryReader, live, proposed *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, start, end time.Time, step time.Duration) ([]controller.SimulationStep, int32, error) {
+	replicas, err := history.History(ctx, fmt.Sprintf(replicasHistoryQuery, live.Namespace, live.Name), start, end, step)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(replicas) == 0 {
+		return nil, 0, metrics.ErrNoData
+	}
+
+	names := sets.New(controller.MetricNames(live)...)
+	if proposed != nil {
+		names.Insert(controller.MetricNames(proposed)...)
+	}
+	values := make(map[int64]map[string]float64)
+	for _, name := range sets.List(names) {
+		samples, err := history.History(ctx, fmt.Sprintf(metricHistoryQuery, live.Namespace, live.Name, name), start, end, step)
+		if errors.Is(err, metrics.ErrNoData) {
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, sample := range samples {
+			at := sample.Timestamp.Unix()
+			if values[at] == nil {
+				values[at] = make(map[string]float64)
+			}
+			values[at][name] = sample.Value
+		}
+	}
+
+	steps := make([]controller.SimulationStep, 0, len(replicas))
+	for _, sample := range replicas {
+		steps = append(steps, controller.SimulationStep{
+			Time:     sample.Timestamp,
+			Metrics:  controller.MetricsFromNamed(values[sample.Timestamp.Unix()]),
+			Replicas: int32(math.Round(sample.Value)),
+		})
+	}
+	return steps, steps[0].Replicas, nil
+}
+
+// simulateFleetPolicy replays the steps through the policy and sums up the
+// GPU-hours of the replicas each decision left the target at until the next
+// step, and the steps a metric was over its target
+func simulateFleetPolicy(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, replicas int32, steps []controller.SimulationStep, step time.Duration) (fleetResult, error) {
+	decisions, err := controller.Simulate(ctx, nil, policy, replicas, steps)
+	if err != nil {
+		return fleetResult{}, err
+	}
+	gpus := max(policy.Status.GPUsPerReplica, 1)
+	var result fleetResult
+	for _, d := range decisions {
+		result.gpuHours += float64(d.To*gpus) * step.Hours()
+		if d.OverTarget {
+			result.overTarget += step
+		}
+	}
+	return result, nil
+}
+
+// loadProposedPolicies reads the policies in the YAML files of dir, keyed by
+// namespace and name; policies without a namespace are in namespace. Other
+// kinds of objects are ignored.
+func loadProposedPolicies(dir, namespace string) (map[string]*kubeaiv1alpha1.AIInferenceAutoscalerPolicy, error) {
+	if dir == "" {
+		return nil, errors.New("name the directory of proposed policies with --proposed")
+	}
+	var files []string
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	proposed := make(map[string]*kubeaiv1alpha1.AIInferenceAutoscalerPolicy)
+	for _, file := range files {
+		data, err := os.ReadFile(file) // #nosec G304 - files the user named
+		if err != nil {
+			return nil, err
+		}
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+		for {
+			doc, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", file, err)
+			}
+			var meta metav1.TypeMeta
+			if err := yaml.Unmarshal(doc, &meta); err != nil {
+				return nil, fmt.Errorf("failed to decode %s: %w", file, err)
+			}
+			if meta.Kind != "AIInferenceAutoscalerPolicy" {
+				continue
+			}
+			policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+			if err := yaml.UnmarshalStrict(doc, policy); err != nil {
+				return nil, fmt.Errorf("failed to decode %s: %w", file, err)
+			}
+			if policy.Namespace == "" {
+				policy.Namespace = namespace
+			}
+			key := policy.Namespace + "/" + policy.Name
+			if _, ok := proposed[key]; ok {
+				return nil, fmt.Errorf("policy %s is proposed more than once", key)
+			}
+			proposed[key] = policy
+		}
+	}
+	return proposed, nil
+}
+
+// sortedKeys returns the keys of the proposed policies in order
+func sortedKeys(policies map[string]*kubeaiv1alpha1.AIInferenceAutoscalerPolicy) []string {
+	keys := make([]string, 0, len(policies))
+	for key := range policies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
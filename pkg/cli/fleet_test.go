@@ -0,0 +1,148 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+// fakeHistory answers range queries with a constant value per query
+type fakeHistory struct {
+	values map[string]float64
+}
+
+func (h *fakeHistory) History(_ context.Context, query string, start, end time.Time, step time.Duration) ([]metrics.Sample, error) {
+	value, ok := h.values[query]
+	if !ok {
+		return nil, metrics.ErrNoData
+	}
+	var samples []metrics.Sample
+	for at := start.Add(step); !at.After(end); at = at.Add(step) {
+		samples = append(samples, metrics.Sample{Value: value, Timestamp: at})
+	}
+	return samples, nil
+}
+
+func fleetPolicy(name string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	policy := testPolicy("search", name, nil)
+	policy.Spec.TargetRef = kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: name}
+	policy.Spec.MinReplicas = int32Ptr(1)
+	policy.Spec.Metrics.GPUUtilization = &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 50}
+	return policy
+}
+
+func TestRunFleet(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kubeaiv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(fleetPolicy("ranker"), fleetPolicy("embedder"), fleetPolicy("chat")).Build()
+
+	// The ranker ran at 2 replicas and 100% GPU utilization for the last
+	// hour; the proposal caps it at 3 replicas
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ranker.yaml"), []byte(`
+apiVersion: kubeai.io/v1alpha1
+kind: AIInferenceAutoscalerPolicy
+metadata:
+  name: ranker
+spec:
+  targetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: ranker
+  minReplicas: 1
+  maxReplicas: 3
+  metrics:
+    gpuUtilization:
+      enabled: true
+      targetPercentage: 50
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+`), 0o600))
+	history := &fakeHistory{values: map[string]float64{
+		`max(kubeai_autoscaler_current_replicas{namespace="search",policy="ranker"})`:                                 2,
+		`max(kubeai_autoscaler_metric_value{namespace="search",policy="ranker",metric_type="gpuUtilizationPercent"})`: 100,
+		`max(kubeai_autoscaler_current_replicas{namespace="search",policy="chat"})`:                                   1,
+		`max(kubeai_autoscaler_metric_value{namespace="search",policy="chat",metric_type="gpuUtilizationPercent"})`:   50,
+	}}
+
+	var out bytes.Buffer
+	opts := BulkOptions{Namespace: "search", All: true, Out: &out}
+	fleet := FleetOptions{
+		Proposed: dir,
+		Window:   time.Hour,
+		Step:     time.Minute,
+		now:      func() time.Time { return time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC) },
+	}
+	err := RunFleet(context.Background(), c, history, opts, fleet)
+	require.EqualError(t, err, "1 policies have no recorded replicas to replay")
+
+	// The live ranker doubles its replicas and meets its target after the
+	// first step; capped at 3 replicas it stays over target all hour
+	assert.Equal(t, `search/chat: unchanged: GPU-hours 1.0, over target 0s
+search/embedder: skipped: no recorded replicas
+search/ranker: GPU-hours 4.0 -> 3.0 (-1.0), over target 1m0s -> 1h0m0s (+59m0s)
+Total over 1h0m0s of 2 policies: GPU-hours 5.0 -> 4.0 (-1.0), over target 1m0s -> 1h0m0s (+59m0s)
+`, out.String())
+}
+
+func TestLoadProposedPolicies(t *testing.T) {
+	dir := t.TempDir()
+	policy := `
+apiVersion: kubeai.io/v1alpha1
+kind: AIInferenceAutoscalerPolicy
+metadata:
+  name: ranker
+  namespace: %s
+spec:
+  maxReplicas: 4
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(fmt.Sprintf(policy, "search")), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte(fmt.Sprintf(policy, "other")), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a manifest"), 0o600))
+
+	proposed, err := loadProposedPolicies(dir, "search")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other/ranker", "search/ranker"}, sortedKeys(proposed))
+	assert.Equal(t, int32(4), proposed["search/ranker"].Spec.MaxReplicas)
+
+	// A policy without a namespace is proposed in the default namespace
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.yaml"), []byte(fmt.Sprintf(policy, `""`)), 0o600))
+	_, err = loadProposedPolicies(dir, "search")
+	assert.EqualError(t, err, "policy search/ranker is proposed more than once")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.yaml"), []byte("kind: AIInferenceAutoscalerPolicy\nspec:\n  maxReplica: 4\n"), 0o600))
+	_, err = loadProposedPolicies(dir, "search")
+	assert.ErrorContains(t, err, `unknown field "maxReplica"`)
+}
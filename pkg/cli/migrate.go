@@ -0,0 +1,115 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/migration"
+)
+
+// RunMigrateStorage rewrites every policy in the cluster at the storage
+// version of the CRD, removing deprecated fields, and then records that
+// policies are only stored at that version. The policy selection of opts is
+// ignored: stored versions are tracked for the whole CRD, so every policy is
+// migrated. The planned changes are printed and confirmed as in RunBulk.
+func RunMigrateStorage(ctx context.Context, c client.Client, opts BulkOptions, deprecated []migration.DeprecatedField) error {
+	storedVersions, err := migration.StoredVersions(ctx, c)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(opts.Out, "Stored versions: %s; storage version: %s\n",
+		strings.Join(storedVersions, ", "), migration.StorageVersion)
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(kubeaiv1alpha1.GroupVersion.WithKind("AIInferenceAutoscalerPolicyList"))
+	if err := c.List(ctx, list); err != nil {
+		return fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	var planned []*unstructured.Unstructured
+	for i := range list.Items {
+		policy := &list.Items[i]
+		key := policy.GetNamespace() + "/" + policy.GetName()
+		changes := migration.Migrate(policy.DeepCopy(), deprecated)
+		if len(changes) == 0 {
+			_, _ = fmt.Fprintf(opts.Out, "%s: unchanged\n", key)
+			continue
+		}
+		planned = append(planned, policy)
+		_, _ = fmt.Fprintf(opts.Out, "%s: %s\n", key, strings.Join(changes, ", "))
+	}
+
+	if opts.DryRun {
+		_, _ = fmt.Fprintf(opts.Out, "Dry run: %d policies would be rewritten\n", len(planned))
+		return nil
+	}
+	if len(planned) > 0 && !opts.Yes {
+		confirmed, err := confirm(opts, len(planned))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			_, _ = fmt.Fprintln(opts.Out, "Aborted")
+			return nil
+		}
+	}
+
+	var failed int
+	for _, policy := range planned {
+		key := policy.GetNamespace() + "/" + policy.GetName()
+		if err := rewrite(ctx, c, policy, deprecated); err != nil {
+			failed++
+			_, _ = fmt.Fprintf(opts.Out, "%s: failed: %v\n", key, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(opts.Out, "%s: rewritten\n", key)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d policies could not be rewritten; stored versions were left unchanged", failed, len(planned))
+	}
+
+	if err := migration.Complete(ctx, c); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(opts.Out, "Policies are stored at %s only\n", migration.StorageVersion)
+	return nil
+}
+
+// rewrite migrates the policy and writes it back, reading it again when it
+// changed in the meantime. Writing the whole object, rather than patching
+// only the annotation, makes the API server store it at the storage version.
+func rewrite(ctx context.Context, c client.Client, policy *unstructured.Unstructured, deprecated []migration.DeprecatedField) error {
+	first := true
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !first {
+			if err := c.Get(ctx, client.ObjectKeyFromObject(policy), policy); err != nil {
+				return err
+			}
+		}
+		first = false
+		migration.Migrate(policy, deprecated)
+		return c.Update(ctx, policy)
+	})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/migration"
+)
+
+func newMigrationTestClient(t *testing.T) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kubeaiv1alpha1.AddToScheme(scheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: migration.CRDName},
+		Status:     apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha0", migration.StorageVersion}},
+	}
+	dryRun := testPolicy("search", "ranker", nil)
+	dryRun.Spec.DryRun = true
+	migrated := testPolicy("other", "ranker", nil)
+	migrated.Annotations = map[string]string{migration.StorageVersionAnnotation: migration.StorageVersion}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd, dryRun, migrated).
+		WithStatusSubresource(crd).Build()
+}
+
+// deprecatedDryRun stands in for a deprecated field in tests
+var deprecatedDryRun = []migration.DeprecatedField{{Path: []string{"spec", "dryRun"}, Replacement: "spec.mode"}}
+
+func TestRunMigrateStorage(t *testing.T) {
+	c := newMigrationTestClient(t)
+	ctx := context.Background()
+	out := &bytes.Buffer{}
+
+	// A dry run lists the policies to rewrite without changing anything
+	require.NoError(t, RunMigrateStorage(ctx, c, BulkOptions{DryRun: true, Out: out}, deprecatedDryRun))
+	assert.Contains(t, out.String(), "search/ranker: remove spec.dryRun (use spec.mode), rewrite at "+migration.StorageVersion)
+	assert.Contains(t, out.String(), "other/ranker: unchanged")
+	assert.True(t, getPolicy(t, c, "search", "ranker").Spec.DryRun)
+	versions, err := migration.StoredVersions(ctx, c)
+	require.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	out.Reset()
+	require.NoError(t, RunMigrateStorage(ctx, c, BulkOptions{Yes: true, Out: out}, deprecatedDryRun))
+	policy := getPolicy(t, c, "search", "ranker")
+	assert.False(t, policy.Spec.DryRun)
+	assert.Equal(t, migration.StorageVersion, policy.Annotations[migration.StorageVersionAnnotation])
+	assert.Contains(t, out.String(), "search/ranker: rewritten")
+	versions, err = migration.StoredVersions(ctx, c)
+	require.NoError(t, err)
+	assert.Equal(t, []string{migration.StorageVersion}, versions)
+}
+
+func TestRunMigrateStorageAborted(t *testing.T) {
+	c := newMigrationTestClient(t)
+	ctx := context.Background()
+	out := &bytes.Buffer{}
+
+	opts := BulkOptions{In: bytes.NewBufferString("n\n"), Out: out}
+	require.NoError(t, RunMigrateStorage(ctx, c, opts, nil))
+	assert.Contains(t, out.String(), "Aborted")
+	assert.Empty(t, getPolicy(t, c, "search", "ranker").Annotations)
+	versions, err := migration.StoredVersions(ctx, c)
+	require.NoError(t, err)
+	assert.Len(t, versions, 2)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	applyv1alpha1 "github.com/pmady/kubeai-autoscaler/pkg/client/applyconfiguration/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/client/clientset/versioned/fake"
+	"github.com/pmady/kubeai-autoscaler/pkg/client/informers/externalversions"
+)
+
+func testPolicy(name string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	return &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef:   kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			MinReplicas: ptr.To[int32](1),
+			MaxReplicas: 10,
+		},
+	}
+}
+
+func TestClientset(t *testing.T) {
+	ctx := context.Background()
+	cs := fake.NewClientset(testPolicy("existing"))
+	policies := cs.KubeaiV1alpha1().AIInferenceAutoscalerPolicies("default")
+
+	_, err := policies.Create(ctx, testPolicy("created"), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	list, err := policies.List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+
+	got, err := policies.Get(ctx, "existing", metav1.GetOptions{})
+	require.NoError(t, err)
+	got.Status.DesiredReplicas = 3
+	updated, err := policies.UpdateStatus(ctx, got, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), updated.Status.DesiredReplicas)
+}
+
+func TestInformerAndLister(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs := fake.NewClientset(testPolicy("existing"))
+	factory := externalversions.NewSharedInformerFactory(cs, time.Minute)
+	informer := factory.Kubeai().V1alpha1().AIInferenceAutoscalerPolicies()
+	lister := informer.Lister()
+
+	factory.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced))
+
+	policy, err := lister.AIInferenceAutoscalerPolicies("default").Get("existing")
+	require.NoError(t, err)
+	assert.Equal(t, "llm", policy.Spec.TargetRef.Name)
+}
+
+func TestServerSideApply(t *testing.T) {
+	ctx := context.Background()
+	cs := fake.NewClientset()
+	policies := cs.KubeaiV1alpha1().AIInferenceAutoscalerPolicies("default")
+
+	policy := applyv1alpha1.AIInferenceAutoscalerPolicy("applied", "default").
+		WithSpec(applyv1alpha1.AIInferenceAutoscalerPolicySpec().
+			WithTargetRef(applyv1alpha1.TargetRef().
+				WithAPIVersion("apps/v1").
+				WithKind("Deployment").
+				WithName("llm")).
+			WithMinReplicas(2).
+			WithMaxReplicas(8).
+			WithMetrics(applyv1alpha1.MetricsSpec().
+				WithGPUUtilization(applyv1alpha1.GPUUtilizationMetric().
+					WithEnabled(true).
+					WithTargetPercentage(80))))
+
+	applied, err := policies.Apply(ctx, policy, metav1.ApplyOptions{FieldManager: "ci", Force: true})
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](2), applied.Spec.MinReplicas)
+	assert.Equal(t, int32(80), applied.Spec.Metrics.GPUUtilization.TargetPercentage)
+
+	// Re-applying with a changed field updates only that field
+	policy.Spec.WithMaxReplicas(12)
+	applied, err = policies.Apply(ctx, policy, metav1.ApplyOptions{FieldManager: "ci", Force: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), applied.Spec.MaxReplicas)
+	assert.Equal(t, "llm", applied.Spec.TargetRef.Name)
+}
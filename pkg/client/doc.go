@@ -0,0 +1,24 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client holds the generated typed clientset, apply configurations,
+// listers and informers for the kubeai.io API group. They depend only on client-go, so external programs
+// such as dashboards and the kubectl plugin can use them without controller-runtime.
+//
+// Regenerate with hack/update-codegen.sh after changing api/v1alpha1.
+package client
+
+//go:generate ../../hack/update-codegen.sh
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloudevents publishes scaling decisions as CloudEvents, so platforms
+// such as FinOps, capacity planning and chatops tooling can subscribe to them
+// without watching Kubernetes events.
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// Types of the events published for scaling decisions
+const (
+	// TypeScalingDecision is published when a decision changes the replicas of a target
+	TypeScalingDecision = "io.kubeai.scaling.decision"
+	// TypeScalingApplied is published when the new replicas were written to the target
+	TypeScalingApplied = "io.kubeai.scaling.applied"
+	// TypeScalingFailed is published when the new replicas could not be written
+	TypeScalingFailed = "io.kubeai.scaling.failed"
+)
+
+const (
+	// SpecVersion is the CloudEvents specification version of published events
+	SpecVersion = "1.0"
+	// ContentType is the media type of an event in structured content mode
+	ContentType = "application/cloudevents+json"
+	// kafkaBridgeContentType is the media type of records sent to a Kafka HTTP bridge
+	kafkaBridgeContentType = "application/vnd.kafka.json.v2+json"
+	// sendTimeout bounds delivering a single event
+	sendTimeout = 10 * time.Second
+)
+
+// Event is a CloudEvent in its JSON format
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype,omitempty"`
+	// PartitionKey keeps the events of one source in order on partitioned
+	// transports such as Kafka (partitioning extension)
+	PartitionKey string          `json:"partitionkey,omitempty"`
+	Data         json.RawMessage `json:"data,omitempty"`
+}
+
+// NewEvent creates an event of eventType with a unique ID and data encoded as JSON
+func NewEvent(eventType, source, subject string, data any, now time.Time) (Event, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("encoding %s data: %w", eventType, err)
+	}
+	return Event{
+		SpecVersion:     SpecVersion,
+		ID:              string(uuid.NewUUID()),
+		Source:          source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            now.UTC(),
+		DataContentType: "application/json",
+		PartitionKey:    source,
+		Data:            encoded,
+	}, nil
+}
+
+// Sink delivers events to a subscriber
+type Sink interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// NewSink returns the sink for address: an HTTP endpoint receiving events in
+// structured content mode, or, when kafkaTopic is set, a Kafka HTTP bridge
+// producing them to kafkaTopic
+func NewSink(address, kafkaTopic string) (Sink, error) {
+	u, err := url.Parse(address)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("sink address must be an http or https URL, got %q", address)
+	}
+	httpClient := &http.Client{Timeout: sendTimeout}
+	address = strings.TrimSuffix(address, "/")
+	if kafkaTopic != "" {
+		return &KafkaBridgeSink{address: address, topic: kafkaTopic, httpClient: httpClient}, nil
+	}
+	return &HTTPSink{address: address, httpClient: httpClient}, nil
+}
+
+// HTTPSink posts each event to an HTTP endpoint, such as a Knative broker, in
+// structured content mode
+type HTTPSink struct {
+	address    string
+	httpClient *http.Client
+}
+
+var _ Sink = &HTTPSink{}
+
+// Send posts the event
+func (s *HTTPSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return post(ctx, s.httpClient, s.address, ContentType, body)
+}
+
+// KafkaBridgeSink produces each event to a Kafka topic through the REST API of
+// a Kafka HTTP bridge, such as the Strimzi Kafka Bridge. The record carries the
+// event in structured content mode, keyed by its partition key.
+type KafkaBridgeSink struct {
+	address    string
+	topic      string
+	httpClient *http.Client
+}
+
+var _ Sink = &KafkaBridgeSink{}
+
+// kafkaRecords is the body of a produce request to a Kafka HTTP bridge
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// kafkaRecord is a record of a produce request; header values are base64 encoded
+type kafkaRecord struct {
+	Key     string        `json:"key,omitempty"`
+	Value   Event         `json:"value"`
+	Headers []kafkaHeader `json:"headers"`
+}
+
+// kafkaHeader is a header of a Kafka record
+type kafkaHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Send produces the event to the topic
+func (s *KafkaBridgeSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{
+		Key:   event.PartitionKey,
+		Value: event,
+		Headers: []kafkaHeader{{
+			Key:   "content-type",
+			Value: base64.StdEncoding.EncodeToString([]byte(ContentType)),
+		}},
+	}}})
+	if err != nil {
+		return err
+	}
+	return post(ctx, s.httpClient, s.address+"/topics/"+url.PathEscape(s.topic), kafkaBridgeContentType, body)
+}
+
+// post sends body to address and fails on any status other than 2xx
+func post(ctx context.Context, httpClient *http.Client, address, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending event: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("sink returned %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
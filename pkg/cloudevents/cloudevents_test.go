@@ -0,0 +1,188 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+// request is a request received by the test server
+type request struct {
+	path        string
+	contentType string
+	body        []byte
+}
+
+// recordingServer answers every request with status and keeps what it received
+func recordingServer(t *testing.T, status int) (*httptest.Server, chan request) {
+	requests := make(chan request, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{path: r.URL.Path, contentType: r.Header.Get("Content-Type"), body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func testEvent(t *testing.T) Event {
+	event, err := NewEvent(TypeScalingApplied, "/policies/llm", "Deployment/llm",
+		map[string]int{"desiredReplicas": 4}, time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	return event
+}
+
+func TestNewEvent(t *testing.T) {
+	event := testEvent(t)
+	assert.Equal(t, SpecVersion, event.SpecVersion)
+	assert.NotEmpty(t, event.ID)
+	assert.NotEqual(t, event.ID, testEvent(t).ID)
+	assert.Equal(t, "/policies/llm", event.PartitionKey)
+	assert.JSONEq(t, `{"desiredReplicas": 4}`, string(event.Data))
+
+	_, err := NewEvent(TypeScalingApplied, "/policies/llm", "", math.NaN(), time.Now())
+	assert.Error(t, err)
+}
+
+func TestHTTPSink(t *testing.T) {
+	server, requests := recordingServer(t, http.StatusAccepted)
+	sink, err := NewSink(server.URL+"/", "")
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(context.Background(), testEvent(t)))
+	received := <-requests
+	assert.Equal(t, "/", received.path)
+	assert.Equal(t, ContentType, received.contentType)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(received.body, &decoded))
+	assert.Equal(t, "1.0", decoded["specversion"])
+	assert.Equal(t, TypeScalingApplied, decoded["type"])
+	assert.Equal(t, "2026-05-01T12:00:00Z", decoded["time"])
+	assert.Equal(t, map[string]any{"desiredReplicas": 4.0}, decoded["data"])
+}
+
+func TestKafkaBridgeSink(t *testing.T) {
+	server, requests := recordingServer(t, http.StatusOK)
+	sink, err := NewSink(server.URL, "scaling-events")
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(context.Background(), testEvent(t)))
+	received := <-requests
+	assert.Equal(t, "/topics/scaling-events", received.path)
+	assert.Equal(t, "application/vnd.kafka.json.v2+json", received.contentType)
+	var records kafkaRecords
+	require.NoError(t, json.Unmarshal(received.body, &records))
+	require.Len(t, records.Records, 1)
+	assert.Equal(t, "/policies/llm", records.Records[0].Key)
+	assert.Equal(t, TypeScalingApplied, records.Records[0].Value.Type)
+	assert.Equal(t, []kafkaHeader{{Key: "content-type", Value: "YXBwbGljYXRpb24vY2xvdWRldmVudHMranNvbg=="}}, records.Records[0].Headers)
+}
+
+func TestSinkErrors(t *testing.T) {
+	server, _ := recordingServer(t, http.StatusServiceUnavailable)
+	sink, err := NewSink(server.URL, "")
+	require.NoError(t, err)
+	assert.ErrorContains(t, sink.Send(context.Background(), testEvent(t)), "503")
+
+	for _, address := range []string{"", "broker:8080", "kafka://broker:9092"} {
+		_, err := NewSink(address, "")
+		assert.Error(t, err, address)
+	}
+}
+
+// fakeSink records delivered events and fails while err is set
+type fakeSink struct {
+	mu     sync.Mutex
+	events []Event
+	err    error
+}
+
+func (s *fakeSink) Send(_ context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *fakeSink) delivered() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+func TestAsyncPublisher(t *testing.T) {
+	sink := &fakeSink{}
+	publisher := NewAsyncPublisher(sink, 2)
+	event := testEvent(t)
+	event.Type = "io.kubeai.test.publisher"
+	dropped := metrics.CloudEvents.WithLabelValues(event.Type, "dropped")
+	sent := metrics.CloudEvents.WithLabelValues(event.Type, "sent")
+	failed := metrics.CloudEvents.WithLabelValues(event.Type, "failed")
+	droppedBefore, sentBefore, failedBefore := testutil.ToFloat64(dropped), testutil.ToFloat64(sent), testutil.ToFloat64(failed)
+
+	// Events that do not fit in the queue are dropped without blocking
+	publisher.Publish(event)
+	publisher.Publish(event)
+	publisher.Publish(event)
+	assert.Equal(t, droppedBefore+1, testutil.ToFloat64(dropped))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- publisher.Start(ctx) }()
+	require.Eventually(t, func() bool { return sink.delivered() == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, sentBefore+2, testutil.ToFloat64(sent))
+
+	// Failed deliveries are counted, not retried
+	sink.mu.Lock()
+	sink.err = errors.New("connection refused")
+	sink.mu.Unlock()
+	publisher.Publish(event)
+	require.Eventually(t, func() bool { return testutil.ToFloat64(failed) == failedBefore+1 }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestAsyncPublisherFlushesOnShutdown(t *testing.T) {
+	sink := &fakeSink{}
+	publisher := NewAsyncPublisher(sink, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	publisher.Publish(testEvent(t))
+	publisher.Publish(testEvent(t))
+	require.NoError(t, publisher.Start(ctx))
+	assert.Equal(t, 2, sink.delivered())
+}
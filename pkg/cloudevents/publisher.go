@@ -0,0 +1,108 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// DefaultBufferSize is how many events wait for delivery before new ones are dropped
+	DefaultBufferSize = 1024
+	// flushTimeout bounds delivering the events still queued at shutdown
+	flushTimeout = 5 * time.Second
+)
+
+// Publisher accepts events for delivery without blocking the caller
+type Publisher interface {
+	Publish(event Event)
+}
+
+// AsyncPublisher queues events and delivers them to a Sink in the background,
+// so a slow or unreachable subscriber never holds up a reconcile. Events are
+// delivered in order, once; events that fail or do not fit in the queue are
+// dropped and counted in kubeai_autoscaler_cloudevents_total.
+type AsyncPublisher struct {
+	sink  Sink
+	queue chan Event
+}
+
+var _ Publisher = &AsyncPublisher{}
+var _ manager.Runnable = &AsyncPublisher{}
+
+// NewAsyncPublisher creates a publisher delivering to sink with room for
+// bufferSize queued events
+func NewAsyncPublisher(sink Sink, bufferSize int) *AsyncPublisher {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &AsyncPublisher{sink: sink, queue: make(chan Event, bufferSize)}
+}
+
+// Publish queues the event, dropping it when the queue is full
+func (p *AsyncPublisher) Publish(event Event) {
+	select {
+	case p.queue <- event:
+	default:
+		metrics.RecordCloudEvent(event.Type, "dropped")
+	}
+}
+
+// Start delivers queued events until ctx is done, then makes a last attempt
+// to deliver the events still queued
+func (p *AsyncPublisher) Start(ctx context.Context) error {
+	for {
+		select {
+		case event := <-p.queue:
+			// Deliveries in progress at shutdown are bounded by the sink's timeout
+			p.send(context.WithoutCancel(ctx), event)
+		case <-ctx.Done():
+			p.flush()
+			return nil
+		}
+	}
+}
+
+// flush delivers the queued events within flushTimeout
+func (p *AsyncPublisher) flush() {
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	for {
+		select {
+		case event := <-p.queue:
+			p.send(ctx, event)
+		default:
+			return
+		}
+	}
+}
+
+// send delivers a single event
+func (p *AsyncPublisher) send(ctx context.Context, event Event) {
+	if err := p.sink.Send(ctx, event); err != nil {
+		ctrl.Log.WithName("cloudevents").Error(err, "Failed to deliver event", "type", event.Type, "source", event.Source)
+		metrics.RecordCloudEvent(event.Type, "failed")
+		return
+	}
+	metrics.RecordCloudEvent(event.Type, "sent")
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// ActivatorBackend wakes the target of a scale-to-zero policy on behalf of the
+// activator and reports when the Service in front of it can serve again
+type ActivatorBackend struct {
+	r       *AIInferenceAutoscalerPolicyReconciler
+	policy  types.NamespacedName
+	service string
+}
+
+// NewActivatorBackend returns a backend for the policy's target, served by the
+// named Service in the policy's namespace. A nil emergencyStop is never in effect.
+func NewActivatorBackend(
+	c client.Client,
+	scheme *runtime.Scheme,
+	policy types.NamespacedName,
+	service string,
+	emergencyStop *EmergencyStop,
+) *ActivatorBackend {
+	return &ActivatorBackend{
+		r:       &AIInferenceAutoscalerPolicyReconciler{Client: c, Scheme: scheme, EmergencyStop: emergencyStop},
+		policy:  policy,
+		service: service,
+	}
+}
+
+// Ready reports whether the Service has at least one ready endpoint
+func (b *ActivatorBackend) Ready(ctx context.Context) (bool, error) {
+	slices := &discoveryv1.EndpointSliceList{}
+	if err := b.r.List(ctx, slices, client.InNamespace(b.policy.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: b.service}); err != nil {
+		return false, fmt.Errorf("failed to list endpoints of service %s: %w", b.service, err)
+	}
+	for _, slice := range slices.Items {
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition means ready, as for any endpoint consumer
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Activate scales the target to one replica if it is at zero. Further scaling is
+// left to the controller once the woken replica reports load. The target is left
+// at zero while the policy is suspended, its namespace has autoscaling disabled
+// or an emergency stop is in effect.
+func (b *ActivatorBackend) Activate(ctx context.Context) error {
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	if err := b.r.Get(ctx, b.policy, policy); err != nil {
+		return fmt.Errorf("failed to get policy %s: %w", b.policy, err)
+	}
+	if policy.Spec.Suspend {
+		return fmt.Errorf("policy %s is suspended", b.policy)
+	}
+	if b.r.namespaceDisabled(ctx, policy) {
+		return fmt.Errorf("autoscaling is disabled for namespace %s", policy.Namespace)
+	}
+	if b.r.emergencyStopped(ctx).Stopped {
+		return fmt.Errorf("an emergency stop holds policy %s", b.policy)
+	}
+	scale, err := b.r.getScale(ctx, policy)
+	if err != nil {
+		return fmt.Errorf("failed to get scale of %s/%s: %w", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, err)
+	}
+	if scale.Spec.Replicas > 0 {
+		return nil
+	}
+	scale.Spec.Replicas = 1
+	return b.r.updateScale(ctx, policy, scale)
+}
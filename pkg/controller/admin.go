@@ -0,0 +1,253 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+const (
+	// DefaultDecisionLoggingDuration is how long decision logging stays on when no duration is given
+	DefaultDecisionLoggingDuration = 10 * time.Minute
+	// MaxDecisionLoggingDuration caps how long decision logging stays on
+	MaxDecisionLoggingDuration = 24 * time.Hour
+)
+
+// TokenAuthenticator checks a bearer token and returns the name of its user,
+// such as dashboard.APIServerAuthenticator
+type TokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// RequireToken serves handler only to requests with a bearer token the
+// authenticator accepts, for the admin endpoints sharing the unauthenticated
+// metrics server
+func RequireToken(authenticator TokenAuthenticator, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := authenticateRequest(w, r, authenticator); ok {
+			handler.ServeHTTP(w, r)
+		}
+	})
+}
+
+// authenticateRequest returns the user of the request's bearer token. A
+// request without a token or with one the authenticator refuses is answered
+// with 401 or 403 and false is returned.
+func authenticateRequest(w http.ResponseWriter, r *http.Request, authenticator TokenAuthenticator) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		http.Error(w, "a bearer token is required", http.StatusUnauthorized)
+		return "", false
+	}
+	user, err := authenticator.Authenticate(r.Context(), strings.TrimSpace(token))
+	if err != nil {
+		log.FromContext(r.Context()).Info("Admin request refused", "path", r.URL.Path, "error", err.Error())
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return "", false
+	}
+	return user, true
+}
+
+// LogLevelHandler serves the controller log level. GET returns it; PUT or
+// POST with a level parameter changes it without a restart. Levels are named
+// as in --zap-log-level: debug, info or error, or a logr verbosity such as 3.
+func LogLevelHandler(level zap.AtomicLevel) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			value := r.FormValue("level")
+			parsed, err := parseLogLevel(value)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if parsed != level.Level() {
+				log.FromContext(r.Context()).Info("Changing log level", "from", level.Level().String(), "to", parsed.String())
+				level.SetLevel(parsed)
+			}
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, map[string]string{"level": level.Level().String()})
+	})
+}
+
+// parseLogLevel parses a level name or a positive logr verbosity
+func parseLogLevel(value string) (zapcore.Level, error) {
+	switch strings.ToLower(value) {
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "info":
+		return zapcore.InfoLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
+	}
+	verbosity, err := strconv.Atoi(value)
+	if err != nil || verbosity <= 0 || verbosity > 127 {
+		return 0, fmt.Errorf("invalid log level %q, must be debug, info, error or a verbosity above 0", value)
+	}
+	return zapcore.Level(-verbosity), nil
+}
+
+// DecisionLogging turns on logging of the full inputs of every decision for
+// single policies for a limited time, to debug a misbehaving policy without
+// raising the log level of the whole controller or restarting it
+type DecisionLogging struct {
+	clock clock.PassiveClock
+
+	mu    sync.Mutex
+	until map[string]time.Time
+}
+
+// NewDecisionLogging creates a DecisionLogging with no policy enabled
+func NewDecisionLogging() *DecisionLogging {
+	return &DecisionLogging{clock: clock.RealClock{}, until: make(map[string]time.Time)}
+}
+
+// Enable logs the decisions of the policy, keyed namespace/name, for duration
+// and returns when logging stops
+func (d *DecisionLogging) Enable(policyKey string, duration time.Duration) time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	until := d.clock.Now().Add(duration)
+	d.until[policyKey] = until
+	return until
+}
+
+// Disable stops logging the decisions of the policy
+func (d *DecisionLogging) Disable(policyKey string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.until, policyKey)
+}
+
+// Enabled reports whether the decisions of the policy are logged
+func (d *DecisionLogging) Enabled(policyKey string) bool {
+	if d == nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	until, ok := d.until[policyKey]
+	if ok && !d.clock.Now().Before(until) {
+		delete(d.until, policyKey)
+		return false
+	}
+	return ok
+}
+
+// Active returns the policies whose decisions are logged and when logging stops
+func (d *DecisionLogging) Active() map[string]time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.clock.Now()
+	active := make(map[string]time.Time, len(d.until))
+	for policyKey, until := range d.until {
+		if now.Before(until) {
+			active[policyKey] = until
+		} else {
+			delete(d.until, policyKey)
+		}
+	}
+	return active
+}
+
+// Handler serves decision logging. GET lists the policies whose decisions are
+// logged; POST with policy=namespace/name and an optional duration, such as
+// 30m, turns it on for a policy; DELETE with policy turns it off.
+func (d *DecisionLogging) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete:
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		policyKey := r.FormValue("policy")
+		if r.Method != http.MethodGet {
+			if namespace, name, ok := strings.Cut(policyKey, "/"); !ok || namespace == "" || name == "" {
+				http.Error(w, fmt.Sprintf("policy must be namespace/name, got %q", policyKey), http.StatusBadRequest)
+				return
+			}
+		}
+
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			duration := DefaultDecisionLoggingDuration
+			if value := r.FormValue("duration"); value != "" {
+				parsed, err := time.ParseDuration(value)
+				if err != nil || parsed <= 0 || parsed > MaxDecisionLoggingDuration {
+					http.Error(w, fmt.Sprintf("duration must be between 0 and %s, got %q", MaxDecisionLoggingDuration, value),
+						http.StatusBadRequest)
+					return
+				}
+				duration = parsed
+			}
+			until := d.Enable(policyKey, duration)
+			log.FromContext(r.Context()).Info("Decision logging enabled", "policy", policyKey, "until", until)
+		case http.MethodDelete:
+			d.Disable(policyKey)
+			log.FromContext(r.Context()).Info("Decision logging disabled", "policy", policyKey)
+		}
+		writeJSON(w, d.Active())
+	})
+}
+
+// writeJSON writes v as indented JSON
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
+
+// logDecisionInputs logs everything a decision was made from when decision
+// logging is on for the policy. It is logged at info level, so it shows
+// without raising the controller log level.
+func (r *AIInferenceAutoscalerPolicyReconciler) logDecisionInputs(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
+	decision scaling.ScalingResult,
+	snapshot *decisionSnapshot,
+) {
+	if !r.DecisionLogging.Enabled(policy.Namespace + "/" + policy.Name) {
+		return
+	}
+	log.FromContext(ctx).Info("Decision inputs",
+		"spec", policy.Spec,
+		"currentMetrics", currentMetrics,
+		"algorithmResult", decision,
+		"decision", snapshot)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// serve sends a request to handler and returns the response
+func serve(handler http.Handler, method, target string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+	return w
+}
+
+func TestLogLevelHandler(t *testing.T) {
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	handler := LogLevelHandler(level)
+
+	w := serve(handler, http.MethodGet, "/debug/log-level")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"level": "info"}`, w.Body.String())
+
+	w = serve(handler, http.MethodPut, "/debug/log-level?level=debug")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, zapcore.DebugLevel, level.Level())
+
+	// Verbosities map to logr V levels as with --zap-log-level
+	w = serve(handler, http.MethodPost, "/debug/log-level?level=3")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, zapcore.Level(-3), level.Level())
+
+	for _, value := range []string{"", "verbose", "0", "-2"} {
+		w = serve(handler, http.MethodPut, "/debug/log-level?level="+value)
+		assert.Equal(t, http.StatusBadRequest, w.Code, value)
+	}
+	assert.Equal(t, zapcore.Level(-3), level.Level())
+
+	w = serve(handler, http.MethodDelete, "/debug/log-level")
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestRequireToken(t *testing.T) {
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	handler := RequireToken(tokenAuthenticator{}, LogLevelHandler(level))
+	serveWithToken := func(method, target, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, request)
+		return w
+	}
+
+	// Requests without a token the authenticator accepts leave the level alone
+	assert.Equal(t, http.StatusUnauthorized, serveWithToken(http.MethodPut, "/debug/log-level?level=debug", "").Code)
+	assert.Equal(t, http.StatusForbidden, serveWithToken(http.MethodPut, "/debug/log-level?level=debug", "viewer").Code)
+	assert.Equal(t, zapcore.InfoLevel, level.Level())
+
+	w := serveWithToken(http.MethodPut, "/debug/log-level?level=debug", "admin")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, zapcore.DebugLevel, level.Level())
+}
+
+func TestDecisionLoggingHandler(t *testing.T) {
+	d := NewDecisionLogging()
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC))
+	d.clock = fakeClock
+	handler := d.Handler()
+
+	w := serve(handler, http.MethodPost, "/debug/decision-logging?policy=default/llm&duration=30m")
+	require.Equal(t, http.StatusOK, w.Code)
+	var active map[string]time.Time
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &active))
+	assert.Equal(t, map[string]time.Time{"default/llm": fakeClock.Now().Add(30 * time.Minute)}, active)
+	assert.True(t, d.Enabled("default/llm"))
+	assert.False(t, d.Enabled("default/other"))
+
+	// Without a duration logging stays on for the default duration
+	w = serve(handler, http.MethodPost, "/debug/decision-logging?policy=default/other")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, fakeClock.Now().Add(DefaultDecisionLoggingDuration), d.Active()["default/other"])
+
+	w = serve(handler, http.MethodDelete, "/debug/decision-logging?policy=default/other")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, d.Enabled("default/other"))
+
+	// Logging stops on its own
+	fakeClock.SetTime(fakeClock.Now().Add(30 * time.Minute))
+	assert.False(t, d.Enabled("default/llm"))
+	w = serve(handler, http.MethodGet, "/debug/decision-logging")
+	assert.JSONEq(t, `{}`, w.Body.String())
+
+	for _, target := range []string{
+		"/debug/decision-logging?policy=llm",
+		"/debug/decision-logging?policy=default/",
+		"/debug/decision-logging?policy=default/llm&duration=48h",
+		"/debug/decision-logging?policy=default/llm&duration=-1m",
+	} {
+		w = serve(handler, http.MethodPost, target)
+		assert.Equal(t, http.StatusBadRequest, w.Code, target)
+	}
+	w = serve(handler, http.MethodPatch, "/debug/decision-logging?policy=default/llm")
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestReconcileLogsDecisionInputs(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("logged")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	r.DecisionLogging = NewDecisionLogging()
+	var logs bytes.Buffer
+	ctx := log.IntoContext(context.Background(), ctrlzap.New(ctrlzap.WriteTo(&logs), ctrlzap.Level(zapcore.InfoLevel)))
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "logged", Namespace: "default"}}
+
+	// Decisions are not logged until logging is turned on for the policy
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.NotContains(t, logs.String(), "Decision inputs")
+
+	r.DecisionLogging.Enable("default/logged", time.Minute)
+	r.LastScaleTime = map[string]time.Time{}
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Contains(t, logs.String(), "Decision inputs")
+	assert.Contains(t, logs.String(), `"gpuUtilizationPercent":{"current":100,"target":50}`)
+}
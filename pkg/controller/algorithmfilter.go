@@ -0,0 +1,137 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+const (
+	// AllowedAlgorithmsAnnotation on a Namespace lists, comma-separated, the
+	// only algorithms policies in the namespace may use
+	AllowedAlgorithmsAnnotation = "kubeai.io/allowed-algorithms"
+	// DeniedAlgorithmsAnnotation on a Namespace lists, comma-separated, the
+	// algorithms policies in the namespace may not use
+	DeniedAlgorithmsAnnotation = "kubeai.io/denied-algorithms"
+)
+
+// NamespaceAlgorithmFilter returns the algorithm filter set by the annotations
+// of a namespace. A namespace that does not exist sets no filter.
+func NamespaceAlgorithmFilter(ctx context.Context, reader client.Reader, name string) (scaling.AlgorithmFilter, error) {
+	namespace := &corev1.Namespace{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		if errors.IsNotFound(err) {
+			return scaling.AlgorithmFilter{}, nil
+		}
+		return scaling.AlgorithmFilter{}, err
+	}
+	return scaling.NewAlgorithmFilter(namespace.Annotations[AllowedAlgorithmsAnnotation],
+		namespace.Annotations[DeniedAlgorithmsAnnotation]), nil
+}
+
+// DisallowedAlgorithm returns the field and name of the first algorithm the
+// policy selects that one of the filters does not allow. The default
+// algorithm is always allowed, since every policy falls back to it.
+func DisallowedAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, filters ...scaling.AlgorithmFilter) (field, name string) {
+	selected := []struct {
+		field string
+		name  string
+	}{
+		{"spec.algorithm.name", ""},
+		{"spec.scaleUp.algorithm", ""},
+		{"spec.scaleDown.algorithm", ""},
+		{"spec.algorithm.fallback", ""},
+	}
+	if policy.Spec.Algorithm != nil {
+		selected[0].name = policy.Spec.Algorithm.Name
+		selected[3].name = policy.Spec.Algorithm.Fallback
+	}
+	if policy.Spec.ScaleUp != nil {
+		selected[1].name = policy.Spec.ScaleUp.Algorithm
+	}
+	if policy.Spec.ScaleDown != nil {
+		selected[2].name = policy.Spec.ScaleDown.Algorithm
+	}
+
+	for _, s := range selected {
+		if s.name == "" || s.name == DefaultAlgorithmName {
+			continue
+		}
+		for _, filter := range filters {
+			if !filter.Allows(s.name) {
+				return s.field, s.name
+			}
+		}
+	}
+	return "", ""
+}
+
+// filterAlgorithms checks the policy against the controller's algorithm
+// filter and that of its namespace, and returns the policy to decide with
+// along with the field and name of the first disallowed algorithm. Disallowed
+// algorithms are removed from the returned copy. A namespace that cannot be
+// read is checked against the controller's filter only.
+func (r *AIInferenceAutoscalerPolicyReconciler) filterAlgorithms(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+) (decision *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, field, name string) {
+	filters := []scaling.AlgorithmFilter{r.AlgorithmFilter}
+	if r.Client != nil {
+		namespaceFilter, err := NamespaceAlgorithmFilter(ctx, r.Client, policy.Namespace)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Failed to read namespace algorithm filter", "namespace", policy.Namespace)
+		}
+		filters = append(filters, namespaceFilter)
+	}
+
+	decision = policy
+	field, name = DisallowedAlgorithm(policy, filters...)
+	for disallowed := field; disallowed != ""; disallowed, _ = DisallowedAlgorithm(decision, filters...) {
+		decision = withoutAlgorithm(decision, disallowed)
+	}
+	return decision, field, name
+}
+
+// withoutAlgorithm returns a copy of the policy that no longer selects the
+// algorithm in field, so the decision falls back to the default algorithm,
+// for a direction to the policy's main algorithm, and for the fallback to the
+// controller's fallback
+func withoutAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, field string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	policy = policy.DeepCopy()
+	switch field {
+	case "spec.algorithm.name":
+		// The parameters belong to the disallowed algorithm
+		policy.Spec.Algorithm.Name = ""
+		policy.Spec.Algorithm.Parameters = nil
+	case "spec.scaleUp.algorithm":
+		policy.Spec.ScaleUp.Algorithm = ""
+	case "spec.scaleDown.algorithm":
+		policy.Spec.ScaleDown.Algorithm = ""
+	case "spec.algorithm.fallback":
+		policy.Spec.Algorithm.Fallback = ""
+	}
+	return policy
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestDisallowedAlgorithm(t *testing.T) {
+	policy := lockTestPolicy("filtered")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "LittlesLaw"}
+	policy.Spec.ScaleDown = &kubeaiv1alpha1.ScaleBehavior{Algorithm: "experimental-forecast"}
+
+	field, name := DisallowedAlgorithm(policy, scaling.AlgorithmFilter{})
+	assert.Empty(t, field+name)
+
+	field, name = DisallowedAlgorithm(policy, scaling.NewAlgorithmFilter("", "Predictive"), scaling.NewAlgorithmFilter("", "experimental-*"))
+	assert.Equal(t, "spec.scaleDown.algorithm", field)
+	assert.Equal(t, "experimental-forecast", name)
+
+	// The default algorithm is the fallback and stays allowed
+	policy.Spec.Algorithm.Name = DefaultAlgorithmName
+	policy.Spec.ScaleDown = nil
+	field, name = DisallowedAlgorithm(policy, scaling.NewAlgorithmFilter("LittlesLaw", ""))
+	assert.Empty(t, field+name)
+
+	// A policy's own fallback is filtered like any other algorithm
+	policy.Spec.Algorithm.Fallback = "Predictive"
+	field, name = DisallowedAlgorithm(policy, scaling.NewAlgorithmFilter("", "Predictive"))
+	assert.Equal(t, "spec.algorithm.fallback", field)
+	assert.Equal(t, "Predictive", name)
+}
+
+func TestFilterAlgorithms(t *testing.T) {
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	r.AlgorithmFilter = scaling.NewAlgorithmFilter("", "Predictive, experimental-*")
+	policy := lockTestPolicy("filtered")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "Predictive", Tolerance: 0.2, Parameters: map[string]string{"alpha": "0.5"}}
+	policy.Spec.ScaleUp = &kubeaiv1alpha1.ScaleBehavior{Algorithm: "experimental-forecast"}
+
+	decision, field, name := r.filterAlgorithms(context.Background(), policy)
+	assert.Equal(t, "spec.algorithm.name", field)
+	assert.Equal(t, "Predictive", name)
+	assert.Empty(t, decision.Spec.Algorithm.Name)
+	assert.Nil(t, decision.Spec.Algorithm.Parameters)
+	assert.Equal(t, 0.2, decision.Spec.Algorithm.Tolerance)
+	assert.Empty(t, decision.Spec.ScaleUp.Algorithm)
+
+	// The policy itself is left untouched
+	assert.Equal(t, "Predictive", policy.Spec.Algorithm.Name)
+	assert.Equal(t, "experimental-forecast", policy.Spec.ScaleUp.Algorithm)
+}
+
+func TestReconcileAlgorithmNotAllowed(t *testing.T) {
+	scheme := newTestScheme(t)
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "default",
+		Annotations: map[string]string{DeniedAlgorithmsAnnotation: "WeightedRatio"},
+	}}
+	policy := lockTestPolicy("policy")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "WeightedRatio"}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace, policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+	stored := func() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		updated := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, updated))
+		return updated
+	}
+
+	// The policy keeps scaling with the default algorithm
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, r.hasCondition(stored(), ConditionTypeAlgorithmValid, metav1.ConditionFalse, ReasonAlgorithmNotAllowed))
+	assert.Equal(t, DefaultAlgorithmName, stored().Status.LastAlgorithm)
+
+	// Lifting the restriction restores the selected algorithm
+	namespace.Annotations = nil
+	require.NoError(t, c.Update(ctx, namespace))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, r.hasCondition(stored(), ConditionTypeAlgorithmValid, metav1.ConditionTrue, "AlgorithmFound"))
+}
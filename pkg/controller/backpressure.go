@@ -0,0 +1,201 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// ConditionTypeBackpressure indicates a load shedding signal is published for the target
+	ConditionTypeBackpressure = "Backpressure"
+	// DefaultBackpressureKey is the ConfigMap data key written when backpressure.key is not set
+	DefaultBackpressureKey = "backpressure"
+)
+
+// backpressureRatio returns the ratio of the most overloaded metric to its
+// target once the policy has been saturated for the configured delay, and 0
+// otherwise
+func backpressureRatio(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, desiredReplicas int32, ratios []float64, now time.Time) float64 {
+	if !isSaturated(desiredReplicas, policy.Spec.MaxReplicas, ratios) || policy.Status.SaturatedSince == nil {
+		return 0
+	}
+	delay := time.Duration(policy.Spec.Backpressure.DelaySeconds) * time.Second
+	if now.Sub(policy.Status.SaturatedSince.Time) < delay {
+		return 0
+	}
+	ratio := 0.0
+	for _, r := range ratios {
+		ratio = max(ratio, r)
+	}
+	return ratio
+}
+
+// formatBackpressure formats a ratio the way it is published to gateways
+func formatBackpressure(ratio float64) string {
+	return strconv.FormatFloat(ratio, 'f', 2, 64)
+}
+
+// publishBackpressure publishes the load shedding signal while the policy is
+// saturated at maxReplicas and withdraws it once the load fits again. It runs
+// after trackSaturation, which maintains status.saturatedSince. Dry-run
+// policies only export the gauge.
+func (r *AIInferenceAutoscalerPolicyReconciler) publishBackpressure(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	desiredReplicas int32,
+	ratios []float64,
+	now time.Time,
+) {
+	logger := log.FromContext(ctx)
+
+	signaled := r.isConditionTrue(policy, ConditionTypeBackpressure)
+	if policy.Spec.Backpressure == nil {
+		if signaled {
+			metrics.RecordBackpressure(policy.Namespace, policy.Name, 0)
+			r.updateCondition(ctx, policy, ConditionTypeBackpressure, metav1.ConditionFalse, "BackpressureDisabled",
+				"Backpressure was removed from the policy; a published annotation or ConfigMap value is left in place")
+		}
+		return
+	}
+
+	ratio := backpressureRatio(policy, desiredReplicas, ratios, now)
+	metrics.RecordBackpressure(policy.Namespace, policy.Name, ratio)
+	if policy.Spec.DryRun || (ratio == 0 && !signaled) {
+		return
+	}
+
+	if err := r.writeBackpressure(ctx, policy, ratio); err != nil {
+		logger.Error(err, "Failed to publish backpressure signal", "ratio", ratio)
+		return
+	}
+
+	switch {
+	case ratio > 0 && !signaled:
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordBackpressureSignaled(policy, formatBackpressure(ratio))
+		}
+		r.updateCondition(ctx, policy, ConditionTypeBackpressure, metav1.ConditionTrue, ReasonBackpressureSignaled,
+			fmt.Sprintf("Load is %sx the target at maxReplicas=%d; gateways should shed or queue %.0f%% of requests",
+				formatBackpressure(ratio), policy.Spec.MaxReplicas, (1-1/ratio)*100))
+	case ratio == 0 && signaled:
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordBackpressureCleared(policy)
+		}
+		r.updateCondition(ctx, policy, ConditionTypeBackpressure, metav1.ConditionFalse, ReasonBackpressureCleared,
+			"Desired replicas are below maxReplicas or metrics are within target")
+	}
+}
+
+// writeBackpressure writes the ratio to the annotation and ConfigMap of the
+// policy's backpressure spec. A ratio of 0 removes the annotation and writes
+// "0" to the ConfigMap.
+func (r *AIInferenceAutoscalerPolicyReconciler) writeBackpressure(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, ratio float64) error {
+	spec := policy.Spec.Backpressure
+	value := formatBackpressure(ratio)
+	if spec.Annotation != "" {
+		annotation := value
+		if ratio == 0 {
+			annotation = ""
+		}
+		if err := r.setTargetAnnotation(ctx, policy, spec.Annotation, annotation); err != nil {
+			return fmt.Errorf("failed to annotate %s/%s: %w", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, err)
+		}
+	}
+	if spec.ConfigMapName != "" {
+		key := spec.Key
+		if key == "" {
+			key = DefaultBackpressureKey
+		}
+		if ratio == 0 {
+			value = "0"
+		}
+		ref := types.NamespacedName{Namespace: policy.Namespace, Name: spec.ConfigMapName}
+		if err := r.setConfigMapValue(ctx, ref, key, value); err != nil {
+			return fmt.Errorf("failed to write ConfigMap %s: %w", ref.Name, err)
+		}
+	}
+	return nil
+}
+
+// setTargetAnnotation sets an annotation on the policy's target, or removes it
+// when value is empty. The target is only patched when the annotation changes.
+func (r *AIInferenceAutoscalerPolicyReconciler) setTargetAnnotation(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, key, value string) error {
+	obj, err := r.targetObject(policy)
+	if err != nil {
+		return err
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return err
+	}
+
+	annotations := obj.GetAnnotations()
+	current, set := annotations[key]
+	if (value == "" && !set) || (value != "" && current == value) {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	if value == "" {
+		delete(annotations, key)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = value
+	}
+	obj.SetAnnotations(annotations)
+	return r.Patch(ctx, obj, patch)
+}
+
+// setConfigMapValue writes a ConfigMap data key, creating the ConfigMap if
+// needed. The ConfigMap is only updated when the value changes.
+func (r *AIInferenceAutoscalerPolicyReconciler) setConfigMapValue(ctx context.Context, ref types.NamespacedName, key, value string) error {
+	cm := &corev1.ConfigMap{}
+	err := r.Get(ctx, ref, cm)
+	if errors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: ref.Namespace},
+			Data:       map[string]string{key: value},
+		}
+		return r.Create(ctx, cm)
+	}
+	if err != nil {
+		return err
+	}
+	if current, ok := cm.Data[key]; ok && current == value {
+		return nil
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[key] = value
+	return r.Update(ctx, cm)
+}
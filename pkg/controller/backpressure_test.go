@@ -0,0 +1,151 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestBackpressureRatio(t *testing.T) {
+	now := time.Now()
+	since := metav1.NewTime(now.Add(-time.Minute))
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			MaxReplicas:  10,
+			Backpressure: &kubeaiv1alpha1.BackpressureSpec{DelaySeconds: 30},
+		},
+		Status: kubeaiv1alpha1.AIInferenceAutoscalerPolicyStatus{SaturatedSince: &since},
+	}
+
+	assert.Equal(t, 1.5, backpressureRatio(policy, 10, []float64{1.2, 1.5}, now))
+	assert.Zero(t, backpressureRatio(policy, 9, []float64{1.5}, now))
+	assert.Zero(t, backpressureRatio(policy, 10, []float64{0.9}, now))
+
+	// Saturated for less than the delay
+	policy.Spec.Backpressure.DelaySeconds = 120
+	assert.Zero(t, backpressureRatio(policy, 10, []float64{1.5}, now))
+}
+
+func TestPublishBackpressure(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("backpressure-policy")
+	policy.Spec.Backpressure = &kubeaiv1alpha1.BackpressureSpec{
+		Annotation:    "gateway.example.com/backpressure",
+		ConfigMapName: "llm-backpressure",
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(10)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+
+	ctx := context.Background()
+	now := time.Now()
+	deploymentKey := types.NamespacedName{Namespace: "default", Name: "llm"}
+	configMapKey := types.NamespacedName{Namespace: "default", Name: "llm-backpressure"}
+
+	// Not saturated: nothing is written
+	r.publishBackpressure(ctx, policy, 8, []float64{0.8}, now)
+	err := c.Get(ctx, configMapKey, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err), "ConfigMap should not be created before a signal is published")
+	assert.Empty(t, fakeRecorder.Events)
+
+	// Saturated at maxReplicas
+	r.trackSaturation(ctx, policy, 10, []float64{1.2, 2.0}, now)
+	r.publishBackpressure(ctx, policy, 10, []float64{1.2, 2.0}, now)
+	assert.True(t, r.hasCondition(policy, ConditionTypeBackpressure, metav1.ConditionTrue, ReasonBackpressureSignaled))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.BackpressureRatio.WithLabelValues("default", "backpressure-policy")))
+
+	got := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, deploymentKey, got))
+	assert.Equal(t, "2.00", got.Annotations["gateway.example.com/backpressure"])
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, configMapKey, cm))
+	assert.Equal(t, "2.00", cm.Data[DefaultBackpressureKey])
+	select {
+	case event := <-fakeRecorder.Events:
+		assert.Contains(t, event, ReasonBackpressureSignaled)
+	default:
+		t.Fatal("Expected an event to be recorded")
+	}
+
+	// Still saturated: the ratio follows the load without another event
+	r.publishBackpressure(ctx, policy, 10, []float64{1.5}, now.Add(time.Minute))
+	require.NoError(t, c.Get(ctx, deploymentKey, got))
+	assert.Equal(t, "1.50", got.Annotations["gateway.example.com/backpressure"])
+	assert.Empty(t, fakeRecorder.Events)
+
+	// Load subsides
+	r.trackSaturation(ctx, policy, 9, []float64{0.9}, now.Add(2*time.Minute))
+	r.publishBackpressure(ctx, policy, 9, []float64{0.9}, now.Add(2*time.Minute))
+	assert.True(t, r.hasCondition(policy, ConditionTypeBackpressure, metav1.ConditionFalse, ReasonBackpressureCleared))
+	assert.Zero(t, testutil.ToFloat64(metrics.BackpressureRatio.WithLabelValues("default", "backpressure-policy")))
+	require.NoError(t, c.Get(ctx, deploymentKey, got))
+	assert.NotContains(t, got.Annotations, "gateway.example.com/backpressure")
+	require.NoError(t, c.Get(ctx, configMapKey, cm))
+	assert.Equal(t, "0", cm.Data[DefaultBackpressureKey])
+	select {
+	case event := <-fakeRecorder.Events:
+		assert.Contains(t, event, ReasonBackpressureCleared)
+	default:
+		t.Fatal("Expected an event to be recorded")
+	}
+}
+
+func TestPublishBackpressureDryRun(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("backpressure-dry-run")
+	policy.Spec.DryRun = true
+	policy.Spec.Backpressure = &kubeaiv1alpha1.BackpressureSpec{Annotation: "gateway.example.com/backpressure"}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(10)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+
+	ctx := context.Background()
+	now := time.Now()
+	r.trackSaturation(ctx, policy, 10, []float64{2.0}, now)
+	r.publishBackpressure(ctx, policy, 10, []float64{2.0}, now)
+
+	// Only the gauge reports the signal
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.BackpressureRatio.WithLabelValues("default", "backpressure-dry-run")))
+	got := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "default", Name: "llm"}, got))
+	assert.NotContains(t, got.Annotations, "gateway.example.com/backpressure")
+	assert.False(t, r.isConditionTrue(policy, ConditionTypeBackpressure))
+}
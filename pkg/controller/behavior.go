@@ -0,0 +1,93 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeScalingLimited indicates the recommendation was held back by behavior settings
+	ConditionTypeScalingLimited = "ScalingLimited"
+
+	// ReasonScaleDownDisabled indicates scale-down is disabled for the policy
+	ReasonScaleDownDisabled = "ScaleDownDisabled"
+	// ReasonScaleUpDisabled indicates scale-up is disabled for the policy
+	ReasonScaleUpDisabled = "ScaleUpDisabled"
+)
+
+// behaviorFor returns the behavior configured for the direction of the change
+func behaviorFor(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentReplicas, desiredReplicas int32) *kubeaiv1alpha1.ScaleBehavior {
+	switch {
+	case desiredReplicas > currentReplicas:
+		return policy.Spec.ScaleUp
+	case desiredReplicas < currentReplicas:
+		return policy.Spec.ScaleDown
+	default:
+		return nil
+	}
+}
+
+// directionDisabled reports whether behavior disables scaling in its direction,
+// with disabled or a Disabled selectPolicy
+func directionDisabled(behavior *kubeaiv1alpha1.ScaleBehavior) bool {
+	return behavior != nil && (behavior.Disabled || behavior.SelectPolicy == SelectPolicyDisabled)
+}
+
+// scaleDirection returns the direction of a change as up, down or none
+func scaleDirection(currentReplicas, desiredReplicas int32) string {
+	switch {
+	case desiredReplicas > currentReplicas:
+		return "up"
+	case desiredReplicas < currentReplicas:
+		return "down"
+	default:
+		return "none"
+	}
+}
+
+// applyDisabledDirections holds replicas at the current count when the direction
+// of the recommended change is disabled, and reports the held-back recommendation
+// through the ScalingLimited condition so operators can see what would have happened.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyDisabledDirections(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, recommendedReplicas int32,
+) int32 {
+	behavior := behaviorFor(policy, currentReplicas, recommendedReplicas)
+	if !directionDisabled(behavior) {
+		if r.isConditionTrue(policy, ConditionTypeScalingLimited) {
+			r.updateCondition(ctx, policy, ConditionTypeScalingLimited, metav1.ConditionFalse,
+				"NotLimited", "Recommendation applied without behavior limits")
+		}
+		return recommendedReplicas
+	}
+
+	reason := ReasonScaleUpDisabled
+	if recommendedReplicas < currentReplicas {
+		reason = ReasonScaleDownDisabled
+	}
+	r.updateCondition(ctx, policy, ConditionTypeScalingLimited, metav1.ConditionTrue, reason,
+		fmt.Sprintf("Scale %s is disabled; would have scaled from %d to %d replicas",
+			scaleDirection(currentReplicas, recommendedReplicas), currentReplicas, recommendedReplicas))
+	return currentReplicas
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestApplyDisabledDirections(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+			ScaleDown:   &kubeaiv1alpha1.ScaleBehavior{Disabled: true},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+
+	// Scale-down is held at current replicas and reported
+	assert.Equal(t, int32(5), r.applyDisabledDirections(ctx, policy, 5, 2))
+	assert.True(t, r.hasCondition(policy, ConditionTypeScalingLimited, metav1.ConditionTrue, ReasonScaleDownDisabled))
+
+	// Scale-up still applies and clears the condition
+	assert.Equal(t, int32(8), r.applyDisabledDirections(ctx, policy, 5, 8))
+	assert.False(t, r.isConditionTrue(policy, ConditionTypeScalingLimited))
+
+	// Scale-up can be disabled the same way
+	policy.Spec.ScaleUp = &kubeaiv1alpha1.ScaleBehavior{Disabled: true}
+	assert.Equal(t, int32(5), r.applyDisabledDirections(ctx, policy, 5, 8))
+	assert.True(t, r.hasCondition(policy, ConditionTypeScalingLimited, metav1.ConditionTrue, ReasonScaleUpDisabled))
+
+	// ...or with the HPA's Disabled selectPolicy
+	policy.Spec.ScaleUp = &kubeaiv1alpha1.ScaleBehavior{SelectPolicy: SelectPolicyDisabled}
+	assert.Equal(t, int32(5), r.applyDisabledDirections(ctx, policy, 5, 8))
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/capacity"
+)
+
+// getPodTemplate returns the pod template of the policy's target. Deployments
+// and statefulsets are read typed; other kinds, such as a CRD exposing /scale,
+// are read through spec.template of the unstructured object.
+func (r *AIInferenceAutoscalerPolicyReconciler) getPodTemplate(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (*corev1.PodTemplateSpec, error) {
+	key := types.NamespacedName{
+		Namespace: policy.Namespace,
+		Name:      policy.Spec.TargetRef.Name,
+	}
+
+	switch policy.Spec.TargetRef.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, key, deployment); err != nil {
+			return nil, err
+		}
+		return &deployment.Spec.Template, nil
+
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, key, statefulSet); err != nil {
+			return nil, err
+		}
+		return &statefulSet.Spec.Template, nil
+	}
+
+	obj, err := r.targetObject(policy)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	raw, found, err := unstructured.NestedMap(content, "spec", "template")
+	if err != nil {
+		return nil, fmt.Errorf("%s %s has an invalid spec.template: %w", policy.Spec.TargetRef.Kind, key, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("%s %s has no pod template at spec.template", policy.Spec.TargetRef.Kind, key)
+	}
+	template := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, template); err != nil {
+		return nil, fmt.Errorf("%s %s has an invalid spec.template: %w", policy.Spec.TargetRef.Kind, key, err)
+	}
+	return template, nil
+}
+
+// probeCapacity discovers the per-replica capacity configured by spec.capacityProbe
+func (r *AIInferenceAutoscalerPolicyReconciler) probeCapacity(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int32, error) {
+	probe := policy.Spec.CapacityProbe
+
+	switch probe.Source {
+	case "", "Annotation":
+		template, err := r.getPodTemplate(ctx, policy)
+		if err != nil {
+			return 0, err
+		}
+		return capacity.FromAnnotations(template.Annotations, probe.AnnotationKey)
+
+	case "HTTP":
+		if r.CapacityProber == nil {
+			return 0, fmt.Errorf("capacity prober not configured")
+		}
+		return r.CapacityProber.Probe(ctx, probe.URL, probe.Format)
+
+	default:
+		return 0, fmt.Errorf("unsupported capacity probe source: %s", probe.Source)
+	}
+}
+
+// queueDepthTarget returns the per-replica queue depth target, falling back to
+// the discovered capacity when no explicit target is configured
+func queueDepthTarget(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) *resource.Quantity {
+	if target := policy.Spec.Metrics.RequestQueueDepth.TargetDepth; kubeaiv1alpha1.QuantityFloat64(target) > 0 {
+		return perReplicaTarget(policy, target)
+	}
+	if policy.Status.DiscoveredCapacity > 0 {
+		return resource.NewQuantity(int64(policy.Status.DiscoveredCapacity), resource.DecimalSI)
+	}
+	return nil
+}
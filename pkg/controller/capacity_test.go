@@ -0,0 +1,133 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/capacity"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestProbeCapacityFromAnnotation(t *testing.T) {
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "vllm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{capacity.DefaultAnnotationKey: "16"},
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "vllm",
+			},
+			CapacityProbe: &kubeaiv1alpha1.CapacityProbeSpec{Source: "Annotation"},
+		},
+	}
+
+	got, err := r.probeCapacity(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(16), got)
+}
+
+func TestProbeCapacityFromCustomResourceAnnotation(t *testing.T) {
+	scheme := newTestScheme(t)
+	server := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "serving.example.com/v1",
+		"kind":       "InferenceServer",
+		"metadata":   map[string]any{"name": "vllm", "namespace": "default"},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]any{capacity.DefaultAnnotationKey: "24"},
+				},
+			},
+		},
+	}}
+	bare := server.DeepCopy()
+	bare.SetName("bare")
+	unstructured.RemoveNestedField(bare.Object, "spec", "template")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(server, bare).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{
+				APIVersion: "serving.example.com/v1",
+				Kind:       "InferenceServer",
+				Name:       "vllm",
+			},
+			CapacityProbe: &kubeaiv1alpha1.CapacityProbeSpec{Source: "Annotation"},
+		},
+	}
+
+	got, err := r.probeCapacity(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(24), got)
+
+	policy.Spec.TargetRef.Name = "bare"
+	_, err = r.probeCapacity(context.Background(), policy)
+	assert.EqualError(t, err, "InferenceServer default/bare has no pod template at spec.template")
+}
+
+func TestDiscoveredCapacityDrivesQueueRatio(t *testing.T) {
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true},
+			},
+		},
+		Status: kubeaiv1alpha1.AIInferenceAutoscalerPolicyStatus{DiscoveredCapacity: 10},
+	}
+	r := &AIInferenceAutoscalerPolicyReconciler{AlgorithmRegistry: scaling.DefaultRegistry}
+
+	// 2 replicas with capacity 10 each, 40 queued requests -> ratio 2.0
+	ratios := r.buildMetricRatios(policy, 2, &kubeaiv1alpha1.CurrentMetrics{RequestQueueDepth: 40})
+	assert.Equal(t, []float64{2.0}, ratios)
+
+	// An explicit target takes precedence over the discovered capacity
+	policy.Spec.Metrics.RequestQueueDepth.TargetDepth = ptr.To(resource.MustParse("20"))
+	ratios = r.buildMetricRatios(policy, 2, &kubeaiv1alpha1.CurrentMetrics{RequestQueueDepth: 40})
+	assert.Equal(t, []float64{1.0}, ratios)
+}
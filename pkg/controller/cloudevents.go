@@ -0,0 +1,82 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/cloudevents"
+)
+
+// scalingEventData is the data of the scaling CloudEvents
+type scalingEventData struct {
+	Policy   policyReference          `json:"policy"`
+	Target   kubeaiv1alpha1.TargetRef `json:"target"`
+	DryRun   bool                     `json:"dryRun,omitempty"`
+	Decision *decisionSnapshot        `json:"decision"`
+	Error    string                   `json:"error,omitempty"`
+	// Metadata is the policy's spec.eventMetadata
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// policyReference identifies the policy a scaling event belongs to
+type policyReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// cloudEventSource returns the CloudEvents source of a policy's events
+func cloudEventSource(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) string {
+	return fmt.Sprintf("/apis/%s/namespaces/%s/aiinferenceautoscalerpolicies/%s",
+		kubeaiv1alpha1.GroupVersion.String(), policy.Namespace, policy.Name)
+}
+
+// publishScalingEvent publishes a scaling CloudEvent of eventType for the
+// decision, with the error that kept it from being applied, if any
+func (r *AIInferenceAutoscalerPolicyReconciler) publishScalingEvent(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	eventType string,
+	snapshot *decisionSnapshot,
+	scaleErr error,
+) {
+	if r.CloudEvents == nil {
+		return
+	}
+	data := scalingEventData{
+		Policy:   policyReference{Namespace: policy.Namespace, Name: policy.Name},
+		Target:   policy.Spec.TargetRef,
+		DryRun:   policy.Spec.DryRun,
+		Decision: snapshot,
+		Metadata: policy.Spec.EventMetadata,
+	}
+	if scaleErr != nil {
+		data.Error = scaleErr.Error()
+	}
+	subject := policy.Spec.TargetRef.Kind + "/" + policy.Spec.TargetRef.Name
+	event, err := cloudevents.NewEvent(eventType, cloudEventSource(policy), subject, data, r.now())
+	if err != nil {
+		// Such as a NaN confidence from an external algorithm
+		log.FromContext(ctx).Error(err, "Failed to encode scaling CloudEvent", "type", eventType)
+		return
+	}
+	r.CloudEvents.Publish(event)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/cloudevents"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// recordingPublisher keeps the published events
+type recordingPublisher struct {
+	events []cloudevents.Event
+}
+
+func (p *recordingPublisher) Publish(event cloudevents.Event) {
+	p.events = append(p.events, event)
+}
+
+// types returns the types of the published events
+func (p *recordingPublisher) types() []string {
+	var types []string
+	for _, event := range p.events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func TestReconcilePublishesCloudEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		dryRun    bool
+		scaleErr  error
+		wantTypes []string
+		wantError string
+	}{
+		{name: "applied", wantTypes: []string{cloudevents.TypeScalingDecision, cloudevents.TypeScalingApplied}},
+		{name: "failed", scaleErr: errors.New("admission webhook denied the request"),
+			wantTypes: []string{cloudevents.TypeScalingDecision, cloudevents.TypeScalingFailed},
+			wantError: "admission webhook denied the request"},
+		{name: "dry run", dryRun: true, wantTypes: []string{cloudevents.TypeScalingDecision}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := newTestScheme(t)
+			policy := lockTestPolicy("events")
+			policy.Spec.DryRun = tt.dryRun
+			policy.Spec.EventMetadata = map[string]string{"team": "search", "cost-center": "cc-42"}
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+				WithStatusSubresource(policy).WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if tt.scaleErr != nil {
+						return tt.scaleErr
+					}
+					return c.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+			}).Build()
+			r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+			publisher := &recordingPublisher{}
+			r.CloudEvents = publisher
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "events", Namespace: "default"}})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTypes, publisher.types())
+
+			last := publisher.events[len(publisher.events)-1]
+			assert.Equal(t, "/apis/kubeai.io/v1alpha1/namespaces/default/aiinferenceautoscalerpolicies/events", last.Source)
+			assert.Equal(t, "Deployment/llm", last.Subject)
+			var data scalingEventData
+			require.NoError(t, json.Unmarshal(last.Data, &data))
+			assert.Equal(t, policyReference{Namespace: "default", Name: "events"}, data.Policy)
+			assert.Equal(t, "llm", data.Target.Name)
+			assert.Equal(t, tt.dryRun, data.DryRun)
+			assert.Equal(t, tt.wantError, data.Error)
+			assert.Equal(t, map[string]string{"team": "search", "cost-center": "cc-42"}, data.Metadata)
+			require.NotNil(t, data.Decision)
+			assert.Equal(t, int32(2), data.Decision.CurrentReplicas)
+			assert.Equal(t, int32(4), data.Decision.DesiredReplicas)
+			assert.Equal(t, scaling.MetricGPUUtilization, data.Decision.DrivingMetric)
+		})
+	}
+}
+
+func TestReconcileWithoutScalingPublishesNoCloudEvents(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("steady")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 50}, scaling.DefaultRegistry, nil)
+	publisher := &recordingPublisher{}
+	r.CloudEvents = publisher
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "steady", Namespace: "default"}})
+	require.NoError(t, err)
+	assert.Empty(t, publisher.events)
+}
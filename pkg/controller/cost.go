@@ -0,0 +1,116 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// DefaultCostWindow is the period the realized cost is averaged over
+	DefaultCostWindow = time.Hour
+	// DefaultCostRefreshInterval is how long a policy's realized cost is
+	// reused before OpenCost is queried again. Allocation data is only
+	// updated every few minutes, so querying on every reconcile adds load
+	// without fresher numbers.
+	DefaultCostRefreshInterval = 5 * time.Minute
+)
+
+// cachedCost is the realized cost of a policy's target and when it was read
+type cachedCost struct {
+	cost      metrics.WorkloadCost
+	fetchedAt time.Time
+}
+
+// workloadCost returns the realized cost of the policy's target, querying
+// the cost client at most once per refresh interval
+func (r *AIInferenceAutoscalerPolicyReconciler) workloadCost(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (metrics.WorkloadCost, error) {
+	key := policy.Namespace + "/" + policy.Name
+	now := r.now()
+	refresh := r.CostRefreshInterval
+	if refresh <= 0 {
+		refresh = DefaultCostRefreshInterval
+	}
+
+	r.costMu.Lock()
+	cached, ok := r.costs[key]
+	r.costMu.Unlock()
+	if ok && now.Sub(cached.fetchedAt) < refresh {
+		return cached.cost, nil
+	}
+
+	window := r.CostWindow
+	if window <= 0 {
+		window = DefaultCostWindow
+	}
+	cost, err := r.CostClient.WorkloadCost(ctx, policy.Namespace, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, window)
+	if err != nil {
+		return metrics.WorkloadCost{}, err
+	}
+
+	r.costMu.Lock()
+	defer r.costMu.Unlock()
+	if r.costs == nil {
+		r.costs = make(map[string]cachedCost)
+	}
+	r.costs[key] = cachedCost{cost: cost, fetchedAt: now}
+	return cost, nil
+}
+
+// applyRealizedCost adds the realized cost of the policy's target to the
+// current metrics, so it is reported in status and exported, and passed to
+// algorithms in place of static per-replica estimates. The cost of 1000
+// requests needs the arrival rate, so it is only reported when queueing
+// metrics are enabled. Cost is informational: a failed query leaves the
+// metrics unchanged.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyRealizedCost(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas int32,
+	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
+) {
+	if r.CostClient == nil {
+		return
+	}
+	cost, err := r.workloadCost(ctx, policy)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to fetch realized cost from OpenCost")
+		return
+	}
+
+	perReplicaHour := cost.CostPerReplicaHour()
+	currentMetrics.CostPerReplicaHour = perReplicaHour
+	if currentMetrics.ArrivalRate != nil && *currentMetrics.ArrivalRate > 0 && currentReplicas > 0 {
+		// The hourly cost of the current replicas over the requests they serve in an hour
+		requestsPerHour := *currentMetrics.ArrivalRate * time.Hour.Seconds()
+		currentMetrics.CostPer1kRequests = perReplicaHour * float64(currentReplicas) / requestsPerHour * 1000
+	}
+	metrics.RecordCost(policy.Namespace, policy.Name, currentMetrics.CostPerReplicaHour, currentMetrics.CostPer1kRequests)
+}
+
+// forgetCost drops the cached realized cost of a deleted policy
+func (r *AIInferenceAutoscalerPolicyReconciler) forgetCost(key string) {
+	r.costMu.Lock()
+	defer r.costMu.Unlock()
+	delete(r.costs, key)
+}
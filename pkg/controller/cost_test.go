@@ -0,0 +1,89 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// fakeCostClient returns cost and err, counting the queries it answers
+type fakeCostClient struct {
+	cost    metrics.WorkloadCost
+	err     error
+	queries int
+}
+
+func (c *fakeCostClient) WorkloadCost(_ context.Context, _, _, _ string, _ time.Duration) (metrics.WorkloadCost, error) {
+	c.queries++
+	return c.cost, c.err
+}
+
+func TestApplyRealizedCost(t *testing.T) {
+	scheme := newTestScheme(t)
+	r := NewReconciler(nil, scheme, nil, scaling.DefaultRegistry, nil)
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+	r.Clock = fakeClock
+	costs := &fakeCostClient{cost: metrics.WorkloadCost{TotalCost: 12, ReplicaHours: 4}}
+	r.CostClient = costs
+	policy := lockTestPolicy("policy")
+	ctx := context.Background()
+
+	// 4 replicas at 3 per hour serve 2 requests per second, 7200 per hour
+	current := &kubeaiv1alpha1.CurrentMetrics{ArrivalRate: ptr.To(2.0)}
+	r.applyRealizedCost(ctx, policy, 4, current)
+	assert.InDelta(t, 3, current.CostPerReplicaHour, 1e-9)
+	assert.InDelta(t, 12.0/7.2, current.CostPer1kRequests, 1e-9)
+
+	// Algorithms see the realized cost
+	named := namedMetrics(policy, current)
+	assert.InDelta(t, 3, named[scaling.MetricCostPerReplicaHour], 1e-9)
+
+	// The cost is reused within the refresh interval
+	r.applyRealizedCost(ctx, policy, 4, &kubeaiv1alpha1.CurrentMetrics{})
+	assert.Equal(t, 1, costs.queries)
+	fakeClock.SetTime(fakeClock.Now().Add(DefaultCostRefreshInterval))
+	r.applyRealizedCost(ctx, policy, 4, &kubeaiv1alpha1.CurrentMetrics{})
+	assert.Equal(t, 2, costs.queries)
+
+	// Without an arrival rate only the cost per replica is reported
+	current = &kubeaiv1alpha1.CurrentMetrics{}
+	r.applyRealizedCost(ctx, policy, 4, current)
+	assert.InDelta(t, 3, current.CostPerReplicaHour, 1e-9)
+	assert.Zero(t, current.CostPer1kRequests)
+}
+
+func TestApplyRealizedCostError(t *testing.T) {
+	scheme := newTestScheme(t)
+	r := NewReconciler(nil, scheme, nil, scaling.DefaultRegistry, nil)
+	r.CostClient = &fakeCostClient{err: errors.New("connection refused")}
+
+	current := &kubeaiv1alpha1.CurrentMetrics{ArrivalRate: ptr.To(2.0)}
+	r.applyRealizedCost(context.Background(), lockTestPolicy("policy"), 4, current)
+	assert.Zero(t, current.CostPerReplicaHour)
+	assert.Zero(t, current.CostPer1kRequests)
+}
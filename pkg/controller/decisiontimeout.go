@@ -0,0 +1,87 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// ConditionTypeDecisionTimeout indicates the last reconcile exceeded its decision budget
+	ConditionTypeDecisionTimeout = "DecisionTimeout"
+	// DefaultDecisionTimeout bounds reading the target, fetching metrics, computing
+	// and writing one scaling decision
+	DefaultDecisionTimeout = 5 * time.Second
+)
+
+// Stages of a decision reported when its budget runs out
+const (
+	DecisionStageTarget    = "target"
+	DecisionStageMetrics   = "metrics"
+	DecisionStageAlgorithm = "algorithm"
+	DecisionStageScale     = "scale"
+)
+
+// decisionContext bounds ctx by the decision budget so one slow dependency
+// cannot hold the reconcile, and the worker serving it, for minutes
+func (r *AIInferenceAutoscalerPolicyReconciler) decisionContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.DecisionTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, r.DecisionTimeout)
+}
+
+// decisionTimedOut reports whether the decision budget ran out by the end of
+// stage. If it did, the DecisionTimeout condition and metric are recorded and
+// the caller abandons the decision. Conditions are written with ctx, which the
+// budget does not bound.
+func (r *AIInferenceAutoscalerPolicyReconciler) decisionTimedOut(
+	ctx, decisionCtx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	stage string,
+) bool {
+	if decisionCtx.Err() == nil {
+		return false
+	}
+	log.FromContext(ctx).Info("Decision budget exceeded, abandoning this reconcile",
+		"budget", r.DecisionTimeout,
+		"stage", stage)
+	metrics.RecordDecisionTimeout(policy.Namespace, policy.Name, stage)
+	if !r.hasCondition(policy, ConditionTypeDecisionTimeout, metav1.ConditionTrue, ReasonDecisionTimeout) && r.EventRecorder != nil {
+		r.EventRecorder.RecordDecisionTimeout(policy, stage, r.DecisionTimeout)
+	}
+	r.updateCondition(ctx, policy, ConditionTypeDecisionTimeout, metav1.ConditionTrue, ReasonDecisionTimeout,
+		fmt.Sprintf("Decision exceeded its %s budget at the %s stage; replicas were left unchanged", r.DecisionTimeout, stage))
+	return true
+}
+
+// clearDecisionTimeout lifts the DecisionTimeout condition once a decision
+// completes within its budget
+func (r *AIInferenceAutoscalerPolicyReconciler) clearDecisionTimeout(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) {
+	if r.isConditionTrue(policy, ConditionTypeDecisionTimeout) {
+		r.updateCondition(ctx, policy, ConditionTypeDecisionTimeout, metav1.ConditionFalse,
+			"WithinBudget", fmt.Sprintf("Decision completed within its %s budget", r.DecisionTimeout))
+	}
+}
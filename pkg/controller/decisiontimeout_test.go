@@ -0,0 +1,108 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// hangingMetricsClient blocks GPU utilization queries until the caller gives up
+type hangingMetricsClient struct {
+	*metrics.MockClient
+}
+
+func (c hangingMetricsClient) GetMetric(ctx context.Context, query metrics.MetricQuery) (metrics.Sample, error) {
+	if query.Metric != metrics.MetricGPUUtilization {
+		return c.MockClient.GetMetric(ctx, query)
+	}
+	<-ctx.Done()
+	return metrics.Sample{}, ctx.Err()
+}
+
+func TestDecisionTimeout(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("slow-metrics")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, hangingMetricsClient{&metrics.MockClient{}}, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	r.DecisionTimeout = 50 * time.Millisecond
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "slow-metrics", Namespace: "default"}}
+
+	stored := func() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		updated := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, updated))
+		return updated
+	}
+	timeouts := func() float64 {
+		return testutil.ToFloat64(metrics.DecisionTimeouts.WithLabelValues("default", "slow-metrics", DecisionStageMetrics))
+	}
+
+	// The hung query is abandoned at the budget instead of holding the worker
+	start := time.Now()
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, pollingInterval(policy), result.RequeueAfter)
+	assert.Equal(t, 1.0, timeouts())
+	assert.True(t, r.hasCondition(stored(), ConditionTypeDecisionTimeout, metav1.ConditionTrue, ReasonDecisionTimeout))
+	select {
+	case event := <-fakeRecorder.Events:
+		assert.Contains(t, event, ReasonDecisionTimeout)
+	default:
+		t.Fatal("Expected an event to be recorded")
+	}
+
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(2), *updated.Spec.Replicas)
+
+	// Still timing out: no duplicate event
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, timeouts())
+	assert.Empty(t, fakeRecorder.Events)
+
+	// A decision within the budget clears the condition
+	r.MetricsClient = &metrics.MockClient{GPUUtilizationValue: 100}
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, r.hasCondition(stored(), ConditionTypeDecisionTimeout, metav1.ConditionFalse, "WithinBudget"))
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(4), *updated.Spec.Replicas)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeNodeDrain indicates target pods run on cordoned nodes or are being evicted
+	ConditionTypeNodeDrain = "NodeDrain"
+)
+
+// drainingPods returns the number of target pods on cordoned nodes or being
+// evicted, together with the names of the nodes they run on
+func (r *AIInferenceAutoscalerPolicyReconciler) drainingPods(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int, []string, error) {
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return 0, nil, err
+	}
+	if labelSelector == nil {
+		return 0, nil, nil
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(policy.Namespace), client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return 0, nil, err
+	}
+
+	count := 0
+	cordoned := map[string]bool{}
+	drainingNodes := map[string]bool{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		draining := evicted(pod)
+		if nodeName := pod.Spec.NodeName; nodeName != "" {
+			unschedulable, checked := cordoned[nodeName]
+			if !checked {
+				node := &corev1.Node{}
+				if err := r.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil && !errors.IsNotFound(err) {
+					return 0, nil, err
+				}
+				unschedulable = node.Spec.Unschedulable
+				cordoned[nodeName] = unschedulable
+			}
+			draining = draining || unschedulable
+			if draining {
+				drainingNodes[nodeName] = true
+			}
+		}
+		if draining {
+			count++
+		}
+	}
+
+	nodes := make([]string, 0, len(drainingNodes))
+	for name := range drainingNodes {
+		nodes = append(nodes, name)
+	}
+	sort.Strings(nodes)
+	return count, nodes, nil
+}
+
+// evicted reports whether the pod is being evicted, as marked by its
+// DisruptionTarget condition
+func evicted(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.DisruptionTarget && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// applyDrainGuard holds scale-down while target pods run on cordoned nodes or
+// are being evicted, and with compensateScaleUp adds a replica for each of
+// them. The compensation is added to the recommendation rather than to the
+// current replicas, so it does not grow on every reconcile of a long drain.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyDrainGuard(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, desiredReplicas int32,
+) int32 {
+	logger := log.FromContext(ctx)
+
+	guard := policy.Spec.DrainGuard
+	draining, nodes := 0, []string(nil)
+	if guard != nil && guard.Enabled {
+		var err error
+		draining, nodes, err = r.drainingPods(ctx, policy)
+		if err != nil {
+			logger.Error(err, "Failed to check target pods for node drains")
+			return desiredReplicas
+		}
+	}
+
+	if draining == 0 {
+		if r.isConditionTrue(policy, ConditionTypeNodeDrain) {
+			r.updateCondition(ctx, policy, ConditionTypeNodeDrain, metav1.ConditionFalse,
+				"NoDrain", "No target pods are on cordoned nodes or being evicted")
+		}
+		return desiredReplicas
+	}
+
+	bounded := max(desiredReplicas, currentReplicas)
+	if guard.CompensateScaleUp {
+		// #nosec G115 - pod counts won't exceed int32 max in practice
+		bounded = max(bounded, min(desiredReplicas+int32(draining), policy.Spec.MaxReplicas))
+	}
+
+	message := fmt.Sprintf("%d target pod(s) on cordoned or draining nodes %s; holding scale-down",
+		draining, strings.Join(nodes, ", "))
+	if guard.CompensateScaleUp {
+		message += fmt.Sprintf(" and compensating with up to %d replica(s)", draining)
+	}
+	if !r.isConditionTrue(policy, ConditionTypeNodeDrain) && r.EventRecorder != nil {
+		r.EventRecorder.RecordNodeDrain(policy, draining, nodes)
+	}
+	r.updateCondition(ctx, policy, ConditionTypeNodeDrain, metav1.ConditionTrue, "NodeDrain", message)
+
+	if bounded != desiredReplicas {
+		logger.Info("Adjusting replicas while target pods are drained",
+			"current", currentReplicas,
+			"desired", desiredReplicas,
+			"bounded", bounded,
+			"draining", draining)
+	}
+	return bounded
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func runningPod(name, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "llm"}},
+		Spec:       corev1.PodSpec{NodeName: node},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func testNode(name string, cordoned bool) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{Unschedulable: cordoned},
+	}
+}
+
+func newDrainGuardTest(t *testing.T, guard *kubeaiv1alpha1.DrainGuardSpec, objects ...client.Object) (
+	*AIInferenceAutoscalerPolicyReconciler, *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, client.Client, *record.FakeRecorder) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("drain")
+	policy.Spec.DrainGuard = guard
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(3),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(append(objects, policy, deployment)...).
+		WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	return r, policy, c, fakeRecorder
+}
+
+func TestApplyDrainGuard(t *testing.T) {
+	r, policy, c, fakeRecorder := newDrainGuardTest(t, &kubeaiv1alpha1.DrainGuardSpec{Enabled: true},
+		testNode("node-a", true), testNode("node-b", false),
+		runningPod("llm-a", "node-a"), runningPod("llm-b", "node-a"), runningPod("llm-c", "node-b"))
+	ctx := context.Background()
+
+	// Scale-down is held while pods are on a cordoned node
+	assert.Equal(t, int32(3), r.applyDrainGuard(ctx, policy, 3, 1))
+	require.True(t, r.hasCondition(policy, ConditionTypeNodeDrain, metav1.ConditionTrue, "NodeDrain"))
+	event := <-fakeRecorder.Events
+	assert.Contains(t, event, ReasonNodeDrain)
+	assert.Contains(t, event, "2 pod(s) of Deployment/llm are on cordoned or draining nodes node-a")
+
+	// Scale-up still applies, and the event is not repeated
+	assert.Equal(t, int32(5), r.applyDrainGuard(ctx, policy, 3, 5))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// Once the node is uncordoned the condition clears
+	require.NoError(t, c.Update(ctx, testNode("node-a", false)))
+	assert.Equal(t, int32(1), r.applyDrainGuard(ctx, policy, 3, 1))
+	assert.False(t, r.isConditionTrue(policy, ConditionTypeNodeDrain))
+}
+
+func TestApplyDrainGuardCompensates(t *testing.T) {
+	evicting := runningPod("llm-b", "node-b")
+	evicting.Status.Conditions = []corev1.PodCondition{{
+		Type:   corev1.DisruptionTarget,
+		Status: corev1.ConditionTrue,
+		Reason: "EvictionByEvictionAPI",
+	}}
+	r, policy, _, _ := newDrainGuardTest(t, &kubeaiv1alpha1.DrainGuardSpec{Enabled: true, CompensateScaleUp: true},
+		testNode("node-a", true), testNode("node-b", false),
+		runningPod("llm-a", "node-a"), evicting, runningPod("llm-c", "node-b"))
+	ctx := context.Background()
+
+	// A replica is added for the pod on the cordoned node and the evicted one
+	assert.Equal(t, int32(5), r.applyDrainGuard(ctx, policy, 3, 3))
+	// The compensation is relative to the recommendation, not the current replicas
+	assert.Equal(t, int32(5), r.applyDrainGuard(ctx, policy, 5, 3))
+	// and capped at maxReplicas
+	assert.Equal(t, int32(10), r.applyDrainGuard(ctx, policy, 5, 9))
+}
+
+func TestApplyDrainGuardDisabled(t *testing.T) {
+	r, policy, _, fakeRecorder := newDrainGuardTest(t, nil,
+		testNode("node-a", true), runningPod("llm-a", "node-a"))
+
+	assert.Equal(t, int32(1), r.applyDrainGuard(context.Background(), policy, 3, 1))
+	assert.Empty(t, policy.Status.Conditions)
+	assert.Empty(t, fakeRecorder.Events)
+}
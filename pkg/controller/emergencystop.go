@@ -0,0 +1,242 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=delete
+
+const (
+	// DefaultEmergencyStopConfigMap is the ConfigMap in the controller namespace
+	// whose existence stops every policy
+	DefaultEmergencyStopConfigMap = "kubeai-autoscaler-emergency-stop"
+
+	// ConditionTypeEmergencyStopped indicates an emergency stop holds the policy's target
+	ConditionTypeEmergencyStopped = "EmergencyStopped"
+
+	// Keys of the emergency stop ConfigMap
+	emergencyStopReasonKey = "reason"
+	emergencyStopUserKey   = "user"
+	emergencyStopSinceKey  = "since"
+)
+
+// EmergencyStopState is whether an emergency stop is in effect, and who
+// started it and why
+type EmergencyStopState struct {
+	Stopped bool       `json:"stopped"`
+	Reason  string     `json:"reason,omitempty"`
+	User    string     `json:"user,omitempty"`
+	Since   *time.Time `json:"since,omitempty"`
+}
+
+// EmergencyStop halts target changes of every policy while its ConfigMap
+// exists, for when the autoscaler itself misbehaves. Metrics are still fetched
+// and recommendations reported. The ConfigMap is read on every reconcile, so a
+// stop is honored by every controller replica from its next decision on and
+// survives restarts; it can also be created with kubectl when the controller
+// cannot be reached.
+type EmergencyStop struct {
+	Client    client.Client
+	ConfigMap types.NamespacedName
+	// Authenticator checks the bearer token of requests to the Handler
+	Authenticator TokenAuthenticator
+	Clock         clock.PassiveClock
+
+	mu   sync.Mutex
+	last EmergencyStopState
+}
+
+// NewEmergencyStop creates an EmergencyStop kept in configMap. Client and
+// Authenticator are set before it is used, such as once the manager exists.
+func NewEmergencyStop(configMap types.NamespacedName) *EmergencyStop {
+	return &EmergencyStop{ConfigMap: configMap, Clock: clock.RealClock{}}
+}
+
+// State reads whether a stop is in effect. When the ConfigMap cannot be read,
+// the last state read is returned with the error, so a stop is not lifted by
+// an API server hiccup.
+func (e *EmergencyStop) State(ctx context.Context) (EmergencyStopState, error) {
+	cm := &corev1.ConfigMap{}
+	err := e.Client.Get(ctx, e.ConfigMap, cm)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch {
+	case apierrors.IsNotFound(err):
+		e.last = EmergencyStopState{}
+	case err != nil:
+		return e.last, fmt.Errorf("failed to read emergency stop %s: %w", e.ConfigMap, err)
+	default:
+		e.last = emergencyStopStateFrom(cm)
+	}
+	setEmergencyStopMetric(e.last)
+	return e.last, nil
+}
+
+// Stop starts an emergency stop on behalf of user. A stop already in effect
+// is kept with its original reason.
+func (e *EmergencyStop) Stop(ctx context.Context, user, reason string) (EmergencyStopState, error) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: e.ConfigMap.Namespace, Name: e.ConfigMap.Name},
+		Data: map[string]string{
+			emergencyStopReasonKey: reason,
+			emergencyStopUserKey:   user,
+			emergencyStopSinceKey:  e.Clock.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	if err := e.Client.Create(ctx, cm); err != nil && !apierrors.IsAlreadyExists(err) {
+		return EmergencyStopState{}, fmt.Errorf("failed to create emergency stop %s: %w", e.ConfigMap, err)
+	}
+	return e.State(ctx)
+}
+
+// Resume lifts the emergency stop
+func (e *EmergencyStop) Resume(ctx context.Context) error {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: e.ConfigMap.Namespace, Name: e.ConfigMap.Name}}
+	if err := e.Client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete emergency stop %s: %w", e.ConfigMap, err)
+	}
+	_, err := e.State(ctx)
+	return err
+}
+
+// emergencyStopStateFrom returns the stop recorded in cm; a ConfigMap created
+// by hand may leave every key out
+func emergencyStopStateFrom(cm *corev1.ConfigMap) EmergencyStopState {
+	state := EmergencyStopState{
+		Stopped: true,
+		Reason:  cm.Data[emergencyStopReasonKey],
+		User:    cm.Data[emergencyStopUserKey],
+	}
+	since := cm.CreationTimestamp.Time
+	if parsed, err := time.Parse(time.RFC3339, cm.Data[emergencyStopSinceKey]); err == nil {
+		since = parsed
+	}
+	if !since.IsZero() {
+		state.Since = &since
+	}
+	return state
+}
+
+// setEmergencyStopMetric exports whether a stop is in effect
+func setEmergencyStopMetric(state EmergencyStopState) {
+	if state.Stopped {
+		metrics.EmergencyStopActive.Set(1)
+	} else {
+		metrics.EmergencyStopActive.Set(0)
+	}
+}
+
+// Handler serves the emergency stop. Every request needs a bearer token the
+// Authenticator accepts. GET returns the state; POST with an optional reason
+// stops every policy; DELETE resumes them.
+func (e *EmergencyStop) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete:
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		user, ok := authenticateRequest(w, r, e.Authenticator)
+		if !ok {
+			return
+		}
+
+		logger := log.FromContext(r.Context())
+		var state EmergencyStopState
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			state, err = e.State(r.Context())
+		case http.MethodPost, http.MethodPut:
+			reason := r.FormValue("reason")
+			state, err = e.Stop(r.Context(), user, reason)
+			if err == nil {
+				logger.Info("Emergency stop started", "user", user, "reason", reason)
+			}
+		case http.MethodDelete:
+			err = e.Resume(r.Context())
+			if err == nil {
+				logger.Info("Emergency stop lifted", "user", user)
+			}
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, state)
+	})
+}
+
+// emergencyStopped returns the emergency stop in effect; none without an EmergencyStop
+func (r *AIInferenceAutoscalerPolicyReconciler) emergencyStopped(ctx context.Context) EmergencyStopState {
+	if r.EmergencyStop == nil {
+		return EmergencyStopState{}
+	}
+	state, err := r.EmergencyStop.State(ctx)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read the emergency stop, keeping the last state", "stopped", state.Stopped)
+	}
+	return state
+}
+
+// applyEmergencyStop holds replicas at the current count while an emergency
+// stop is in effect. The recommendation is still computed and reported.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyEmergencyStop(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	stop EmergencyStopState,
+	currentReplicas, recommendedReplicas int32,
+) int32 {
+	if !stop.Stopped {
+		if r.isConditionTrue(policy, ConditionTypeEmergencyStopped) {
+			r.updateCondition(ctx, policy, ConditionTypeEmergencyStopped, metav1.ConditionFalse,
+				"EmergencyStopLifted", "The emergency stop was lifted")
+		}
+		return recommendedReplicas
+	}
+
+	log.FromContext(ctx).Info("Emergency stop in effect, holding replicas",
+		"current", currentReplicas,
+		"recommended", recommendedReplicas)
+	message := fmt.Sprintf("Emergency stop in effect; recommending %d replicas, holding at %d", recommendedReplicas, currentReplicas)
+	if stop.User != "" {
+		message += "; stopped by " + stop.User
+	}
+	if stop.Reason != "" {
+		message += ": " + stop.Reason
+	}
+	r.updateCondition(ctx, policy, ConditionTypeEmergencyStopped, metav1.ConditionTrue, "EmergencyStop", message)
+	return currentReplicas
+}
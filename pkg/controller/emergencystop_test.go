@@ -0,0 +1,196 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// tokenAuthenticator accepts the token "admin" as user alice
+type tokenAuthenticator struct{}
+
+func (tokenAuthenticator) Authenticate(_ context.Context, token string) (string, error) {
+	if token != "admin" {
+		return "", errors.New("not allowed to update aiinferenceautoscalerpolicies")
+	}
+	return "alice", nil
+}
+
+func TestEmergencyStop(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	stop := NewEmergencyStop(types.NamespacedName{Namespace: "kubeai-system", Name: DefaultEmergencyStopConfigMap})
+	stop.Client = c
+	stop.Authenticator = tokenAuthenticator{}
+	stop.Clock = clocktesting.NewFakePassiveClock(time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	r.EmergencyStop = stop
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+	stored := func() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		updated := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, updated))
+		return updated
+	}
+	serve := func(method, target, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		stop.Handler().ServeHTTP(w, request)
+		return w
+	}
+
+	// Requests need a token the authenticator accepts
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, "/admin/emergency-stop", "").Code)
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/admin/emergency-stop", "viewer").Code)
+
+	w := serve(http.MethodPost, "/admin/emergency-stop?reason=runaway+scale-up", "admin")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.JSONEq(t, `{"stopped": true, "reason": "runaway scale-up", "user": "alice", "since": "2026-10-16T09:00:00Z"}`, w.Body.String())
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, stop.ConfigMap, cm))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.EmergencyStopActive))
+
+	// The target is left alone but the recommendation is still reported
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), replicas())
+	assert.Equal(t, int32(4), stored().Status.RecommendedReplicas)
+	assert.True(t, r.hasCondition(stored(), ConditionTypeEmergencyStopped, metav1.ConditionTrue, "EmergencyStop"))
+
+	// Lifting the stop resumes scaling
+	w = serve(http.MethodDelete, "/admin/emergency-stop", "admin")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.JSONEq(t, `{"stopped": false}`, w.Body.String())
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.EmergencyStopActive))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), replicas())
+	assert.True(t, r.hasCondition(stored(), ConditionTypeEmergencyStopped, metav1.ConditionFalse, "EmergencyStopLifted"))
+}
+
+func TestEmergencyStopCreatedByHand(t *testing.T) {
+	scheme := newTestScheme(t)
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "kubeai-system", Name: DefaultEmergencyStopConfigMap}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+	stop := NewEmergencyStop(types.NamespacedName{Namespace: "kubeai-system", Name: DefaultEmergencyStopConfigMap})
+	stop.Client = c
+
+	state, err := stop.State(context.Background())
+	require.NoError(t, err)
+	assert.True(t, state.Stopped)
+	assert.Empty(t, state.Reason)
+
+	// A second stop keeps the first one's record
+	state, err = stop.Stop(context.Background(), "bob", "again")
+	require.NoError(t, err)
+	assert.True(t, state.Stopped)
+	assert.Empty(t, state.User)
+}
+
+func TestEmergencyStopHoldsFallback(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.Fallback = &kubeaiv1alpha1.FallbackSpec{Behavior: FallbackScaleToMax, FailureThreshold: 1}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "kubeai-system", Name: DefaultEmergencyStopConfigMap}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, cm).
+		WithStatusSubresource(policy).Build()
+	stop := NewEmergencyStop(types.NamespacedName{Namespace: "kubeai-system", Name: DefaultEmergencyStopConfigMap})
+	stop.Client = c
+	r := NewReconciler(c, scheme, &metrics.MockClient{Error: errors.New("prometheus unavailable")}, scaling.DefaultRegistry, nil)
+	r.EmergencyStop = stop
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+
+	// The fallback is reported but the stopped target is not scaled to max
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(2), *updated.Spec.Replicas)
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+	assert.True(t, r.hasCondition(stored, ConditionTypeFallbackActive, metav1.ConditionTrue, "MetricsUnavailable"))
+	assert.True(t, r.hasCondition(stored, ConditionTypeEmergencyStopped, metav1.ConditionTrue, "EmergencyStop"))
+}
+
+func TestEmergencyStopHoldsActivator(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.MinReplicas = int32Ptr(0)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(0)},
+	}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "kubeai-system", Name: DefaultEmergencyStopConfigMap}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, cm).Build()
+	stop := NewEmergencyStop(types.NamespacedName{Namespace: "kubeai-system", Name: DefaultEmergencyStopConfigMap})
+	stop.Client = c
+	ctx := context.Background()
+	backend := NewActivatorBackend(c, scheme, types.NamespacedName{Name: "policy", Namespace: "default"}, "llm", stop)
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+
+	// A stopped target stays at zero
+	assert.Error(t, backend.Activate(ctx))
+	assert.Equal(t, int32(0), replicas())
+
+	require.NoError(t, stop.Resume(ctx))
+	require.NoError(t, backend.Activate(ctx))
+	assert.Equal(t, int32(1), replicas())
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// DefaultEventBurst is how many events with the same reason an object may emit per window
+	DefaultEventBurst = 5
+	// DefaultEventWindow is the window over which DefaultEventBurst applies
+	DefaultEventWindow = 5 * time.Minute
+)
+
+// AggregatingRecorder is a record.EventRecorder that limits each object to
+// burst events per reason within a window, so a failure repeated on every
+// reconcile, such as MetricsFetchFailed, cannot flood the event stream.
+// Suppressed events are counted and the count is appended to the next event
+// emitted for the same object and reason.
+type AggregatingRecorder struct {
+	delegate record.EventRecorder
+	burst    int
+	window   time.Duration
+	clock    clock.PassiveClock
+
+	mu         sync.Mutex
+	buckets    map[eventKey]*eventBucket
+	lastPruned time.Time
+}
+
+var _ record.EventRecorder = &AggregatingRecorder{}
+
+// eventKey identifies a class of events: one reason on one object
+type eventKey struct {
+	object string
+	reason string
+}
+
+// eventBucket counts the events of a class in the current window
+type eventBucket struct {
+	start      time.Time
+	emitted    int
+	suppressed int
+}
+
+// NewAggregatingRecorder wraps delegate so each object emits at most burst
+// events per reason within window. A burst of 0 or less disables aggregation.
+func NewAggregatingRecorder(delegate record.EventRecorder, burst int, window time.Duration) *AggregatingRecorder {
+	return &AggregatingRecorder{
+		delegate: delegate,
+		burst:    burst,
+		window:   window,
+		clock:    clock.RealClock{},
+		buckets:  make(map[eventKey]*eventBucket),
+	}
+}
+
+// Event records an event unless its class has used up the window's burst
+func (a *AggregatingRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if message, ok := a.admit(object, reason, message); ok {
+		a.delegate.Event(object, eventtype, reason, message)
+	}
+}
+
+// Eventf is like Event, with Sprintf for the message
+func (a *AggregatingRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	a.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf is like Eventf, with annotations attached
+func (a *AggregatingRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	if message, ok := a.admit(object, reason, fmt.Sprintf(messageFmt, args...)); ok {
+		a.delegate.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+// admit reports whether an event may be emitted, and returns its message with
+// the count of events suppressed since the last one emitted
+func (a *AggregatingRecorder) admit(object runtime.Object, reason, message string) (string, bool) {
+	if a.burst <= 0 {
+		return message, true
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.clock.Now()
+	a.prune(now)
+
+	key := eventKey{object: eventObjectKey(object), reason: reason}
+	bucket, ok := a.buckets[key]
+	if !ok {
+		bucket = &eventBucket{start: now}
+		a.buckets[key] = bucket
+	}
+	if now.Sub(bucket.start) >= a.window {
+		bucket.start = now
+		bucket.emitted = 0
+	}
+	if bucket.emitted >= a.burst {
+		bucket.suppressed++
+		return "", false
+	}
+
+	bucket.emitted++
+	if bucket.suppressed > 0 {
+		message = fmt.Sprintf("%s (%d similar events suppressed)", message, bucket.suppressed)
+		bucket.suppressed = 0
+	}
+	return message, true
+}
+
+// prune drops classes that have been quiet for two windows, at most once per window
+func (a *AggregatingRecorder) prune(now time.Time) {
+	if now.Sub(a.lastPruned) < a.window {
+		return
+	}
+	a.lastPruned = now
+	for key, bucket := range a.buckets {
+		if now.Sub(bucket.start) >= 2*a.window {
+			delete(a.buckets, key)
+		}
+	}
+}
+
+// eventObjectKey identifies the object an event is about
+func eventObjectKey(object runtime.Object) string {
+	if ref, ok := object.(*corev1.ObjectReference); ok {
+		return ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+	}
+	if accessor, err := meta.Accessor(object); err == nil {
+		return fmt.Sprintf("%T/%s/%s", object, accessor.GetNamespace(), accessor.GetName())
+	}
+	return fmt.Sprintf("%T", object)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestAggregatingRecorder(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(100)
+	clock := clocktesting.NewFakePassiveClock(time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC))
+	aggregator := NewAggregatingRecorder(fakeRecorder, 2, 5*time.Minute)
+	aggregator.clock = clock
+	recorder := NewEventRecorder(aggregator)
+
+	llm := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"}}
+	chat := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{ObjectMeta: metav1.ObjectMeta{Name: "chat", Namespace: "default"}}
+	fetchErr := errors.New("prometheus unreachable")
+
+	// Only the burst gets through for one policy and reason
+	for i := 0; i < 5; i++ {
+		recorder.RecordMetricsFailed(llm, fetchErr)
+	}
+	// Other policies and other reasons are counted separately
+	recorder.RecordMetricsFailed(chat, fetchErr)
+	recorder.RecordScalingFailed(llm, fetchErr)
+	assert.Equal(t, []string{
+		"Warning MetricsFetchFailed Failed to fetch metrics: prometheus unreachable",
+		"Warning MetricsFetchFailed Failed to fetch metrics: prometheus unreachable",
+		"Warning MetricsFetchFailed Failed to fetch metrics: prometheus unreachable",
+		"Warning ScalingFailed Failed to scale /: prometheus unreachable",
+	}, drainEvents(fakeRecorder))
+
+	// The next window reports how many events were suppressed
+	clock.SetTime(clock.Now().Add(5 * time.Minute))
+	recorder.RecordMetricsFailed(llm, fetchErr)
+	recorder.RecordMetricsFailed(llm, fetchErr)
+	assert.Equal(t, []string{
+		"Warning MetricsFetchFailed Failed to fetch metrics: prometheus unreachable (3 similar events suppressed)",
+		"Warning MetricsFetchFailed Failed to fetch metrics: prometheus unreachable",
+	}, drainEvents(fakeRecorder))
+}
+
+func TestAggregatingRecorderTargetEvents(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(100)
+	aggregator := NewAggregatingRecorder(fakeRecorder, 1, time.Minute)
+
+	target := &corev1.ObjectReference{Kind: "Deployment", Namespace: "default", Name: "llm"}
+	other := &corev1.ObjectReference{Kind: "Deployment", Namespace: "default", Name: "chat"}
+	aggregator.Eventf(target, corev1.EventTypeNormal, ReasonSuccessfulRescale, "New size: %d", 3)
+	aggregator.Eventf(target, corev1.EventTypeNormal, ReasonSuccessfulRescale, "New size: %d", 4)
+	aggregator.Eventf(other, corev1.EventTypeNormal, ReasonSuccessfulRescale, "New size: %d", 2)
+	assert.Equal(t, []string{
+		"Normal SuccessfulRescale New size: 3",
+		"Normal SuccessfulRescale New size: 2",
+	}, drainEvents(fakeRecorder))
+}
+
+func TestAggregatingRecorderDisabled(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(100)
+	aggregator := NewAggregatingRecorder(fakeRecorder, 0, time.Minute)
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"}}
+	for i := 0; i < 10; i++ {
+		aggregator.Event(policy, corev1.EventTypeWarning, ReasonMetricsFailed, "down")
+	}
+	assert.Len(t, drainEvents(fakeRecorder), 10)
+}
@@ -18,10 +18,15 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 
 	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
 )
 
 const (
@@ -29,6 +34,9 @@ const (
 	ReasonScaledUp = "ScaledUp"
 	// ReasonScaledDown indicates the target was scaled down.
 	ReasonScaledDown = "ScaledDown"
+	// ReasonSuccessfulRescale is recorded on the target when its replicas were changed,
+	// matching the reason used by the HorizontalPodAutoscaler.
+	ReasonSuccessfulRescale = "SuccessfulRescale"
 	// ReasonScalingFailed indicates scaling operation failed.
 	ReasonScalingFailed = "ScalingFailed"
 	// ReasonMetricsFailed indicates metrics fetch failed.
@@ -39,6 +47,54 @@ const (
 	ReasonCooldown = "CooldownActive"
 	// ReasonUnknownAlgorithm indicates the specified algorithm is not registered.
 	ReasonUnknownAlgorithm = "UnknownAlgorithm"
+	// ReasonAlgorithmNotAllowed indicates the specified algorithm is excluded by the controller or namespace.
+	ReasonAlgorithmNotAllowed = "AlgorithmNotAllowed"
+	// ReasonInvalidAlgorithmParameters indicates spec.algorithm.parameters were rejected by the algorithm.
+	ReasonInvalidAlgorithmParameters = "InvalidAlgorithmParameters"
+	// ReasonInsufficientMetrics indicates the algorithm lacked the metrics it needs.
+	ReasonInsufficientMetrics = "InsufficientMetrics"
+	// ReasonAlgorithmStateCorrupt indicates the algorithm's stored state was unusable and was reset.
+	ReasonAlgorithmStateCorrupt = "AlgorithmStateCorrupt"
+	// ReasonAlgorithmFailed indicates the algorithm returned an error that has no specific reason.
+	ReasonAlgorithmFailed = "AlgorithmFailed"
+	// ReasonAlgorithmTimeout indicates the algorithm exceeded its deadline.
+	ReasonAlgorithmTimeout = "AlgorithmTimeout"
+	// ReasonDecisionTimeout indicates a reconcile was aborted for exceeding the decision budget.
+	ReasonDecisionTimeout = "DecisionTimeout"
+	// ReasonSaturatedAtMax indicates the policy has been pinned at maxReplicas while over target.
+	ReasonSaturatedAtMax = "SaturatedAtMax"
+	// ReasonDryRunAccepted indicates a dry-run scale was accepted by the API server.
+	ReasonDryRunAccepted = "DryRunAccepted"
+	// ReasonDryRunRejected indicates a dry-run scale was rejected by the API server.
+	ReasonDryRunRejected = "DryRunRejected"
+	// ReasonSchedulingBlocked indicates replicas of the target cannot be scheduled.
+	ReasonSchedulingBlocked = "SchedulingBlocked"
+	// ReasonMetricsSourceChanged indicates a different metric source is now in use.
+	ReasonMetricsSourceChanged = "MetricsSourceChanged"
+	// ReasonScaleLockHeld indicates another writer holds the scale lock of the target.
+	ReasonScaleLockHeld = "ScaleLockHeld"
+	// ReasonEvictionProtected indicates target pods were protected from eviction during a scale-up surge.
+	ReasonEvictionProtected = "EvictionProtected"
+	// ReasonShardCountUnavailable indicates the cache shard count could not be read from its ConfigMap.
+	ReasonShardCountUnavailable = "ShardCountUnavailable"
+	// ReasonShardMapUpdated indicates the cache shard count was updated after the target rolled out.
+	ReasonShardMapUpdated = "ShardMapUpdated"
+	// ReasonEvictionProtectionRemoved indicates the surge ended and pod eviction protection was removed.
+	ReasonEvictionProtectionRemoved = "EvictionProtectionRemoved"
+	// ReasonBackpressureSignaled indicates a load shedding signal was published for the saturated target.
+	ReasonBackpressureSignaled = "BackpressureSignaled"
+	// ReasonBackpressureCleared indicates the load shedding signal was withdrawn.
+	ReasonBackpressureCleared = "BackpressureCleared"
+	// ReasonFallbackActivated indicates spec.fallback took over after repeated metric fetch failures.
+	ReasonFallbackActivated = "FallbackActivated"
+	// ReasonFallbackCleared indicates metrics are available again and the fallback ended.
+	ReasonFallbackCleared = "FallbackCleared"
+	// ReasonRolloutHold indicates replicas were held back to keep a partitioned rollout of the target intact.
+	ReasonRolloutHold = "RolloutHold"
+	// ReasonNodeDrain indicates target pods are on cordoned nodes or being evicted.
+	ReasonNodeDrain = "NodeDrain"
+	// ReasonModelProfileSelected indicates a different model profile now supplies the policy's defaults.
+	ReasonModelProfileSelected = "ModelProfileSelected"
 )
 
 // EventRecorder wraps the Kubernetes event recorder
@@ -53,24 +109,56 @@ func NewEventRecorder(recorder record.EventRecorder) *EventRecorder {
 	}
 }
 
-// RecordScaleUp records a scale up event
-func (e *EventRecorder) RecordScaleUp(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, from, to int32) {
+// eventf records an event on the policy, annotated with its spec.eventMetadata
+func (e *EventRecorder) eventf(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, eventtype, reason, messageFmt string, args ...interface{}) {
+	e.recorder.AnnotatedEventf(policy, policy.Spec.EventMetadata, eventtype, reason, messageFmt, args...)
+}
+
+// RecordScaleUp records a scale up event with the details of the decision
+func (e *EventRecorder) RecordScaleUp(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, from, to int32, decision scaling.ScalingResult) {
 	if e.recorder == nil {
 		return
 	}
-	e.recorder.Eventf(policy, corev1.EventTypeNormal, ReasonScaledUp,
-		"Scaled %s/%s from %d to %d replicas",
-		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, from, to)
+	e.eventf(policy, corev1.EventTypeNormal, ReasonScaledUp,
+		"Scaled %s/%s from %d to %d replicas%s",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, from, to, decisionDetails(decision))
 }
 
-// RecordScaleDown records a scale down event
-func (e *EventRecorder) RecordScaleDown(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, from, to int32) {
+// RecordScaleDown records a scale down event with the details of the decision
+func (e *EventRecorder) RecordScaleDown(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, from, to int32, decision scaling.ScalingResult) {
 	if e.recorder == nil {
 		return
 	}
-	e.recorder.Eventf(policy, corev1.EventTypeNormal, ReasonScaledDown,
-		"Scaled %s/%s from %d to %d replicas",
-		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, from, to)
+	e.eventf(policy, corev1.EventTypeNormal, ReasonScaledDown,
+		"Scaled %s/%s from %d to %d replicas%s",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, from, to, decisionDetails(decision))
+}
+
+// decisionDetails formats the driving metric, unclamped recommendation and
+// confidence of a decision as "; key: value" pairs, leaving out those the
+// algorithm did not report
+func decisionDetails(decision scaling.ScalingResult) string {
+	var details strings.Builder
+	if decision.DrivingMetric != "" {
+		fmt.Fprintf(&details, "; driving metric: %s", decision.DrivingMetric)
+	}
+	if decision.UnclampedReplicas != 0 && decision.UnclampedReplicas != decision.DesiredReplicas {
+		fmt.Fprintf(&details, "; unclamped: %d", decision.UnclampedReplicas)
+	}
+	if decision.Confidence != 0 {
+		fmt.Fprintf(&details, "; confidence: %.2f", decision.Confidence)
+	}
+	return details.String()
+}
+
+// RecordTargetRescaled records a scale event on the target itself, so it shows up
+// in the target's own events like HorizontalPodAutoscaler rescales do
+func (e *EventRecorder) RecordTargetRescaled(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, target *corev1.ObjectReference, replicas int32, reason string) {
+	if e.recorder == nil {
+		return
+	}
+	e.recorder.AnnotatedEventf(target, policy.Spec.EventMetadata, corev1.EventTypeNormal, ReasonSuccessfulRescale,
+		"New size: %d; reason: %s; policy: %s", replicas, reason, policy.Name)
 }
 
 // RecordScalingFailed records a scaling failure event
@@ -78,7 +166,7 @@ func (e *EventRecorder) RecordScalingFailed(policy *kubeaiv1alpha1.AIInferenceAu
 	if e.recorder == nil {
 		return
 	}
-	e.recorder.Eventf(policy, corev1.EventTypeWarning, ReasonScalingFailed,
+	e.eventf(policy, corev1.EventTypeWarning, ReasonScalingFailed,
 		"Failed to scale %s/%s: %v",
 		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, err)
 }
@@ -88,7 +176,7 @@ func (e *EventRecorder) RecordMetricsFailed(policy *kubeaiv1alpha1.AIInferenceAu
 	if e.recorder == nil {
 		return
 	}
-	e.recorder.Eventf(policy, corev1.EventTypeWarning, ReasonMetricsFailed,
+	e.eventf(policy, corev1.EventTypeWarning, ReasonMetricsFailed,
 		"Failed to fetch metrics: %v", err)
 }
 
@@ -97,7 +185,7 @@ func (e *EventRecorder) RecordTargetNotFound(policy *kubeaiv1alpha1.AIInferenceA
 	if e.recorder == nil {
 		return
 	}
-	e.recorder.Eventf(policy, corev1.EventTypeWarning, ReasonTargetNotFound,
+	e.eventf(policy, corev1.EventTypeWarning, ReasonTargetNotFound,
 		"Target %s/%s not found: %v",
 		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, err)
 }
@@ -107,7 +195,7 @@ func (e *EventRecorder) RecordCooldown(policy *kubeaiv1alpha1.AIInferenceAutosca
 	if e.recorder == nil {
 		return
 	}
-	e.recorder.Eventf(policy, corev1.EventTypeNormal, ReasonCooldown,
+	e.eventf(policy, corev1.EventTypeNormal, ReasonCooldown,
 		"Scaling skipped, cooldown active for %d more seconds", remainingSeconds)
 }
 
@@ -116,7 +204,243 @@ func (e *EventRecorder) RecordUnknownAlgorithm(policy *kubeaiv1alpha1.AIInferenc
 	if e.recorder == nil {
 		return
 	}
-	e.recorder.Eventf(policy, corev1.EventTypeWarning, ReasonUnknownAlgorithm,
+	e.eventf(policy, corev1.EventTypeWarning, ReasonUnknownAlgorithm,
 		"spec.algorithm.name=%q is not registered; falling back to %q. Available: %v",
 		requested, fallback, available)
 }
+
+// RecordUnknownDirectionAlgorithm records a warning event when a scale-up or scale-down algorithm is not found
+func (e *EventRecorder) RecordUnknownDirectionAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, field, requested, fallback string, available []string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonUnknownAlgorithm,
+		"%s=%q is not registered; using %q in that direction. Available: %v",
+		field, requested, fallback, available)
+}
+
+// RecordUnknownFallbackAlgorithm records a warning event when the policy's fallback algorithm is not found
+func (e *EventRecorder) RecordUnknownFallbackAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, requested, fallback string, available []string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonUnknownAlgorithm,
+		"spec.algorithm.fallback=%q is not registered; falling back to %q instead. Available: %v",
+		requested, fallback, available)
+}
+
+// RecordAlgorithmNotAllowed records a warning event when the policy selects an algorithm it may not use
+func (e *EventRecorder) RecordAlgorithmNotAllowed(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, field, requested, fallback string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonAlgorithmNotAllowed,
+		"%s=%q is not allowed in namespace %s; using %q instead",
+		field, requested, policy.Namespace, fallback)
+}
+
+// RecordInvalidAlgorithmParameters records a warning event when the algorithm rejects the policy's parameters
+func (e *EventRecorder) RecordInvalidAlgorithmParameters(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, err error) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonInvalidAlgorithmParameters,
+		"%v; using the algorithm's defaults", err)
+}
+
+// RecordAlgorithmError records a warning event when the algorithm fails and the current replicas are kept
+func (e *EventRecorder) RecordAlgorithmError(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, reason string, err error) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, reason,
+		"%v; keeping current replicas", err)
+}
+
+// RecordDryRunScale records that a dry-run scale would have succeeded
+func (e *EventRecorder) RecordDryRunScale(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, from, to int32) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonDryRunAccepted,
+		"Dry run: would scale %s/%s from %d to %d replicas",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, from, to)
+}
+
+// RecordDryRunRejected records that a dry-run scale was rejected by the API server
+func (e *EventRecorder) RecordDryRunRejected(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, from, to int32, err error) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonDryRunRejected,
+		"Dry run: scaling %s/%s from %d to %d replicas would fail: %v",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, from, to, err)
+}
+
+// RecordAlgorithmTimeout records a warning event when an algorithm exceeds its deadline
+func (e *EventRecorder) RecordAlgorithmTimeout(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, algorithm, fallback string, err error) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonAlgorithmTimeout,
+		"Algorithm %q failed to return in time (%v); falling back to %q",
+		algorithm, err, fallback)
+}
+
+// RecordDecisionTimeout records a warning event when a reconcile exceeds the decision budget
+func (e *EventRecorder) RecordDecisionTimeout(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, stage string, budget time.Duration) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonDecisionTimeout,
+		"Scaling decision exceeded its %s budget at the %s stage; replicas unchanged until the next reconcile",
+		budget, stage)
+}
+
+// RecordSaturatedAtMax records a warning event when a policy is pinned at maxReplicas while over target
+func (e *EventRecorder) RecordSaturatedAtMax(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, duration time.Duration) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonSaturatedAtMax,
+		"%s/%s has been at maxReplicas=%d with metrics above target for %s; consider raising maxReplicas or GPU capacity",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, policy.Spec.MaxReplicas, duration.Round(time.Second))
+}
+
+// RecordSchedulingBlocked records a warning event when target pods cannot be scheduled
+func (e *EventRecorder) RecordSchedulingBlocked(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, pending int, message string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonSchedulingBlocked,
+		"%d pod(s) of %s/%s cannot be scheduled, pausing scale-up: %s",
+		pending, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, message)
+}
+
+// RecordMetricsSourceChanged records an event when the policy fails over to another metric source
+func (e *EventRecorder) RecordMetricsSourceChanged(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, from, to string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonMetricsSourceChanged,
+		"Metrics source changed from %s to %s", from, to)
+}
+
+// RecordScaleLockHeld records a warning event when another writer holds the target's scale lock
+func (e *EventRecorder) RecordScaleLockHeld(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, holder string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonScaleLockHeld,
+		"Skipping scale of %s/%s: replicas are being written by %s",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, holder)
+}
+
+// RecordEvictionProtected records an event when target pods are protected from eviction
+func (e *EventRecorder) RecordEvictionProtected(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, pods int) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonEvictionProtected,
+		"Marked %d pod(s) of %s/%s as not safe to evict during a scale-up surge",
+		pods, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+}
+
+// RecordEvictionProtectionRemoved records an event when eviction protection is lifted from target pods
+func (e *EventRecorder) RecordEvictionProtectionRemoved(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, pods int) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonEvictionProtectionRemoved,
+		"Removed eviction protection from %d pod(s) of %s/%s",
+		pods, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+}
+
+// RecordShardCountUnavailable records a warning event when the cache shard count cannot be read
+func (e *EventRecorder) RecordShardCountUnavailable(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, err error) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonShardCountUnavailable,
+		"Holding replicas: %v", err)
+}
+
+// RecordShardMapUpdated records an event when the cache shard count is updated to the new replicas
+func (e *EventRecorder) RecordShardMapUpdated(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, configMap string, from, to int32) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonShardMapUpdated,
+		"Updated shard count in ConfigMap %s from %d to %d after %s/%s rolled out",
+		configMap, from, to, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+}
+
+// RecordBackpressureSignaled records a warning event when a load shedding signal is published
+func (e *EventRecorder) RecordBackpressureSignaled(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, ratio string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonBackpressureSignaled,
+		"%s/%s is at maxReplicas=%d with load at %sx its target; published a load shedding signal",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, policy.Spec.MaxReplicas, ratio)
+}
+
+// RecordBackpressureCleared records an event when the load shedding signal is withdrawn
+func (e *EventRecorder) RecordBackpressureCleared(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonBackpressureCleared,
+		"Withdrew the load shedding signal for %s/%s",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+}
+
+// RecordFallbackActivated records a warning event when spec.fallback takes over
+func (e *EventRecorder) RecordFallbackActivated(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, behavior string, replicas, failures int32) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonFallbackActivated,
+		"Metrics unavailable for %d consecutive fetches; fallback %s holds %s/%s at %d replicas",
+		failures, behavior, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, replicas)
+}
+
+// RecordFallbackCleared records an event when metrics recover and the fallback ends
+func (e *EventRecorder) RecordFallbackCleared(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonFallbackCleared,
+		"Metrics are available again; resuming scaling of %s/%s",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+}
+
+// RecordRolloutHold records an event when replicas are held back for the partitioned rollout of the target
+func (e *EventRecorder) RecordRolloutHold(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, desired, bounded int32, reason string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonRolloutHold,
+		"Scaling %s/%s to %d instead of %d replicas: %s",
+		policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, bounded, desired, reason)
+}
+
+// RecordNodeDrain records a warning event when target pods are on cordoned nodes or being evicted
+func (e *EventRecorder) RecordNodeDrain(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, pods int, nodes []string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeWarning, ReasonNodeDrain,
+		"%d pod(s) of %s/%s are on cordoned or draining nodes %s; holding scale-down until the drain completes",
+		pods, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, strings.Join(nodes, ", "))
+}
+
+// RecordModelProfileSelected records an event when a different model profile supplies the policy's defaults
+func (e *EventRecorder) RecordModelProfileSelected(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, profile string) {
+	if e.recorder == nil {
+		return
+	}
+	e.eventf(policy, corev1.EventTypeNormal, ReasonModelProfileSelected,
+		"Using defaults of model profile %q for the model of %s/%s",
+		profile, policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+}
@@ -20,12 +20,15 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
 	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
 )
 
 func TestEventRecorderNilSafe(_ *testing.T) {
@@ -46,13 +49,32 @@ func TestEventRecorderNilSafe(_ *testing.T) {
 	}
 
 	// These should not panic
-	recorder.RecordScaleUp(policy, 2, 4)
-	recorder.RecordScaleDown(policy, 4, 2)
+	recorder.RecordScaleUp(policy, 2, 4, scaling.ScalingResult{DesiredReplicas: 4})
+	recorder.RecordScaleDown(policy, 4, 2, scaling.ScalingResult{DesiredReplicas: 2})
+	recorder.RecordTargetRescaled(policy, &corev1.ObjectReference{Kind: "Deployment", Name: "test-deployment"}, 4, "scale up")
 	recorder.RecordScalingFailed(policy, errors.New("test error"))
 	recorder.RecordMetricsFailed(policy, errors.New("test error"))
 	recorder.RecordTargetNotFound(policy, errors.New("test error"))
 	recorder.RecordCooldown(policy, 60)
 	recorder.RecordUnknownAlgorithm(policy, "CustomAlgo", "MaxRatio", []string{"MaxRatio", "AverageRatio"})
+	recorder.RecordUnknownDirectionAlgorithm(policy, "spec.scaleDown.algorithm", "CustomAlgo", "MaxRatio", []string{"MaxRatio"})
+	recorder.RecordInvalidAlgorithmParameters(policy, errors.New("test error"))
+	recorder.RecordAlgorithmError(policy, ReasonAlgorithmFailed, errors.New("test error"))
+	recorder.RecordShardCountUnavailable(policy, errors.New("test error"))
+	recorder.RecordShardMapUpdated(policy, "cache-shards", 4, 8)
+	recorder.RecordDryRunScale(policy, 2, 4)
+	recorder.RecordSaturatedAtMax(policy, 10*time.Minute)
+	recorder.RecordAlgorithmTimeout(policy, "CustomAlgo", "MaxRatio", errors.New("test error"))
+	recorder.RecordDryRunRejected(policy, 2, 4, errors.New("test error"))
+	recorder.RecordSchedulingBlocked(policy, 2, "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.")
+	recorder.RecordMetricsSourceChanged(policy, "prometheus", "thanos")
+	recorder.RecordScaleLockHeld(policy, "kubeai-autoscaler-old/default/other-policy")
+	recorder.RecordEvictionProtected(policy, 3)
+	recorder.RecordEvictionProtectionRemoved(policy, 3)
+	recorder.RecordDecisionTimeout(policy, DecisionStageMetrics, 5*time.Second)
+	recorder.RecordBackpressureSignaled(policy, "1.35")
+	recorder.RecordBackpressureCleared(policy)
+	recorder.RecordAlgorithmNotAllowed(policy, "spec.algorithm.name", "experimental-forecast", "MaxRatio")
 }
 
 func TestRecordUnknownAlgorithm(t *testing.T) {
@@ -84,3 +106,59 @@ func TestRecordUnknownAlgorithm(t *testing.T) {
 		t.Fatal("Expected an event to be recorded")
 	}
 }
+
+func TestRecordScaleUpIncludesDecisionDetails(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	recorder := NewEventRecorder(fakeRecorder)
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-policy",
+			Namespace: "default",
+		},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{
+				Kind: "Deployment",
+				Name: "test-deployment",
+			},
+		},
+	}
+
+	recorder.RecordScaleUp(policy, 2, 4, scaling.ScalingResult{
+		DesiredReplicas:   4,
+		DrivingMetric:     scaling.MetricLatencyP99Ms,
+		UnclampedReplicas: 6,
+		Confidence:        0.8,
+	})
+	recorder.RecordScaleDown(policy, 4, 2, scaling.ScalingResult{DesiredReplicas: 2, UnclampedReplicas: 2})
+
+	assert.Equal(t, "Normal ScaledUp Scaled Deployment/test-deployment from 2 to 4 replicas; "+
+		"driving metric: latencyP99Ms; unclamped: 6; confidence: 0.80", <-fakeRecorder.Events)
+	assert.Equal(t, "Normal ScaledDown Scaled Deployment/test-deployment from 4 to 2 replicas", <-fakeRecorder.Events)
+}
+
+func TestEventsCarryEventMetadata(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	recorder := NewEventRecorder(fakeRecorder)
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-policy",
+			Namespace: "default",
+		},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{
+				Kind: "Deployment",
+				Name: "test-deployment",
+			},
+			EventMetadata: map[string]string{"team": "search"},
+		},
+	}
+
+	recorder.RecordCooldown(policy, 30)
+	recorder.RecordTargetRescaled(policy, &corev1.ObjectReference{Kind: "Deployment", Name: "test-deployment"}, 4, "latency above target")
+
+	assert.Equal(t, "Normal CooldownActive Scaling skipped, cooldown active for 30 more seconds map[team:search]", <-fakeRecorder.Events)
+	assert.Equal(t, "Normal SuccessfulRescale New size: 4; reason: latency above target; policy: test-policy map[team:search]",
+		<-fakeRecorder.Events)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// SafeToEvictAnnotation tells the cluster autoscaler whether a pod may be evicted when its node is removed
+	SafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+	// EvictionProtectedAnnotation marks pods protected by the controller, so only
+	// protection it added is removed again
+	EvictionProtectedAnnotation = "kubeai.io/eviction-protected"
+
+	// ConditionTypeEvictionProtected indicates the target's pods are protected from eviction
+	ConditionTypeEvictionProtected = "EvictionProtected"
+)
+
+// applyEvictionProtection protects the target's pods from eviction while the
+// policy recommends scaling up, and lifts the protection once the recommendation
+// drops below the current replicas. Pods created during the surge are protected
+// on the following reconciles.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyEvictionProtection(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, recommendedReplicas int32,
+) {
+	logger := log.FromContext(ctx)
+
+	protected := r.isConditionTrue(policy, ConditionTypeEvictionProtected)
+	protect := protected
+	reason, message := "LoadSubsided", "Recommended replicas dropped below the current replicas"
+	switch {
+	case !policy.Spec.EvictionProtection || policy.Spec.DryRun:
+		protect = false
+		reason, message = "EvictionProtectionDisabled", "Eviction protection is not enabled for the policy"
+	case recommendedReplicas > currentReplicas:
+		protect = true
+	case recommendedReplicas < currentReplicas:
+		protect = false
+	}
+	if !protect && !protected {
+		return
+	}
+
+	changed, err := r.setEvictionProtection(ctx, policy, protect)
+	if err != nil {
+		logger.Error(err, "Failed to update eviction protection of target pods", "protect", protect)
+		return
+	}
+
+	switch {
+	case protect && !protected:
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordEvictionProtected(policy, changed)
+		}
+		r.updateCondition(ctx, policy, ConditionTypeEvictionProtected, metav1.ConditionTrue, "ScaleUpSurge",
+			fmt.Sprintf("Pods are not safe to evict while scaling from %d toward %d replicas", currentReplicas, recommendedReplicas))
+	case !protect && protected:
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordEvictionProtectionRemoved(policy, changed)
+		}
+		r.updateCondition(ctx, policy, ConditionTypeEvictionProtected, metav1.ConditionFalse, reason, message)
+	}
+}
+
+// setEvictionProtection adds or removes the controller's eviction protection on
+// the target's pods and returns the number of pods changed
+func (r *AIInferenceAutoscalerPolicyReconciler) setEvictionProtection(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, protect bool) (int, error) {
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return 0, err
+	}
+	if labelSelector == nil {
+		return 0, fmt.Errorf("target %s/%s has no selector", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(policy.Namespace), client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		_, marked := pod.Annotations[EvictionProtectedAnnotation]
+		if protect == marked {
+			continue
+		}
+		// Leave pods whose safe-to-evict annotation was set by someone else untouched
+		if _, set := pod.Annotations[SafeToEvictAnnotation]; protect && set {
+			continue
+		}
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		if protect {
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[SafeToEvictAnnotation] = "false"
+			pod.Annotations[EvictionProtectedAnnotation] = "true"
+		} else {
+			delete(pod.Annotations, SafeToEvictAnnotation)
+			delete(pod.Annotations, EvictionProtectedAnnotation)
+		}
+		if err := r.Patch(ctx, pod, patch); err != nil {
+			return changed, fmt.Errorf("failed to patch pod %s: %w", pod.Name, err)
+		}
+		changed++
+	}
+	return changed, nil
+}
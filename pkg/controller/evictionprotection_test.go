@@ -0,0 +1,110 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func llmPod(name string, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Labels:      map[string]string{"app": "llm"},
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestApplyEvictionProtection(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("test-policy")
+	policy.Spec.EvictionProtection = true
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(2),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(policy, deployment,
+			llmPod("llm-a", nil),
+			llmPod("llm-b", nil),
+			// Set by the operator; never touched by the controller
+			llmPod("llm-pinned", map[string]string{SafeToEvictAnnotation: "false"}),
+		).
+		WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	ctx := context.Background()
+
+	annotations := func(name string) map[string]string {
+		pod := &corev1.Pod{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, pod))
+		return pod.Annotations
+	}
+
+	// Steady load leaves the pods alone
+	r.applyEvictionProtection(ctx, policy, 2, 2)
+	assert.Empty(t, annotations("llm-a"))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// A scale-up surge protects the pods
+	r.applyEvictionProtection(ctx, policy, 2, 4)
+	assert.Equal(t, "false", annotations("llm-a")[SafeToEvictAnnotation])
+	assert.Equal(t, "false", annotations("llm-b")[SafeToEvictAnnotation])
+	assert.NotContains(t, annotations("llm-pinned"), EvictionProtectedAnnotation)
+	assert.True(t, r.hasCondition(policy, ConditionTypeEvictionProtected, metav1.ConditionTrue, "ScaleUpSurge"))
+	assert.Contains(t, <-fakeRecorder.Events, "Marked 2 pod(s) of Deployment/llm")
+
+	// Pods created during the surge are protected while the recommendation holds
+	require.NoError(t, c.Create(ctx, llmPod("llm-c", nil)))
+	r.applyEvictionProtection(ctx, policy, 4, 4)
+	assert.Equal(t, "false", annotations("llm-c")[SafeToEvictAnnotation])
+	assert.Empty(t, fakeRecorder.Events)
+
+	// Load subsiding lifts only the controller's protection
+	r.applyEvictionProtection(ctx, policy, 4, 3)
+	assert.Empty(t, annotations("llm-a"))
+	assert.Empty(t, annotations("llm-c"))
+	assert.Equal(t, "false", annotations("llm-pinned")[SafeToEvictAnnotation])
+	assert.True(t, r.hasCondition(policy, ConditionTypeEvictionProtected, metav1.ConditionFalse, "LoadSubsided"))
+	assert.Contains(t, <-fakeRecorder.Events, "Removed eviction protection from 3 pod(s)")
+
+	// Disabling the feature mid-surge removes the protection
+	r.applyEvictionProtection(ctx, policy, 3, 5)
+	assert.Equal(t, "false", annotations("llm-a")[SafeToEvictAnnotation])
+	<-fakeRecorder.Events
+	policy.Spec.EvictionProtection = false
+	r.applyEvictionProtection(ctx, policy, 3, 5)
+	assert.Empty(t, annotations("llm-a"))
+	assert.True(t, r.hasCondition(policy, ConditionTypeEvictionProtected, metav1.ConditionFalse, "EvictionProtectionDisabled"))
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// Fallback behaviors applied while metrics cannot be fetched
+const (
+	FallbackHold         = "Hold"
+	FallbackScaleToMin   = "ScaleToMin"
+	FallbackScaleToFixed = "ScaleToFixed"
+	FallbackScaleToMax   = "ScaleToMax"
+)
+
+// DefaultFallbackFailureThreshold is how many consecutive metric fetches
+// must fail before spec.fallback is applied when failureThreshold is unset
+const DefaultFallbackFailureThreshold = 3
+
+// ConditionTypeFallbackActive indicates spec.fallback is setting the replicas
+// because metrics cannot be fetched
+const ConditionTypeFallbackActive = "FallbackActive"
+
+// fallbackReplicas returns the replicas the policy's fallback behavior moves
+// the target to
+func fallbackReplicas(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentReplicas int32) int32 {
+	minReplicas := int32(1)
+	if policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas > 0 {
+		minReplicas = *policy.Spec.MinReplicas
+	}
+
+	switch policy.Spec.Fallback.Behavior {
+	case FallbackScaleToMin:
+		return minReplicas
+	case FallbackScaleToMax:
+		return policy.Spec.MaxReplicas
+	case FallbackScaleToFixed:
+		return min(max(policy.Spec.Fallback.Replicas, minReplicas), policy.Spec.MaxReplicas)
+	default:
+		return currentReplicas
+	}
+}
+
+// applyFallback scales the target to the fallback replicas once metrics have
+// failed failureThreshold times in a row. Cooldown and behavior policies are
+// not applied, since they rely on the metrics that are missing, but suspend,
+// namespace disable, the emergency stop, recommend mode and dry-run are still
+// honored.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyFallback(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas int32,
+	fetchErr error,
+) {
+	fallback := policy.Spec.Fallback
+	if fallback == nil {
+		return
+	}
+	threshold := fallback.FailureThreshold
+	if threshold <= 0 {
+		threshold = DefaultFallbackFailureThreshold
+	}
+	failures := policy.Status.MetricsFailureCount
+	if failures < threshold {
+		return
+	}
+
+	logger := log.FromContext(ctx)
+	behavior := fallback.Behavior
+	if behavior == "" {
+		behavior = FallbackHold
+	}
+	desiredReplicas := fallbackReplicas(policy, currentReplicas)
+
+	if !r.isConditionTrue(policy, ConditionTypeFallbackActive) && r.EventRecorder != nil {
+		r.EventRecorder.RecordFallbackActivated(policy, behavior, desiredReplicas, failures)
+	}
+	r.updateCondition(ctx, policy, ConditionTypeFallbackActive, metav1.ConditionTrue, "MetricsUnavailable",
+		fmt.Sprintf("%d consecutive metric fetches failed; fallback %s at %d replicas: %v", failures, behavior, desiredReplicas, fetchErr))
+
+	if desiredReplicas == currentReplicas || r.Mode == ModeRecommend || policy.Spec.Suspend || r.namespaceDisabled(ctx, policy) {
+		return
+	}
+	if stop := r.emergencyStopped(ctx); stop.Stopped {
+		r.applyEmergencyStop(ctx, policy, stop, currentReplicas, desiredReplicas)
+		return
+	}
+
+	logger.Info("Metrics unavailable, scaling target to fallback replicas",
+		"current", currentReplicas,
+		"desired", desiredReplicas,
+		"behavior", behavior,
+		"failures", failures)
+	if policy.Spec.DryRun {
+		r.dryRunScale(ctx, policy, currentReplicas, desiredReplicas)
+		return
+	}
+
+	acquired, holder, err := r.acquireScaleLock(ctx, policy, r.now())
+	if err != nil || !acquired {
+		logger.Info("Scale lock not acquired, retrying fallback next reconcile", "holder", holder, "error", err)
+		return
+	}
+	scale, err := r.scaleTarget(ctx, policy, desiredReplicas)
+	if err != nil {
+		logger.Error(err, "Failed to scale target to fallback replicas")
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordScalingFailed(policy, err)
+		}
+		r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionFalse, "ScaleFailed", err.Error())
+		return
+	}
+
+	decision := scaling.ScalingResult{
+		DesiredReplicas: desiredReplicas,
+		Reason:          fmt.Sprintf("metrics unavailable, fallback %s", behavior),
+	}
+	if r.EventRecorder != nil {
+		if desiredReplicas > currentReplicas {
+			r.EventRecorder.RecordScaleUp(policy, currentReplicas, desiredReplicas, decision)
+		} else {
+			r.EventRecorder.RecordScaleDown(policy, currentReplicas, desiredReplicas, decision)
+		}
+		r.EventRecorder.RecordTargetRescaled(policy, targetReference(policy, scale.UID), desiredReplicas, decision.Reason)
+	}
+
+	policyKey := fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)
+	now := metav1.NewTime(r.now())
+	r.LastScaleTime[policyKey] = now.Time
+	r.recordScaleEvent(policy, policyKey, currentReplicas, desiredReplicas, now.Time)
+	policy.Status.LastScaleTime = &now
+	policy.Status.DesiredReplicas = desiredReplicas
+	r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionTrue, "Scaled",
+		fmt.Sprintf("Scaled from %d to %d replicas by fallback %s", currentReplicas, desiredReplicas, behavior))
+}
+
+// clearFallback resets the metric failure count after a successful fetch and
+// ends an active fallback
+func (r *AIInferenceAutoscalerPolicyReconciler) clearFallback(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) {
+	if r.isConditionTrue(policy, ConditionTypeFallbackActive) {
+		policy.Status.MetricsFailureCount = 0
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordFallbackCleared(policy)
+		}
+		r.updateCondition(ctx, policy, ConditionTypeFallbackActive, metav1.ConditionFalse, "MetricsRecovered",
+			"Metrics are available again")
+		return
+	}
+	if policy.Status.MetricsFailureCount == 0 {
+		return
+	}
+
+	patch := client.MergeFrom(policy.DeepCopy())
+	policy.Status.MetricsFailureCount = 0
+	if err := r.Status().Patch(ctx, policy, patch); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to reset metrics failure count")
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestFallbackReplicas(t *testing.T) {
+	tests := []struct {
+		name     string
+		fallback kubeaiv1alpha1.FallbackSpec
+		want     int32
+	}{
+		{name: "hold", fallback: kubeaiv1alpha1.FallbackSpec{Behavior: FallbackHold}, want: 4},
+		{name: "unset behavior holds", fallback: kubeaiv1alpha1.FallbackSpec{}, want: 4},
+		{name: "scale to min", fallback: kubeaiv1alpha1.FallbackSpec{Behavior: FallbackScaleToMin}, want: 2},
+		{name: "scale to max", fallback: kubeaiv1alpha1.FallbackSpec{Behavior: FallbackScaleToMax}, want: 10},
+		{name: "scale to fixed", fallback: kubeaiv1alpha1.FallbackSpec{Behavior: FallbackScaleToFixed, Replicas: 6}, want: 6},
+		{name: "fixed kept within max", fallback: kubeaiv1alpha1.FallbackSpec{Behavior: FallbackScaleToFixed, Replicas: 20}, want: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := lockTestPolicy("policy")
+			policy.Spec.MinReplicas = int32Ptr(2)
+			policy.Spec.Fallback = &tt.fallback
+			assert.Equal(t, tt.want, fallbackReplicas(policy, 4))
+		})
+	}
+}
+
+func TestReconcileAppliesFallbackDuringMetricsOutage(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.Fallback = &kubeaiv1alpha1.FallbackSpec{Behavior: FallbackScaleToMax, FailureThreshold: 2}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	mock := &metrics.MockClient{Error: errors.New("prometheus unavailable")}
+	r := NewReconciler(c, scheme, mock, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+	stored := func() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		p := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, p))
+		return p
+	}
+	replicas := func() int32 {
+		d := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, d))
+		return *d.Spec.Replicas
+	}
+
+	// Below the threshold the current replicas are held
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stored().Status.MetricsFailureCount)
+	assert.Equal(t, int32(2), replicas())
+	assert.False(t, r.isConditionTrue(stored(), ConditionTypeFallbackActive))
+
+	// At the threshold the fallback scales to maxReplicas
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), stored().Status.MetricsFailureCount)
+	assert.Equal(t, int32(10), replicas())
+	assert.True(t, r.hasCondition(stored(), ConditionTypeFallbackActive, metav1.ConditionTrue, "MetricsUnavailable"))
+
+	// A successful fetch resets the count and ends the fallback
+	mock.Error = nil
+	mock.GPUUtilizationValue = 50
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Zero(t, stored().Status.MetricsFailureCount)
+	assert.True(t, r.hasCondition(stored(), ConditionTypeFallbackActive, metav1.ConditionFalse, "MetricsRecovered"))
+}
+
+func TestReconcileFallbackRespectsSuspend(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.Suspend = true
+	policy.Spec.Fallback = &kubeaiv1alpha1.FallbackSpec{Behavior: FallbackScaleToMax, FailureThreshold: 1}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{Error: errors.New("prometheus unavailable")}, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}})
+	require.NoError(t, err)
+
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(2), *updated.Spec.Replicas)
+}
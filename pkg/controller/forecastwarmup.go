@@ -0,0 +1,121 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+const (
+	// forecastHistoryQuery selects the replicas recommended for a policy, as
+	// exported by the controller, by namespace and name
+	forecastHistoryQuery = `max(kubeai_autoscaler_unconstrained_replicas{namespace=%q,policy=%q})`
+	// maxForecastHistorySamples bounds the samples replayed per policy, below
+	// the 11,000 points Prometheus returns per series
+	maxForecastHistorySamples = 10000
+	// minForecastHistoryStep is the finest resolution history is replayed at
+	minForecastHistoryStep = time.Minute
+)
+
+// ForecastWarmup seeds the forecasts of Predictive policies that have none,
+// such as on the first start with persistence or after the saved state was
+// lost, by replaying the replicas recommended for them over a past window from
+// the kubeai_autoscaler_unconstrained_replicas series in Prometheus. A policy
+// then anticipates its daily peaks right away instead of relearning them.
+type ForecastWarmup struct {
+	// Reader lists the policies
+	Reader client.Reader
+	// History reads the recorded recommendations
+	History metrics.HistoryReader
+	// Window is how far back history is replayed; a few seasonality periods
+	Window time.Duration
+	// Reconciler configures the algorithm of each policy as its reconciles do
+	Reconciler *AIInferenceAutoscalerPolicyReconciler
+
+	now func() time.Time
+}
+
+// Warm replays history into every Predictive policy without a forecast and
+// returns the number of policies seeded. Policies without recorded history
+// are skipped; the errors of the others are joined.
+func (w *ForecastWarmup) Warm(ctx context.Context) (int, error) {
+	policies := &kubeaiv1alpha1.AIInferenceAutoscalerPolicyList{}
+	if err := w.Reader.List(ctx, policies); err != nil {
+		return 0, err
+	}
+	now := time.Now
+	if w.now != nil {
+		now = w.now
+	}
+	end := now()
+	step := max(w.Window/maxForecastHistorySamples, minForecastHistoryStep).Round(time.Second)
+
+	warmed := 0
+	var errs []error
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		algo := w.predictive(policy)
+		key := policy.Namespace + "/" + policy.Name
+		if algo == nil || algo.Learned(key) {
+			continue
+		}
+		query := fmt.Sprintf(forecastHistoryQuery, policy.Namespace, policy.Name)
+		samples, err := w.History.History(ctx, query, end.Add(-w.Window), end, step)
+		if errors.Is(err, metrics.ErrNoData) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("policy %s: %w", key, err))
+			continue
+		}
+		for _, sample := range samples {
+			if err = algo.Learn(key, sample.Timestamp, sample.Value); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("policy %s: %w", key, err))
+			continue
+		}
+		warmed++
+	}
+	return warmed, errors.Join(errs...)
+}
+
+// predictive returns the policy's algorithm configured as for a reconcile
+// when it is Predictive, or nil
+func (w *ForecastWarmup) predictive(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) *scaling.PredictiveAlgorithm {
+	name := DefaultAlgorithmName
+	if policy.Spec.Algorithm != nil && policy.Spec.Algorithm.Name != "" {
+		name = policy.Spec.Algorithm.Name
+	}
+	algorithm, err := w.Reconciler.AlgorithmRegistry.Get(name)
+	if err != nil {
+		return nil
+	}
+	predictive, _ := w.Reconciler.withParameters(policy, algorithm).(*scaling.PredictiveAlgorithm)
+	return predictive
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// fakeHistory serves recorded samples by query
+type fakeHistory struct {
+	samples map[string][]metrics.Sample
+	queries []string
+	step    time.Duration
+}
+
+func (h *fakeHistory) History(_ context.Context, query string, _, _ time.Time, step time.Duration) ([]metrics.Sample, error) {
+	h.queries = append(h.queries, query)
+	h.step = step
+	samples, ok := h.samples[query]
+	if !ok {
+		return nil, metrics.ErrNoData
+	}
+	return samples, nil
+}
+
+func TestForecastWarmup(t *testing.T) {
+	now := time.Date(2026, 5, 4, 11, 0, 0, 0, time.UTC)
+	predictive := func(name string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		policy := lockTestPolicy(name)
+		policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "Predictive", ForecastHorizonSeconds: 3600, SeasonalityPeriodSeconds: 86400}
+		return policy
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(predictive("llm"), predictive("learned"), predictive("new"), lockTestPolicy("max-ratio")).Build()
+
+	// A week of recommendations with a daily peak from 12:00 to 14:00
+	var week []metrics.Sample
+	for at := now.Add(-7 * 24 * time.Hour); at.Before(now); at = at.Add(10 * time.Minute) {
+		replicas := 2.0
+		if hour := at.Hour(); hour >= 12 && hour < 14 {
+			replicas = 10
+		}
+		week = append(week, metrics.Sample{Value: replicas, Timestamp: at})
+	}
+	history := &fakeHistory{samples: map[string][]metrics.Sample{
+		`max(kubeai_autoscaler_unconstrained_replicas{namespace="default",policy="llm"})`: week,
+	}}
+
+	state := scaling.NewStateStore()
+	state.Set("default/learned", "predictive.level", 3)
+	r := NewReconciler(c, nil, nil, scaling.DefaultRegistry, nil)
+	r.AlgorithmState = state
+	w := &ForecastWarmup{Reader: c, History: history, Window: 7 * 24 * time.Hour, Reconciler: r, now: func() time.Time { return now }}
+
+	warmed, err := w.Warm(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, warmed)
+	// Policies with a forecast or another algorithm are not queried
+	assert.Equal(t, []string{
+		`max(kubeai_autoscaler_unconstrained_replicas{namespace="default",policy="llm"})`,
+		`max(kubeai_autoscaler_unconstrained_replicas{namespace="default",policy="new"})`,
+	}, history.queries)
+	assert.Equal(t, time.Minute, history.step)
+	level, _ := state.Get("default/learned", "predictive.level")
+	assert.Equal(t, 3.0, level)
+	_, ok := state.Get("default/new", "predictive.level")
+	assert.False(t, ok)
+
+	// The replayed forecast has learned the daily peak
+	peak, ok := state.Get("default/llm", "predictive.season.12")
+	require.True(t, ok)
+	night, _ := state.Get("default/llm", "predictive.season.3")
+	assert.Greater(t, peak-night, 2.0)
+}
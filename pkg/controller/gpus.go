@@ -0,0 +1,73 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// GPUResourceName is the extended resource counted for per-GPU targets
+const GPUResourceName corev1.ResourceName = "nvidia.com/gpu"
+
+// discoverGPUsPerReplica reads the GPU count of each replica from the target's pod template
+func (r *AIInferenceAutoscalerPolicyReconciler) discoverGPUsPerReplica(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int32, error) {
+	template, err := r.getPodTemplate(ctx, policy)
+	if err != nil {
+		return 0, err
+	}
+	return podGPUs(&template.Spec), nil
+}
+
+// podGPUs returns the GPUs requested by a pod: the sum over its containers, or
+// the largest init container request if that is higher. Extended resources
+// may be set as limits only, in which case the limit is the request.
+func podGPUs(spec *corev1.PodSpec) int32 {
+	var total int64
+	for i := range spec.Containers {
+		total += containerGPUs(&spec.Containers[i])
+	}
+	for i := range spec.InitContainers {
+		total = max(total, containerGPUs(&spec.InitContainers[i]))
+	}
+	return int32(total) // #nosec G115 - GPU counts are small
+}
+
+func containerGPUs(c *corev1.Container) int64 {
+	if q, ok := c.Resources.Requests[GPUResourceName]; ok {
+		return q.Value()
+	}
+	if q, ok := c.Resources.Limits[GPUResourceName]; ok {
+		return q.Value()
+	}
+	return 0
+}
+
+// perReplicaTarget scales a per-GPU target to a per-replica target when
+// spec.targetsPerGPU is set. Replicas without GPUs count as one GPU.
+func perReplicaTarget(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, target *resource.Quantity) *resource.Quantity {
+	if !policy.Spec.TargetsPerGPU || target == nil {
+		return target
+	}
+	scaled := target.DeepCopy()
+	scaled.Mul(int64(max(policy.Status.GPUsPerReplica, 1)))
+	return &scaled
+}
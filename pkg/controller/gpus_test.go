@@ -0,0 +1,132 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func gpuContainer(name string, requests, limits int64) corev1.Container {
+	c := corev1.Container{Name: name}
+	if requests > 0 {
+		c.Resources.Requests = corev1.ResourceList{GPUResourceName: *resource.NewQuantity(requests, resource.DecimalSI)}
+	}
+	if limits > 0 {
+		c.Resources.Limits = corev1.ResourceList{GPUResourceName: *resource.NewQuantity(limits, resource.DecimalSI)}
+	}
+	return c
+}
+
+func TestPodGPUs(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     corev1.PodSpec
+		expected int32
+	}{
+		{
+			name:     "no GPUs",
+			spec:     corev1.PodSpec{Containers: []corev1.Container{{Name: "server"}}},
+			expected: 0,
+		},
+		{
+			name:     "limits only",
+			spec:     corev1.PodSpec{Containers: []corev1.Container{gpuContainer("server", 0, 8)}},
+			expected: 8,
+		},
+		{
+			name: "summed across containers",
+			spec: corev1.PodSpec{Containers: []corev1.Container{
+				gpuContainer("server", 4, 4),
+				gpuContainer("draft", 1, 0),
+				{Name: "proxy"},
+			}},
+			expected: 5,
+		},
+		{
+			name: "init container needing more",
+			spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{gpuContainer("warmup", 2, 2)},
+				Containers:     []corev1.Container{gpuContainer("server", 1, 1)},
+			},
+			expected: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, podGPUs(&tt.spec))
+		})
+	}
+}
+
+func TestPerGPUTargets(t *testing.T) {
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-70b", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{gpuContainer("vllm", 8, 8)}},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "llama-70b",
+			},
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("4"))},
+				TokensPerSecond:   &kubeaiv1alpha1.TokensPerSecondMetric{Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("250"))},
+			},
+			TargetsPerGPU: true,
+		},
+	}
+
+	gpus, err := r.discoverGPUsPerReplica(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(8), gpus)
+	policy.Status.GPUsPerReplica = gpus
+
+	// 2 replicas of 8 GPUs: targets of 32 queued requests and 2000 tokens/s per replica
+	current := &kubeaiv1alpha1.CurrentMetrics{RequestQueueDepth: 96, TokensPerSecond: 2000}
+	assert.Equal(t, []float64{1.5, 0.5}, r.buildMetricRatios(policy, 2, current))
+
+	// Without targetsPerGPU the targets apply per replica
+	policy.Spec.TargetsPerGPU = false
+	assert.Equal(t, []float64{12.0, 4.0}, r.buildMetricRatios(policy, 2, current))
+}
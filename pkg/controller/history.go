@@ -0,0 +1,124 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/features"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+const (
+	// MetricHistorySamples is how many samples of each metric status keeps
+	MetricHistorySamples = 20
+
+	// maxMetricHistories bounds how many metrics keep a history, so many
+	// custom metrics cannot grow the status without limit
+	maxMetricHistories = 16
+
+	// fractionalDecimals is the precision kept for metrics that are not whole numbers
+	fractionalDecimals = 3
+)
+
+// historySample is the current value of a metric to append to its history
+type historySample struct {
+	name     string
+	decimals int32
+	value    float64
+}
+
+// historySamples returns the current value of every metric enabled in the
+// policy, named as in status.currentMetrics. Unlike the ratios, metrics at
+// zero are kept: an idle queue is part of the trend.
+func historySamples(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, current *kubeaiv1alpha1.CurrentMetrics) []historySample {
+	var samples []historySample
+	add := func(name string, enabled bool, decimals int32, value float64) {
+		if enabled {
+			samples = append(samples, historySample{name: name, decimals: decimals, value: value})
+		}
+	}
+
+	spec := policy.Spec.Metrics
+	latency := spec.Latency != nil && spec.Latency.Enabled
+	add(scaling.MetricLatencyP99Ms, latency && kubeaiv1alpha1.QuantityFloat64(spec.Latency.TargetP99Ms) > 0, 0, current.LatencyP99Ms)
+	add(scaling.MetricLatencyP95Ms, latency && kubeaiv1alpha1.QuantityFloat64(spec.Latency.TargetP95Ms) > 0, 0, current.LatencyP95Ms)
+	add(scaling.MetricGPUUtilization, spec.GPUUtilization != nil && spec.GPUUtilization.Enabled, 0,
+		float64(current.GPUUtilizationPercent))
+	add(scaling.MetricCPUUtilization, spec.CPUUtilization != nil && spec.CPUUtilization.Enabled, 0,
+		float64(current.CPUUtilizationPercent))
+	add(scaling.MetricMemoryUtilization, spec.MemoryUtilization != nil && spec.MemoryUtilization.Enabled, 0,
+		float64(current.MemoryUtilizationPercent))
+	add(scaling.MetricRequestQueueDepth, spec.RequestQueueDepth != nil && spec.RequestQueueDepth.Enabled, 0,
+		float64(current.RequestQueueDepth))
+	add(scaling.MetricTokensPerSecond, spec.TokensPerSecond != nil && spec.TokensPerSecond.Enabled, 0,
+		float64(current.TokensPerSecond))
+	add(scaling.MetricInFlightRequests, spec.InFlightRequests != nil && spec.InFlightRequests.Enabled, 0,
+		float64(current.InFlightRequests))
+	add(scaling.MetricSyntheticLatencyMs, spec.SyntheticProbe != nil && spec.SyntheticProbe.Enabled, 0,
+		float64(current.SyntheticLatencyMs))
+	queueing := spec.Queueing != nil && spec.Queueing.Enabled
+	if current.ArrivalRate != nil {
+		add(scaling.MetricArrivalRate, queueing, fractionalDecimals, *current.ArrivalRate)
+	}
+	if current.ServiceTimeMs != nil {
+		add("serviceTimeMs", queueing, fractionalDecimals, *current.ServiceTimeMs)
+	}
+	for i := range spec.CustomMetrics {
+		name := spec.CustomMetrics[i].Name
+		if value, ok := customMetricValue(current, name); ok {
+			add(name, true, fractionalDecimals, value)
+		}
+	}
+	return samples
+}
+
+// recordMetricHistory appends the current metrics to their histories in
+// status, keeping the last MetricHistorySamples of each. Histories of metrics
+// no longer enabled are dropped, and all of them while the MetricHistory
+// feature gate is disabled.
+func (r *AIInferenceAutoscalerPolicyReconciler) recordMetricHistory(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, current *kubeaiv1alpha1.CurrentMetrics) {
+	if !r.Features.Enabled(features.MetricHistory) {
+		policy.Status.MetricHistory = nil
+		return
+	}
+	if current == nil {
+		return
+	}
+
+	previous := make(map[string]*kubeaiv1alpha1.MetricHistory, len(policy.Status.MetricHistory))
+	for i := range policy.Status.MetricHistory {
+		previous[policy.Status.MetricHistory[i].Name] = &policy.Status.MetricHistory[i]
+	}
+
+	var histories []kubeaiv1alpha1.MetricHistory
+	for _, sample := range historySamples(policy, current) {
+		if len(histories) == maxMetricHistories {
+			break
+		}
+		var values []float64
+		if history, ok := previous[sample.name]; ok && history.Decimals == sample.decimals {
+			// A history that cannot be decoded starts over
+			values, _ = history.Values()
+		}
+		values = append(values, sample.value)
+		if len(values) > MetricHistorySamples {
+			values = values[len(values)-MetricHistorySamples:]
+		}
+		histories = append(histories, kubeaiv1alpha1.NewMetricHistory(sample.name, sample.decimals, values))
+	}
+	policy.Status.MetricHistory = histories
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/features"
+)
+
+func historyValues(t *testing.T, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) map[string][]float64 {
+	t.Helper()
+	values := make(map[string][]float64)
+	for i := range policy.Status.MetricHistory {
+		history := &policy.Status.MetricHistory[i]
+		decoded, err := history.Values()
+		require.NoError(t, err)
+		values[history.Name] = decoded
+	}
+	return values
+}
+
+func TestRecordMetricHistory(t *testing.T) {
+	r := &AIInferenceAutoscalerPolicyReconciler{Features: features.Gates{features.MetricHistory: true}}
+	policy := lockTestPolicy("policy")
+	policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))}
+	policy.Spec.Metrics.CustomMetrics = []kubeaiv1alpha1.CustomMetric{{Name: "kv_cache", Query: "q", TargetValue: 0.8}}
+
+	for i := 0; i < MetricHistorySamples+5; i++ {
+		r.recordMetricHistory(policy, &kubeaiv1alpha1.CurrentMetrics{
+			GPUUtilizationPercent: int32(50 + i),
+			Custom:                []kubeaiv1alpha1.CustomMetricValue{{Name: "kv_cache", Value: 0.5}},
+		})
+	}
+
+	values := historyValues(t, policy)
+	require.Len(t, values["gpuUtilizationPercent"], MetricHistorySamples)
+	assert.Equal(t, 55.0, values["gpuUtilizationPercent"][0], "the oldest samples are dropped")
+	assert.Equal(t, 74.0, values["gpuUtilizationPercent"][MetricHistorySamples-1])
+	assert.Equal(t, make([]float64, MetricHistorySamples), values["requestQueueDepth"], "zero values are part of the trend")
+	assert.Equal(t, 0.5, values["kv_cache"][0])
+	assert.Equal(t, "55"+strings.Repeat(",1", MetricHistorySamples-1), policy.Status.MetricHistory[0].Samples)
+
+	// Metrics no longer enabled lose their history
+	policy.Spec.Metrics.RequestQueueDepth.Enabled = false
+	r.recordMetricHistory(policy, &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 75})
+	values = historyValues(t, policy)
+	assert.NotContains(t, values, "requestQueueDepth")
+	assert.NotContains(t, values, "kv_cache", "custom metrics without a value this reconcile are dropped")
+	assert.Equal(t, 75.0, values["gpuUtilizationPercent"][MetricHistorySamples-1])
+}
+
+func TestRecordMetricHistoryDisabled(t *testing.T) {
+	r := &AIInferenceAutoscalerPolicyReconciler{}
+	policy := lockTestPolicy("policy")
+	policy.Status.MetricHistory = []kubeaiv1alpha1.MetricHistory{{Name: "gpuUtilizationPercent", Samples: "70"}}
+
+	r.recordMetricHistory(policy, &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 80})
+	assert.Nil(t, policy.Status.MetricHistory, "the feature gate is off by default")
+}
+
+func TestRecordMetricHistoryCapsMetrics(t *testing.T) {
+	r := &AIInferenceAutoscalerPolicyReconciler{Features: features.Gates{features.MetricHistory: true}}
+	policy := lockTestPolicy("policy")
+	current := &kubeaiv1alpha1.CurrentMetrics{}
+	for i := 0; i < 2*maxMetricHistories; i++ {
+		name := string(rune('a'+i%26)) + string(rune('a'+i/26))
+		policy.Spec.Metrics.CustomMetrics = append(policy.Spec.Metrics.CustomMetrics,
+			kubeaiv1alpha1.CustomMetric{Name: name, Query: "q", TargetValue: 1})
+		current.Custom = append(current.Custom, kubeaiv1alpha1.CustomMetricValue{Name: name, Value: 1})
+	}
+
+	r.recordMetricHistory(policy, current)
+	assert.Len(t, policy.Status.MetricHistory, maxMetricHistories)
+	assert.Equal(t, "gpuUtilizationPercent", policy.Status.MetricHistory[0].Name, "built-in metrics come first")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultMetricsFetchTimeout bounds fetching all metrics of one reconcile,
+	// leaving the rest of the decision budget to the algorithm and the scale
+	DefaultMetricsFetchTimeout = 3 * time.Second
+
+	// maxConcurrentMetricQueries caps the queries one reconcile runs at once,
+	// so a policy with many custom metrics does not flood Prometheus
+	maxConcurrentMetricQueries = 8
+)
+
+// metricQuery is the result of one metric query of a fetch
+type metricQuery struct {
+	value float64
+	err   error
+}
+
+// metricFetch runs the metric queries of one reconcile concurrently under a
+// shared deadline. A query still running at the deadline is cancelled and
+// fails, so its metric is handled by its missing-data policy.
+type metricFetch struct {
+	ctx     context.Context
+	parent  context.Context
+	cancel  context.CancelFunc
+	timeout time.Duration
+	group   errgroup.Group
+}
+
+// newMetricFetch starts a fetch bounded by MetricsFetchTimeout (0 disables it)
+func (r *AIInferenceAutoscalerPolicyReconciler) newMetricFetch(ctx context.Context) *metricFetch {
+	f := &metricFetch{parent: ctx, timeout: r.MetricsFetchTimeout}
+	if f.timeout > 0 {
+		f.ctx, f.cancel = context.WithTimeout(ctx, f.timeout)
+	} else {
+		f.ctx, f.cancel = context.WithCancel(ctx)
+	}
+	f.group.SetLimit(maxConcurrentMetricQueries)
+	return f
+}
+
+// run starts query, storing its result in result once it returns. Queries
+// never fail the group: each failure is resolved on its own after wait.
+func (f *metricFetch) run(result *metricQuery, query func(ctx context.Context) (float64, error)) {
+	f.group.Go(func() error {
+		result.value, result.err = query(f.ctx)
+		if result.err != nil && f.ctx.Err() != nil && f.parent.Err() == nil {
+			result.err = fmt.Errorf("metrics fetch deadline of %s exceeded: %w", f.timeout, result.err)
+		}
+		return nil
+	})
+}
+
+// wait waits for every query and releases the deadline
+func (f *metricFetch) wait() {
+	_ = f.group.Wait()
+	f.cancel()
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// barrierClient holds every latency, GPU and queue query until all of them
+// are in flight, so it only answers queries that run concurrently
+type barrierClient struct {
+	*metrics.MockClient
+	once    sync.Once
+	arrived chan struct{}
+	all     chan struct{}
+	queries int
+}
+
+func newBarrierClient(queries int) *barrierClient {
+	return &barrierClient{
+		MockClient: &metrics.MockClient{LatencyP99Value: 0.3, GPUUtilizationValue: 80, QueueDepthValue: 4},
+		arrived:    make(chan struct{}, queries),
+		all:        make(chan struct{}),
+		queries:    queries,
+	}
+}
+
+func (c *barrierClient) wait(ctx context.Context) error {
+	c.arrived <- struct{}{}
+	if len(c.arrived) == c.queries {
+		c.once.Do(func() { close(c.all) })
+	}
+	select {
+	case <-c.all:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *barrierClient) GetMetric(ctx context.Context, query metrics.MetricQuery) (metrics.Sample, error) {
+	if err := c.wait(ctx); err != nil {
+		return metrics.Sample{}, err
+	}
+	return c.MockClient.GetMetric(ctx, query)
+}
+
+func fetchTestPolicy() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	policy := lockTestPolicy("policy")
+	policy.Spec.Metrics.Latency = &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))}
+	policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))}
+	return policy
+}
+
+func TestFetchMetricsConcurrently(t *testing.T) {
+	client := newBarrierClient(3)
+	r := NewReconciler(nil, newTestScheme(t), client, scaling.DefaultRegistry, nil)
+	r.MetricsFetchTimeout = 5 * time.Second
+
+	current, err := r.fetchMetrics(context.Background(), fetchTestPolicy())
+	require.NoError(t, err)
+	assert.Equal(t, 300.0, current.LatencyP99Ms)
+	assert.Equal(t, int32(80), current.GPUUtilizationPercent)
+	assert.Equal(t, int32(4), current.RequestQueueDepth)
+}
+
+// stuckGPUClient serves the mock values but GPU utilization queries never return
+type stuckGPUClient struct {
+	*metrics.MockClient
+}
+
+func (c *stuckGPUClient) GetMetric(ctx context.Context, query metrics.MetricQuery) (metrics.Sample, error) {
+	if query.Metric != metrics.MetricGPUUtilization {
+		return c.MockClient.GetMetric(ctx, query)
+	}
+	<-ctx.Done()
+	return metrics.Sample{}, ctx.Err()
+}
+
+func TestFetchMetricsDeadline(t *testing.T) {
+	client := &stuckGPUClient{MockClient: &metrics.MockClient{LatencyP99Value: 0.3, QueueDepthValue: 4}}
+	r := NewReconciler(nil, newTestScheme(t), client, scaling.DefaultRegistry, nil)
+	r.MetricsFetchTimeout = 50 * time.Millisecond
+
+	// The metrics that arrived in time are used; the stuck one is missing
+	current, err := r.fetchMetrics(context.Background(), fetchTestPolicy())
+	require.NoError(t, err)
+	assert.Equal(t, 300.0, current.LatencyP99Ms)
+	assert.Equal(t, int32(4), current.RequestQueueDepth)
+	assert.Zero(t, current.GPUUtilizationPercent)
+
+	policy := fetchTestPolicy()
+	policy.Spec.Metrics.GPUUtilization.OnMissing = MissingFailClosed
+	_, err = r.fetchMetrics(context.Background(), policy)
+	assert.ErrorContains(t, err, "metrics fetch deadline of 50ms exceeded")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+// rangeClient records the query range each GPU and custom metric query carries
+type rangeClient struct {
+	*metrics.MockClient
+	mu     sync.Mutex
+	ranges map[string]metrics.Range
+}
+
+func (c *rangeClient) record(ctx context.Context, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if r, ok := metrics.QueryRangeFrom(ctx); ok {
+		c.ranges[name] = r
+	}
+}
+
+func (c *rangeClient) GetMetric(ctx context.Context, query metrics.MetricQuery) (metrics.Sample, error) {
+	switch query.Metric {
+	case metrics.MetricGPUUtilization:
+		c.record(ctx, "gpu")
+	case metrics.MetricLatencyP99:
+		c.record(ctx, "latency")
+	case "":
+		c.record(ctx, query.Query)
+	}
+	return c.MockClient.GetMetric(ctx, query)
+}
+
+func TestFetchMetricsWindow(t *testing.T) {
+	client := &rangeClient{
+		MockClient: &metrics.MockClient{LatencyP99Value: 0.3, GPUUtilizationValue: 80, QueryValue: 5},
+		ranges:     map[string]metrics.Range{},
+	}
+	r := NewReconciler(nil, newTestScheme(t), client, scaling.DefaultRegistry, nil)
+
+	policy := fetchTestPolicy()
+	policy.Spec.Metrics.GPUUtilization.Window = &kubeaiv1alpha1.MetricWindow{Seconds: 300, StepSeconds: 30, Aggregation: "P90"}
+	policy.Spec.Metrics.CustomMetrics = []kubeaiv1alpha1.CustomMetric{{Name: "kv_cache", Query: "kv_cache_usage", TargetValue: 1,
+		Window: &kubeaiv1alpha1.MetricWindow{Seconds: 120, Aggregation: "Max"}}}
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(80), current.GPUUtilizationPercent)
+
+	assert.Equal(t, map[string]metrics.Range{
+		"gpu":            {Window: 5 * time.Minute, Step: 30 * time.Second, Aggregation: "P90"},
+		"kv_cache_usage": {Window: 2 * time.Minute, Aggregation: "Max"},
+	}, client.ranges, "latency sets no window and stays an instant query")
+}
@@ -0,0 +1,461 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// MetricSourcePrometheus reads metrics from a Prometheus-compatible server
+	MetricSourcePrometheus = "Prometheus"
+	// MetricSourcePodScrape reads metrics directly from the target pods
+	MetricSourcePodScrape = "PodScrape"
+	// MetricSourceCloudWatch reads metrics from Amazon CloudWatch
+	MetricSourceCloudWatch = "CloudWatch"
+	// MetricSourceCloudMonitoring reads metrics from Google Cloud Monitoring
+	MetricSourceCloudMonitoring = "CloudMonitoring"
+	// MetricSourceSQS reads the backlog of an Amazon SQS queue
+	MetricSourceSQS = "SQS"
+	// MetricSourceRabbitMQ reads the backlog of a RabbitMQ queue
+	MetricSourceRabbitMQ = "RabbitMQ"
+	// MetricSourceKafka reads the lag of a Kafka consumer group from the brokers or a REST Proxy
+	MetricSourceKafka = "Kafka"
+	// MetricSourceMetricsAPI reads the Kubernetes custom and external metrics APIs
+	MetricSourceMetricsAPI = "MetricsAPI"
+	// MetricSourceOTLP reads the metrics the target pods push to the controller's OTLP receiver
+	MetricSourceOTLP = "OTLP"
+
+	// metricSourceHealthTimeout bounds the health check of a single metric source
+	metricSourceHealthTimeout = 5 * time.Second
+)
+
+// MetricsClientFactory builds a metrics client for a policy's Prometheus endpoints
+type MetricsClientFactory func(addresses []string, merge string) (metrics.Client, error)
+
+// defaultMetricsClientFactory builds Prometheus clients for the given addresses
+func defaultMetricsClientFactory(addresses []string, merge string) (metrics.Client, error) {
+	return metrics.NewMultiPrometheusClient(addresses, merge, metrics.DefaultRetryConfig())
+}
+
+// PrometheusClientFactory returns a factory building Prometheus clients that
+// retry transient query failures according to retry, stop querying endpoints
+// that keep failing according to breaker and share results for cacheTTL
+func PrometheusClientFactory(retry metrics.RetryConfig, breaker metrics.BreakerConfig, cacheTTL time.Duration) MetricsClientFactory {
+	return func(addresses []string, merge string) (metrics.Client, error) {
+		c, err := metrics.NewMultiPrometheusClient(addresses, merge, retry)
+		if err != nil {
+			return nil, err
+		}
+		return WrapMetricsClient(c, addresses, breaker, cacheTTL), nil
+	}
+}
+
+// WrapMetricsClient wraps the client for the Prometheus addresses in a circuit
+// breaker and, outside it, a query cache, skipping those that are disabled
+func WrapMetricsClient(c metrics.Client, addresses []string, breaker metrics.BreakerConfig, cacheTTL time.Duration) metrics.Client {
+	if breaker.FailureThreshold > 0 {
+		c = metrics.NewCircuitBreakerClient(c, strings.Join(addresses, ","), breaker)
+	}
+	if cacheTTL > 0 {
+		c = metrics.NewCachingClient(c, cacheTTL)
+	}
+	return c
+}
+
+// metricsClientFor returns the metrics client for a policy. Policies without a
+// prometheus override use the controller-wide client; others get a cached
+// client that queries all of their configured endpoints.
+func (r *AIInferenceAutoscalerPolicyReconciler) metricsClientFor(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (metrics.Client, error) {
+	spec := policy.Spec.Prometheus
+	if spec == nil || len(spec.Addresses) == 0 {
+		return r.MetricsClient, nil
+	}
+
+	return r.cachedMetricsClient(policy, spec.Merge+"|"+strings.Join(spec.Addresses, ","), func() (metrics.Client, error) {
+		return r.metricsClientFactory()(spec.Addresses, spec.Merge)
+	})
+}
+
+// metricsClientFactory returns the configured factory or the default one
+func (r *AIInferenceAutoscalerPolicyReconciler) metricsClientFactory() MetricsClientFactory {
+	if r.NewMetricsClient != nil {
+		return r.NewMetricsClient
+	}
+	return defaultMetricsClientFactory
+}
+
+// cachedMetricsClient returns the client cached under key, building it on
+// first use, and records that the policy uses it
+func (r *AIInferenceAutoscalerPolicyReconciler) cachedMetricsClient(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, key string, build func() (metrics.Client, error)) (metrics.Client, error) {
+	r.metricsClientsMu.Lock()
+	defer r.metricsClientsMu.Unlock()
+	c, ok := r.metricsClients[key]
+	if !ok {
+		var err error
+		if c, err = build(); err != nil {
+			return nil, err
+		}
+		if r.metricsClients == nil {
+			r.metricsClients = make(map[string]metrics.Client)
+		}
+		r.metricsClients[key] = c
+	}
+
+	if r.metricsClientUsers == nil {
+		r.metricsClientUsers = make(map[string]sets.Set[string])
+	}
+	if r.metricsClientUsers[key] == nil {
+		r.metricsClientUsers[key] = sets.New[string]()
+	}
+	r.metricsClientUsers[key].Insert(fmt.Sprintf("%s/%s", policy.Namespace, policy.Name))
+	return c, nil
+}
+
+// forgetMetricsClients drops a deleted policy from the users of the cached
+// clients, and the clients no other policy uses
+func (r *AIInferenceAutoscalerPolicyReconciler) forgetMetricsClients(policyKey string) {
+	r.metricsClientsMu.Lock()
+	defer r.metricsClientsMu.Unlock()
+	for key, users := range r.metricsClientUsers {
+		users.Delete(policyKey)
+		if users.Len() == 0 {
+			delete(r.metricsClientUsers, key)
+			delete(r.metricsClients, key)
+		}
+	}
+}
+
+// selectMetricsClient returns the metrics client for this reconcile and the
+// name of the metric source it belongs to. With spec.metrics.sources set, the
+// first source that passes its health check wins; otherwise the policy's
+// prometheus override or the controller default is used and the name is empty.
+// MetricsOverride takes precedence over all of them.
+func (r *AIInferenceAutoscalerPolicyReconciler) selectMetricsClient(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (metrics.Client, string, error) {
+	if r.MetricsOverride != nil {
+		return r.MetricsOverride, "", nil
+	}
+
+	sources := policy.Spec.Metrics.Sources
+	if len(sources) == 0 {
+		c, err := r.metricsClientFor(policy)
+		return c, "", err
+	}
+
+	var errs []error
+	for i := range sources {
+		source := &sources[i]
+		c, err := r.metricSourceClient(policy, source)
+		if err == nil {
+			checkCtx, cancel := context.WithTimeout(ctx, metricSourceHealthTimeout)
+			err = metrics.CheckHealth(checkCtx, c)
+			cancel()
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source.Name, err))
+			continue
+		}
+		return c, source.Name, nil
+	}
+	return nil, "", fmt.Errorf("no healthy metric source: %w", errors.Join(errs...))
+}
+
+// metricSourceClient returns the cached client for one entry of spec.metrics.sources
+func (r *AIInferenceAutoscalerPolicyReconciler) metricSourceClient(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, source *kubeaiv1alpha1.MetricSource) (metrics.Client, error) {
+	switch source.Type {
+	case MetricSourcePrometheus:
+		return r.cachedMetricsClient(policy, "|"+source.Address, func() (metrics.Client, error) {
+			return r.metricsClientFactory()([]string{source.Address}, "")
+		})
+
+	case MetricSourcePodScrape:
+		path := source.Path
+		if path == "" {
+			path = "/metrics"
+		}
+		target := policy.DeepCopy()
+		key := fmt.Sprintf("pods|%s/%s|%s/%s|%d%s", policy.Namespace, policy.Name,
+			policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, source.Port, path)
+		return r.cachedMetricsClient(policy, key, func() (metrics.Client, error) {
+			return metrics.NewScrapeClient(func(ctx context.Context) ([]string, error) {
+				return r.podMetricsEndpoints(ctx, target, source.Port, path)
+			}), nil
+		})
+
+	case MetricSourceCloudWatch:
+		key := fmt.Sprintf("cloudwatch|%s|%s|%s", source.Region, source.RoleARN, source.Address)
+		return r.cachedMetricsClient(policy, key, func() (metrics.Client, error) {
+			credentials, err := r.awsCredentials(source)
+			if err != nil {
+				return nil, err
+			}
+			return metrics.NewCloudWatchClient(metrics.CloudWatchConfig{
+				Region:      source.Region,
+				Endpoint:    source.Address,
+				Credentials: credentials,
+			})
+		})
+
+	case MetricSourceCloudMonitoring:
+		key := fmt.Sprintf("cloudmonitoring|%s|%s|%s", source.Project, source.QueryLanguage, source.Address)
+		return r.cachedMetricsClient(policy, key, func() (metrics.Client, error) {
+			cfg := metrics.CloudMonitoringConfig{
+				Project:     source.Project,
+				Endpoint:    source.Address,
+				TokenSource: r.GoogleTokenSource,
+			}
+			if source.QueryLanguage == "MQL" {
+				return metrics.NewCloudMonitoringMQLClient(cfg)
+			}
+			return metrics.NewCloudMonitoringPromQLClient(cfg, metrics.DefaultRetryConfig())
+		})
+
+	case MetricSourceSQS:
+		key := fmt.Sprintf("sqs|%s|%s|%s", source.Region, source.RoleARN, source.Queue)
+		return r.cachedMetricsClient(policy, key, func() (metrics.Client, error) {
+			credentials, err := r.awsCredentials(source)
+			if err != nil {
+				return nil, err
+			}
+			backlog, err := metrics.NewSQSBacklog(source.Region, credentials)
+			if err != nil {
+				return nil, err
+			}
+			return metrics.NewQueueClient(backlog, source.Queue), nil
+		})
+
+	case MetricSourceRabbitMQ, MetricSourceKafka:
+		auth := r.secretBasicAuth(policy.Namespace, source.CredentialsSecret)
+		key := fmt.Sprintf("%s|%s|%s|%t|%s|%s|%s/%s", source.Type, source.Address, strings.Join(source.Brokers, ","),
+			source.TLS, source.Topic, source.Queue, policy.Namespace, source.CredentialsSecret)
+		return r.cachedMetricsClient(policy, key, func() (metrics.Client, error) {
+			var backlog metrics.BacklogReader
+			switch {
+			case source.Type == MetricSourceRabbitMQ:
+				backlog = metrics.NewRabbitMQBacklog(source.Address, auth)
+			case len(source.Brokers) > 0:
+				var tlsConfig *tls.Config
+				if source.TLS {
+					tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+				}
+				var err error
+				if backlog, err = metrics.NewKafkaBrokerBacklog(source.Brokers, source.Topic, tlsConfig, auth); err != nil {
+					return nil, err
+				}
+			default:
+				backlog = metrics.NewKafkaBacklog(source.Address, source.Topic, auth)
+			}
+			return metrics.NewQueueClient(backlog, source.Queue), nil
+		})
+
+	case MetricSourceMetricsAPI:
+		if r.RESTConfig == nil {
+			return nil, errors.New("the metrics APIs require the controller's Kubernetes configuration")
+		}
+		target := policy.DeepCopy()
+		key := fmt.Sprintf("metricsapi|%s/%s|%s/%s", policy.Namespace, policy.Name,
+			policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+		return r.cachedMetricsClient(policy, key, func() (metrics.Client, error) {
+			httpClient, err := rest.HTTPClientFor(r.RESTConfig)
+			if err != nil {
+				return nil, err
+			}
+			return metrics.NewMetricsAPIClient(metrics.MetricsAPIConfig{
+				Host:       r.RESTConfig.Host,
+				HTTPClient: httpClient,
+				Namespace:  target.Namespace,
+				PodSelector: func(ctx context.Context) (string, error) {
+					return r.targetPodSelector(ctx, target)
+				},
+			})
+		})
+
+	case MetricSourceOTLP:
+		if r.OTLPReceiver == nil {
+			return nil, errors.New("the controller is not receiving OTLP metrics; set --otlp-bind-address")
+		}
+		target := policy.DeepCopy()
+		key := fmt.Sprintf("otlp|%s/%s|%s/%s", policy.Namespace, policy.Name,
+			policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+		return r.cachedMetricsClient(policy, key, func() (metrics.Client, error) {
+			return r.OTLPReceiver.Client(target.Namespace, func(ctx context.Context) ([]string, error) {
+				return r.targetPodNames(ctx, target)
+			}), nil
+		})
+
+	default:
+		return nil, fmt.Errorf("unsupported metric source type: %s", source.Type)
+	}
+}
+
+// awsCredentials returns the credentials an AWS metric source signs requests
+// with: the controller's own, or those of the source's role assumed with them
+func (r *AIInferenceAutoscalerPolicyReconciler) awsCredentials(source *kubeaiv1alpha1.MetricSource) (metrics.AWSCredentialsProvider, error) {
+	credentials := r.AWSCredentials
+	if credentials == nil {
+		var err error
+		if credentials, err = metrics.DefaultAWSCredentials(source.Region); err != nil {
+			return nil, err
+		}
+	}
+	if source.RoleARN != "" {
+		credentials = metrics.NewCachingAWSCredentials(&metrics.AssumeRoleCredentials{
+			Base:    credentials,
+			RoleARN: source.RoleARN,
+			Region:  source.Region,
+		})
+	}
+	return credentials, nil
+}
+
+// secretBasicAuth returns the username and password keys of the named Secret,
+// read on every request so that rotated credentials are used; nil without a Secret
+func (r *AIInferenceAutoscalerPolicyReconciler) secretBasicAuth(namespace, name string) metrics.BasicAuthFunc {
+	if name == "" {
+		return nil
+	}
+	return func(ctx context.Context) (string, string, error) {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+			return "", "", fmt.Errorf("reading credentials secret %s/%s: %w", namespace, name, err)
+		}
+		return string(secret.Data["username"]), string(secret.Data["password"]), nil
+	}
+}
+
+// targetPodSelector returns the label selector of the target pods
+func (r *AIInferenceAutoscalerPolicyReconciler) targetPodSelector(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (string, error) {
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return "", err
+	}
+	if labelSelector == nil {
+		return "", fmt.Errorf("target %s/%s has no selector", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+	}
+	return labelSelector.String(), nil
+}
+
+// presetQueries returns the queries of the policy's metrics preset, restricted
+// to the target pods and keyed by metric; nil without a preset
+func (r *AIInferenceAutoscalerPolicyReconciler) presetQueries(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (map[string]string, error) {
+	if policy.Spec.Metrics.Preset == "" {
+		return nil, nil
+	}
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if labelSelector == nil {
+		return nil, fmt.Errorf("target %s/%s has no selector", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+	}
+	return metrics.PresetQueries(policy.Spec.Metrics.Preset, policy.Namespace, policy.Spec.Metrics.PresetModel, labelSelector)
+}
+
+// gpuUtilizationQuery returns the GPU utilization query of the policy: its own
+// query, the DCGM query scoped to the target pods, or the preset's query
+func (r *AIInferenceAutoscalerPolicyReconciler) gpuUtilizationQuery(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, preset map[string]string) (string, error) {
+	gpu := policy.Spec.Metrics.GPUUtilization
+	if gpu.PrometheusQuery != "" || !gpu.ScopeToTarget {
+		return presetQuery(gpu.PrometheusQuery, preset, metrics.MetricGPUUtilization), nil
+	}
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return "", err
+	}
+	if labelSelector == nil {
+		return "", fmt.Errorf("target %s/%s has no selector", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+	}
+	return metrics.DCGMTargetQuery(policy.Namespace, labelSelector)
+}
+
+// presetQuery returns query, or the preset's query of metric when it is empty
+func presetQuery(query string, preset map[string]string, metric string) string {
+	if query == "" {
+		return preset[metric]
+	}
+	return query
+}
+
+// runningTargetPods returns the running target pods
+func (r *AIInferenceAutoscalerPolicyReconciler) runningTargetPods(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) ([]*corev1.Pod, error) {
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if labelSelector == nil {
+		return nil, fmt.Errorf("target %s/%s has no selector", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(policy.Namespace), client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return nil, err
+	}
+	var running []*corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		running = append(running, pod)
+	}
+	return running, nil
+}
+
+// podMetricsEndpoints returns the metrics URLs of the running target pods
+func (r *AIInferenceAutoscalerPolicyReconciler) podMetricsEndpoints(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, port int32, path string) ([]string, error) {
+	pods, err := r.runningTargetPods(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []string
+	for _, pod := range pods {
+		if pod.Status.PodIP == "" {
+			continue
+		}
+		endpoints = append(endpoints, "http://"+net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port)))+path)
+	}
+	return endpoints, nil
+}
+
+// targetPodNames returns the names of the running target pods
+func (r *AIInferenceAutoscalerPolicyReconciler) targetPodNames(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) ([]string, error) {
+	pods, err := r.runningTargetPods(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(pods))
+	for i, pod := range pods {
+		names[i] = pod.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,537 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestMetricsClientForPolicy(t *testing.T) {
+	defaultClient := &metrics.MockClient{GPUUtilizationValue: 10}
+	r := NewReconciler(nil, nil, defaultClient, scaling.DefaultRegistry, nil)
+
+	calls := 0
+	r.NewMetricsClient = func(addresses []string, merge string) (metrics.Client, error) {
+		calls++
+		// First replica is down; the second keeps serving metrics
+		return metrics.NewMultiClient([]metrics.Client{
+			&metrics.MockClient{Error: errors.New("connection refused")},
+			&metrics.MockClient{GPUUtilizationValue: 75},
+		}, merge)
+	}
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 80},
+			},
+		},
+	}
+
+	// Without an override the controller-wide client is used
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), current.GPUUtilizationPercent)
+
+	policy.Spec.Prometheus = &kubeaiv1alpha1.PrometheusSpec{
+		Addresses: []string{"http://prometheus-0:9090", "http://prometheus-1:9090"},
+		Merge:     metrics.MergeMax,
+	}
+	current, err = r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(75), current.GPUUtilizationPercent)
+
+	// The client is reused across reconciles
+	_, err = r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMetricSourceFailover(t *testing.T) {
+	backends := map[string]*metrics.MockClient{
+		"http://prometheus:9090":   {GPUUtilizationValue: 60},
+		"http://thanos-query:9090": {GPUUtilizationValue: 65},
+	}
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(nil, nil, &metrics.MockClient{GPUUtilizationValue: 10}, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	r.NewMetricsClient = func(addresses []string, _ string) (metrics.Client, error) {
+		return backends[addresses[0]], nil
+	}
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 80},
+				Sources: []kubeaiv1alpha1.MetricSource{
+					{Name: "prometheus", Type: MetricSourcePrometheus, Address: "http://prometheus:9090"},
+					{Name: "thanos", Type: MetricSourcePrometheus, Address: "http://thanos-query:9090"},
+				},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	// The primary is healthy and wins; the first selection is not an event
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(60), current.GPUUtilizationPercent)
+	assert.Equal(t, "prometheus", policy.Status.MetricsSource)
+	assert.Empty(t, fakeRecorder.Events)
+
+	// The primary goes down and the secondary takes over
+	backends["http://prometheus:9090"].HealthError = errors.New("connection refused")
+	current, err = r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(65), current.GPUUtilizationPercent)
+	assert.Equal(t, "thanos", policy.Status.MetricsSource)
+	event := <-fakeRecorder.Events
+	assert.Contains(t, event, ReasonMetricsSourceChanged)
+	assert.Contains(t, event, "from prometheus to thanos")
+
+	// With every source down the reconcile fails instead of using stale data
+	backends["http://thanos-query:9090"].HealthError = errors.New("connection refused")
+	_, err = r.fetchMetrics(ctx, policy)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no healthy metric source")
+	assert.Contains(t, err.Error(), "thanos")
+
+	// In local development the override serves every policy regardless of its sources
+	r.MetricsOverride = &metrics.MockClient{GPUUtilizationValue: 42}
+	current, err = r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), current.GPUUtilizationPercent)
+	assert.Empty(t, policy.Status.MetricsSource)
+}
+
+func TestPodScrapeMetricSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/stats" {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = fmt.Fprintln(w, "# TYPE inference_request_queue_depth gauge")
+		_, _ = fmt.Fprintln(w, `inference_request_queue_depth{model="llama"} 7`)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	scheme := newTestScheme(t)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef:   kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			MaxReplicas: 10,
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("5"))},
+				Sources: []kubeaiv1alpha1.MetricSource{
+					{Name: "prometheus", Type: MetricSourcePrometheus, Address: "http://prometheus:9090"},
+					{Name: "pods", Type: MetricSourcePodScrape, Port: int32(port), Path: "/stats"},
+				},
+			},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	running := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm-a", Namespace: "default", Labels: map[string]string{"app": "llm"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: serverURL.Hostname()},
+	}
+	pending := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm-b", Namespace: "default", Labels: map[string]string{"app": "llm"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, running, pending).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	r.NewMetricsClient = func(_ []string, _ string) (metrics.Client, error) {
+		return &metrics.MockClient{HealthError: errors.New("connection refused")}, nil
+	}
+
+	// Prometheus is down, so the pods are scraped directly
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, "pods", policy.Status.MetricsSource)
+	assert.Equal(t, int32(7), current.RequestQueueDepth)
+}
+
+func TestCloudWatchMetricSource(t *testing.T) {
+	var expression string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.NoError(t, req.ParseForm())
+		expression = req.PostForm.Get("MetricDataQueries.member.1.Expression")
+		_, _ = fmt.Fprint(w, `<GetMetricDataResponse><GetMetricDataResult><MetricDataResults><member>
+			<Id>q</Id><Label>ApproximateNumberOfMessagesVisible</Label><StatusCode>Complete</StatusCode>
+			<Timestamps><member>2026-06-01T11:59:00Z</member></Timestamps><Values><member>42</member></Values>
+		</member></MetricDataResults></GetMetricDataResult></GetMetricDataResponse>`)
+	}))
+	defer server.Close()
+
+	query := `SELECT AVG(ApproximateNumberOfMessagesVisible) FROM SCHEMA("AWS/SQS", QueueName) WHERE QueueName = 'inference'`
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10")), PrometheusQuery: query},
+				Sources: []kubeaiv1alpha1.MetricSource{
+					{Name: "cloudwatch", Type: MetricSourceCloudWatch, Region: "us-east-1", Address: server.URL},
+				},
+			},
+		},
+	}
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	r.AWSCredentials = metrics.StaticAWSCredentials(metrics.AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, "cloudwatch", policy.Status.MetricsSource)
+	assert.Equal(t, int32(42), current.RequestQueueDepth)
+	assert.Equal(t, query, expression)
+}
+
+func TestCloudMonitoringMetricSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer ya29.test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch req.URL.Path {
+		case "/v1/projects/llm-prod/location/global/prometheus/api/v1/query":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprint(w, `{"status": "success", "data": {"resultType": "vector", "result": [
+				{"metric": {}, "value": [1780315200, "0.4"]}]}}`)
+		case "/v3/projects/llm-prod/timeSeries:query":
+			_, _ = fmt.Fprint(w, `{"timeSeriesData": [{"pointData": [
+				{"values": [{"int64Value": "12"}], "timeInterval": {"endTime": "2026-06-01T11:59:00Z"}}]}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	r.GoogleTokenSource = metrics.StaticGoogleToken("ya29.test")
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Latency: &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+				Sources: []kubeaiv1alpha1.MetricSource{
+					{Name: "gmp", Type: MetricSourceCloudMonitoring, Project: "llm-prod", Address: server.URL},
+				},
+			},
+		},
+	}
+
+	// PromQL sources run the default queries of the built-in metrics
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, "gmp", policy.Status.MetricsSource)
+	assert.Equal(t, 400.0, current.LatencyP99Ms)
+
+	policy.Spec.Metrics.Latency = nil
+	policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{
+		Enabled: true, TargetDepth: ptr.To(resource.MustParse("10")),
+		PrometheusQuery: `fetch k8s_container | metric 'workload.googleapis.com/queue_depth' | group_by [], sum(val())`,
+	}
+	policy.Spec.Metrics.Sources = []kubeaiv1alpha1.MetricSource{
+		{Name: "mql", Type: MetricSourceCloudMonitoring, Project: "llm-prod", QueryLanguage: "MQL", Address: server.URL},
+	}
+	current, err = r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, "mql", policy.Status.MetricsSource)
+	assert.Equal(t, int32(12), current.RequestQueueDepth)
+}
+
+func TestQueueMetricSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if user, password, ok := req.BasicAuth(); !ok || user != "autoscaler" || password != "rotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"name": "requests", "messages_ready": 60}`)
+	}))
+	defer server.Close()
+
+	scheme := newTestScheme(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "rabbitmq", Namespace: "default"},
+		Data:       map[string][]byte{"username": []byte("autoscaler"), "password": []byte("old")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "batch", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))},
+				Sources: []kubeaiv1alpha1.MetricSource{{
+					Name: "rabbitmq", Type: MetricSourceRabbitMQ, Address: server.URL,
+					Queue: "requests", CredentialsSecret: "rabbitmq",
+				}},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	_, err := r.fetchMetrics(ctx, policy)
+	assert.ErrorContains(t, err, "401")
+
+	// A rotated password is used without restarting the controller
+	secret.Data["password"] = []byte("rotated")
+	require.NoError(t, c.Update(ctx, secret))
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, "rabbitmq", policy.Status.MetricsSource)
+	assert.Equal(t, int32(60), current.RequestQueueDepth)
+}
+
+func TestMetricsAPIMetricSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/custom.metrics.k8s.io/v1beta2":
+			_, _ = fmt.Fprint(w, `{"kind": "APIResourceList"}`)
+		case "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/pods/*/vllm_num_requests_waiting":
+			assert.Equal(t, "app=llm", req.URL.Query().Get("labelSelector"))
+			_, _ = fmt.Fprint(w, `{"items": [{"value": "30"}, {"value": "10"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{
+					Enabled: true, TargetDepth: ptr.To(resource.MustParse("10")), PrometheusQuery: "pods/vllm_num_requests_waiting",
+				},
+				Sources: []kubeaiv1alpha1.MetricSource{{Name: "adapter", Type: MetricSourceMetricsAPI}},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	_, err := r.fetchMetrics(ctx, policy)
+	assert.ErrorContains(t, err, "Kubernetes configuration")
+
+	r.RESTConfig = &rest.Config{Host: server.URL}
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, "adapter", policy.Status.MetricsSource)
+	assert.Equal(t, int32(20), current.RequestQueueDepth)
+}
+
+func TestOTLPMetricSource(t *testing.T) {
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	pod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "llm"}},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(deployment, pod("llm-0", corev1.PodRunning), pod("llm-1", corev1.PodSucceeded)).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))},
+				Sources:           []kubeaiv1alpha1.MetricSource{{Name: "push", Type: MetricSourceOTLP}},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	_, err := r.fetchMetrics(ctx, policy)
+	assert.ErrorContains(t, err, "--otlp-bind-address")
+
+	r.OTLPReceiver = metrics.NewOTLPReceiver()
+	_, err = r.fetchMetrics(ctx, policy)
+	assert.ErrorContains(t, err, "no OTLP metrics received")
+
+	// Both pods push their queue depth; only the running one is a target pod
+	for name, depth := range map[string]int{"llm-0": 12, "llm-1": 40} {
+		body := fmt.Sprintf(`{"resourceMetrics": [{
+			"resource": {"attributes": [
+				{"key": "k8s.namespace.name", "value": {"stringValue": "default"}},
+				{"key": "k8s.pod.name", "value": {"stringValue": %q}}]},
+			"scopeMetrics": [{"metrics": [{"name": "inference_request_queue_depth",
+				"gauge": {"dataPoints": [{"timeUnixNano": "1777896000000000000", "asInt": "%d"}]}}]}]}]}`, name, depth)
+		req := httptest.NewRequest(http.MethodPost, metrics.OTLPMetricsPath, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.OTLPReceiver.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	}
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, "push", policy.Status.MetricsSource)
+	assert.Equal(t, int32(12), current.RequestQueueDepth)
+}
+
+// queryRecorder records the query each built-in metric is fetched with
+type queryRecorder struct {
+	*metrics.MockClient
+	mu      sync.Mutex
+	queries map[string]string
+}
+
+func (c *queryRecorder) GetMetric(ctx context.Context, query metrics.MetricQuery) (metrics.Sample, error) {
+	c.mu.Lock()
+	c.queries[query.Metric] = query.Query
+	c.mu.Unlock()
+	return c.MockClient.GetMetric(ctx, query)
+}
+
+func TestMetricsPreset(t *testing.T) {
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	recorder := &queryRecorder{MockClient: &metrics.MockClient{QueueDepthValue: 4}, queries: map[string]string{}}
+	r := NewReconciler(c, scheme, recorder, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Preset:            metrics.MetricsPresetVLLM,
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))},
+				InFlightRequests: &kubeaiv1alpha1.InFlightRequestsMetric{
+					Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("8")), PrometheusQuery: "sum(my_requests_running)",
+				},
+				TokensPerSecond: &kubeaiv1alpha1.TokensPerSecondMetric{
+					Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("250")), Preset: metrics.TokensPresetTGI,
+				},
+			},
+		},
+	}
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), current.RequestQueueDepth)
+	assert.Equal(t, `sum(vllm:num_requests_waiting{namespace="default"} * on (namespace, pod) group_left () `+
+		`max by (namespace, pod) (kube_pod_labels{namespace="default",label_app="llm"}))`, recorder.queries[metrics.MetricQueueDepth])
+	// A query set on the metric, or another runtime's tokens preset, takes precedence
+	assert.Equal(t, "sum(my_requests_running)", recorder.queries[metrics.MetricInFlightRequests])
+	assert.Equal(t, metrics.TokensPerSecondQuery(metrics.TokensPresetTGI), recorder.queries[metrics.MetricTokensPerSecond])
+
+	// A tokens preset naming the same runtime uses the scoped query
+	policy.Spec.Metrics.Preset = metrics.MetricsPresetTGI
+	_, err = r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, `sum(rate(tgi_request_generated_tokens_sum{namespace="default"}[$__window]) * on (namespace, pod) group_left () `+
+		`max by (namespace, pod) (kube_pod_labels{namespace="default",label_app="llm"}))`, recorder.queries[metrics.MetricTokensPerSecond])
+
+	policy.Spec.TargetRef.Name = "missing"
+	_, err = r.fetchMetrics(context.Background(), policy)
+	assert.ErrorContains(t, err, "failed to resolve metrics preset")
+}
+
+func TestGPUUtilizationScopedToTarget(t *testing.T) {
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	recorder := &queryRecorder{MockClient: &metrics.MockClient{GPUUtilizationValue: 60}, queries: map[string]string{}}
+	r := NewReconciler(c, scheme, recorder, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Preset:         metrics.MetricsPresetVLLM,
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 80, ScopeToTarget: true},
+			},
+		},
+	}
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(60), current.GPUUtilizationPercent)
+	// The scoped DCGM query takes precedence over the preset
+	assert.Equal(t, `avg(DCGM_FI_DEV_GPU_UTIL{exported_namespace="default"} * on (exported_namespace, exported_pod) group_left () `+
+		`max by (exported_namespace, exported_pod) (label_replace(label_replace(kube_pod_labels{namespace="default",label_app="llm"}, `+
+		`"exported_namespace", "$1", "namespace", "(.*)"), "exported_pod", "$1", "pod", "(.*)")))`, recorder.queries[metrics.MetricGPUUtilization])
+
+	// but not over a query set on the metric
+	policy.Spec.Metrics.GPUUtilization.PrometheusQuery = "avg(my_gpu_util)"
+	_, err = r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, "avg(my_gpu_util)", recorder.queries[metrics.MetricGPUUtilization])
+
+	policy.Spec.Metrics.Preset = ""
+	policy.Spec.Metrics.GPUUtilization.PrometheusQuery = ""
+	policy.Spec.TargetRef.Name = "missing"
+	_, err = r.fetchMetrics(context.Background(), policy)
+	assert.ErrorContains(t, err, "failed to resolve GPU utilization query")
+}
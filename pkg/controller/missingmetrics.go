@@ -0,0 +1,145 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+// Missing-data policies of a metric whose query fails
+const (
+	// MissingIgnore leaves the metric out of the decision. The remaining
+	// metrics decide alone, which can read as a scale-down signal.
+	MissingIgnore = "Ignore"
+	// MissingFailClosed fails the whole fetch, so replicas are held or
+	// spec.fallback applies
+	MissingFailClosed = "FailClosed"
+	// MissingUseLastValue reuses the last value fetched for the metric until
+	// it is older than maxStalenessSeconds, then fails closed
+	MissingUseLastValue = "UseLastValue"
+)
+
+// DefaultMaxStaleness is how old a value UseLastValue reuses when
+// maxStalenessSeconds is unset
+const DefaultMaxStaleness = 5 * time.Minute
+
+// timestampedMetric is a metric value and when it was fetched
+type timestampedMetric struct {
+	value     float64
+	timestamp time.Time
+}
+
+// missingMetrics applies the missing-data policies of one fetch and tracks
+// whether the fetch as a whole failed
+type missingMetrics struct {
+	r         *AIInferenceAutoscalerPolicyReconciler
+	policy    *kubeaiv1alpha1.AIInferenceAutoscalerPolicy
+	policyKey string
+	now       time.Time
+
+	queries    int
+	unresolved int
+	lastErr    error
+	failClosed []string
+}
+
+// newMissingMetrics starts resolving the metrics of a fetch for the policy
+func (r *AIInferenceAutoscalerPolicyReconciler) newMissingMetrics(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) *missingMetrics {
+	return &missingMetrics{
+		r:         r,
+		policy:    policy,
+		policyKey: fmt.Sprintf("%s/%s", policy.Namespace, policy.Name),
+		now:       r.now(),
+	}
+}
+
+// resolve returns the value to use for the named metric and whether to use
+// it. A fetched value is remembered for UseLastValue; a failed query is
+// handled according to onMissing.
+func (m *missingMetrics) resolve(name, onMissing string, maxStalenessSeconds int32, value float64, err error) (float64, bool) {
+	m.queries++
+	if err == nil {
+		m.r.rememberMetric(m.policyKey, name, value, m.now)
+		return value, true
+	}
+
+	switch onMissing {
+	case MissingUseLastValue:
+		maxStaleness := DefaultMaxStaleness
+		if maxStalenessSeconds > 0 {
+			maxStaleness = time.Duration(maxStalenessSeconds) * time.Second
+		}
+		if last, ok := m.r.lastMetric(m.policyKey, name); ok && m.now.Sub(last.timestamp) <= maxStaleness {
+			metrics.RecordMetricMissing(m.policy.Namespace, m.policy.Name, name, "lastValue")
+			return last.value, true
+		}
+		fallthrough
+	case MissingFailClosed:
+		metrics.RecordMetricMissing(m.policy.Namespace, m.policy.Name, name, "failClosed")
+		m.failClosed = append(m.failClosed, name)
+	default:
+		metrics.RecordMetricMissing(m.policy.Namespace, m.policy.Name, name, "ignored")
+	}
+	m.unresolved++
+	m.lastErr = err
+	return 0, false
+}
+
+// err returns the error failing the fetch: every query failed with no last
+// value to stand in, or a metric configured to fail closed is missing
+func (m *missingMetrics) err() error {
+	if m.queries > 0 && m.unresolved == m.queries {
+		return fmt.Errorf("all %d metric queries failed: %w", m.queries, m.lastErr)
+	}
+	if len(m.failClosed) > 0 {
+		return fmt.Errorf("metrics %s are missing and fail closed: %w", strings.Join(m.failClosed, ", "), m.lastErr)
+	}
+	return nil
+}
+
+// rememberMetric records the last fetched value of a policy's metric
+func (r *AIInferenceAutoscalerPolicyReconciler) rememberMetric(policyKey, name string, value float64, now time.Time) {
+	r.lastMetricsMu.Lock()
+	defer r.lastMetricsMu.Unlock()
+	if r.lastMetrics == nil {
+		r.lastMetrics = make(map[string]map[string]timestampedMetric)
+	}
+	if r.lastMetrics[policyKey] == nil {
+		r.lastMetrics[policyKey] = make(map[string]timestampedMetric)
+	}
+	r.lastMetrics[policyKey][name] = timestampedMetric{value: value, timestamp: now}
+}
+
+// lastMetric returns the last fetched value of a policy's metric
+func (r *AIInferenceAutoscalerPolicyReconciler) lastMetric(policyKey, name string) (timestampedMetric, bool) {
+	r.lastMetricsMu.Lock()
+	defer r.lastMetricsMu.Unlock()
+	last, ok := r.lastMetrics[policyKey][name]
+	return last, ok
+}
+
+// forgetLastMetrics drops the last fetched values of a deleted policy
+func (r *AIInferenceAutoscalerPolicyReconciler) forgetLastMetrics(policyKey string) {
+	r.lastMetricsMu.Lock()
+	defer r.lastMetricsMu.Unlock()
+	delete(r.lastMetrics, policyKey)
+}
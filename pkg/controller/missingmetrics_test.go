@@ -0,0 +1,112 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// gpuOutageClient serves the mock values but fails GPU utilization queries with gpuErr
+type gpuOutageClient struct {
+	*metrics.MockClient
+	gpuErr error
+}
+
+func (c *gpuOutageClient) GetMetric(ctx context.Context, query metrics.MetricQuery) (metrics.Sample, error) {
+	if query.Metric == metrics.MetricGPUUtilization && c.gpuErr != nil {
+		return metrics.Sample{}, c.gpuErr
+	}
+	return c.MockClient.GetMetric(ctx, query)
+}
+
+func TestFetchMetricsOnMissing(t *testing.T) {
+	tests := []struct {
+		name      string
+		onMissing string
+		// elapsed is the time between the last successful fetch and the failed one
+		elapsed time.Duration
+		wantGPU int32
+		wantErr bool
+	}{
+		{name: "ignore leaves metric out", onMissing: MissingIgnore, wantGPU: 0},
+		{name: "unset ignores", onMissing: "", wantGPU: 0},
+		{name: "fail closed", onMissing: MissingFailClosed, wantErr: true},
+		{name: "last value reused", onMissing: MissingUseLastValue, elapsed: time.Minute, wantGPU: 80},
+		{name: "stale last value fails closed", onMissing: MissingUseLastValue, elapsed: 10 * time.Minute, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &gpuOutageClient{MockClient: &metrics.MockClient{GPUUtilizationValue: 80, QueueDepthValue: 4}}
+			r := NewReconciler(nil, newTestScheme(t), client, scaling.DefaultRegistry, nil)
+			fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+			r.Clock = fakeClock
+			policy := lockTestPolicy("policy")
+			policy.Spec.Metrics.GPUUtilization.OnMissing = tt.onMissing
+			policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))}
+			ctx := context.Background()
+
+			_, err := r.fetchMetrics(ctx, policy)
+			require.NoError(t, err)
+
+			client.gpuErr = errors.New("query timed out")
+			fakeClock.SetTime(fakeClock.Now().Add(tt.elapsed))
+			current, err := r.fetchMetrics(ctx, policy)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, scaling.MetricGPUUtilization)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantGPU, current.GPUUtilizationPercent)
+			assert.Equal(t, int32(4), current.RequestQueueDepth)
+		})
+	}
+}
+
+func TestFetchMetricsAllQueriesFailed(t *testing.T) {
+	client := &gpuOutageClient{MockClient: &metrics.MockClient{GPUUtilizationValue: 80}}
+	r := NewReconciler(nil, newTestScheme(t), client, scaling.DefaultRegistry, nil)
+	policy := lockTestPolicy("policy")
+	policy.Spec.Metrics.GPUUtilization.OnMissing = MissingUseLastValue
+	ctx := context.Background()
+
+	_, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+
+	// A last value stands in for the failed query, so the fetch succeeds
+	client.gpuErr = errors.New("connection refused")
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(80), current.GPUUtilizationPercent)
+
+	// Without one the source is unavailable
+	r.forgetLastMetrics("default/policy")
+	_, err = r.fetchMetrics(ctx, policy)
+	assert.ErrorContains(t, err, "all 1 metric queries failed")
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// ModelSizeAnnotation on a target declares the parameter count of its model, such as "70B" or "500M"
+	ModelSizeAnnotation = "kubeai.io/model-size"
+	// ModelQuantizationAnnotation on a target declares the quantization of its model, such as "fp16" or "int4"
+	ModelQuantizationAnnotation = "kubeai.io/model-quantization"
+	// ModelContextLengthAnnotation on a target declares the context length of its model, such as "8192" or "128k"
+	ModelContextLengthAnnotation = "kubeai.io/model-context-length"
+
+	// ModelProfilesKey is the ConfigMap data key holding the model profile table as YAML
+	ModelProfilesKey = "profiles.yaml"
+)
+
+// ModelMetadata describes the model a target serves, as declared by its annotations
+type ModelMetadata struct {
+	// ParametersBillions is the parameter count in billions; zero when not declared
+	ParametersBillions float64
+	// Quantization is the lower-cased quantization; empty when not declared
+	Quantization string
+	// ContextLength is the context length in tokens; zero when not declared
+	ContextLength int64
+}
+
+// ModelProfile holds the tuned defaults for the models it matches. A model
+// matches when it satisfies every criterion the profile sets.
+type ModelProfile struct {
+	// Name identifies the profile in status.modelProfile
+	Name string `json:"name"`
+
+	// MinParametersBillions is the smallest matching model, inclusive
+	MinParametersBillions float64 `json:"minParametersBillions,omitempty"`
+	// MaxParametersBillions is the largest matching model, exclusive
+	MaxParametersBillions float64 `json:"maxParametersBillions,omitempty"`
+	// Quantizations lists the matching quantizations
+	Quantizations []string `json:"quantizations,omitempty"`
+	// MinContextLength is the shortest matching context length
+	MinContextLength int64 `json:"minContextLength,omitempty"`
+
+	// Targets are the defaults for enabled metrics that set no target
+	Targets kubeaiv1alpha1.MetricTargets `json:"targets,omitempty"`
+	// WarmupSeconds is how long new replicas take to load the model; the
+	// cooldown between scaling events is raised to at least this long
+	WarmupSeconds int32 `json:"warmupSeconds,omitempty"`
+	// ScaleUp is the scale-up behavior of policies that configure none
+	ScaleUp *kubeaiv1alpha1.ScaleBehavior `json:"scaleUp,omitempty"`
+	// ScaleDown is the scale-down behavior of policies that configure none
+	ScaleDown *kubeaiv1alpha1.ScaleBehavior `json:"scaleDown,omitempty"`
+}
+
+// DefaultModelProfiles is the built-in profile table used when no ConfigMap
+// provides one. Larger models take longer to load and are costlier to bring
+// back, so they wait longer after scaling and give up replicas more slowly.
+var DefaultModelProfiles = []ModelProfile{
+	{
+		Name:                  "large-long-context",
+		MinParametersBillions: 40,
+		MinContextLength:      65536,
+		Targets: kubeaiv1alpha1.MetricTargets{
+			LatencyP99Ms:          resource.NewQuantity(4000, resource.DecimalSI),
+			GPUUtilizationPercent: 60,
+			RequestQueueDepth:     resource.NewQuantity(1, resource.DecimalSI),
+		},
+		WarmupSeconds: 900,
+		ScaleDown: &kubeaiv1alpha1.ScaleBehavior{
+			StabilizationWindowSeconds: 1200,
+			Policies:                   []kubeaiv1alpha1.ScalingPolicy{{Type: ScalingPolicyPods, Value: 1, PeriodSeconds: 600}},
+		},
+	},
+	{
+		Name:                  "large-quantized",
+		MinParametersBillions: 40,
+		Quantizations:         []string{"int4", "int8", "fp8", "awq", "gptq"},
+		Targets: kubeaiv1alpha1.MetricTargets{
+			LatencyP99Ms:          resource.NewQuantity(2000, resource.DecimalSI),
+			GPUUtilizationPercent: 70,
+			RequestQueueDepth:     resource.NewQuantity(2, resource.DecimalSI),
+		},
+		WarmupSeconds: 300,
+		ScaleDown: &kubeaiv1alpha1.ScaleBehavior{
+			StabilizationWindowSeconds: 600,
+			Policies:                   []kubeaiv1alpha1.ScalingPolicy{{Type: ScalingPolicyPods, Value: 1, PeriodSeconds: 300}},
+		},
+	},
+	{
+		Name:                  "large",
+		MinParametersBillions: 40,
+		Targets: kubeaiv1alpha1.MetricTargets{
+			LatencyP99Ms:          resource.NewQuantity(2000, resource.DecimalSI),
+			GPUUtilizationPercent: 65,
+			RequestQueueDepth:     resource.NewQuantity(2, resource.DecimalSI),
+		},
+		WarmupSeconds: 600,
+		ScaleDown: &kubeaiv1alpha1.ScaleBehavior{
+			StabilizationWindowSeconds: 900,
+			Policies:                   []kubeaiv1alpha1.ScalingPolicy{{Type: ScalingPolicyPods, Value: 1, PeriodSeconds: 300}},
+		},
+	},
+	{
+		Name:                  "medium",
+		MinParametersBillions: 13,
+		MaxParametersBillions: 40,
+		Targets: kubeaiv1alpha1.MetricTargets{
+			LatencyP99Ms:          resource.NewQuantity(1000, resource.DecimalSI),
+			GPUUtilizationPercent: 70,
+			RequestQueueDepth:     resource.NewQuantity(4, resource.DecimalSI),
+		},
+		WarmupSeconds: 180,
+		ScaleDown: &kubeaiv1alpha1.ScaleBehavior{
+			StabilizationWindowSeconds: 600,
+			Policies:                   []kubeaiv1alpha1.ScalingPolicy{{Type: ScalingPolicyPercent, Value: 25, PeriodSeconds: 120}},
+		},
+	},
+	{
+		Name:                  "small",
+		MaxParametersBillions: 13,
+		Targets: kubeaiv1alpha1.MetricTargets{
+			LatencyP99Ms:          resource.NewQuantity(500, resource.DecimalSI),
+			GPUUtilizationPercent: 75,
+			RequestQueueDepth:     resource.NewQuantity(8, resource.DecimalSI),
+		},
+		WarmupSeconds: 60,
+		ScaleDown:     &kubeaiv1alpha1.ScaleBehavior{StabilizationWindowSeconds: 300},
+	},
+}
+
+// Matches reports whether the model satisfies every criterion of the profile
+func (p *ModelProfile) Matches(model ModelMetadata) bool {
+	if p.MinParametersBillions > 0 && model.ParametersBillions < p.MinParametersBillions {
+		return false
+	}
+	if p.MaxParametersBillions > 0 && (model.ParametersBillions == 0 || model.ParametersBillions >= p.MaxParametersBillions) {
+		return false
+	}
+	if p.MinContextLength > 0 && model.ContextLength < p.MinContextLength {
+		return false
+	}
+	if len(p.Quantizations) == 0 {
+		return true
+	}
+	for _, q := range p.Quantizations {
+		if strings.EqualFold(q, model.Quantization) {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectModelProfile returns the first profile of the table matching the model, or nil
+func SelectModelProfile(profiles []ModelProfile, model ModelMetadata) *ModelProfile {
+	for i := range profiles {
+		if profiles[i].Matches(model) {
+			return &profiles[i]
+		}
+	}
+	return nil
+}
+
+// ParseModelProfiles decodes a YAML list of profiles
+func ParseModelProfiles(data []byte) ([]ModelProfile, error) {
+	var profiles []ModelProfile
+	if err := yaml.UnmarshalStrict(data, &profiles); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(profiles))
+	for i, p := range profiles {
+		if p.Name == "" {
+			return nil, fmt.Errorf("profile %d has no name", i)
+		}
+		if names[p.Name] {
+			return nil, fmt.Errorf("duplicate profile %q", p.Name)
+		}
+		names[p.Name] = true
+		for _, behavior := range []*kubeaiv1alpha1.ScaleBehavior{p.ScaleUp, p.ScaleDown} {
+			if directionDisabled(behavior) || (behavior != nil && behavior.Algorithm != "") {
+				return nil, fmt.Errorf("profile %q: scale behaviors may only set stabilizationWindowSeconds, policies and selectPolicy Max or Min", p.Name)
+			}
+		}
+	}
+	return profiles, nil
+}
+
+// parseModelMetadata reads the model annotations; ok is false when none is set
+func parseModelMetadata(annotations map[string]string) (model ModelMetadata, ok bool, err error) {
+	if value, set := annotations[ModelSizeAnnotation]; set {
+		ok = true
+		if model.ParametersBillions, err = parseModelSize(value); err != nil {
+			return model, ok, fmt.Errorf("annotation %s: %w", ModelSizeAnnotation, err)
+		}
+	}
+	if value, set := annotations[ModelQuantizationAnnotation]; set {
+		ok = true
+		model.Quantization = strings.ToLower(strings.TrimSpace(value))
+	}
+	if value, set := annotations[ModelContextLengthAnnotation]; set {
+		ok = true
+		if model.ContextLength, err = parseContextLength(value); err != nil {
+			return model, ok, fmt.Errorf("annotation %s: %w", ModelContextLengthAnnotation, err)
+		}
+	}
+	return model, ok, nil
+}
+
+// parseModelSize parses a parameter count such as "70B", "1.5b", "500M" or
+// "1T" into billions; a bare number is taken as billions
+func parseModelSize(value string) (float64, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	scale := 1.0
+	switch {
+	case strings.HasSuffix(s, "T"):
+		scale, s = 1000, strings.TrimSuffix(s, "T")
+	case strings.HasSuffix(s, "B"):
+		s = strings.TrimSuffix(s, "B")
+	case strings.HasSuffix(s, "M"):
+		scale, s = 0.001, strings.TrimSuffix(s, "M")
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a model size", value)
+	}
+	return n * scale, nil
+}
+
+// parseContextLength parses a token count such as "8192", "128k" or "1M",
+// where k and M are powers of 1024 as model cards use them
+func parseContextLength(value string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	scale := int64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		scale, s = 1024, strings.TrimSuffix(s, "K")
+	case strings.HasSuffix(s, "M"):
+		scale, s = 1024*1024, strings.TrimSuffix(s, "M")
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a context length", value)
+	}
+	return n * scale, nil
+}
+
+// modelMetadata reads the model annotations of the policy's target
+func (r *AIInferenceAutoscalerPolicyReconciler) modelMetadata(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (ModelMetadata, bool, error) {
+	obj, err := r.targetObject(policy)
+	if err != nil {
+		return ModelMetadata{}, false, err
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return ModelMetadata{}, false, err
+	}
+	return parseModelMetadata(obj.GetAnnotations())
+}
+
+// modelProfiles returns the profile table of the controller: the one in
+// ModelProfilesConfigMap when set, otherwise the built-in one. A ConfigMap
+// that cannot be read falls back to the built-in table.
+func (r *AIInferenceAutoscalerPolicyReconciler) modelProfiles(ctx context.Context) []ModelProfile {
+	ref := r.ModelProfilesConfigMap
+	if ref.Name == "" {
+		return DefaultModelProfiles
+	}
+	cm := &corev1.ConfigMap{}
+	if err := r.Get(ctx, ref, cm); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read model profiles, using the built-in profiles", "configMap", ref)
+		return DefaultModelProfiles
+	}
+	profiles, err := ParseModelProfiles([]byte(cm.Data[ModelProfilesKey]))
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Invalid model profiles, using the built-in profiles", "configMap", ref, "key", ModelProfilesKey)
+		return DefaultModelProfiles
+	}
+	return profiles
+}
+
+// applyModelProfile selects the profile for the model the target declares,
+// records it in status and returns the policy to base the scaling decision on,
+// with the settings the policy leaves unset filled from the profile. The
+// returned policy is a copy when a profile applies, so the stored spec is
+// never modified.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyModelProfile(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	logger := log.FromContext(ctx)
+	model, ok, err := r.modelMetadata(ctx, policy)
+	if err != nil {
+		// Keep the last profile rather than changing the decision on a transient error
+		logger.Error(err, "Failed to read model metadata, keeping the last model profile",
+			"modelProfile", policy.Status.ModelProfile)
+		return withModelProfile(policy, findModelProfile(r.modelProfiles(ctx), policy.Status.ModelProfile))
+	}
+
+	var profile *ModelProfile
+	if ok {
+		profile = SelectModelProfile(r.modelProfiles(ctx), model)
+	}
+	name := ""
+	if profile != nil {
+		name = profile.Name
+	}
+	if name != policy.Status.ModelProfile {
+		logger.Info("Model profile changed", "from", policy.Status.ModelProfile, "to", name,
+			"parametersBillions", model.ParametersBillions, "quantization", model.Quantization, "contextLength", model.ContextLength)
+		if name != "" && r.EventRecorder != nil {
+			r.EventRecorder.RecordModelProfileSelected(policy, name)
+		}
+	}
+	policy.Status.ModelProfile = name
+	return withModelProfile(policy, profile)
+}
+
+// withModelProfile returns a copy of the policy with the defaults of the
+// profile applied, or the policy itself without a profile
+func withModelProfile(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, profile *ModelProfile) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	if profile == nil {
+		return policy
+	}
+	decision := policy.DeepCopy()
+	applyModelProfileDefaults(decision, profile)
+	return decision
+}
+
+// findModelProfile returns the profile with the given name, or nil
+func findModelProfile(profiles []ModelProfile, name string) *ModelProfile {
+	if name == "" {
+		return nil
+	}
+	for i := range profiles {
+		if profiles[i].Name == name {
+			return &profiles[i]
+		}
+	}
+	return nil
+}
+
+// applyModelProfileDefaults fills the metric targets the enabled metrics
+// leave at zero, the scale behavior of directions without one and raises the
+// cooldown to the model's warmup
+func applyModelProfileDefaults(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, profile *ModelProfile) {
+	spec := &policy.Spec
+	targets := profile.Targets.DeepCopy()
+	if l := spec.Metrics.Latency; l != nil && l.Enabled && kubeaiv1alpha1.QuantityFloat64(l.TargetP99Ms) == 0 && kubeaiv1alpha1.QuantityFloat64(l.TargetP95Ms) == 0 {
+		l.TargetP99Ms, l.TargetP95Ms = targets.LatencyP99Ms, targets.LatencyP95Ms
+	}
+	if g := spec.Metrics.GPUUtilization; g != nil && g.Enabled && g.TargetPercentage == 0 {
+		g.TargetPercentage = targets.GPUUtilizationPercent
+	}
+	// A capacity probe sets the queue depth target from the runtime instead
+	if q := spec.Metrics.RequestQueueDepth; q != nil && q.Enabled && kubeaiv1alpha1.QuantityFloat64(q.TargetDepth) == 0 && spec.CapacityProbe == nil {
+		q.TargetDepth = targets.RequestQueueDepth
+	}
+
+	if spec.ScaleUp == nil && profile.ScaleUp != nil {
+		spec.ScaleUp = profile.ScaleUp.DeepCopy()
+	}
+	if spec.ScaleDown == nil && profile.ScaleDown != nil {
+		spec.ScaleDown = profile.ScaleDown.DeepCopy()
+	}
+	cooldown := spec.CooldownPeriod
+	if cooldown == 0 {
+		cooldown = int32(DefaultCooldownPeriod.Seconds())
+	}
+	if profile.WarmupSeconds > cooldown {
+		spec.CooldownPeriod = profile.WarmupSeconds
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestParseModelMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        ModelMetadata
+		wantOK      bool
+		wantErr     bool
+	}{
+		{name: "no annotations"},
+		{
+			name: "all annotations",
+			annotations: map[string]string{
+				ModelSizeAnnotation: "70B", ModelQuantizationAnnotation: "INT4", ModelContextLengthAnnotation: "128k",
+			},
+			want:   ModelMetadata{ParametersBillions: 70, Quantization: "int4", ContextLength: 131072},
+			wantOK: true,
+		},
+		{name: "fractional size", annotations: map[string]string{ModelSizeAnnotation: "1.5b"}, want: ModelMetadata{ParametersBillions: 1.5}, wantOK: true},
+		{name: "millions", annotations: map[string]string{ModelSizeAnnotation: "500M"}, want: ModelMetadata{ParametersBillions: 0.5}, wantOK: true},
+		{name: "bare number", annotations: map[string]string{ModelSizeAnnotation: "8"}, want: ModelMetadata{ParametersBillions: 8}, wantOK: true},
+		{name: "plain context length", annotations: map[string]string{ModelContextLengthAnnotation: "8192"}, want: ModelMetadata{ContextLength: 8192}, wantOK: true},
+		{name: "invalid size", annotations: map[string]string{ModelSizeAnnotation: "big"}, wantOK: true, wantErr: true},
+		{name: "invalid context length", annotations: map[string]string{ModelContextLengthAnnotation: "-1"}, wantOK: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := parseModelMetadata(tt.annotations)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelectModelProfile(t *testing.T) {
+	tests := []struct {
+		name  string
+		model ModelMetadata
+		want  string
+	}{
+		{name: "small model", model: ModelMetadata{ParametersBillions: 8}, want: "small"},
+		{name: "medium model", model: ModelMetadata{ParametersBillions: 34, Quantization: "int4"}, want: "medium"},
+		{name: "large model", model: ModelMetadata{ParametersBillions: 70, Quantization: "fp16"}, want: "large"},
+		{name: "large quantized model", model: ModelMetadata{ParametersBillions: 70, Quantization: "awq"}, want: "large-quantized"},
+		{name: "large long-context model", model: ModelMetadata{ParametersBillions: 70, Quantization: "int4", ContextLength: 131072}, want: "large-long-context"},
+		{name: "size not declared", model: ModelMetadata{Quantization: "int4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := SelectModelProfile(DefaultModelProfiles, tt.model)
+			if tt.want == "" {
+				assert.Nil(t, profile)
+				return
+			}
+			require.NotNil(t, profile)
+			assert.Equal(t, tt.want, profile.Name)
+		})
+	}
+}
+
+func TestParseModelProfiles(t *testing.T) {
+	profiles, err := ParseModelProfiles([]byte(`
+- name: mixtral
+  minParametersBillions: 40
+  quantizations: [fp8]
+  targets:
+    latencyP99Ms: 1500
+  warmupSeconds: 420
+  scaleDown:
+    stabilizationWindowSeconds: 900
+    policies:
+    - type: Pods
+      value: 1
+      periodSeconds: 300
+- name: everything-else
+`))
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "1500", profiles[0].Targets.LatencyP99Ms.String())
+	assert.Equal(t, int32(420), profiles[0].WarmupSeconds)
+	assert.Equal(t, []kubeaiv1alpha1.ScalingPolicy{{Type: ScalingPolicyPods, Value: 1, PeriodSeconds: 300}}, profiles[0].ScaleDown.Policies)
+	// A profile without criteria matches every model
+	assert.Equal(t, "everything-else", SelectModelProfile(profiles, ModelMetadata{ParametersBillions: 70}).Name)
+
+	_, err = ParseModelProfiles([]byte("- name: a\n  warmup: 60\n"))
+	assert.Error(t, err, "unknown fields are rejected")
+	_, err = ParseModelProfiles([]byte("- name: a\n- name: a\n"))
+	assert.ErrorContains(t, err, "duplicate")
+	_, err = ParseModelProfiles([]byte("- warmupSeconds: 60\n"))
+	assert.ErrorContains(t, err, "no name")
+}
+
+func TestApplyModelProfileDefaults(t *testing.T) {
+	profile := &ModelProfile{
+		Name:          "large",
+		Targets:       kubeaiv1alpha1.MetricTargets{LatencyP99Ms: ptr.To(resource.MustParse("2000")), GPUUtilizationPercent: 65, RequestQueueDepth: ptr.To(resource.MustParse("2"))},
+		WarmupSeconds: 600,
+		ScaleDown:     &kubeaiv1alpha1.ScaleBehavior{StabilizationWindowSeconds: 900},
+	}
+
+	policy := lockTestPolicy("llm")
+	policy.Spec.Metrics.GPUUtilization.TargetPercentage = 0
+	policy.Spec.Metrics.Latency = &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP95Ms: ptr.To(resource.MustParse("800"))}
+	policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{Enabled: true}
+	applyModelProfileDefaults(policy, profile)
+	assert.Equal(t, int32(65), policy.Spec.Metrics.GPUUtilization.TargetPercentage)
+	assert.Equal(t, "2", policy.Spec.Metrics.RequestQueueDepth.TargetDepth.String())
+	// A latency target set by the policy is kept
+	assert.Nil(t, policy.Spec.Metrics.Latency.TargetP99Ms)
+	assert.Equal(t, "800", policy.Spec.Metrics.Latency.TargetP95Ms.String())
+	assert.Equal(t, int32(900), policy.Spec.ScaleDown.StabilizationWindowSeconds)
+	assert.Nil(t, policy.Spec.ScaleUp)
+	// The default cooldown is raised to the warmup
+	assert.Equal(t, int32(600), policy.Spec.CooldownPeriod)
+	// The profile is not shared with the policy
+	policy.Spec.ScaleDown.StabilizationWindowSeconds = 1
+	assert.Equal(t, int32(900), profile.ScaleDown.StabilizationWindowSeconds)
+
+	policy = lockTestPolicy("llm")
+	policy.Spec.CooldownPeriod = 1200
+	policy.Spec.ScaleDown = &kubeaiv1alpha1.ScaleBehavior{StabilizationWindowSeconds: 60}
+	policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{Enabled: true}
+	policy.Spec.CapacityProbe = &kubeaiv1alpha1.CapacityProbeSpec{}
+	applyModelProfileDefaults(policy, profile)
+	assert.Equal(t, int32(50), policy.Spec.Metrics.GPUUtilization.TargetPercentage)
+	assert.Equal(t, int32(60), policy.Spec.ScaleDown.StabilizationWindowSeconds)
+	assert.Equal(t, int32(1200), policy.Spec.CooldownPeriod)
+	// The capacity probe supplies the queue depth target
+	assert.Nil(t, policy.Spec.Metrics.RequestQueueDepth.TargetDepth)
+}
+
+func TestReconcileModelProfile(t *testing.T) {
+	profiles := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "model-profiles", Namespace: "kubeai-system"},
+		Data:       map[string]string{ModelProfilesKey: "- name: custom\n  targets:\n    gpuUtilizationPercent: 40\n"},
+	}
+	reconcile := func(t *testing.T, annotations map[string]string, profilesConfigMap string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		scheme := newTestScheme(t)
+		policy := lockTestPolicy("llm")
+		policy.Spec.Metrics.GPUUtilization.TargetPercentage = 0
+		policy.Status.ModelProfile = "previous"
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default", Annotations: annotations},
+			Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, profiles.DeepCopy()).
+			WithStatusSubresource(policy, deployment).Build()
+		// GPU at 130% is twice the target of the large profile
+		r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 130}, scaling.DefaultRegistry, nil)
+		if profilesConfigMap != "" {
+			r.ModelProfilesConfigMap = types.NamespacedName{Namespace: "kubeai-system", Name: profilesConfigMap}
+		}
+		ctx := context.Background()
+		req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "llm", Namespace: "default"}}
+		_, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+		return stored
+	}
+	model := map[string]string{ModelSizeAnnotation: "70B", ModelQuantizationAnnotation: "bf16"}
+
+	t.Run("built-in profile", func(t *testing.T) {
+		stored := reconcile(t, model, "")
+		assert.Equal(t, "large", stored.Status.ModelProfile)
+		require.NotNil(t, stored.Status.EffectiveTargets)
+		assert.Equal(t, int32(65), stored.Status.EffectiveTargets.GPUUtilizationPercent)
+		assert.Equal(t, int32(4), stored.Status.DesiredReplicas)
+		// The defaults are not written to the spec
+		assert.Equal(t, int32(0), stored.Spec.Metrics.GPUUtilization.TargetPercentage)
+		assert.Nil(t, stored.Spec.ScaleDown)
+	})
+
+	t.Run("ConfigMap profile", func(t *testing.T) {
+		stored := reconcile(t, model, "model-profiles")
+		assert.Equal(t, "custom", stored.Status.ModelProfile)
+		assert.Equal(t, int32(40), stored.Status.EffectiveTargets.GPUUtilizationPercent)
+	})
+
+	t.Run("missing ConfigMap falls back to built-in profiles", func(t *testing.T) {
+		stored := reconcile(t, model, "missing")
+		assert.Equal(t, "large", stored.Status.ModelProfile)
+	})
+
+	t.Run("no model annotations", func(t *testing.T) {
+		stored := reconcile(t, nil, "")
+		assert.Empty(t, stored.Status.ModelProfile)
+		assert.Equal(t, int32(2), stored.Status.DesiredReplicas)
+	})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// activeTargetModulation returns the first targetModulation window that covers now
+func activeTargetModulation(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, now time.Time) *kubeaiv1alpha1.TargetModulation {
+	for i := range policy.Spec.TargetModulation {
+		m := &policy.Spec.TargetModulation[i]
+		if modulationActive(m, now) {
+			return m
+		}
+	}
+	return nil
+}
+
+// modulationActive reports whether now falls inside the window. Windows that
+// end before they start run past midnight, and DaysOfWeek refers to the day
+// the window starts on.
+func modulationActive(m *kubeaiv1alpha1.TargetModulation, now time.Time) bool {
+	loc := time.UTC
+	if m.TimeZone != "" {
+		l, err := time.LoadLocation(m.TimeZone)
+		if err != nil {
+			return false
+		}
+		loc = l
+	}
+	start, err := time.Parse("15:04", m.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", m.End)
+	if err != nil {
+		return false
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	if startMinute < endMinute {
+		return minute >= startMinute && minute < endMinute && onDay(m.DaysOfWeek, local.Weekday())
+	}
+	// Overnight window: the late part belongs to today, the early part to yesterday
+	if minute >= startMinute {
+		return onDay(m.DaysOfWeek, local.Weekday())
+	}
+	if minute < endMinute {
+		return onDay(m.DaysOfWeek, (local.Weekday()+6)%7)
+	}
+	return false
+}
+
+// onDay reports whether day is listed, treating an empty list as every day
+func onDay(days []string, day time.Weekday) bool {
+	if len(days) == 0 {
+		return true
+	}
+	for _, d := range days {
+		if kubeaiv1alpha1.Weekdays[d] == day {
+			return true
+		}
+	}
+	return false
+}
+
+// applyTargetModulation returns the policy to base the scaling decision on,
+// with the metric targets of base replaced by the window active at now, and
+// records the effective targets in the status of policy. base is the policy
+// itself or the copy carrying its model profile defaults; the returned policy
+// is a copy when a window applies, so the stored spec is never modified.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyTargetModulation(policy, base *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, now time.Time) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	decision := base
+	policy.Status.ActiveTargetModulation = ""
+
+	if m := activeTargetModulation(policy, now); m != nil {
+		if decision == policy {
+			decision = policy.DeepCopy()
+		}
+		metricsSpec := &decision.Spec.Metrics
+		if metricsSpec.Latency != nil {
+			if kubeaiv1alpha1.QuantityFloat64(m.Targets.LatencyP99Ms) > 0 {
+				metricsSpec.Latency.TargetP99Ms = m.Targets.LatencyP99Ms
+			}
+			if kubeaiv1alpha1.QuantityFloat64(m.Targets.LatencyP95Ms) > 0 {
+				metricsSpec.Latency.TargetP95Ms = m.Targets.LatencyP95Ms
+			}
+		}
+		if metricsSpec.GPUUtilization != nil && m.Targets.GPUUtilizationPercent > 0 {
+			metricsSpec.GPUUtilization.TargetPercentage = m.Targets.GPUUtilizationPercent
+		}
+		if metricsSpec.RequestQueueDepth != nil && kubeaiv1alpha1.QuantityFloat64(m.Targets.RequestQueueDepth) > 0 {
+			metricsSpec.RequestQueueDepth.TargetDepth = m.Targets.RequestQueueDepth
+		}
+		policy.Status.ActiveTargetModulation = m.Name
+	}
+
+	policy.Status.EffectiveTargets = effectiveTargets(decision)
+	return decision
+}
+
+// effectiveTargets returns the targets of the enabled metrics
+func effectiveTargets(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) *kubeaiv1alpha1.MetricTargets {
+	targets := &kubeaiv1alpha1.MetricTargets{}
+	metricsSpec := policy.Spec.Metrics
+	if metricsSpec.Latency != nil && metricsSpec.Latency.Enabled {
+		targets.LatencyP99Ms = metricsSpec.Latency.TargetP99Ms
+		targets.LatencyP95Ms = metricsSpec.Latency.TargetP95Ms
+	}
+	if metricsSpec.GPUUtilization != nil && metricsSpec.GPUUtilization.Enabled {
+		targets.GPUUtilizationPercent = metricsSpec.GPUUtilization.TargetPercentage
+	}
+	if metricsSpec.RequestQueueDepth != nil && metricsSpec.RequestQueueDepth.Enabled {
+		targets.RequestQueueDepth = queueDepthTarget(policy)
+	}
+	return targets
+}
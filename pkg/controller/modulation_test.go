@@ -0,0 +1,110 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestModulationActive(t *testing.T) {
+	businessHours := &kubeaiv1alpha1.TargetModulation{
+		Name:       "business-hours",
+		Start:      "09:00",
+		End:        "18:00",
+		DaysOfWeek: []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+		TimeZone:   "America/New_York",
+	}
+	overnight := &kubeaiv1alpha1.TargetModulation{
+		Name:       "friday-night",
+		Start:      "22:00",
+		End:        "06:00",
+		DaysOfWeek: []string{"Fri"},
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		window *kubeaiv1alpha1.TargetModulation
+		now    time.Time
+		active bool
+	}{
+		{"weekday inside window", businessHours, time.Date(2026, 3, 4, 10, 0, 0, 0, newYork), true},
+		{"start is inclusive", businessHours, time.Date(2026, 3, 4, 9, 0, 0, 0, newYork), true},
+		{"end is exclusive", businessHours, time.Date(2026, 3, 4, 18, 0, 0, 0, newYork), false},
+		{"evaluated in the window time zone", businessHours, time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC), true},
+		{"weekend", businessHours, time.Date(2026, 3, 7, 10, 0, 0, 0, newYork), false},
+		{"overnight before midnight", overnight, time.Date(2026, 3, 6, 23, 0, 0, 0, time.UTC), true},
+		{"overnight after midnight belongs to the start day", overnight, time.Date(2026, 3, 7, 5, 0, 0, 0, time.UTC), true},
+		{"overnight after midnight on the wrong day", overnight, time.Date(2026, 3, 6, 5, 0, 0, 0, time.UTC), false},
+		{"overnight gap", overnight, time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.active, modulationActive(tt.window, tt.now))
+		})
+	}
+}
+
+func TestApplyTargetModulation(t *testing.T) {
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Latency:        &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("400"))},
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 80},
+			},
+			TargetModulation: []kubeaiv1alpha1.TargetModulation{
+				{Name: "overnight", Start: "20:00", End: "08:00", Targets: kubeaiv1alpha1.MetricTargets{LatencyP99Ms: ptr.To(resource.MustParse("700"))}},
+				{Name: "late-evening", Start: "22:00", End: "23:00", Targets: kubeaiv1alpha1.MetricTargets{LatencyP99Ms: ptr.To(resource.MustParse("900"))}},
+			},
+		},
+	}
+
+	// During business hours the configured targets are used
+	decision := r.applyTargetModulation(policy, policy, time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+	assert.Same(t, policy, decision)
+	assert.Empty(t, policy.Status.ActiveTargetModulation)
+	assert.Equal(t, &kubeaiv1alpha1.MetricTargets{LatencyP99Ms: ptr.To(resource.MustParse("400")), GPUUtilizationPercent: 80}, policy.Status.EffectiveTargets)
+
+	// Overnight the first matching window relaxes latency without touching the spec or replica bounds
+	decision = r.applyTargetModulation(policy, policy, time.Date(2026, 3, 4, 22, 30, 0, 0, time.UTC))
+	assert.Equal(t, "700", decision.Spec.Metrics.Latency.TargetP99Ms.String())
+	assert.Equal(t, int32(80), decision.Spec.Metrics.GPUUtilization.TargetPercentage)
+	assert.Equal(t, int32(10), decision.Spec.MaxReplicas)
+	assert.Equal(t, "400", policy.Spec.Metrics.Latency.TargetP99Ms.String())
+	assert.Equal(t, "overnight", policy.Status.ActiveTargetModulation)
+	assert.Equal(t, &kubeaiv1alpha1.MetricTargets{LatencyP99Ms: ptr.To(resource.MustParse("700")), GPUUtilizationPercent: 80}, policy.Status.EffectiveTargets)
+
+	// 600ms P99 is over the daytime target but within the overnight one
+	current := &kubeaiv1alpha1.CurrentMetrics{LatencyP99Ms: 600}
+	assert.InDelta(t, 1.5, r.buildMetricRatios(policy, 2, current)[0], 1e-9)
+	assert.InDelta(t, 600.0/700.0, r.buildMetricRatios(decision, 2, current)[0], 1e-9)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// AutoscalingDisabledAnnotation on a Namespace set to "true" stops every
+	// policy in the namespace from changing its target
+	AutoscalingDisabledAnnotation = "kubeai.io/autoscaling-disabled"
+
+	// ConditionTypeNamespaceDisabled indicates autoscaling is disabled for the policy's namespace
+	ConditionTypeNamespaceDisabled = "NamespaceDisabled"
+)
+
+// namespaceDisabled reports whether autoscaling is disabled by the policy's
+// namespace. A namespace that cannot be read does not disable autoscaling.
+func (r *AIInferenceAutoscalerPolicyReconciler) namespaceDisabled(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) bool {
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: policy.Namespace}, namespace); err != nil {
+		if !errors.IsNotFound(err) {
+			log.FromContext(ctx).Error(err, "Failed to read namespace, assuming autoscaling is enabled", "namespace", policy.Namespace)
+		}
+		return false
+	}
+	return namespace.Annotations[AutoscalingDisabledAnnotation] == "true"
+}
+
+// applyNamespaceDisable holds replicas at the current count while autoscaling
+// is disabled for the policy's namespace. The recommendation is still computed
+// and reported, so tenant admins can see what would happen once it is re-enabled.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyNamespaceDisable(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	disabled bool,
+	currentReplicas, recommendedReplicas int32,
+) int32 {
+	if !disabled {
+		if r.isConditionTrue(policy, ConditionTypeNamespaceDisabled) {
+			r.updateCondition(ctx, policy, ConditionTypeNamespaceDisabled, metav1.ConditionFalse,
+				"AutoscalingEnabled", fmt.Sprintf("Autoscaling is enabled for namespace %s", policy.Namespace))
+		}
+		return recommendedReplicas
+	}
+
+	log.FromContext(ctx).Info("Autoscaling is disabled for the namespace, holding replicas",
+		"current", currentReplicas,
+		"recommended", recommendedReplicas)
+	r.updateCondition(ctx, policy, ConditionTypeNamespaceDisabled, metav1.ConditionTrue, "AutoscalingDisabled",
+		fmt.Sprintf("Namespace %s has %s=true; recommending %d replicas, holding at %d",
+			policy.Namespace, AutoscalingDisabledAnnotation, recommendedReplicas, currentReplicas))
+	return currentReplicas
+}
+
+// policiesInNamespace maps a Namespace to reconcile requests for every policy
+// in it, so toggling the annotation takes effect without waiting for a requeue
+func (r *AIInferenceAutoscalerPolicyReconciler) policiesInNamespace(ctx context.Context, namespace client.Object) []reconcile.Request {
+	policies := &kubeaiv1alpha1.AIInferenceAutoscalerPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(namespace.GetName())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list policies in namespace", "namespace", namespace.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(policies.Items))
+	for _, policy := range policies.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name},
+		})
+	}
+	return requests
+}
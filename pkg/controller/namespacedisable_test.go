@@ -0,0 +1,126 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestNamespaceDisabled(t *testing.T) {
+	scheme := newTestScheme(t)
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "default",
+		Annotations: map[string]string{AutoscalingDisabledAnnotation: "true"},
+	}}
+	policy := lockTestPolicy("policy")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace, policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+	stored := func() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		updated := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, updated))
+		return updated
+	}
+
+	// The target is left alone but the recommendation is still reported
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), replicas())
+	assert.Equal(t, int32(4), stored().Status.RecommendedReplicas)
+	assert.True(t, r.hasCondition(stored(), ConditionTypeNamespaceDisabled, metav1.ConditionTrue, "AutoscalingDisabled"))
+
+	// Removing the annotation resumes scaling
+	namespace.Annotations = nil
+	require.NoError(t, c.Update(ctx, namespace))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), replicas())
+	assert.True(t, r.hasCondition(stored(), ConditionTypeNamespaceDisabled, metav1.ConditionFalse, "AutoscalingEnabled"))
+}
+
+func TestPoliciesInNamespace(t *testing.T) {
+	scheme := newTestScheme(t)
+	other := lockTestPolicy("other")
+	other.Namespace = "other"
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(lockTestPolicy("a"), lockTestPolicy("b"), other).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+
+	requests := r.policiesInNamespace(context.Background(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "a"}},
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "b"}},
+	}, requests)
+}
+
+func TestNamespaceDisabledHoldsActivator(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.MinReplicas = int32Ptr(0)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(0)},
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "default",
+		Annotations: map[string]string{AutoscalingDisabledAnnotation: "true"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, namespace).Build()
+	ctx := context.Background()
+	backend := NewActivatorBackend(c, scheme, types.NamespacedName{Name: "policy", Namespace: "default"}, "llm", nil)
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+
+	// A target in a namespace with autoscaling disabled stays at zero
+	assert.Error(t, backend.Activate(ctx))
+	assert.Equal(t, int32(0), replicas())
+
+	namespace.Annotations = nil
+	require.NoError(t, c.Update(ctx, namespace))
+	require.NoError(t, backend.Activate(ctx))
+	assert.Equal(t, int32(1), replicas())
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// PreferredGPUPoolAnnotation is set on the target to the GPU pool the
+// external optimizer advises it to run on
+const PreferredGPUPoolAnnotation = "kubeai.io/preferred-gpu-pool"
+
+// applyOptimizerAdvice asks the external optimizer, if one is configured, for
+// advice on the recommendation. The advice is soft: its replica bound only
+// limits scale-ups and never goes below the current replicas or minReplicas,
+// and a failed call leaves the decision unchanged.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyOptimizerAdvice(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	algorithm string,
+	currentReplicas, recommendedReplicas int32,
+	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
+) int32 {
+	if r.Optimizer == nil {
+		return recommendedReplicas
+	}
+	logger := log.FromContext(ctx)
+	minReplicas := int32(1)
+	if policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas > 0 {
+		minReplicas = *policy.Spec.MinReplicas
+	}
+
+	advice, err := r.Optimizer.Advise(ctx, scaling.DecisionContext{
+		PolicyName:          policy.Name,
+		PolicyNamespace:     policy.Namespace,
+		TargetKind:          policy.Spec.TargetRef.Kind,
+		TargetName:          policy.Spec.TargetRef.Name,
+		Algorithm:           algorithm,
+		CurrentReplicas:     currentReplicas,
+		RecommendedReplicas: recommendedReplicas,
+		MinReplicas:         minReplicas,
+		MaxReplicas:         policy.Spec.MaxReplicas,
+		MetricSamples:       metricSamples(policy, currentReplicas, currentMetrics, r.now()),
+		PreferredPool:       policy.Status.PreferredGPUPool,
+	})
+	if err != nil {
+		logger.Error(err, "Optimizer failed, deciding without advice")
+		metrics.RecordOptimizerAdvice(policy.Namespace, policy.Name, "error")
+		return recommendedReplicas
+	}
+
+	if advice.PreferredPool != policy.Status.PreferredGPUPool {
+		if err := r.setTargetAnnotation(ctx, policy, PreferredGPUPoolAnnotation, advice.PreferredPool); err != nil {
+			logger.Error(err, "Failed to annotate target with preferred GPU pool", "pool", advice.PreferredPool)
+		} else {
+			policy.Status.PreferredGPUPool = advice.PreferredPool
+		}
+	}
+
+	desiredReplicas := recommendedReplicas
+	if advice.MaxReplicas > 0 && recommendedReplicas > currentReplicas && recommendedReplicas > advice.MaxReplicas {
+		desiredReplicas = max(advice.MaxReplicas, currentReplicas, minReplicas)
+	}
+	if desiredReplicas == recommendedReplicas {
+		metrics.RecordOptimizerAdvice(policy.Namespace, policy.Name, "unchanged")
+		return recommendedReplicas
+	}
+	logger.Info("Optimizer bounded scale-up",
+		"recommended", recommendedReplicas,
+		"advisedMax", advice.MaxReplicas,
+		"desired", desiredReplicas,
+		"reason", advice.Reason)
+	metrics.RecordOptimizerAdvice(policy.Namespace, policy.Name, "applied")
+	return desiredReplicas
+}
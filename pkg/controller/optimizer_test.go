@@ -0,0 +1,121 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// fakeOptimizer returns advice and err, recording the decisions it was asked about
+type fakeOptimizer struct {
+	advice    scaling.Advice
+	err       error
+	decisions []scaling.DecisionContext
+}
+
+func (o *fakeOptimizer) Advise(_ context.Context, decision scaling.DecisionContext) (scaling.Advice, error) {
+	o.decisions = append(o.decisions, decision)
+	return o.advice, o.err
+}
+
+func TestApplyOptimizerAdvice(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     int32
+		recommended int32
+		advice      scaling.Advice
+		err         error
+		want        int32
+	}{
+		{name: "bounds scale-up", current: 2, recommended: 8, advice: scaling.Advice{PreferredPool: "l4", MaxReplicas: 5}, want: 5},
+		{name: "never below current", current: 6, recommended: 8, advice: scaling.Advice{MaxReplicas: 4}, want: 6},
+		{name: "never scales down", current: 6, recommended: 6, advice: scaling.Advice{MaxReplicas: 4}, want: 6},
+		{name: "scale-down left alone", current: 6, recommended: 3, advice: scaling.Advice{MaxReplicas: 4}, want: 3},
+		{name: "pool only", current: 2, recommended: 8, advice: scaling.Advice{PreferredPool: "a100"}, want: 8},
+		{name: "error leaves decision", current: 2, recommended: 8, err: errors.New("Unavailable"), want: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := newTestScheme(t)
+			policy := lockTestPolicy("policy")
+			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"}}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).Build()
+			r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+			r.Optimizer = &fakeOptimizer{advice: tt.advice, err: tt.err}
+
+			ctx := context.Background()
+			got := r.applyOptimizerAdvice(ctx, policy, DefaultAlgorithmName, tt.current, tt.recommended, &kubeaiv1alpha1.CurrentMetrics{})
+			assert.Equal(t, tt.want, got)
+
+			// The preferred pool is passed on to the target
+			annotated := &appsv1.Deployment{}
+			require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, annotated))
+			assert.Equal(t, tt.advice.PreferredPool, annotated.Annotations[PreferredGPUPoolAnnotation])
+			assert.Equal(t, tt.advice.PreferredPool, policy.Status.PreferredGPUPool)
+		})
+	}
+}
+
+func TestReconcileAppliesOptimizerAdvice(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.DecisionSnapshot = true
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	optimizer := &fakeOptimizer{advice: scaling.Advice{PreferredPool: "h100-spot", MaxReplicas: 3, Reason: "hourly budget"}}
+	r.Optimizer = optimizer
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.Len(t, optimizer.decisions, 1)
+	decision := optimizer.decisions[0]
+	assert.Equal(t, "llm", decision.TargetName)
+	assert.Equal(t, int32(2), decision.CurrentReplicas)
+	assert.Equal(t, int32(4), decision.RecommendedReplicas)
+	assert.Contains(t, decision.MetricSamples, scaling.MetricGPUUtilization)
+
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(3), *updated.Spec.Replicas)
+
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+	assert.Equal(t, "h100-spot", stored.Status.PreferredGPUPool)
+	assert.Contains(t, stored.Status.LastDecision, `{"name":"optimizer","from":4,"to":3,"delta":-1}`)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+// OTLPServer serves an OTLPReceiver to inference servers and collectors
+// pushing metrics to the controller
+type OTLPServer struct {
+	Receiver *metrics.OTLPReceiver
+	// HTTPAddress serves OTLP/HTTP on metrics.OTLPMetricsPath, e.g. :4318
+	HTTPAddress string
+	// GRPCAddress serves OTLP/gRPC, e.g. :4317; empty disables it
+	GRPCAddress string
+}
+
+var _ manager.LeaderElectionRunnable = &OTLPServer{}
+
+// NeedLeaderElection is false so every replica receives metrics and a new
+// leader does not start without them
+func (s *OTLPServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves OTLP until ctx is done
+func (s *OTLPServer) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	errs := make(chan error, 2)
+
+	mux := http.NewServeMux()
+	mux.Handle(metrics.OTLPMetricsPath, s.Receiver)
+	httpServer := &http.Server{Addr: s.HTTPAddress, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- err
+		}
+	}()
+	logger.Info("Receiving OTLP metrics over HTTP", "address", s.HTTPAddress)
+
+	var grpcServer *grpc.Server
+	if s.GRPCAddress != "" {
+		listener, err := net.Listen("tcp", s.GRPCAddress)
+		if err != nil {
+			_ = httpServer.Close()
+			return err
+		}
+		grpcServer = grpc.NewServer()
+		colmetricspb.RegisterMetricsServiceServer(grpcServer, s.Receiver)
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				errs <- err
+			}
+		}()
+		logger.Info("Receiving OTLP metrics over gRPC", "address", s.GRPCAddress)
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	_ = httpServer.Shutdown(shutdownCtx)
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeRolloutHold indicates the replicas are held back to keep a
+	// partitioned rollout of the target intact
+	ConditionTypeRolloutHold = "RolloutHold"
+)
+
+// updatePartition returns the partition of the StatefulSet's rolling update.
+// Ordinals at or above it run the update revision.
+func updatePartition(statefulSet *appsv1.StatefulSet) int32 {
+	strategy := statefulSet.Spec.UpdateStrategy
+	if strategy.Type == appsv1.OnDeleteStatefulSetStrategyType || strategy.RollingUpdate == nil || strategy.RollingUpdate.Partition == nil {
+		return 0
+	}
+	return *strategy.RollingUpdate.Partition
+}
+
+// partitionBoundReplicas returns the replicas closest to desired that keep
+// the partitioned rollout of the StatefulSet intact, and why the replicas
+// were held back, if they were. StatefulSets remove the highest ordinals
+// first, so scale-down stops at the partition and, while a rollout is in
+// progress, at the current replicas, which keeps the freshly updated shards.
+// Scale-up waits until the rollout controller has updated every existing
+// ordinal at or above the partition and they are ready.
+func partitionBoundReplicas(statefulSet *appsv1.StatefulSet, currentReplicas, desiredReplicas int32) (int32, string) {
+	partition := updatePartition(statefulSet)
+	status := statefulSet.Status
+	rolling := status.UpdateRevision != "" && status.UpdateRevision != status.CurrentRevision
+
+	if desiredReplicas < currentReplicas {
+		if rolling && partition < currentReplicas {
+			return currentReplicas, fmt.Sprintf("rollout to revision %s is in progress; keeping updated ordinals %d-%d",
+				status.UpdateRevision, partition, currentReplicas-1)
+		}
+		if desiredReplicas < partition {
+			floor := min(partition, currentReplicas)
+			return floor, fmt.Sprintf("keeping ordinals below update partition %d", partition)
+		}
+		return desiredReplicas, ""
+	}
+
+	if desiredReplicas > currentReplicas {
+		if status.ObservedGeneration < statefulSet.Generation {
+			return currentReplicas, fmt.Sprintf("waiting for the StatefulSet controller to observe generation %d", statefulSet.Generation)
+		}
+		if rolling {
+			toUpdate := currentReplicas - min(partition, currentReplicas)
+			if status.UpdatedReplicas < toUpdate || status.ReadyReplicas < currentReplicas {
+				return currentReplicas, fmt.Sprintf("waiting for the rollout to revision %s: %d/%d ordinals from partition %d updated, %d/%d ready",
+					status.UpdateRevision, status.UpdatedReplicas, toUpdate, partition, status.ReadyReplicas, currentReplicas)
+			}
+		}
+	}
+	return desiredReplicas, ""
+}
+
+// applyUpdatePartition coordinates scaling of a StatefulSet target with its
+// partitioned rolling update, such as the staged rollout of sharded model
+// index servers. The replicas are held while the target cannot be read.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyUpdatePartition(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, desiredReplicas int32,
+) int32 {
+	if !policy.Spec.PartitionAware || policy.Spec.TargetRef.Kind != "StatefulSet" {
+		return desiredReplicas
+	}
+	logger := log.FromContext(ctx)
+
+	statefulSet := &appsv1.StatefulSet{}
+	key := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Spec.TargetRef.Name}
+	if err := r.Get(ctx, key, statefulSet); err != nil {
+		logger.Error(err, "Failed to read StatefulSet update partition, holding replicas")
+		return currentReplicas
+	}
+
+	bounded, reason := partitionBoundReplicas(statefulSet, currentReplicas, desiredReplicas)
+	if reason == "" {
+		if r.isConditionTrue(policy, ConditionTypeRolloutHold) {
+			r.updateCondition(ctx, policy, ConditionTypeRolloutHold, metav1.ConditionFalse,
+				"RolloutCompatible", "Scaling does not disturb the rollout of the target")
+		}
+		return desiredReplicas
+	}
+
+	logger.Info("Holding replicas for the partitioned rollout of the target",
+		"current", currentReplicas,
+		"desired", desiredReplicas,
+		"bounded", bounded,
+		"reason", reason)
+	// Only report on transition to avoid event spam
+	if !r.isConditionTrue(policy, ConditionTypeRolloutHold) && r.EventRecorder != nil {
+		r.EventRecorder.RecordRolloutHold(policy, desiredReplicas, bounded, reason)
+	}
+	r.updateCondition(ctx, policy, ConditionTypeRolloutHold, metav1.ConditionTrue, ReasonRolloutHold, reason)
+	return bounded
+}
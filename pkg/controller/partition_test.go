@@ -0,0 +1,135 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// partitionedStatefulSet returns a StatefulSet of replicas rolling out from
+// revision r1 to r2 from ordinal partition up, with updated pods on r2
+func partitionedStatefulSet(replicas, partition, updated int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default", Generation: 2},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: int32Ptr(replicas),
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: int32Ptr(partition)},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			ObservedGeneration: 2,
+			Replicas:           replicas,
+			ReadyReplicas:      replicas,
+			UpdatedReplicas:    updated,
+			CurrentRevision:    "r1",
+			UpdateRevision:     "r2",
+		},
+	}
+}
+
+func TestPartitionBoundReplicas(t *testing.T) {
+	settled := partitionedStatefulSet(6, 4, 0)
+	settled.Status.UpdateRevision = "r1"
+	stale := partitionedStatefulSet(6, 2, 4)
+	stale.Generation = 3
+	notReady := partitionedStatefulSet(6, 2, 4)
+	notReady.Status.ReadyReplicas = 5
+	onDelete := partitionedStatefulSet(6, 4, 0)
+	onDelete.Status.UpdateRevision = "r1"
+	onDelete.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+
+	tests := []struct {
+		name        string
+		statefulSet *appsv1.StatefulSet
+		desired     int32
+		want        int32
+		wantHeld    bool
+		wantReason  string
+	}{
+		{name: "scale-down during rollout keeps updated ordinals", statefulSet: partitionedStatefulSet(6, 2, 4), desired: 3, want: 6,
+			wantHeld: true, wantReason: "keeping updated ordinals 2-5"},
+		{name: "scale-down stops at partition", statefulSet: settled, desired: 2, want: 4,
+			wantHeld: true, wantReason: "below update partition 4"},
+		{name: "scale-down above partition", statefulSet: settled, desired: 5, want: 5},
+		{name: "scale-down during rollout above replicas", statefulSet: partitionedStatefulSet(6, 8, 0), desired: 3, want: 6,
+			wantHeld: true, wantReason: "below update partition 8"},
+		{name: "scale-up after rollout caught up", statefulSet: partitionedStatefulSet(6, 2, 4), desired: 8, want: 8},
+		{name: "scale-up waits for updates", statefulSet: partitionedStatefulSet(6, 2, 3), desired: 8, want: 6,
+			wantHeld: true, wantReason: "3/4 ordinals from partition 2 updated"},
+		{name: "scale-up waits for readiness", statefulSet: notReady, desired: 8, want: 6,
+			wantHeld: true, wantReason: "5/6 ready"},
+		{name: "scale-up waits for observed generation", statefulSet: stale, desired: 8, want: 6,
+			wantHeld: true, wantReason: "observe generation 3"},
+		{name: "on delete has no partition", statefulSet: onDelete, desired: 2, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := partitionBoundReplicas(tt.statefulSet, 6, tt.desired)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantHeld, reason != "")
+			assert.Contains(t, reason, tt.wantReason)
+		})
+	}
+}
+
+func TestApplyUpdatePartition(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("sharded")
+	policy.Spec.TargetRef = kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "llm"}
+	policy.Spec.PartitionAware = true
+	statefulSet := partitionedStatefulSet(6, 2, 4)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, statefulSet).
+		WithStatusSubresource(policy, statefulSet).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	ctx := context.Background()
+
+	// The freshly updated ordinals are kept while the rollout is in progress
+	assert.Equal(t, int32(6), r.applyUpdatePartition(ctx, policy, 6, 3))
+	require.True(t, r.hasCondition(policy, ConditionTypeRolloutHold, metav1.ConditionTrue, ReasonRolloutHold))
+	assert.Contains(t, <-fakeRecorder.Events, "to 6 instead of 3 replicas")
+
+	// The event is not repeated while the hold lasts
+	assert.Equal(t, int32(6), r.applyUpdatePartition(ctx, policy, 6, 4))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// Once the rollout completes the replicas follow the recommendation
+	statefulSet.Status.CurrentRevision = "r2"
+	statefulSet.Status.UpdatedReplicas = 6
+	require.NoError(t, c.Status().Update(ctx, statefulSet))
+	assert.Equal(t, int32(3), r.applyUpdatePartition(ctx, policy, 6, 3))
+	assert.True(t, r.hasCondition(policy, ConditionTypeRolloutHold, metav1.ConditionFalse, "RolloutCompatible"))
+
+	// Policies that do not opt in are not affected
+	policy.Spec.PartitionAware = false
+	statefulSet.Status.CurrentRevision = "r1"
+	require.NoError(t, c.Status().Update(ctx, statefulSet))
+	assert.Equal(t, int32(3), r.applyUpdatePartition(ctx, policy, 6, 3))
+}
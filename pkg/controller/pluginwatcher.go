@@ -0,0 +1,246 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// DefaultPluginReloadDelay is how long a plugin file must go without writes
+// before it is loaded, so a file being copied in is not loaded half written
+const DefaultPluginReloadDelay = time.Second
+
+// PluginWatcher loads algorithm plugins from a directory and reloads them when
+// files are added or changed, so plugins can be rolled out without restarting
+// the controller. An updated plugin replaces the algorithm it registered
+// earlier, and removing a file unregisters its algorithm; a plugin may not
+// replace a built-in or external algorithm.
+//
+// Go plugins cannot be unloaded, and a changed .so at a path that was already
+// loaded is not read again, so updated Go plugins need a new file name. WASM
+// modules are reloaded in place.
+type PluginWatcher struct {
+	// Dir is the plugin directory
+	Dir string
+	// Registry receives the loaded algorithms
+	Registry *scaling.Registry
+	// Delay is how long a file must be left unchanged before it is loaded
+	Delay time.Duration
+
+	// load loads one plugin file; tests substitute it
+	load func(path string) (scaling.ScalingAlgorithm, error)
+
+	mu sync.Mutex
+	// owners maps the algorithms registered by plugins to their file
+	owners map[string]string
+}
+
+var _ manager.LeaderElectionRunnable = &PluginWatcher{}
+
+// NeedLeaderElection returns false: every replica serves the plugins it loaded
+func (w *PluginWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// LoadAll loads every plugin in Dir and returns the names of the algorithms
+// registered. Plugins that fail to load are reported in the error and skipped.
+func (w *PluginWatcher) LoadAll() ([]string, error) {
+	info, err := os.Stat(w.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat plugin directory %q: %w", w.Dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("plugin path is not a directory: path=%q", w.Dir)
+	}
+
+	var matches []string
+	for _, ext := range []string{scaling.NativePluginExtension, scaling.WASMPluginExtension} {
+		found, err := filepath.Glob(filepath.Join(w.Dir, "*"+ext))
+		if err != nil {
+			return nil, fmt.Errorf("failed to glob plugins: %w", err)
+		}
+		matches = append(matches, found...)
+	}
+
+	var names []string
+	var loadErrors []error
+	for _, path := range matches {
+		name, err := w.loadFile(context.Background(), path)
+		if err != nil {
+			loadErrors = append(loadErrors, err)
+			continue
+		}
+		names = append(names, name)
+	}
+	if len(loadErrors) > 0 {
+		return names, fmt.Errorf("failed to load %d plugin(s): %v", len(loadErrors), loadErrors)
+	}
+	return names, nil
+}
+
+// Start watches Dir until ctx is done, loading plugin files once writes to
+// them settle
+func (w *PluginWatcher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithValues("directory", w.Dir)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create plugin watcher: %w", err)
+	}
+	defer func() { _ = watcher.Close() }()
+	if err := watcher.Add(w.Dir); err != nil {
+		return fmt.Errorf("failed to watch plugin directory %q: %w", w.Dir, err)
+	}
+
+	delay := w.Delay
+	if delay <= 0 {
+		delay = DefaultPluginReloadDelay
+	}
+	// Each change restarts the file's timer; the file is loaded when it fires
+	ready := make(chan string)
+	timers := make(map[string]*time.Timer)
+	defer func() {
+		for _, timer := range timers {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if !scaling.IsPluginFile(event.Name) {
+				continue
+			}
+			if !event.Has(fsnotify.Create | fsnotify.Write | fsnotify.Remove | fsnotify.Rename) {
+				continue
+			}
+			path := event.Name
+			if timer, ok := timers[path]; ok {
+				timer.Reset(delay)
+				continue
+			}
+			timers[path] = time.AfterFunc(delay, func() {
+				select {
+				case ready <- path:
+				case <-ctx.Done():
+				}
+			})
+		case path := <-ready:
+			delete(timers, path)
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				for _, name := range w.unloadFile(ctx, path) {
+					logger.Info("Plugin file removed, unregistered its algorithm", "path", path, "algorithm", name)
+				}
+				continue
+			}
+			name, err := w.loadFile(ctx, path)
+			if err != nil {
+				logger.Error(err, "Failed to reload plugin, keeping the registered algorithms", "path", path)
+				continue
+			}
+			logger.Info("Loaded algorithm plugin", "path", path, "algorithm", name)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.Error(err, "Plugin watcher error")
+		}
+	}
+}
+
+// loadFile loads the plugin at path and registers its algorithm, replacing the
+// algorithm if a plugin registered it before
+func (w *PluginWatcher) loadFile(ctx context.Context, path string) (string, error) {
+	load := w.load
+	if load == nil {
+		load = scaling.LoadPluginFile
+	}
+	algorithm, err := load(path)
+	if err != nil {
+		return "", err
+	}
+	name := algorithm.Name()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.owners == nil {
+		w.owners = make(map[string]string)
+	}
+
+	if _, owned := w.owners[name]; !owned {
+		if err := w.Registry.Register(algorithm); err != nil {
+			closePlugin(ctx, algorithm)
+			return "", fmt.Errorf("plugin %q: %w", path, err)
+		}
+		w.owners[name] = path
+		return name, nil
+	}
+
+	previous, err := w.Registry.Replace(algorithm)
+	if err != nil {
+		closePlugin(ctx, algorithm)
+		return "", fmt.Errorf("plugin %q: %w", path, err)
+	}
+	w.owners[name] = path
+	closePlugin(ctx, previous)
+	return name, nil
+}
+
+// unloadFile unregisters the algorithms loaded from path and returns their names
+func (w *PluginWatcher) unloadFile(ctx context.Context, path string) []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var names []string
+	for name, owner := range w.owners {
+		if owner != path {
+			continue
+		}
+		delete(w.owners, name)
+		if algorithm, err := w.Registry.Unregister(name); err == nil {
+			closePlugin(ctx, algorithm)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// closePlugin releases a WASM module that is no longer registered. Go plugins
+// cannot be unloaded.
+func closePlugin(ctx context.Context, algorithm scaling.ScalingAlgorithm) {
+	if module, ok := algorithm.(*scaling.WASMAlgorithm); ok {
+		_ = module.Close(context.WithoutCancel(ctx))
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// filePlugin is loaded from a file holding "name:version"
+type filePlugin struct {
+	name    string
+	version string
+}
+
+func (p *filePlugin) Name() string {
+	return p.name
+}
+
+func (p *filePlugin) ComputeScale(_ context.Context, input scaling.ScalingInput) (scaling.ScalingResult, error) {
+	return scaling.ScalingResult{DesiredReplicas: input.CurrentReplicas, Reason: p.version}, nil
+}
+
+func loadFilePlugin(path string) (scaling.ScalingAlgorithm, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	name, version, ok := strings.Cut(strings.TrimSpace(string(data)), ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed plugin %q", path)
+	}
+	return &filePlugin{name: name, version: version}, nil
+}
+
+func TestPluginWatcher(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin := func(file, contents string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(contents), 0o600))
+	}
+	version := func(registry *scaling.Registry, name string) string {
+		algorithm, err := registry.Get(name)
+		if err != nil {
+			return ""
+		}
+		result, err := algorithm.ComputeScale(context.Background(), scaling.ScalingInput{})
+		require.NoError(t, err)
+		return result.Reason
+	}
+
+	writePlugin("alpha.wasm", "Alpha:v1")
+	writePlugin("notes.txt", "Ignored:v1")
+	registry := scaling.NewRegistry()
+	require.NoError(t, registry.Register(scaling.NewMaxRatioAlgorithm(scaling.DefaultTolerance)))
+	w := &PluginWatcher{Dir: dir, Registry: registry, Delay: 10 * time.Millisecond, load: loadFilePlugin}
+
+	names, err := w.LoadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Alpha"}, names)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, w.Start(ctx))
+	}()
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	// Let the watcher subscribe before changing files
+	time.Sleep(100 * time.Millisecond)
+
+	// New plugins are registered and updated ones replace their algorithm
+	writePlugin("beta.wasm", "Beta:v1")
+	writePlugin("alpha.wasm", "Alpha:v2")
+	assert.Eventually(t, func() bool {
+		return version(registry, "Beta") == "v1" && version(registry, "Alpha") == "v2"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A plugin cannot replace a built-in algorithm
+	writePlugin("shadow.wasm", "MaxRatio:v1")
+	time.Sleep(100 * time.Millisecond)
+	writePlugin("gamma.wasm", "Gamma:v1")
+	assert.Eventually(t, func() bool { return registry.Has("Gamma") }, 5*time.Second, 10*time.Millisecond)
+	algorithm, err := registry.Get("MaxRatio")
+	require.NoError(t, err)
+	assert.IsType(t, &scaling.MaxRatioAlgorithm{}, algorithm)
+
+	// Removing a file unregisters its algorithm
+	require.NoError(t, os.Remove(filepath.Join(dir, "beta.wasm")))
+	assert.Eventually(t, func() bool { return !registry.Has("Beta") }, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, registry.Has("Alpha"))
+}
+
+func TestPluginWatcherMissingDirectory(t *testing.T) {
+	w := &PluginWatcher{Dir: filepath.Join(t.TempDir(), "missing"), Registry: scaling.NewRegistry()}
+	_, err := w.LoadAll()
+	assert.Error(t, err)
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/features"
+)
+
+const (
+	// ConditionTypePodMonitorReady indicates a managed PodMonitor scrapes the target's pods
+	ConditionTypePodMonitorReady = "PodMonitorReady"
+	// DefaultPodMonitorPath is scraped when podMonitor.path is not set
+	DefaultPodMonitorPath = "/metrics"
+
+	// managedByLabel marks the PodMonitors created by the controller, which
+	// never takes over one it did not create
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "kubeai-autoscaler"
+)
+
+// podMonitorGVK is the Prometheus Operator PodMonitor, addressed as
+// unstructured so the operator's types are not a dependency
+var podMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"}
+
+// podMonitorName returns the name of the PodMonitor managed for the policy
+func podMonitorName(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) string {
+	return "kubeai-autoscaler-" + policy.Name
+}
+
+// scrapesTargetPods reports whether an enabled metric is served by the
+// target's pods. GPU utilization comes from the DCGM exporter and synthetic
+// probes are measured by the controller, so neither needs a PodMonitor.
+func scrapesTargetPods(spec kubeaiv1alpha1.MetricsSpec) bool {
+	return (spec.Latency != nil && spec.Latency.Enabled) ||
+		(spec.RequestQueueDepth != nil && spec.RequestQueueDepth.Enabled) ||
+		(spec.TokensPerSecond != nil && spec.TokensPerSecond.Enabled) ||
+		(spec.InFlightRequests != nil && spec.InFlightRequests.Enabled) ||
+		(spec.Queueing != nil && spec.Queueing.Enabled) ||
+		len(spec.CustomMetrics) > 0
+}
+
+// reconcilePodMonitor creates or updates the PodMonitor requested by
+// spec.podMonitor, so the metrics the policy scales on are scraped before
+// they are queried, and deletes it once it is no longer requested. Recommend
+// mode controllers leave PodMonitors to the active controller.
+func (r *AIInferenceAutoscalerPolicyReconciler) reconcilePodMonitor(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) {
+	logger := log.FromContext(ctx)
+	if r.Mode == ModeRecommend {
+		return
+	}
+
+	if policy.Spec.PodMonitor == nil || !scrapesTargetPods(policy.Spec.Metrics) {
+		if meta.FindStatusCondition(policy.Status.Conditions, ConditionTypePodMonitorReady) == nil ||
+			r.hasCondition(policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "PodMonitorRemoved") {
+			return
+		}
+		if r.Features.Enabled(features.ManagedPodMonitors) {
+			if err := r.deletePodMonitor(ctx, policy); err != nil {
+				logger.Error(err, "Failed to delete PodMonitor")
+				return
+			}
+		}
+		r.updateCondition(ctx, policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "PodMonitorRemoved",
+			"No PodMonitor is requested, or no enabled metric is served by the target's pods")
+		return
+	}
+
+	if !r.Features.Enabled(features.ManagedPodMonitors) {
+		if !r.hasCondition(policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "FeatureGateDisabled") {
+			r.updateCondition(ctx, policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "FeatureGateDisabled",
+				"Start the controller with --feature-gates=ManagedPodMonitors=true to create the PodMonitor")
+		}
+		return
+	}
+
+	status, reason, message := metav1.ConditionTrue, "PodMonitorApplied",
+		fmt.Sprintf("PodMonitor %s scrapes port %s of the target's pods", podMonitorName(policy), policy.Spec.PodMonitor.Port)
+	if err := r.applyPodMonitor(ctx, policy); meta.IsNoMatchError(err) {
+		status, reason, message = metav1.ConditionFalse, "PodMonitorCRDMissing",
+			"The Prometheus Operator PodMonitor CRD is not installed"
+	} else if err != nil {
+		logger.Error(err, "Failed to apply PodMonitor")
+		status, reason, message = metav1.ConditionFalse, "PodMonitorFailed", err.Error()
+	}
+	if !r.hasCondition(policy, ConditionTypePodMonitorReady, status, reason) {
+		r.updateCondition(ctx, policy, ConditionTypePodMonitorReady, status, reason, message)
+	}
+}
+
+// desiredPodMonitorSpec returns the spec of the PodMonitor scraping the
+// target's pods on the policy's polling interval unless one is set
+func (r *AIInferenceAutoscalerPolicyReconciler) desiredPodMonitorSpec(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (map[string]any, error) {
+	selector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if selector == nil || selector.Empty() {
+		return nil, fmt.Errorf("%s %s has no pod selector", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+	}
+	labelSelector, err := metav1.ParseToLabelSelector(selector.String())
+	if err != nil {
+		return nil, err
+	}
+	selectorFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := policy.Spec.PodMonitor
+	path := spec.Path
+	if path == "" {
+		path = DefaultPodMonitorPath
+	}
+	interval := int64(spec.IntervalSeconds)
+	if interval == 0 {
+		interval = int64(pollingInterval(policy).Seconds())
+	}
+	return map[string]any{
+		"selector": selectorFields,
+		"podMetricsEndpoints": []any{map[string]any{
+			"port":     spec.Port,
+			"path":     path,
+			"interval": fmt.Sprintf("%ds", interval),
+		}},
+	}, nil
+}
+
+// applyPodMonitor creates the PodMonitor, owned by the policy so it is
+// garbage collected with it, or updates it when its spec or labels drifted
+func (r *AIInferenceAutoscalerPolicyReconciler) applyPodMonitor(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) error {
+	spec, err := r.desiredPodMonitorSpec(ctx, policy)
+	if err != nil {
+		return err
+	}
+	labels := map[string]string{managedByLabel: managedByValue}
+	for key, value := range policy.Spec.PodMonitor.Labels {
+		labels[key] = value
+	}
+
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(podMonitorGVK)
+	ref := types.NamespacedName{Namespace: policy.Namespace, Name: podMonitorName(policy)}
+	err = r.Get(ctx, ref, monitor)
+	if errors.IsNotFound(err) {
+		monitor.SetName(ref.Name)
+		monitor.SetNamespace(ref.Namespace)
+		monitor.SetLabels(labels)
+		monitor.SetOwnerReferences([]metav1.OwnerReference{
+			*metav1.NewControllerRef(policy, kubeaiv1alpha1.GroupVersion.WithKind("AIInferenceAutoscalerPolicy")),
+		})
+		monitor.Object["spec"] = spec
+		return r.Create(ctx, monitor)
+	}
+	if err != nil {
+		return err
+	}
+	if monitor.GetLabels()[managedByLabel] != managedByValue {
+		return fmt.Errorf("PodMonitor %s already exists and is not managed by the autoscaler", ref.Name)
+	}
+
+	current, _, _ := unstructured.NestedMap(monitor.Object, "spec")
+	if equality.Semantic.DeepEqual(current, spec) && equality.Semantic.DeepEqual(monitor.GetLabels(), labels) {
+		return nil
+	}
+	monitor.SetLabels(labels)
+	monitor.Object["spec"] = spec
+	return r.Update(ctx, monitor)
+}
+
+// deletePodMonitor deletes the PodMonitor managed for the policy, if any
+func (r *AIInferenceAutoscalerPolicyReconciler) deletePodMonitor(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) error {
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(podMonitorGVK)
+	err := r.Get(ctx, types.NamespacedName{Namespace: policy.Namespace, Name: podMonitorName(policy)}, monitor)
+	if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if monitor.GetLabels()[managedByLabel] != managedByValue {
+		return nil
+	}
+	if err := r.Delete(ctx, monitor); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/features"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// podMonitorTestSetup returns a reconciler with the ManagedPodMonitors gate
+// on, a latency policy requesting a PodMonitor and its Deployment target.
+// withCRD serves PodMonitors, as if the Prometheus Operator were installed.
+func podMonitorTestSetup(t *testing.T, withCRD bool, objs ...runtime.Object) (*AIInferenceAutoscalerPolicyReconciler, *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) {
+	t.Helper()
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("llm-policy")
+	policy.Spec.Metrics = kubeaiv1alpha1.MetricsSpec{
+		Latency: &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+	}
+	policy.Spec.PodMonitor = &kubeaiv1alpha1.PodMonitorSpec{
+		Port:   "metrics",
+		Labels: map[string]string{"release": "prometheus"},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(2),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithRuntimeObjects(objs...).WithStatusSubresource(policy)
+	if !withCRD {
+		// The fake client serves any unstructured kind, so fail PodMonitor reads the way the API server does
+		builder = builder.WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if obj.GetObjectKind().GroupVersionKind() == podMonitorGVK {
+					return &meta.NoKindMatchError{GroupKind: podMonitorGVK.GroupKind(), SearchedVersions: []string{podMonitorGVK.Version}}
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		})
+	}
+	c := builder.Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	r.Features = features.Gates{features.ManagedPodMonitors: true}
+	return r, policy
+}
+
+// getPodMonitor reads the PodMonitor managed for the policy
+func getPodMonitor(t *testing.T, r *AIInferenceAutoscalerPolicyReconciler, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (*unstructured.Unstructured, error) {
+	t.Helper()
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(podMonitorGVK)
+	err := r.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: podMonitorName(policy)}, monitor)
+	return monitor, err
+}
+
+func TestScrapesTargetPods(t *testing.T) {
+	assert.False(t, scrapesTargetPods(kubeaiv1alpha1.MetricsSpec{
+		GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 50},
+	}))
+	assert.False(t, scrapesTargetPods(kubeaiv1alpha1.MetricsSpec{
+		Latency: &kubeaiv1alpha1.LatencyMetric{Enabled: false, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+	}))
+	assert.True(t, scrapesTargetPods(kubeaiv1alpha1.MetricsSpec{
+		RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))},
+	}))
+	assert.True(t, scrapesTargetPods(kubeaiv1alpha1.MetricsSpec{
+		CustomMetrics: []kubeaiv1alpha1.CustomMetric{{Name: "cache_hit_rate", Query: "vllm:cache_hit_rate"}},
+	}))
+}
+
+func TestReconcilePodMonitor(t *testing.T) {
+	r, policy := podMonitorTestSetup(t, true)
+	ctx := context.Background()
+
+	r.reconcilePodMonitor(ctx, policy)
+	assert.True(t, r.hasCondition(policy, ConditionTypePodMonitorReady, metav1.ConditionTrue, "PodMonitorApplied"))
+	monitor, err := getPodMonitor(t, r, policy)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"release": "prometheus", managedByLabel: managedByValue}, monitor.GetLabels())
+	require.Len(t, monitor.GetOwnerReferences(), 1)
+	assert.Equal(t, "llm-policy", monitor.GetOwnerReferences()[0].Name)
+	matchLabels, _, _ := unstructured.NestedStringMap(monitor.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{"app": "llm"}, matchLabels)
+	endpoints, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "podMetricsEndpoints")
+	assert.Equal(t, []any{map[string]any{"port": "metrics", "path": "/metrics", "interval": "30s"}}, endpoints)
+
+	// A changed spec updates the PodMonitor
+	policy.Spec.PodMonitor.Port = "http"
+	policy.Spec.PodMonitor.IntervalSeconds = 10
+	r.reconcilePodMonitor(ctx, policy)
+	monitor, err = getPodMonitor(t, r, policy)
+	require.NoError(t, err)
+	endpoints, _, _ = unstructured.NestedSlice(monitor.Object, "spec", "podMetricsEndpoints")
+	assert.Equal(t, []any{map[string]any{"port": "http", "path": "/metrics", "interval": "10s"}}, endpoints)
+
+	// Only GPU utilization, read from the DCGM exporter, is left
+	policy.Spec.Metrics = kubeaiv1alpha1.MetricsSpec{
+		GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 50},
+	}
+	r.reconcilePodMonitor(ctx, policy)
+	assert.True(t, r.hasCondition(policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "PodMonitorRemoved"))
+	_, err = getPodMonitor(t, r, policy)
+	assert.True(t, apierrors.IsNotFound(err), "PodMonitor should be deleted")
+}
+
+func TestReconcilePodMonitorFeatureGateDisabled(t *testing.T) {
+	r, policy := podMonitorTestSetup(t, true)
+	r.Features = nil
+
+	r.reconcilePodMonitor(context.Background(), policy)
+	assert.True(t, r.hasCondition(policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "FeatureGateDisabled"))
+	_, err := getPodMonitor(t, r, policy)
+	assert.True(t, apierrors.IsNotFound(err), "PodMonitor should not be created")
+}
+
+func TestReconcilePodMonitorCRDMissing(t *testing.T) {
+	r, policy := podMonitorTestSetup(t, false)
+
+	r.reconcilePodMonitor(context.Background(), policy)
+	assert.True(t, r.hasCondition(policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "PodMonitorCRDMissing"))
+}
+
+func TestReconcilePodMonitorNotManaged(t *testing.T) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(podMonitorGVK)
+	existing.SetName("kubeai-autoscaler-llm-policy")
+	existing.SetNamespace("default")
+	existing.Object["spec"] = map[string]any{"podMetricsEndpoints": []any{map[string]any{"port": "admin"}}}
+	r, policy := podMonitorTestSetup(t, true, existing)
+
+	r.reconcilePodMonitor(context.Background(), policy)
+	assert.True(t, r.hasCondition(policy, ConditionTypePodMonitorReady, metav1.ConditionFalse, "PodMonitorFailed"))
+	monitor, err := getPodMonitor(t, r, policy)
+	require.NoError(t, err)
+	port, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "podMetricsEndpoints")
+	assert.Equal(t, []any{map[string]any{"port": "admin"}}, port, "a PodMonitor the controller did not create is left alone")
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+// Controller modes
+const (
+	// ModeEnforce scales targets and owns policy status
+	ModeEnforce = "Enforce"
+	// ModeRecommend computes every decision without writing anything and compares
+	// it with the decision of the active controller, so a new controller version
+	// can run alongside the current one before it is switched to Enforce
+	ModeRecommend = "Recommend"
+)
+
+// activeDecision returns the replicas last decided by the active controller, if
+// it is reconciling the policy successfully
+func (r *AIInferenceAutoscalerPolicyReconciler) activeDecision(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int32, bool) {
+	if !r.isConditionTrue(policy, ConditionTypeReady) {
+		return 0, false
+	}
+	return policy.Status.DesiredReplicas, true
+}
+
+// recordRecommendation compares the decision made in Recommend mode with the
+// active controller's decision read at the start of the reconcile
+func (r *AIInferenceAutoscalerPolicyReconciler) recordRecommendation(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, recommended, active int32, activeOK bool) {
+	if !activeOK {
+		return
+	}
+	metrics.RecordRecommendComparison(policy.Namespace, policy.Name, recommended, active)
+}
+
+// NewReadOnlyClient returns a client that reads through c and drops every
+// write. Recommend mode runs the full decision path with it, so conditions,
+// scale locks and ConfigMaps written along the way never reach the API server.
+func NewReadOnlyClient(c client.Client) client.Client {
+	return readOnlyClient{Client: c}
+}
+
+type readOnlyClient struct {
+	client.Client
+}
+
+func (readOnlyClient) Apply(context.Context, runtime.ApplyConfiguration, ...client.ApplyOption) error {
+	return nil
+}
+
+func (readOnlyClient) Create(context.Context, client.Object, ...client.CreateOption) error {
+	return nil
+}
+
+func (readOnlyClient) Delete(context.Context, client.Object, ...client.DeleteOption) error {
+	return nil
+}
+
+func (readOnlyClient) Update(context.Context, client.Object, ...client.UpdateOption) error {
+	return nil
+}
+
+func (readOnlyClient) Patch(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+	return nil
+}
+
+func (readOnlyClient) DeleteAllOf(context.Context, client.Object, ...client.DeleteAllOfOption) error {
+	return nil
+}
+
+func (readOnlyClient) Status() client.SubResourceWriter {
+	return readOnlySubResourceClient{}
+}
+
+func (c readOnlyClient) SubResource(subResource string) client.SubResourceClient {
+	return readOnlySubResourceClient{SubResourceReader: c.Client.SubResource(subResource)}
+}
+
+type readOnlySubResourceClient struct {
+	client.SubResourceReader
+}
+
+func (readOnlySubResourceClient) Create(context.Context, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+	return nil
+}
+
+func (readOnlySubResourceClient) Update(context.Context, client.Object, ...client.SubResourceUpdateOption) error {
+	return nil
+}
+
+func (readOnlySubResourceClient) Patch(context.Context, client.Object, client.Patch, ...client.SubResourcePatchOption) error {
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestRecommendMode(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("recommend")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	active := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	recommendMetrics := &metrics.MockClient{GPUUtilizationValue: 100}
+	recommend := NewReconciler(NewReadOnlyClient(c), scheme, recommendMetrics, scaling.DefaultRegistry, nil)
+	recommend.Mode = ModeRecommend
+	clock := clocktesting.NewFakePassiveClock(time.Now())
+	recommend.Clock = clock
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "recommend", Namespace: "default"}}
+
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+	stored := func() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		updated := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, updated))
+		return updated
+	}
+	comparisons := func(result string) float64 {
+		return testutil.ToFloat64(metrics.RecommendComparisons.WithLabelValues("default", "recommend", result))
+	}
+
+	// Nothing is written, and nothing is compared until the active controller has decided
+	_, err := recommend.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), replicas())
+	assert.Empty(t, stored().Status.Conditions)
+	assert.Error(t, c.Get(ctx, types.NamespacedName{Name: scaleLockName(policy), Namespace: "default"}, &coordinationv1.Lease{}))
+	assert.Zero(t, comparisons("agree")+comparisons("disagree"))
+
+	// The active controller scales to 4
+	_, err = active.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(4), replicas())
+
+	// On target, both keep 4 replicas
+	recommendMetrics.GPUUtilizationValue = 50
+	_, err = recommend.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, comparisons("agree"))
+
+	// A decision held by the active controller's cooldown is not compared
+	recommendMetrics.GPUUtilizationValue = 100
+	_, err = recommend.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Zero(t, comparisons("disagree"))
+
+	// Once cooldown has passed the new version would scale to 8 where the active controller decided 4
+	clock.SetTime(clock.Now().Add(time.Hour))
+	_, err = recommend.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, comparisons("disagree"))
+	assert.Equal(t, 4.0, testutil.ToFloat64(metrics.RecommendDifference.WithLabelValues("default", "recommend")))
+	assert.Equal(t, int32(4), replicas())
+	assert.Equal(t, int32(4), stored().Status.DesiredReplicas)
+}
@@ -18,21 +18,39 @@ package controller
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"math"
+	"sync"
 	"time"
 
-	appsv1 "k8s.io/api/apps/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/capacity"
+	"github.com/pmady/kubeai-autoscaler/pkg/cloudevents"
+	"github.com/pmady/kubeai-autoscaler/pkg/features"
 	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
 	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+	"github.com/pmady/kubeai-autoscaler/pkg/synthetic"
+	"github.com/pmady/kubeai-autoscaler/pkg/tracing"
 )
 
 const (
@@ -42,6 +60,8 @@ const (
 	ConditionTypeScaling = "Scaling"
 	// ConditionTypeAlgorithmValid indicates the configured algorithm is valid
 	ConditionTypeAlgorithmValid = "AlgorithmValid"
+	// ConditionTypeDryRun reports the outcome of the last server-side dry-run scale
+	ConditionTypeDryRun = "DryRun"
 	// DefaultCooldownPeriod is the default cooldown between scaling events
 	DefaultCooldownPeriod = 300 * time.Second
 	// DefaultRequeueInterval is the default requeue interval
@@ -63,6 +83,123 @@ type AIInferenceAutoscalerPolicyReconciler struct {
 	EventRecorder     *EventRecorder
 	LastScaleTime     map[string]time.Time
 	CooldownPeriod    time.Duration
+	CapacityProber    *capacity.Prober
+	SyntheticProber   *synthetic.Prober
+
+	// AlgorithmTimeout is the deadline for a single algorithm computation
+	AlgorithmTimeout time.Duration
+	// FallbackAlgorithm is used when a policy's algorithm is not registered or
+	// does not complete and the policy sets no fallback of its own; empty
+	// means DefaultAlgorithmName
+	FallbackAlgorithm string
+	// DecisionTimeout bounds reading the target, fetching metrics, computing and
+	// writing one decision; past it the reconcile is abandoned (0 disables)
+	DecisionTimeout time.Duration
+	// MetricsFetchTimeout bounds the concurrent metric queries of one reconcile;
+	// queries still running at the deadline count as missing (0 disables)
+	MetricsFetchTimeout time.Duration
+	// StorageVersions reports policies still stored at an old CRD version
+	// through the StorageVersionOutdated condition; nil disables it
+	StorageVersions *StorageVersionChecker
+	// Features holds the feature gates; nil leaves every gate at its default
+	Features features.Gates
+	// SaturationThreshold is how long a policy may stay pinned at maxReplicas before it is reported
+	SaturationThreshold time.Duration
+
+	// ConvergenceRequeueInterval is the requeue interval used right after a scale change
+	ConvergenceRequeueInterval time.Duration
+	// ConvergenceRequeueCount is the number of short requeues after a scale change
+	ConvergenceRequeueCount int
+
+	// NewMetricsClient builds clients for policies that set their own Prometheus endpoints
+	NewMetricsClient MetricsClientFactory
+	// MetricsOverride, when set, serves every policy in place of its Prometheus and
+	// metric source settings; used by the local development mode
+	MetricsOverride metrics.Client
+	// AWSCredentials, when set, replace the credentials found in the controller's
+	// environment for CloudWatch and SQS metric sources
+	AWSCredentials metrics.AWSCredentialsProvider
+	// GoogleTokenSource, when set, replaces the credentials found in the
+	// controller's environment for Cloud Monitoring metric sources
+	GoogleTokenSource metrics.GoogleTokenSource
+	// RESTConfig reaches the API server serving the custom and external
+	// metrics APIs for MetricsAPI metric sources
+	RESTConfig *rest.Config
+	// OTLPReceiver holds the metrics pushed to the controller for OTLP metric
+	// sources; nil when the controller does not receive OTLP
+	OTLPReceiver *metrics.OTLPReceiver
+	// ResourceMetrics reads the CPU and memory usage of target pods from
+	// metrics-server; without it those metrics are missing
+	ResourceMetrics *metrics.ResourceMetricsClient
+
+	// ScaleLockDuration is how long the per-target Lease blocks other writers after a
+	// replica change (0 disables locking)
+	ScaleLockDuration time.Duration
+	// LockIdentity identifies this controller instance in scale locks
+	LockIdentity string
+
+	// Clock supplies the time used for scaling decisions; tests substitute a fake clock
+	Clock clock.PassiveClock
+
+	// AlgorithmState holds per-policy state of stateful algorithms and decorators
+	AlgorithmState *scaling.StateStore
+
+	// AlgorithmFilter limits the algorithms policies may use; namespaces can
+	// restrict them further with the allowed- and denied-algorithms annotations
+	AlgorithmFilter scaling.AlgorithmFilter
+
+	// Optimizer, when set, is asked for advisory constraints on every decision
+	Optimizer scaling.Optimizer
+
+	// CostClient, when set, supplies the realized cost of each policy's target
+	CostClient metrics.CostClient
+	// CostWindow is the period the realized cost is averaged over
+	CostWindow time.Duration
+	// CostRefreshInterval is how long a realized cost is reused before it is queried again
+	CostRefreshInterval time.Duration
+
+	// CloudEvents, when set, receives a CloudEvent for every decision that
+	// changes the replicas and for its outcome
+	CloudEvents cloudevents.Publisher
+
+	// DecisionLogging, when set, selects policies whose decision inputs are logged in full
+	DecisionLogging *DecisionLogging
+
+	// EmergencyStop, when set, halts the target changes of every policy while a stop is in effect
+	EmergencyStop *EmergencyStop
+
+	// ModelProfilesConfigMap, when named, holds the model profile table under
+	// ModelProfilesKey in place of DefaultModelProfiles
+	ModelProfilesConfigMap types.NamespacedName
+
+	// Mode is ModeEnforce or ModeRecommend; empty means ModeEnforce. Recommend mode
+	// stops before scaling and expects a client from NewReadOnlyClient.
+	Mode string
+
+	requeueMu    sync.Mutex
+	fastRequeues map[string]int
+
+	behaviorMu      sync.Mutex
+	recommendations map[string][]timestampedRecommendation
+	scaleUpEvents   map[string][]timestampedScaleEvent
+	scaleDownEvents map[string][]timestampedScaleEvent
+
+	metricsClientsMu   sync.Mutex
+	metricsClients     map[string]metrics.Client
+	metricsClientUsers map[string]sets.Set[string]
+
+	lastMetricsMu sync.Mutex
+	lastMetrics   map[string]map[string]timestampedMetric
+
+	costMu sync.Mutex
+	costs  map[string]cachedCost
+
+	// reconciles tracks reconciles in progress for graceful shutdown
+	reconciles reconcileTracker
+
+	// simulatedMetrics, when set, are returned by fetchMetrics in place of
+	// querying the metric sources; see Simulate
+	simulatedMetrics *kubeaiv1alpha1.CurrentMetrics
 }
 
 // NewReconciler creates a new reconciler
@@ -78,6 +215,29 @@ func NewReconciler(client client.Client, scheme *runtime.Scheme, metricsClient m
 		EventRecorder:     eventRecorder,
 		LastScaleTime:     make(map[string]time.Time),
 		CooldownPeriod:    DefaultCooldownPeriod,
+		CapacityProber:    capacity.NewProber(),
+		SyntheticProber:   synthetic.NewProber(),
+		AlgorithmTimeout:  scaling.DefaultComputeTimeout,
+		DecisionTimeout:   DefaultDecisionTimeout,
+
+		MetricsFetchTimeout: DefaultMetricsFetchTimeout,
+
+		SaturationThreshold: DefaultSaturationThreshold,
+
+		ConvergenceRequeueInterval: DefaultConvergenceRequeueInterval,
+		ConvergenceRequeueCount:    DefaultConvergenceRequeueCount,
+		fastRequeues:               make(map[string]int),
+
+		NewMetricsClient: defaultMetricsClientFactory,
+		metricsClients:   make(map[string]metrics.Client),
+
+		ScaleLockDuration: DefaultScaleLockDuration,
+		LockIdentity:      defaultLockIdentity(),
+		Clock:             clock.RealClock{},
+		AlgorithmState:    scaling.NewStateStore(),
+
+		CostWindow:          DefaultCostWindow,
+		CostRefreshInterval: DefaultCostRefreshInterval,
 	}
 }
 
@@ -87,16 +247,57 @@ func NewReconciler(client client.Client, scheme *runtime.Scheme, metricsClient m
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;create;update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update
+// +kubebuilder:rbac:groups=*,resources=*/scale,verbs=get;update
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+// +kubebuilder:rbac:groups=custom.metrics.k8s.io;external.metrics.k8s.io,resources=*,verbs=get
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=get;create;update;delete
 
 // Reconcile handles the reconciliation loop for AIInferenceAutoscalerPolicy
 func (r *AIInferenceAutoscalerPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	// Once shutdown has begun, leave the policy to the next leader. A reconcile
+	// that has started runs to completion, bounded by the manager's graceful
+	// shutdown timeout, so a scale change is never applied without its status.
+	if !r.reconciles.start() {
+		return ctrl.Result{}, nil
+	}
+	defer r.reconciles.done()
+	ctx = context.WithoutCancel(ctx)
+
+	// Trace the reconcile so metrics can link back to it through exemplars
+	ctx, span := tracing.Tracer().Start(ctx, "Reconcile", trace.WithAttributes(
+		attribute.String("namespace", req.Namespace),
+		attribute.String("policy", req.Name)))
+	defer span.End()
+	start := time.Now()
+	defer func() {
+		metrics.RecordReconcileLatencyContext(ctx, req.Namespace, req.Name, time.Since(start).Seconds())
+	}()
+
 	// Fetch the AIInferenceAutoscalerPolicy instance
 	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
 	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
 		if errors.IsNotFound(err) {
 			logger.Info("AIInferenceAutoscalerPolicy not found, ignoring")
+			r.algorithmState().Forget(req.String())
+			r.forgetCost(req.String())
+			r.forgetLastMetrics(req.String())
+			r.forgetConvergence(req.String())
+			r.forgetBehavior(req.String())
+			r.forgetMetricsClients(req.String())
+			delete(r.LastScaleTime, req.String())
+			if r.SyntheticProber != nil {
+				r.SyntheticProber.Forget(req.String())
+			}
+			metrics.ForgetPolicy(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
@@ -107,27 +308,112 @@ func (r *AIInferenceAutoscalerPolicyReconciler) Reconcile(ctx context.Context, r
 		"namespace", policy.Namespace,
 		"target", policy.Spec.TargetRef.Name)
 
+	// Report policies a storage version migration has not rewritten yet
+	r.reportStorageVersion(ctx, policy)
+
+	// Read the active controller's decision before this reconcile changes the status in memory
+	activeReplicas, activeOK := r.activeDecision(policy)
+
+	// Bound the decision so a slow dependency cannot stall this worker; status
+	// and condition writes keep using ctx
+	decisionCtx, cancel := r.decisionContext(ctx)
+	defer cancel()
+
 	// Get current replica count
-	currentReplicas, err := r.getCurrentReplicas(ctx, policy)
+	currentReplicas, err := r.getCurrentReplicas(decisionCtx, policy)
 	if err != nil {
+		if r.decisionTimedOut(ctx, decisionCtx, policy, DecisionStageTarget) {
+			return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+		}
 		logger.Error(err, "Failed to get current replicas")
 		r.updateCondition(ctx, policy, ConditionTypeReady, metav1.ConditionFalse, "TargetNotFound", err.Error())
-		return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
+		return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+	}
+
+	// Discover per-replica capacity from the inference runtime
+	if policy.Spec.CapacityProbe != nil {
+		discovered, err := r.probeCapacity(decisionCtx, policy)
+		if err != nil {
+			logger.Error(err, "Failed to probe capacity, keeping last discovered value",
+				"discoveredCapacity", policy.Status.DiscoveredCapacity)
+		} else {
+			policy.Status.DiscoveredCapacity = discovered
+		}
+	}
+
+	// Read the GPU count per replica for per-GPU targets
+	if policy.Spec.TargetsPerGPU {
+		gpus, err := r.discoverGPUsPerReplica(decisionCtx, policy)
+		if err != nil {
+			logger.Error(err, "Failed to read GPUs per replica, keeping last value",
+				"gpusPerReplica", policy.Status.GPUsPerReplica)
+		} else {
+			policy.Status.GPUsPerReplica = gpus
+		}
 	}
 
+	// Make sure the metrics the policy scales on are scraped
+	r.reconcilePodMonitor(ctx, policy)
+
 	// Fetch current metrics
-	currentMetrics, err := r.fetchMetrics(ctx, policy)
+	currentMetrics, err := r.fetchMetrics(decisionCtx, policy)
+	if r.decisionTimedOut(ctx, decisionCtx, policy, DecisionStageMetrics) {
+		return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+	}
 	if err != nil {
 		logger.Error(err, "Failed to fetch metrics")
+		policy.Status.MetricsFailureCount++
 		r.updateCondition(ctx, policy, ConditionTypeReady, metav1.ConditionFalse, "MetricsFetchFailed", err.Error())
-		return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
+		r.applyFallback(ctx, policy, currentReplicas, err)
+		return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
 	}
+	r.clearFallback(ctx, policy)
+
+	// Report the realized cost of the target alongside the decision
+	r.applyRealizedCost(decisionCtx, policy, currentReplicas, currentMetrics)
+
+	// Fill unset targets and behavior from the profile of the target's model
+	profilePolicy := r.applyModelProfile(decisionCtx, policy)
+
+	// Adjust metric targets for the active time-of-day window
+	decisionPolicy := r.applyTargetModulation(policy, profilePolicy, r.now())
+
+	// Keep the decision off algorithms the controller or namespace does not allow
+	decisionPolicy, disallowedField, disallowedName := r.filterAlgorithms(ctx, decisionPolicy)
 
 	// Calculate desired replicas
-	desiredReplicas, algorithmUsed, scaleReason, algorithmNotFound, requestedAlgoName := r.calculateDesiredReplicas(ctx, policy, currentReplicas, currentMetrics)
+	desiredReplicas, algorithmUsed, decision, algorithmNotFound, requestedAlgoName, algorithmErr := r.calculateDesiredReplicas(decisionCtx, decisionPolicy, currentReplicas, currentMetrics)
+	if r.decisionTimedOut(ctx, decisionCtx, policy, DecisionStageAlgorithm) {
+		return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+	}
+
+	snapshot := newDecisionSnapshot(decisionPolicy, currentReplicas, currentMetrics, algorithmUsed, decision, r.now())
+	// Export the metrics behind the decision, which kubectl kubeai fleet replays
+	exportMetrics(decisionPolicy, currentReplicas, currentMetrics)
+	// Log the decision as it stands when the reconcile ends, however it ends
+	defer func() { r.logDecisionInputs(ctx, decisionPolicy, currentMetrics, decision, snapshot) }()
 
 	// Handle algorithm validity feedback
-	if requestedAlgoName != "" {
+	if disallowedName != "" {
+		// Only emit event if condition is transitioning (prevent spam)
+		if !r.hasCondition(policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse, ReasonAlgorithmNotAllowed) {
+			if r.EventRecorder != nil {
+				r.EventRecorder.RecordAlgorithmNotAllowed(policy, disallowedField, disallowedName, algorithmUsed)
+			}
+		}
+		r.updateCondition(ctx, policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse,
+			ReasonAlgorithmNotAllowed,
+			fmt.Sprintf("Algorithm %q in %s is not allowed in namespace %s, using %q", disallowedName, disallowedField, policy.Namespace, algorithmUsed))
+	} else if algorithmErr != nil {
+		reason := algorithmErrorReason(algorithmErr)
+		// Only emit event if condition is transitioning (prevent spam)
+		if !r.hasCondition(policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse, reason) {
+			if r.EventRecorder != nil {
+				r.EventRecorder.RecordAlgorithmError(policy, reason, algorithmErr)
+			}
+		}
+		r.updateCondition(ctx, policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse, reason, algorithmErr.Error())
+	} else if requestedAlgoName != "" || hasDirectionAlgorithms(policy) {
 		if algorithmNotFound {
 			// Only emit event if condition is transitioning (prevent spam)
 			if !r.hasCondition(policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse, ReasonUnknownAlgorithm) {
@@ -138,26 +424,142 @@ func (r *AIInferenceAutoscalerPolicyReconciler) Reconcile(ctx context.Context, r
 			r.updateCondition(ctx, policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse,
 				ReasonUnknownAlgorithm,
 				fmt.Sprintf("Algorithm %q not found, using fallback %q", requestedAlgoName, algorithmUsed))
+		} else if err := r.validateAlgorithmParameters(policy, algorithmUsed); err != nil {
+			if !r.hasCondition(policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse, ReasonInvalidAlgorithmParameters) {
+				if r.EventRecorder != nil {
+					r.EventRecorder.RecordInvalidAlgorithmParameters(policy, err)
+				}
+			}
+			r.updateCondition(ctx, policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse,
+				ReasonInvalidAlgorithmParameters, err.Error())
+		} else if field, name := r.unknownDirectionAlgorithm(policy); name != "" {
+			if !r.hasCondition(policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse, ReasonUnknownAlgorithm) {
+				if r.EventRecorder != nil {
+					r.EventRecorder.RecordUnknownDirectionAlgorithm(policy, field, name, algorithmUsed, r.AlgorithmRegistry.List())
+				}
+			}
+			r.updateCondition(ctx, policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse,
+				ReasonUnknownAlgorithm,
+				fmt.Sprintf("Algorithm %q in %s not found, using %q", name, field, algorithmUsed))
+		} else if name := r.unknownFallbackAlgorithm(policy); name != "" {
+			fallbackName := r.fallbackAlgorithmName(policy)
+			if !r.hasCondition(policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse, ReasonUnknownAlgorithm) {
+				if r.EventRecorder != nil {
+					r.EventRecorder.RecordUnknownFallbackAlgorithm(policy, name, fallbackName, r.AlgorithmRegistry.List())
+				}
+			}
+			r.updateCondition(ctx, policy, ConditionTypeAlgorithmValid, metav1.ConditionFalse,
+				ReasonUnknownAlgorithm,
+				fmt.Sprintf("Fallback algorithm %q in spec.algorithm.fallback not found, falling back to %q", name, fallbackName))
 		} else {
 			r.updateCondition(ctx, policy, ConditionTypeAlgorithmValid, metav1.ConditionTrue,
 				"AlgorithmFound", fmt.Sprintf("Using algorithm %q", algorithmUsed))
 		}
 	}
 
-	// Check cooldown period
+	// Scale idle targets with minReplicas 0 to zero
+	if replicas, reason := r.applyScaleToZero(policy, currentReplicas, desiredReplicas, currentMetrics, r.now()); reason != "" {
+		desiredReplicas = replicas
+		decision = scaling.ScalingResult{DesiredReplicas: replicas, Reason: reason, UnclampedReplicas: replicas}
+		snapshot.Reason = reason
+		snapshot.constrain(ConstraintScaleToZero, replicas)
+	}
+
+	// Bound scale-ups by the external optimizer's advice
+	desiredReplicas = r.applyOptimizerAdvice(decisionCtx, decisionPolicy, algorithmUsed, currentReplicas, desiredReplicas, currentMetrics)
+	snapshot.constrain(ConstraintOptimizer, desiredReplicas)
+
+	// Apply stabilization windows, rate policies and disabled directions
 	policyKey := fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)
-	if lastScale, ok := r.LastScaleTime[policyKey]; ok {
-		cooldown := time.Duration(policy.Spec.CooldownPeriod) * time.Second
+	recommendedReplicas := desiredReplicas
+	desiredReplicas = r.applyBehavior(ctx, policy, profilePolicy, policyKey, currentReplicas, recommendedReplicas, r.now(), snapshot)
+	policy.Status.RecommendedReplicas = recommendedReplicas
+
+	// Stop changing the target while autoscaling is disabled for the namespace
+	disabled := r.namespaceDisabled(ctx, policy)
+	desiredReplicas = r.applyNamespaceDisable(ctx, policy, disabled, currentReplicas, desiredReplicas)
+	snapshot.constrain(ConstraintNamespaceDisabled, desiredReplicas)
+
+	// Stop changing the target while the policy is suspended
+	desiredReplicas = r.applySuspend(ctx, policy, currentReplicas, desiredReplicas)
+	snapshot.constrain(ConstraintSuspended, desiredReplicas)
+
+	// Nothing changes the target while it is held
+	stop := r.emergencyStopped(ctx)
+	held := disabled || policy.Spec.Suspend || stop.Stopped
+
+	// Keep node consolidation from evicting hot replicas while scaling up under load
+	if !held {
+		r.applyEvictionProtection(ctx, policy, currentReplicas, recommendedReplicas)
+	}
+
+	// Pause scale-up while new replicas cannot be scheduled
+	desiredReplicas = r.applySchedulingBlock(ctx, policy, currentReplicas, desiredReplicas)
+	snapshot.constrain(ConstraintSchedulingBlocked, desiredReplicas)
+
+	// Keep capacity through node drains; compensation may add replicas that
+	// cannot be scheduled yet, so it is applied after the scheduling block
+	if !held {
+		desiredReplicas = r.applyDrainGuard(ctx, policy, currentReplicas, desiredReplicas)
+		snapshot.constrain(ConstraintNodeDrain, desiredReplicas)
+	}
+
+	// Keep the replicas compatible with the target's cache shard map
+	desiredReplicas = r.applyShardParity(ctx, policy, currentReplicas, desiredReplicas)
+	snapshot.constrain(ConstraintShardParity, desiredReplicas)
+
+	// Keep the partitioned rollout of a StatefulSet target intact
+	desiredReplicas = r.applyUpdatePartition(ctx, policy, currentReplicas, desiredReplicas)
+	snapshot.constrain(ConstraintUpdatePartition, desiredReplicas)
+
+	// Stop changing every target while an emergency stop is in effect; applied
+	// last so no other constraint can move the replicas
+	desiredReplicas = r.applyEmergencyStop(ctx, policy, stop, currentReplicas, desiredReplicas)
+	snapshot.constrain(ConstraintEmergencyStop, desiredReplicas)
+
+	// Check cooldown period
+	if lastScale, ok := r.lastScaleTime(policyKey, policy); ok {
+		cooldown := time.Duration(profilePolicy.Spec.CooldownPeriod) * time.Second
 		if cooldown == 0 {
 			cooldown = DefaultCooldownPeriod
 		}
-		if time.Since(lastScale) < cooldown && desiredReplicas != currentReplicas {
+		if remaining := cooldown - r.now().Sub(lastScale); remaining > 0 && desiredReplicas != currentReplicas {
 			logger.Info("Cooldown period not elapsed, skipping scaling",
 				"lastScale", lastScale,
-				"cooldown", cooldown)
-			return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
+				"cooldown", cooldown,
+				"remaining", remaining)
+			patch := client.MergeFrom(policy.DeepCopy())
+			r.recordCooldownRemaining(policy, remaining)
+			metrics.RecordReplicaCounts(policy.Namespace, policy.Name, policy.Spec.TargetRef.Name, currentReplicas, desiredReplicas)
+			snapshot.constrain(ConstraintCooldown, currentReplicas)
+			r.setDecisionSnapshot(policy, snapshot)
+			r.recordMetricHistory(policy, currentMetrics)
+			if err := r.Status().Patch(ctx, policy, patch); err != nil {
+				logger.Error(err, "Failed to update cooldown status")
+			}
+			// Reconcile again as soon as the cooldown allows the scale
+			return ctrl.Result{RequeueAfter: cooldownRequeueInterval(remaining, r.nextRequeueInterval(policyKey, pollingInterval(policy)))}, nil
 		}
 	}
+	r.recordCooldownRemaining(policy, 0)
+
+	// Compare with the active controller instead of scaling
+	if r.Mode == ModeRecommend {
+		logger.Info("Recommend mode, not scaling",
+			"current", currentReplicas,
+			"recommended", desiredReplicas,
+			"active", activeReplicas,
+			"algorithm", algorithmUsed,
+			"reason", decision.Reason)
+		r.recordRecommendation(policy, desiredReplicas, activeReplicas, activeOK)
+		return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+	}
+
+	metrics.RecordScalingDecisionContext(ctx, policy.Namespace, policy.Name, scaleDirection(currentReplicas, desiredReplicas))
+	span.SetAttributes(
+		attribute.Int("replicas.current", int(currentReplicas)),
+		attribute.Int("replicas.desired", int(desiredReplicas)),
+		attribute.String("algorithm", algorithmUsed))
 
 	// Scale if needed
 	if desiredReplicas != currentReplicas {
@@ -165,125 +567,352 @@ func (r *AIInferenceAutoscalerPolicyReconciler) Reconcile(ctx context.Context, r
 			"current", currentReplicas,
 			"desired", desiredReplicas,
 			"algorithm", algorithmUsed,
-			"reason", scaleReason)
+			"reason", decision.Reason)
+		r.publishScalingEvent(ctx, policy, cloudevents.TypeScalingDecision, snapshot, nil)
 
-		if err := r.scaleTarget(ctx, policy, desiredReplicas); err != nil {
-			logger.Error(err, "Failed to scale target")
-			r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionFalse, "ScaleFailed", err.Error())
-			return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
-		}
+		if policy.Spec.DryRun {
+			r.dryRunScale(ctx, policy, currentReplicas, desiredReplicas)
+		} else {
+			// Serialize replica writes with other controllers and policies targeting the same workload
+			acquired, holder, err := r.acquireScaleLock(ctx, policy, r.now())
+			if err != nil {
+				logger.Error(err, "Failed to acquire scale lock")
+				r.publishScalingEvent(ctx, policy, cloudevents.TypeScalingFailed, snapshot, err)
+				r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionFalse, "ScaleLockFailed", err.Error())
+				return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+			}
+			if !acquired {
+				logger.Info("Target is locked by another writer, skipping scaling", "holder", holder)
+				if !r.hasCondition(policy, ConditionTypeScaling, metav1.ConditionFalse, ReasonScaleLockHeld) && r.EventRecorder != nil {
+					r.EventRecorder.RecordScaleLockHeld(policy, holder)
+				}
+				r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionFalse, ReasonScaleLockHeld,
+					fmt.Sprintf("Replicas of %s/%s are being written by %s", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, holder))
+				return ctrl.Result{RequeueAfter: r.ScaleLockDuration}, nil
+			}
+
+			if r.decisionTimedOut(ctx, decisionCtx, policy, DecisionStageScale) {
+				return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+			}
+			scale, err := r.scaleTarget(decisionCtx, policy, desiredReplicas)
+			if err != nil {
+				r.publishScalingEvent(ctx, policy, cloudevents.TypeScalingFailed, snapshot, err)
+			}
+			if err != nil && r.decisionTimedOut(ctx, decisionCtx, policy, DecisionStageScale) {
+				return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+			}
+			if err != nil {
+				logger.Error(err, "Failed to scale target")
+				if r.EventRecorder != nil {
+					r.EventRecorder.RecordScalingFailed(policy, err)
+				}
+				r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionFalse, "ScaleFailed", err.Error())
+				return ctrl.Result{RequeueAfter: pollingInterval(policy)}, nil
+			}
+			if r.EventRecorder != nil {
+				if desiredReplicas > currentReplicas {
+					r.EventRecorder.RecordScaleUp(policy, currentReplicas, desiredReplicas, decision)
+				} else {
+					r.EventRecorder.RecordScaleDown(policy, currentReplicas, desiredReplicas, decision)
+				}
+				// Workload owners watching only their Deployment see why replicas changed
+				r.EventRecorder.RecordTargetRescaled(policy, targetReference(policy, scale.UID), desiredReplicas, decision.Reason)
+			}
 
-		r.LastScaleTime[policyKey] = time.Now()
-		r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionTrue, "Scaled",
-			fmt.Sprintf("Scaled from %d to %d replicas using %s algorithm", currentReplicas, desiredReplicas, algorithmUsed))
+			r.publishScalingEvent(ctx, policy, cloudevents.TypeScalingApplied, snapshot, nil)
+
+			now := metav1.NewTime(r.now())
+			r.LastScaleTime[policyKey] = now.Time
+			r.recordScaleEvent(profilePolicy, policyKey, currentReplicas, desiredReplicas, now.Time)
+			policy.Status.LastScaleTime = &now
+			r.startConvergenceTracking(policyKey)
+			r.updateCondition(ctx, policy, ConditionTypeScaling, metav1.ConditionTrue, "Scaled",
+				fmt.Sprintf("Scaled from %d to %d replicas using %s algorithm", currentReplicas, desiredReplicas, algorithmUsed))
+		}
 	}
 
+	// Report policies pinned at maxReplicas while still over target and
+	// signal gateways to shed the load the target cannot absorb
+	ratios := r.buildMetricRatios(decisionPolicy, currentReplicas, currentMetrics)
+	r.trackSaturation(ctx, policy, desiredReplicas, ratios, r.now())
+	r.publishBackpressure(ctx, policy, desiredReplicas, ratios, r.now())
+
 	// Update status
-	if err := r.updateStatus(ctx, policy, currentReplicas, desiredReplicas, currentMetrics, algorithmUsed, scaleReason); err != nil {
+	metrics.RecordReplicaCounts(policy.Namespace, policy.Name, policy.Spec.TargetRef.Name, currentReplicas, desiredReplicas)
+	r.setDecisionSnapshot(policy, snapshot)
+	r.recordMetricHistory(policy, currentMetrics)
+	if err := r.updateStatus(ctx, policy, currentReplicas, desiredReplicas, currentMetrics, algorithmUsed, decision); err != nil {
 		logger.Error(err, "Failed to update status")
 	}
 
+	r.clearDecisionTimeout(ctx, policy)
 	r.updateCondition(ctx, policy, ConditionTypeReady, metav1.ConditionTrue, "Ready", "Policy is active")
 
-	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
+	return ctrl.Result{RequeueAfter: r.nextRequeueInterval(policyKey, pollingInterval(policy))}, nil
 }
 
-// getCurrentReplicas gets the current replica count from the target
+// getCurrentReplicas gets the current replica count from the target's scale subresource
 func (r *AIInferenceAutoscalerPolicyReconciler) getCurrentReplicas(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int32, error) {
-	switch policy.Spec.TargetRef.Kind {
-	case "Deployment":
-		deployment := &appsv1.Deployment{}
-		if err := r.Get(ctx, types.NamespacedName{
-			Namespace: policy.Namespace,
-			Name:      policy.Spec.TargetRef.Name,
-		}, deployment); err != nil {
-			return 0, err
-		}
-		if deployment.Spec.Replicas == nil {
-			return 1, nil
-		}
-		return *deployment.Spec.Replicas, nil
-
-	case "StatefulSet":
-		statefulSet := &appsv1.StatefulSet{}
-		if err := r.Get(ctx, types.NamespacedName{
-			Namespace: policy.Namespace,
-			Name:      policy.Spec.TargetRef.Name,
-		}, statefulSet); err != nil {
-			return 0, err
-		}
-		if statefulSet.Spec.Replicas == nil {
-			return 1, nil
-		}
-		return *statefulSet.Spec.Replicas, nil
-
-	default:
-		return 0, fmt.Errorf("unsupported target kind: %s", policy.Spec.TargetRef.Kind)
+	scale, err := r.getScale(ctx, policy)
+	if err != nil {
+		return 0, err
 	}
+	return scale.Spec.Replicas, nil
 }
 
-// fetchMetrics fetches current metrics from Prometheus
+// fetchMetrics fetches current metrics from Prometheus. A metric whose query
+// fails is handled by its onMissing policy: left unset, replaced by its last
+// value, or failing the fetch. When every query fails with no last value to
+// stand in, the metrics source is treated as unavailable.
 func (r *AIInferenceAutoscalerPolicyReconciler) fetchMetrics(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (*kubeaiv1alpha1.CurrentMetrics, error) {
+	if r.simulatedMetrics != nil {
+		return r.simulatedMetrics.DeepCopy(), nil
+	}
 	currentMetrics := &kubeaiv1alpha1.CurrentMetrics{}
+	ctx = metrics.WithQueryWindow(ctx, queryWindow(policy))
+
+	metricsClient, source, err := r.selectMetricsClient(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics client: %w", err)
+	}
+	if source != policy.Status.MetricsSource {
+		if policy.Status.MetricsSource != "" && source != "" && r.EventRecorder != nil {
+			r.EventRecorder.RecordMetricsSourceChanged(policy, policy.Status.MetricsSource, source)
+		}
+		policy.Status.MetricsSource = source
+	}
+
+	// Apply each metric's missing-data policy and report an unreachable source
+	missing := r.newMissingMetrics(policy)
 
-	if r.MetricsClient == nil {
+	// Synthetic requests and resource usage need no metrics client
+	r.resolveSyntheticLatency(policy, currentMetrics, missing)
+	r.resolveResourceUtilization(ctx, policy, currentMetrics, missing)
+	if metricsClient == nil {
+		if err := missing.err(); err != nil {
+			return nil, err
+		}
 		return currentMetrics, nil
 	}
+	preset, err := r.presetQueries(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve metrics preset: %w", err)
+	}
 
-	// Fetch latency metrics
-	if policy.Spec.Metrics.Latency != nil && policy.Spec.Metrics.Latency.Enabled {
-		if policy.Spec.Metrics.Latency.TargetP99Ms > 0 {
-			latency, err := r.MetricsClient.GetLatencyP99(ctx, policy.Spec.Metrics.Latency.PrometheusQuery)
-			if err == nil {
-				currentMetrics.LatencyP99Ms = int32(latency * 1000) // Convert to ms
+	// Run every query at once so a slow Prometheus costs one round trip, not one per metric
+	var p99, p95, gpuUtilization, queueDepth, tokensPerSecond, inFlightRequests, arrivalRate, serviceTime metricQuery
+	fetch := r.newMetricFetch(ctx)
+	latency := policy.Spec.Metrics.Latency
+	latencyEnabled := latency != nil && latency.Enabled
+	if latencyEnabled && kubeaiv1alpha1.QuantityFloat64(latency.TargetP99Ms) > 0 {
+		fetch.run(&p99, func(ctx context.Context) (float64, error) {
+			return metrics.GetLatencyP99(withMetricWindow(ctx, latency.Window), metricsClient, presetQuery(latency.PrometheusQuery, preset, metrics.MetricLatencyP99))
+		})
+	}
+	if latencyEnabled && kubeaiv1alpha1.QuantityFloat64(latency.TargetP95Ms) > 0 {
+		fetch.run(&p95, func(ctx context.Context) (float64, error) {
+			return metrics.GetLatencyP95(withMetricWindow(ctx, latency.Window), metricsClient, presetQuery(latency.PrometheusQuery, preset, metrics.MetricLatencyP95))
+		})
+	}
+	gpu := policy.Spec.Metrics.GPUUtilization
+	if gpu != nil && gpu.Enabled {
+		query, err := r.gpuUtilizationQuery(ctx, policy, preset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve GPU utilization query: %w", err)
+		}
+		fetch.run(&gpuUtilization, func(ctx context.Context) (float64, error) {
+			return metrics.GetGPUUtilization(withMetricWindow(ctx, gpu.Window), metricsClient, query)
+		})
+	}
+	queue := policy.Spec.Metrics.RequestQueueDepth
+	if queue != nil && queue.Enabled {
+		fetch.run(&queueDepth, func(ctx context.Context) (float64, error) {
+			depth, err := metrics.GetQueueDepth(withMetricWindow(ctx, queue.Window), metricsClient, presetQuery(queue.PrometheusQuery, preset, metrics.MetricQueueDepth))
+			return float64(depth), err
+		})
+	}
+	tps := policy.Spec.Metrics.TokensPerSecond
+	if tps != nil && tps.Enabled {
+		query := tps.PrometheusQuery
+		if tps.Preset == "" || tps.Preset == policy.Spec.Metrics.Preset {
+			query = presetQuery(query, preset, metrics.MetricTokensPerSecond)
+		}
+		if query == "" {
+			query = metrics.TokensPerSecondQuery(tps.Preset)
+		}
+		fetch.run(&tokensPerSecond, func(ctx context.Context) (float64, error) {
+			return metrics.GetTokensPerSecond(withMetricWindow(ctx, tps.Window), metricsClient, query)
+		})
+	}
+	inFlight := policy.Spec.Metrics.InFlightRequests
+	if inFlight != nil && inFlight.Enabled {
+		fetch.run(&inFlightRequests, func(ctx context.Context) (float64, error) {
+			requests, err := metrics.GetInFlightRequests(withMetricWindow(ctx, inFlight.Window), metricsClient, presetQuery(inFlight.PrometheusQuery, preset, metrics.MetricInFlightRequests))
+			return float64(requests), err
+		})
+	}
+	queueing := policy.Spec.Metrics.Queueing
+	if queueing != nil && queueing.Enabled {
+		arrivalQuery := presetQuery(queueing.ArrivalRateQuery, preset, metrics.PresetArrivalRate)
+		if arrivalQuery == "" {
+			arrivalQuery = metrics.DefaultArrivalRateQuery
+		}
+		fetch.run(&arrivalRate, func(ctx context.Context) (float64, error) {
+			return metrics.Query(withMetricWindow(ctx, queueing.Window), metricsClient, arrivalQuery)
+		})
+		serviceQuery := presetQuery(queueing.ServiceTimeQuery, preset, metrics.PresetServiceTime)
+		if serviceQuery == "" {
+			serviceQuery = metrics.DefaultServiceTimeQuery
+		}
+		fetch.run(&serviceTime, func(ctx context.Context) (float64, error) {
+			value, err := metrics.Query(withMetricWindow(ctx, queueing.Window), metricsClient, serviceQuery)
+			if err == nil && math.IsNaN(value) {
+				err = fmt.Errorf("service time query returned NaN")
+			}
+			return value, err
+		})
+	}
+	custom := make([]metricQuery, len(policy.Spec.Metrics.CustomMetrics))
+	for i := range policy.Spec.Metrics.CustomMetrics {
+		metric := &policy.Spec.Metrics.CustomMetrics[i]
+		fetch.run(&custom[i], func(ctx context.Context) (float64, error) {
+			return metrics.QueryAggregated(withMetricWindow(ctx, metric.Window), metricsClient, metric.Query, metric.Aggregation)
+		})
+	}
+	fetch.wait()
+
+	// Latency metrics
+	if latencyEnabled {
+		if kubeaiv1alpha1.QuantityFloat64(latency.TargetP99Ms) > 0 {
+			if value, ok := missing.resolve(scaling.MetricLatencyP99Ms, latency.OnMissing, latency.MaxStalenessSeconds,
+				transformMetric(latency.Transform, p99.value), p99.err); ok {
+				currentMetrics.LatencyP99Ms = secondsToMs(value)
 			}
 		}
-		if policy.Spec.Metrics.Latency.TargetP95Ms > 0 {
-			latency, err := r.MetricsClient.GetLatencyP95(ctx, policy.Spec.Metrics.Latency.PrometheusQuery)
-			if err == nil {
-				currentMetrics.LatencyP95Ms = int32(latency * 1000) // Convert to ms
+		if kubeaiv1alpha1.QuantityFloat64(latency.TargetP95Ms) > 0 {
+			if value, ok := missing.resolve(scaling.MetricLatencyP95Ms, latency.OnMissing, latency.MaxStalenessSeconds,
+				transformMetric(latency.Transform, p95.value), p95.err); ok {
+				currentMetrics.LatencyP95Ms = secondsToMs(value)
 			}
 		}
 	}
 
-	// Fetch GPU utilization
-	if policy.Spec.Metrics.GPUUtilization != nil && policy.Spec.Metrics.GPUUtilization.Enabled {
-		gpu, err := r.MetricsClient.GetGPUUtilization(ctx, policy.Spec.Metrics.GPUUtilization.PrometheusQuery)
-		if err == nil {
-			currentMetrics.GPUUtilizationPercent = int32(gpu)
+	// GPU utilization
+	if gpu != nil && gpu.Enabled {
+		if value, ok := missing.resolve(scaling.MetricGPUUtilization, gpu.OnMissing, gpu.MaxStalenessSeconds,
+			transformMetric(gpu.Transform, gpuUtilization.value), gpuUtilization.err); ok {
+			currentMetrics.GPUUtilizationPercent = int32(value)
 		}
 	}
 
-	// Fetch queue depth
-	if policy.Spec.Metrics.RequestQueueDepth != nil && policy.Spec.Metrics.RequestQueueDepth.Enabled {
-		depth, err := r.MetricsClient.GetQueueDepth(ctx, policy.Spec.Metrics.RequestQueueDepth.PrometheusQuery)
-		if err == nil {
-			currentMetrics.RequestQueueDepth = int32(depth) // #nosec G115 - queue depth won't exceed int32 max in practice
+	// Queue depth
+	if queue != nil && queue.Enabled {
+		if value, ok := missing.resolve(scaling.MetricRequestQueueDepth, queue.OnMissing, queue.MaxStalenessSeconds,
+			transformMetric(queue.Transform, queueDepth.value), queueDepth.err); ok {
+			currentMetrics.RequestQueueDepth = int32(value) // #nosec G115 - queue depth won't exceed int32 max in practice
+		}
+	}
+
+	// Token throughput
+	if tps != nil && tps.Enabled {
+		if value, ok := missing.resolve(scaling.MetricTokensPerSecond, tps.OnMissing, tps.MaxStalenessSeconds,
+			transformMetric(tps.Transform, tokensPerSecond.value), tokensPerSecond.err); ok {
+			currentMetrics.TokensPerSecond = int32(value)
+		}
+	}
+
+	// In-flight requests
+	if inFlight != nil && inFlight.Enabled {
+		if value, ok := missing.resolve(scaling.MetricInFlightRequests, inFlight.OnMissing, inFlight.MaxStalenessSeconds,
+			transformMetric(inFlight.Transform, inFlightRequests.value), inFlightRequests.err); ok {
+			currentMetrics.InFlightRequests = int32(value) // #nosec G115 - concurrency won't exceed int32 max in practice
+		}
+	}
+
+	// Arrival rate and service time for queueing-based scaling
+	if queueing != nil && queueing.Enabled {
+		if value, ok := missing.resolve(scaling.MetricArrivalRate, queueing.OnMissing, queueing.MaxStalenessSeconds,
+			transformMetric(queueing.ArrivalRateTransform, arrivalRate.value), arrivalRate.err); ok {
+			currentMetrics.ArrivalRate = &value
+		}
+		if value, ok := missing.resolve(scaling.MetricServiceTimeSeconds, queueing.OnMissing, queueing.MaxStalenessSeconds,
+			transformMetric(queueing.ServiceTimeTransform, serviceTime.value), serviceTime.err); ok {
+			currentMetrics.ServiceTimeMs = ptr.To(secondsToMs(value))
+		}
+	}
+
+	// Custom metrics
+	for i := range policy.Spec.Metrics.CustomMetrics {
+		metric := &policy.Spec.Metrics.CustomMetrics[i]
+		if value, ok := missing.resolve(metric.Name, metric.OnMissing, metric.MaxStalenessSeconds,
+			transformMetric(metric.Transform, custom[i].value), custom[i].err); ok {
+			currentMetrics.Custom = append(currentMetrics.Custom, kubeaiv1alpha1.CustomMetricValue{Name: metric.Name, Value: value})
 		}
 	}
 
+	if err := missing.err(); err != nil {
+		return nil, err
+	}
 	return currentMetrics, nil
 }
 
+// withMetricWindow returns a context evaluating queries over the metric's
+// window, or ctx itself when the metric sets none
+func withMetricWindow(ctx context.Context, window *kubeaiv1alpha1.MetricWindow) context.Context {
+	if window == nil || window.Seconds <= 0 {
+		return ctx
+	}
+	return metrics.WithQueryRange(ctx, metrics.Range{
+		Window:      time.Duration(window.Seconds) * time.Second,
+		Step:        time.Duration(window.StepSeconds) * time.Second,
+		Aggregation: window.Aggregation,
+	})
+}
+
+// secondsToMs converts a latency in seconds to milliseconds, rounded to the
+// microsecond so that sub-millisecond latencies keep their precision
+func secondsToMs(seconds float64) float64 {
+	return math.Round(seconds*1e6) / 1000
+}
+
+// transformMetric applies a metric's unit transform to a raw query result:
+// value*multiplier + offset, clamped to [clampMin, clampMax]
+func transformMetric(transform *kubeaiv1alpha1.MetricTransform, value float64) float64 {
+	if transform == nil {
+		return value
+	}
+	if transform.Multiplier != nil {
+		value *= *transform.Multiplier
+	}
+	value += transform.Offset
+	if transform.ClampMin != nil && value < *transform.ClampMin {
+		value = *transform.ClampMin
+	}
+	if transform.ClampMax != nil && value > *transform.ClampMax {
+		value = *transform.ClampMax
+	}
+	return value
+}
+
 // calculateDesiredReplicas computes the desired replica count based on metrics.
 // Returns:
 //   - desiredReplicas: the computed replica count
 //   - algorithmUsed: the name of the algorithm that was actually used
-//   - reason: explanation of the scaling decision
+//   - result: the algorithm's result, whose Reason explains the scaling decision
 //   - requestedAlgorithmNotFound: true if the user-specified algorithm was not found
 //   - requestedName: the algorithm name the user specified (empty if none specified)
+//   - algorithmErr: the error the algorithm returned, in which case the current replicas are kept
 func (r *AIInferenceAutoscalerPolicyReconciler) calculateDesiredReplicas(
 	ctx context.Context,
 	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
 	currentReplicas int32,
 	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
-) (desiredReplicas int32, algorithmUsed string, reason string, requestedAlgorithmNotFound bool, requestedName string) {
+) (desiredReplicas int32, algorithmUsed string, result scaling.ScalingResult, requestedAlgorithmNotFound bool, requestedName string, algorithmErr error) {
 	logger := log.FromContext(ctx)
 
 	// Determine which algorithm to use
 	algorithmName := DefaultAlgorithmName
 	tolerance := DefaultTolerance
-	var weights []float64
 
 	if policy.Spec.Algorithm != nil {
 		if policy.Spec.Algorithm.Name != "" {
@@ -292,151 +921,555 @@ func (r *AIInferenceAutoscalerPolicyReconciler) calculateDesiredReplicas(
 		}
 		// Always honor the configured tolerance, including 0 (zero tolerance)
 		tolerance = policy.Spec.Algorithm.Tolerance
-		weights = policy.Spec.Algorithm.Weights
 	}
 
 	// Get the algorithm from registry
 	algorithm, err := r.AlgorithmRegistry.Get(algorithmName)
 	if err != nil {
-		logger.Error(err, "Algorithm not found, falling back to default", "algorithm", algorithmName)
+		logger.Error(err, "Algorithm not found, falling back", "algorithm", algorithmName)
 
 		// Only flag as not found if user explicitly specified an algorithm
 		if requestedName != "" {
 			requestedAlgorithmNotFound = true
 		}
 
-		// Try the default algorithm in the configured registry first.
-		algorithmName = DefaultAlgorithmName
-		algorithm, err = r.AlgorithmRegistry.Get(DefaultAlgorithmName)
-		if err != nil {
-			logger.Error(err, "Default algorithm not found in custom registry, trying global default registry", "algorithm", DefaultAlgorithmName)
-
-			// As a final fallback, try the global default registry.
-			algorithm, err = scaling.DefaultRegistry.Get(DefaultAlgorithmName)
-		}
+		algorithmName, algorithm, err = r.fallbackAlgorithm(policy)
 
 		// If we still don't have a valid algorithm, keep the current replicas to avoid a panic.
 		if err != nil || algorithm == nil {
 			logger.Error(err, "No valid scaling algorithm available, keeping current replicas", "algorithm", algorithmName)
-			return currentReplicas, algorithmName, "no algorithm available", requestedAlgorithmNotFound, requestedName
+			return currentReplicas, algorithmName, scaling.ScalingResult{DesiredReplicas: currentReplicas, Reason: "no algorithm available"}, requestedAlgorithmNotFound, requestedName, nil
 		}
 	}
 
-	// If using WeightedRatio, set the weights on a per-request copy to avoid mutating shared instances
-	if weightedAlgo, ok := algorithm.(*scaling.WeightedRatioAlgorithm); ok && len(weights) > 0 {
-		algoCopy := *weightedAlgo
-		copyPtr := &algoCopy
-		copyPtr.SetWeights(weights)
-		algorithm = copyPtr
-	}
+	// Apply the policy's algorithm parameters on a per-request copy
+	algorithm = r.withParameters(policy, algorithm)
+
+	algorithm = r.directional(policy, algorithm)
+
+	algorithm = r.smoothed(policy, algorithm)
 
 	// Build metric ratios
 	metricRatios := r.buildMetricRatios(policy, currentReplicas, currentMetrics)
 
-	// Apply min/max constraints
-	minReplicas := policy.Spec.MinReplicas
-	if minReplicas == 0 {
-		minReplicas = 1
+	// Apply min/max constraints. The algorithm never drives a running target to
+	// zero; idle targets with minReplicas 0 are handled by applyScaleToZero.
+	minReplicas := int32(1)
+	if policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas > 0 {
+		minReplicas = *policy.Spec.MinReplicas
 	}
 	maxReplicas := policy.Spec.MaxReplicas
+	readyReplicas, unavailableReplicas := r.replicaHealth(ctx, policy, currentReplicas)
 
-	// Build scaling input
+	// Build scaling input. The algorithm is floored at one replica only, so the
+	// recommendation it would make without minReplicas can be exported for
+	// capacity planning; minReplicas is applied to its result below.
 	input := scaling.ScalingInput{
-		CurrentReplicas: currentReplicas,
-		MinReplicas:     minReplicas,
-		MaxReplicas:     maxReplicas,
-		MetricRatios:    metricRatios,
-		Tolerance:       tolerance,
-		PolicyName:      policy.Name,
-		PolicyNamespace: policy.Namespace,
+		CurrentReplicas:     currentReplicas,
+		MinReplicas:         1,
+		MaxReplicas:         maxReplicas,
+		MetricRatios:        metricRatios,
+		Tolerance:           tolerance,
+		PolicyName:          policy.Name,
+		PolicyNamespace:     policy.Namespace,
+		Metrics:             namedMetrics(policy, currentMetrics),
+		MetricSamples:       metricSamples(policy, currentReplicas, currentMetrics, r.now()),
+		ReadyReplicas:       readyReplicas,
+		UnavailableReplicas: unavailableReplicas,
 	}
 
-	// Compute scale using the algorithm
-	result, err := algorithm.ComputeScale(ctx, input)
+	// Compute scale using the algorithm, enforcing its deadline
+	result, err = scaling.ComputeWithDeadline(ctx, algorithm, input, r.AlgorithmTimeout)
+	var timeoutErr scaling.ErrComputeTimeout
+	var panicErr scaling.ErrComputePanic
+	fallbackName, fallback, getErr := r.fallbackAlgorithm(policy)
+	if (stderrors.As(err, &timeoutErr) || stderrors.As(err, &panicErr)) && algorithmName != fallbackName {
+		logger.Error(err, "Algorithm did not complete, falling back", "algorithm", algorithmName, "fallback", fallbackName)
+		if timeoutErr.Name != "" {
+			metrics.RecordAlgorithmTimeout(policy.Namespace, policy.Name, algorithmName)
+		}
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordAlgorithmTimeout(policy, algorithmName, fallbackName, err)
+		}
+
+		failedName := algorithmName
+		algorithmName = fallbackName
+		if getErr != nil {
+			return currentReplicas, algorithmName, scaling.ScalingResult{DesiredReplicas: currentReplicas, Reason: "no algorithm available"}, requestedAlgorithmNotFound, requestedName, nil
+		}
+		result, err = scaling.ComputeWithDeadline(ctx, r.smoothed(policy, r.withParameters(policy, fallback)), input, r.AlgorithmTimeout)
+		if err == nil {
+			result.Reason = fmt.Sprintf("%s (fallback after %s did not complete)", result.Reason, failedName)
+		}
+	}
 	if err != nil {
-		logger.Error(err, "Algorithm computation failed, keeping current replicas", "algorithm", algorithmName)
-		return currentReplicas, algorithmName, "computation failed", requestedAlgorithmNotFound, requestedName
+		errorType := scaling.ErrorType(err)
+		logger.Error(err, "Algorithm computation failed, keeping current replicas", "algorithm", algorithmName, "errorType", errorType)
+		metrics.RecordReconcileError(policy.Namespace, policy.Name, errorType)
+		if errorType == scaling.ErrorTypeStateCorrupt {
+			r.algorithmState().Forget(scaling.StateKey(input))
+		}
+		result = scaling.ScalingResult{DesiredReplicas: currentReplicas, Reason: fmt.Sprintf("keeping current replicas: %v", err)}
+		return currentReplicas, algorithmName, result, requestedAlgorithmNotFound, requestedName, err
+	}
+
+	metrics.RecordUnusedCapacity(policy.Namespace, policy.Name, currentReplicas, result.DesiredReplicas)
+	unconstrained := result.DesiredReplicas
+	result.DesiredReplicas = max(result.DesiredReplicas, minReplicas)
+	if result.UnclampedReplicas == 0 {
+		result.UnclampedReplicas = unconstrained
 	}
 
 	logger.Info("Calculated desired replicas",
 		"algorithm", algorithmName,
 		"current", currentReplicas,
+		"unconstrained", unconstrained,
 		"desired", result.DesiredReplicas,
 		"reason", result.Reason,
 		"tolerance", tolerance,
 		"min", minReplicas,
 		"max", maxReplicas)
 
-	return result.DesiredReplicas, algorithmName, result.Reason, requestedAlgorithmNotFound, requestedName
+	return result.DesiredReplicas, algorithmName, result, requestedAlgorithmNotFound, requestedName, nil
 }
 
-// buildMetricRatios builds the list of metric ratios from current metrics
-func (r *AIInferenceAutoscalerPolicyReconciler) buildMetricRatios(
+// algorithmErrorReason returns the condition and event reason for an error
+// returned by an algorithm
+func algorithmErrorReason(err error) string {
+	switch scaling.ErrorType(err) {
+	case scaling.ErrorTypeInsufficientMetrics:
+		return ReasonInsufficientMetrics
+	case scaling.ErrorTypeInvalidParameters:
+		return ReasonInvalidAlgorithmParameters
+	case scaling.ErrorTypeStateCorrupt:
+		return ReasonAlgorithmStateCorrupt
+	case scaling.ErrorTypeTimeout:
+		return ReasonAlgorithmTimeout
+	default:
+		return ReasonAlgorithmFailed
+	}
+}
+
+// withParameters returns a copy of the algorithm configured with the policy's
+// algorithm parameters, so shared registry instances are never mutated.
+// Parameters the algorithm rejects leave it at its defaults; Reconcile reports
+// them through the AlgorithmValid condition.
+func (r *AIInferenceAutoscalerPolicyReconciler) withParameters(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, algorithm scaling.ScalingAlgorithm) scaling.ScalingAlgorithm {
+	spec := policy.Spec.Algorithm
+	if spec == nil {
+		spec = &kubeaiv1alpha1.AlgorithmSpec{}
+	}
+
+	switch algo := algorithm.(type) {
+	case *scaling.MaxRatioAlgorithm:
+		if spec.ScaleDownEnabled {
+			algoCopy := *algo
+			algoCopy.SetScaleDownEnabled(true)
+			algorithm = &algoCopy
+		}
+	case *scaling.WeightedRatioAlgorithm:
+		if len(spec.Weights) > 0 {
+			algoCopy := *algo
+			algoCopy.SetWeights(spec.Weights)
+			algorithm = &algoCopy
+		}
+	case *scaling.LittlesLawAlgorithm:
+		if queueing := policy.Spec.Metrics.Queueing; queueing != nil {
+			concurrency := queueing.ConcurrencyPerReplica
+			if concurrency == 0 {
+				concurrency = policy.Status.DiscoveredCapacity
+			}
+			algoCopy := *algo
+			algoCopy.SetCapacity(concurrency, float64(queueing.TargetUtilization)/100)
+			algorithm = &algoCopy
+		}
+	case *scaling.PredictiveAlgorithm:
+		// Predictive also keeps its forecast in the reconciler's state store
+		algoCopy := *algo
+		algoCopy.SetForecast(time.Duration(spec.ForecastHorizonSeconds)*time.Second,
+			time.Duration(spec.SeasonalityPeriodSeconds)*time.Second)
+		algoCopy.SetStateStore(r.algorithmState())
+		algorithm = &algoCopy
+	}
+
+	// The typed fields are applied first so that parameters configure the copy
+	if configured, err := scaling.Configure(algorithm, spec.Parameters); err == nil {
+		return configured
+	}
+	return algorithm
+}
+
+// validateAlgorithmParameters checks spec.algorithm.parameters against the
+// parameters the named algorithm declares
+func (r *AIInferenceAutoscalerPolicyReconciler) validateAlgorithmParameters(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, algorithmName string) error {
+	if policy.Spec.Algorithm == nil || len(policy.Spec.Algorithm.Parameters) == 0 {
+		return nil
+	}
+	algorithm, err := r.AlgorithmRegistry.Get(algorithmName)
+	if err != nil {
+		return nil
+	}
+	_, err = scaling.Configure(algorithm, policy.Spec.Algorithm.Parameters)
+	return err
+}
+
+// directional wraps algorithm with the policy's scale-up and scale-down
+// algorithms, if any. Unknown names are skipped; Reconcile reports them through
+// the AlgorithmValid condition.
+func (r *AIInferenceAutoscalerPolicyReconciler) directional(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, algorithm scaling.ScalingAlgorithm) scaling.ScalingAlgorithm {
+	scaleUp := r.directionAlgorithm(policy, policy.Spec.ScaleUp)
+	scaleDown := r.directionAlgorithm(policy, policy.Spec.ScaleDown)
+	if scaleUp == nil && scaleDown == nil {
+		return algorithm
+	}
+	return scaling.NewDirectionalAlgorithm(algorithm, scaleUp, scaleDown)
+}
+
+// directionAlgorithm returns the configured algorithm of behavior, or nil when
+// none is set or it is not registered
+func (r *AIInferenceAutoscalerPolicyReconciler) directionAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, behavior *kubeaiv1alpha1.ScaleBehavior) scaling.ScalingAlgorithm {
+	if behavior == nil || behavior.Algorithm == "" {
+		return nil
+	}
+	algorithm, err := r.AlgorithmRegistry.Get(behavior.Algorithm)
+	if err != nil {
+		return nil
+	}
+	return r.withParameters(policy, algorithm)
+}
+
+// hasDirectionAlgorithms reports whether the policy sets a scale-up or scale-down algorithm
+func hasDirectionAlgorithms(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) bool {
+	return (policy.Spec.ScaleUp != nil && policy.Spec.ScaleUp.Algorithm != "") ||
+		(policy.Spec.ScaleDown != nil && policy.Spec.ScaleDown.Algorithm != "")
+}
+
+// unknownDirectionAlgorithm returns the field and name of the first scale-up
+// or scale-down algorithm that is not registered
+func (r *AIInferenceAutoscalerPolicyReconciler) unknownDirectionAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (field, name string) {
+	for _, direction := range []struct {
+		field    string
+		behavior *kubeaiv1alpha1.ScaleBehavior
+	}{
+		{"spec.scaleUp.algorithm", policy.Spec.ScaleUp},
+		{"spec.scaleDown.algorithm", policy.Spec.ScaleDown},
+	} {
+		if direction.behavior == nil || direction.behavior.Algorithm == "" {
+			continue
+		}
+		if _, err := r.AlgorithmRegistry.Get(direction.behavior.Algorithm); err != nil {
+			return direction.field, direction.behavior.Algorithm
+		}
+	}
+	return "", ""
+}
+
+// fallbackAlgorithmName returns the algorithm a policy falls back to: its
+// spec.algorithm.fallback when registered, otherwise the controller's
+// fallback, otherwise DefaultAlgorithmName
+func (r *AIInferenceAutoscalerPolicyReconciler) fallbackAlgorithmName(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) string {
+	if policy.Spec.Algorithm != nil && policy.Spec.Algorithm.Fallback != "" {
+		if _, err := r.AlgorithmRegistry.Get(policy.Spec.Algorithm.Fallback); err == nil {
+			return policy.Spec.Algorithm.Fallback
+		}
+	}
+	if r.FallbackAlgorithm != "" {
+		if _, err := r.AlgorithmRegistry.Get(r.FallbackAlgorithm); err == nil {
+			return r.FallbackAlgorithm
+		}
+	}
+	return DefaultAlgorithmName
+}
+
+// fallbackAlgorithm returns the name and algorithm the policy falls back to.
+// The default algorithm is looked up in the global default registry when the
+// configured registry does not have it.
+func (r *AIInferenceAutoscalerPolicyReconciler) fallbackAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (string, scaling.ScalingAlgorithm, error) {
+	name := r.fallbackAlgorithmName(policy)
+	algorithm, err := r.AlgorithmRegistry.Get(name)
+	if err != nil {
+		algorithm, err = scaling.DefaultRegistry.Get(name)
+	}
+	return name, algorithm, err
+}
+
+// unknownFallbackAlgorithm returns the policy's spec.algorithm.fallback when
+// it is not registered
+func (r *AIInferenceAutoscalerPolicyReconciler) unknownFallbackAlgorithm(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) string {
+	if policy.Spec.Algorithm == nil || policy.Spec.Algorithm.Fallback == "" {
+		return ""
+	}
+	if _, err := r.AlgorithmRegistry.Get(policy.Spec.Algorithm.Fallback); err != nil {
+		return policy.Spec.Algorithm.Fallback
+	}
+	return ""
+}
+
+// smoothed wraps algorithm with the policy's recommendation smoothing, if any
+func (r *AIInferenceAutoscalerPolicyReconciler) smoothed(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, algorithm scaling.ScalingAlgorithm) scaling.ScalingAlgorithm {
+	spec := policy.Spec.Smoothing
+	if spec == nil {
+		return algorithm
+	}
+	return scaling.NewSmoothingDecorator(algorithm, scaling.SmoothingConfig{
+		Factor:         spec.Factor,
+		MaxUpPercent:   spec.MaxUpPercent,
+		MaxDownPercent: spec.MaxDownPercent,
+	}, r.algorithmState())
+}
+
+// algorithmState returns the configured state store or the shared default
+func (r *AIInferenceAutoscalerPolicyReconciler) algorithmState() *scaling.StateStore {
+	if r.AlgorithmState != nil {
+		return r.AlgorithmState
+	}
+	return scaling.DefaultStateStore
+}
+
+// metricComparison is the current value of a metric that has a target
+type metricComparison struct {
+	name            string
+	current, target float64
+}
+
+// compareMetrics pairs each enabled metric that has a target and a current
+// value with its target, in the order their ratios are passed to algorithms.
+// Per-replica targets are multiplied by the current replicas.
+func compareMetrics(
 	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
 	currentReplicas int32,
 	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
-) []float64 {
-	var ratios []float64
+) []metricComparison {
+	var compared []metricComparison
+	add := func(name string, current, target float64) {
+		compared = append(compared, metricComparison{name: name, current: current, target: target})
+	}
 
-	// Calculate latency ratios
+	// Compare latency
 	if policy.Spec.Metrics.Latency != nil && policy.Spec.Metrics.Latency.Enabled {
-		if policy.Spec.Metrics.Latency.TargetP99Ms > 0 && currentMetrics.LatencyP99Ms > 0 {
-			ratio := float64(currentMetrics.LatencyP99Ms) / float64(policy.Spec.Metrics.Latency.TargetP99Ms)
-			ratios = append(ratios, ratio)
+		if target := kubeaiv1alpha1.QuantityFloat64(policy.Spec.Metrics.Latency.TargetP99Ms); target > 0 && currentMetrics.LatencyP99Ms > 0 {
+			add(scaling.MetricLatencyP99Ms, currentMetrics.LatencyP99Ms, target)
 		}
-		if policy.Spec.Metrics.Latency.TargetP95Ms > 0 && currentMetrics.LatencyP95Ms > 0 {
-			ratio := float64(currentMetrics.LatencyP95Ms) / float64(policy.Spec.Metrics.Latency.TargetP95Ms)
-			ratios = append(ratios, ratio)
+		if target := kubeaiv1alpha1.QuantityFloat64(policy.Spec.Metrics.Latency.TargetP95Ms); target > 0 && currentMetrics.LatencyP95Ms > 0 {
+			add(scaling.MetricLatencyP95Ms, currentMetrics.LatencyP95Ms, target)
 		}
 	}
 
-	// Calculate GPU utilization ratio
+	// Compare GPU utilization
 	if policy.Spec.Metrics.GPUUtilization != nil && policy.Spec.Metrics.GPUUtilization.Enabled {
 		if policy.Spec.Metrics.GPUUtilization.TargetPercentage > 0 && currentMetrics.GPUUtilizationPercent > 0 {
-			ratio := float64(currentMetrics.GPUUtilizationPercent) / float64(policy.Spec.Metrics.GPUUtilization.TargetPercentage)
-			ratios = append(ratios, ratio)
+			add(scaling.MetricGPUUtilization, float64(currentMetrics.GPUUtilizationPercent), float64(policy.Spec.Metrics.GPUUtilization.TargetPercentage))
+		}
+	}
+
+	// Compare CPU and memory utilization
+	if cpu := policy.Spec.Metrics.CPUUtilization; cpu != nil && cpu.Enabled {
+		if cpu.TargetAverageUtilization > 0 && currentMetrics.CPUUtilizationPercent > 0 {
+			add(scaling.MetricCPUUtilization, float64(currentMetrics.CPUUtilizationPercent), float64(cpu.TargetAverageUtilization))
+		}
+	}
+	if memory := policy.Spec.Metrics.MemoryUtilization; memory != nil && memory.Enabled {
+		if memory.TargetAverageUtilization > 0 && currentMetrics.MemoryUtilizationPercent > 0 {
+			add(scaling.MetricMemoryUtilization, float64(currentMetrics.MemoryUtilizationPercent), float64(memory.TargetAverageUtilization))
 		}
 	}
 
-	// Calculate queue depth ratio
+	// Compare queue depth
 	if policy.Spec.Metrics.RequestQueueDepth != nil && policy.Spec.Metrics.RequestQueueDepth.Enabled {
-		if policy.Spec.Metrics.RequestQueueDepth.TargetDepth > 0 && currentMetrics.RequestQueueDepth > 0 {
-			ratio := float64(currentMetrics.RequestQueueDepth) / float64(policy.Spec.Metrics.RequestQueueDepth.TargetDepth*currentReplicas)
-			ratios = append(ratios, ratio)
+		targetDepth := kubeaiv1alpha1.QuantityFloat64(queueDepthTarget(policy))
+		if targetDepth > 0 && currentMetrics.RequestQueueDepth > 0 {
+			// A target scaled to zero is sized as a single replica
+			add(scaling.MetricRequestQueueDepth, float64(currentMetrics.RequestQueueDepth), targetDepth*float64(max(currentReplicas, 1)))
+		}
+	}
+
+	// Compare token throughput
+	if tps := policy.Spec.Metrics.TokensPerSecond; tps != nil && tps.Enabled {
+		target := kubeaiv1alpha1.QuantityFloat64(perReplicaTarget(policy, tps.TargetPerReplica))
+		if target > 0 && currentMetrics.TokensPerSecond > 0 {
+			add(scaling.MetricTokensPerSecond, float64(currentMetrics.TokensPerSecond), target*float64(max(currentReplicas, 1)))
+		}
+	}
+
+	// Compare concurrency
+	if inFlight := policy.Spec.Metrics.InFlightRequests; inFlight != nil && inFlight.Enabled {
+		target := kubeaiv1alpha1.QuantityFloat64(perReplicaTarget(policy, inFlight.TargetPerReplica))
+		if target > 0 && currentMetrics.InFlightRequests > 0 {
+			add(scaling.MetricInFlightRequests, float64(currentMetrics.InFlightRequests), target*float64(max(currentReplicas, 1)))
+		}
+	}
+
+	// Compare end-to-end latency
+	if probe := policy.Spec.Metrics.SyntheticProbe; probe != nil && probe.Enabled {
+		if target := kubeaiv1alpha1.QuantityFloat64(probe.TargetLatencyMs); target > 0 && currentMetrics.SyntheticLatencyMs > 0 {
+			add(scaling.MetricSyntheticLatencyMs, float64(currentMetrics.SyntheticLatencyMs), target)
+		}
+	}
+
+	// Compare custom metrics
+	for i := range policy.Spec.Metrics.CustomMetrics {
+		metric := &policy.Spec.Metrics.CustomMetrics[i]
+		value, ok := customMetricValue(currentMetrics, metric.Name)
+		if metric.TargetValue > 0 && ok && value > 0 {
+			target := metric.TargetValue
+			if metric.TargetType == "AverageValue" {
+				// A target scaled to zero is sized as a single replica
+				target *= float64(max(currentReplicas, 1))
+			}
+			add(metric.Name, value, target)
 		}
 	}
 
+	return compared
+}
+
+// buildMetricRatios builds the list of metric ratios from current metrics
+func (r *AIInferenceAutoscalerPolicyReconciler) buildMetricRatios(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas int32,
+	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
+) []float64 {
+	var ratios []float64
+	for _, m := range compareMetrics(policy, currentReplicas, currentMetrics) {
+		ratios = append(ratios, m.current/m.target)
+	}
 	return ratios
 }
 
-// scaleTarget scales the target deployment or statefulset
-func (r *AIInferenceAutoscalerPolicyReconciler) scaleTarget(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, replicas int32) error {
-	switch policy.Spec.TargetRef.Kind {
-	case "Deployment":
-		deployment := &appsv1.Deployment{}
-		if err := r.Get(ctx, types.NamespacedName{
-			Namespace: policy.Namespace,
-			Name:      policy.Spec.TargetRef.Name,
-		}, deployment); err != nil {
-			return err
+// metricSamples describes the metrics behind the ratios, keyed like namedMetrics
+func metricSamples(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas int32,
+	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
+	now time.Time,
+) map[string]scaling.MetricSample {
+	samples := make(map[string]scaling.MetricSample)
+	for _, m := range compareMetrics(policy, currentReplicas, currentMetrics) {
+		samples[m.name] = scaling.MetricSample{Current: m.current, Target: m.target, Ratio: m.current / m.target, Timestamp: now}
+	}
+	return samples
+}
+
+// exportMetrics exports the value of every named metric and the target of
+// every metric compared against one
+func exportMetrics(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentReplicas int32, currentMetrics *kubeaiv1alpha1.CurrentMetrics) {
+	targets := make(map[string]float64)
+	for _, m := range compareMetrics(policy, currentReplicas, currentMetrics) {
+		targets[m.name] = m.target
+	}
+	metrics.RecordPolicyMetrics(policy.Namespace, policy.Name, namedMetrics(policy, currentMetrics), targets)
+}
+
+// namedMetrics returns the current value of every metric enabled in the policy,
+// keyed by name, for algorithms that work on absolute values rather than ratios.
+// Like the ratios, metrics that were not reported are left out. The queueing
+// metrics are reported even at zero, since an idle service has no arrivals.
+// Built-in names take precedence over custom metrics of the same name.
+func namedMetrics(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentMetrics *kubeaiv1alpha1.CurrentMetrics) map[string]float64 {
+	named := make(map[string]float64)
+	for _, custom := range currentMetrics.Custom {
+		named[custom.Name] = custom.Value
+	}
+	set := func(name string, enabled bool, value float64) {
+		if enabled && value > 0 {
+			named[name] = value
+		}
+	}
+
+	spec := policy.Spec.Metrics
+	latency := spec.Latency != nil && spec.Latency.Enabled
+	set(scaling.MetricLatencyP99Ms, latency, currentMetrics.LatencyP99Ms)
+	set(scaling.MetricLatencyP95Ms, latency, currentMetrics.LatencyP95Ms)
+	set(scaling.MetricGPUUtilization, spec.GPUUtilization != nil && spec.GPUUtilization.Enabled,
+		float64(currentMetrics.GPUUtilizationPercent))
+	set(scaling.MetricCPUUtilization, spec.CPUUtilization != nil && spec.CPUUtilization.Enabled,
+		float64(currentMetrics.CPUUtilizationPercent))
+	set(scaling.MetricMemoryUtilization, spec.MemoryUtilization != nil && spec.MemoryUtilization.Enabled,
+		float64(currentMetrics.MemoryUtilizationPercent))
+	set(scaling.MetricRequestQueueDepth, spec.RequestQueueDepth != nil && spec.RequestQueueDepth.Enabled,
+		float64(currentMetrics.RequestQueueDepth))
+	set(scaling.MetricTokensPerSecond, spec.TokensPerSecond != nil && spec.TokensPerSecond.Enabled,
+		float64(currentMetrics.TokensPerSecond))
+	set(scaling.MetricInFlightRequests, spec.InFlightRequests != nil && spec.InFlightRequests.Enabled,
+		float64(currentMetrics.InFlightRequests))
+	set(scaling.MetricSyntheticLatencyMs, spec.SyntheticProbe != nil && spec.SyntheticProbe.Enabled,
+		float64(currentMetrics.SyntheticLatencyMs))
+	if spec.Queueing != nil && spec.Queueing.Enabled {
+		if currentMetrics.ArrivalRate != nil {
+			named[scaling.MetricArrivalRate] = *currentMetrics.ArrivalRate
+		}
+		if currentMetrics.ServiceTimeMs != nil {
+			named[scaling.MetricServiceTimeSeconds] = *currentMetrics.ServiceTimeMs / 1000
 		}
-		deployment.Spec.Replicas = &replicas
-		return r.Update(ctx, deployment)
+	}
+	set(scaling.MetricCostPerReplicaHour, true, currentMetrics.CostPerReplicaHour)
+	set(scaling.MetricCostPer1kRequests, true, currentMetrics.CostPer1kRequests)
+	return named
+}
 
-	case "StatefulSet":
-		statefulSet := &appsv1.StatefulSet{}
-		if err := r.Get(ctx, types.NamespacedName{
-			Namespace: policy.Namespace,
-			Name:      policy.Spec.TargetRef.Name,
-		}, statefulSet); err != nil {
-			return err
+// customMetricValue returns the current value of the named custom metric
+func customMetricValue(currentMetrics *kubeaiv1alpha1.CurrentMetrics, name string) (float64, bool) {
+	for _, custom := range currentMetrics.Custom {
+		if custom.Name == name {
+			return custom.Value, true
 		}
-		statefulSet.Spec.Replicas = &replicas
-		return r.Update(ctx, statefulSet)
+	}
+	return 0, false
+}
 
-	default:
-		return fmt.Errorf("unsupported target kind: %s", policy.Spec.TargetRef.Kind)
+// dryRunScale submits the replica change with dryRun=All and reports whether the
+// API server would have accepted it. The target and cooldown state are left untouched.
+func (r *AIInferenceAutoscalerPolicyReconciler) dryRunScale(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentReplicas, desiredReplicas int32) {
+	logger := log.FromContext(ctx)
+
+	if _, err := r.scaleTarget(ctx, policy, desiredReplicas, client.DryRunAll); err != nil {
+		logger.Error(err, "Dry-run scale rejected by API server")
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordDryRunRejected(policy, currentReplicas, desiredReplicas, err)
+		}
+		r.updateCondition(ctx, policy, ConditionTypeDryRun, metav1.ConditionFalse, ReasonDryRunRejected, err.Error())
+		return
+	}
+
+	logger.Info("Dry-run scale accepted by API server", "current", currentReplicas, "desired", desiredReplicas)
+	if r.EventRecorder != nil {
+		r.EventRecorder.RecordDryRunScale(policy, currentReplicas, desiredReplicas)
+	}
+	r.updateCondition(ctx, policy, ConditionTypeDryRun, metav1.ConditionTrue, ReasonDryRunAccepted,
+		fmt.Sprintf("Would scale from %d to %d replicas", currentReplicas, desiredReplicas))
+}
+
+// scaleTarget sets the target's replicas through its scale subresource, so any
+// workload exposing /scale can be targeted, and returns the written scale
+func (r *AIInferenceAutoscalerPolicyReconciler) scaleTarget(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, replicas int32, opts ...client.SubResourceUpdateOption) (*autoscalingv1.Scale, error) {
+	scale, err := r.getScale(ctx, policy)
+	if err != nil {
+		return nil, err
 	}
+	scale.Spec.Replicas = replicas
+	if err := r.updateScale(ctx, policy, scale, opts...); err != nil {
+		return nil, err
+	}
+	return scale, nil
+}
+
+// now returns the current time from the reconciler's clock
+func (r *AIInferenceAutoscalerPolicyReconciler) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+	return r.Clock.Now()
+}
+
+// lastScaleTime returns when the policy last scaled its target. The time persisted
+// in status survives controller restarts and leader changes, while the in-memory
+// entry covers a status write that has not reached the cache yet.
+func (r *AIInferenceAutoscalerPolicyReconciler) lastScaleTime(policyKey string, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (time.Time, bool) {
+	last, ok := r.LastScaleTime[policyKey]
+	if status := policy.Status.LastScaleTime; status != nil && status.After(last) {
+		return status.Time, true
+	}
+	return last, ok
 }
 
 // updateStatus updates the policy status
@@ -445,18 +1478,17 @@ func (r *AIInferenceAutoscalerPolicyReconciler) updateStatus(
 	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
 	currentReplicas, desiredReplicas int32,
 	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
-	algorithmUsed, scaleReason string,
+	algorithmUsed string,
+	decision scaling.ScalingResult,
 ) error {
 	policy.Status.CurrentReplicas = currentReplicas
 	policy.Status.DesiredReplicas = desiredReplicas
 	policy.Status.CurrentMetrics = currentMetrics
 	policy.Status.LastAlgorithm = algorithmUsed
-	policy.Status.LastScaleReason = scaleReason
-
-	if currentReplicas != desiredReplicas {
-		now := metav1.Now()
-		policy.Status.LastScaleTime = &now
-	}
+	policy.Status.LastScaleReason = decision.Reason
+	policy.Status.LastDrivingMetric = decision.DrivingMetric
+	policy.Status.LastUnclampedReplicas = decision.UnclampedReplicas
+	policy.Status.LastConfidence = decision.Confidence
 
 	return r.Status().Update(ctx, policy)
 }
@@ -472,7 +1504,7 @@ func (r *AIInferenceAutoscalerPolicyReconciler) updateCondition(
 	condition := metav1.Condition{
 		Type:               conditionType,
 		Status:             status,
-		LastTransitionTime: metav1.Now(),
+		LastTransitionTime: metav1.NewTime(r.now()),
 		Reason:             reason,
 		Message:            message,
 	}
@@ -510,9 +1542,24 @@ func (r *AIInferenceAutoscalerPolicyReconciler) hasCondition(
 	return false
 }
 
+// isConditionTrue checks if the policy has a condition of the given type with status True
+func (r *AIInferenceAutoscalerPolicyReconciler) isConditionTrue(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	conditionType string,
+) bool {
+	for _, c := range policy.Status.Conditions {
+		if c.Type == conditionType {
+			return c.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
 // SetupWithManager sets up the controller with the Manager
 func (r *AIInferenceAutoscalerPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.policiesInNamespace),
+			builder.WithPredicates(predicate.AnnotationChangedPredicate{})).
 		Complete(r)
 }
@@ -18,10 +18,26 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"math"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
 	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
@@ -43,12 +59,12 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			name: "scale up based on latency",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: int32Ptr(1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -64,7 +80,7 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			name: "scale up based on GPU utilization",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: int32Ptr(1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
@@ -85,12 +101,12 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			name: "respect max replicas",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: int32Ptr(1),
 					MaxReplicas: 5,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -106,12 +122,12 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			name: "respect min replicas",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 2,
+					MinReplicas: int32Ptr(2),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -127,12 +143,12 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			name: "no scaling when at target",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: int32Ptr(1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -148,12 +164,12 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			name: "use highest ratio from multiple metrics",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: int32Ptr(1),
 					MaxReplicas: 10,
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 						GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
 							Enabled:          true,
@@ -173,7 +189,7 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			name: "use AverageRatio algorithm",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: int32Ptr(1),
 					MaxReplicas: 10,
 					Algorithm: &kubeaiv1alpha1.AlgorithmSpec{
 						Name:      "AverageRatio",
@@ -182,7 +198,7 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 						GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
 							Enabled:          true,
@@ -198,11 +214,102 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			expectedRequestedAlgoNotFound: false,
 			expectedRequestedName:         "AverageRatio",
 		},
+		{
+			name: "scale up based on custom metric",
+			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+					MinReplicas: int32Ptr(1),
+					MaxReplicas: 10,
+					Metrics: kubeaiv1alpha1.MetricsSpec{
+						GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
+							Enabled:          true,
+							TargetPercentage: 50,
+						},
+						CustomMetrics: []kubeaiv1alpha1.CustomMetric{
+							{Name: "tokens-per-second", Query: "tokens", TargetValue: 1000},
+						},
+					},
+				},
+			},
+			currentReplicas: 2,
+			currentMetrics: &kubeaiv1alpha1.CurrentMetrics{
+				GPUUtilizationPercent: 50,
+				Custom:                []kubeaiv1alpha1.CustomMetricValue{{Name: "tokens-per-second", Value: 3000}},
+			},
+			expected:                      6, // max of 1.0 and 3.0 = 3.0, 2 * 3.0 = 6
+			expectedAlgorithm:             "MaxRatio",
+			expectedRequestedAlgoNotFound: false,
+			expectedRequestedName:         "",
+		},
+		{
+			name: "scale up based on tokens per second",
+			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+					MinReplicas: int32Ptr(1),
+					MaxReplicas: 10,
+					Metrics: kubeaiv1alpha1.MetricsSpec{
+						TokensPerSecond: &kubeaiv1alpha1.TokensPerSecondMetric{
+							Enabled:          true,
+							TargetPerReplica: ptr.To(resource.MustParse("1000")),
+						},
+					},
+				},
+			},
+			currentReplicas:               2,
+			currentMetrics:                &kubeaiv1alpha1.CurrentMetrics{TokensPerSecond: 5000},
+			expected:                      5, // 5000 / (1000 * 2) = 2.5, 2 * 2.5 = 5
+			expectedAlgorithm:             "MaxRatio",
+			expectedRequestedAlgoNotFound: false,
+			expectedRequestedName:         "",
+		},
+		{
+			name: "scale up based on in-flight requests",
+			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+					MinReplicas: int32Ptr(1),
+					MaxReplicas: 10,
+					Metrics: kubeaiv1alpha1.MetricsSpec{
+						InFlightRequests: &kubeaiv1alpha1.InFlightRequestsMetric{
+							Enabled:          true,
+							TargetPerReplica: ptr.To(resource.MustParse("8")),
+						},
+					},
+				},
+			},
+			currentReplicas:               3,
+			currentMetrics:                &kubeaiv1alpha1.CurrentMetrics{InFlightRequests: 40},
+			expected:                      5, // 40 / (8 * 3) = 1.67, ceil(3 * 1.67) = 5
+			expectedAlgorithm:             "MaxRatio",
+			expectedRequestedAlgoNotFound: false,
+			expectedRequestedName:         "",
+		},
+		{
+			name: "scale up based on synthetic probe latency",
+			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+					MinReplicas: int32Ptr(1),
+					MaxReplicas: 10,
+					Metrics: kubeaiv1alpha1.MetricsSpec{
+						SyntheticProbe: &kubeaiv1alpha1.SyntheticProbeMetric{
+							Enabled:         true,
+							Endpoint:        "http://llama.models:8000/v1/completions",
+							TargetLatencyMs: ptr.To(resource.MustParse("2000")),
+						},
+					},
+				},
+			},
+			currentReplicas:               2,
+			currentMetrics:                &kubeaiv1alpha1.CurrentMetrics{SyntheticLatencyMs: 3000},
+			expected:                      3, // 3000 / 2000 = 1.5, 2 * 1.5 = 3
+			expectedAlgorithm:             "MaxRatio",
+			expectedRequestedAlgoNotFound: false,
+			expectedRequestedName:         "",
+		},
 		{
 			name: "fallback to MaxRatio for unknown algorithm",
 			policy: &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
-					MinReplicas: 1,
+					MinReplicas: int32Ptr(1),
 					MaxReplicas: 10,
 					Algorithm: &kubeaiv1alpha1.AlgorithmSpec{
 						Name: "NonExistentAlgorithm",
@@ -210,7 +317,7 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 100,
+							TargetP99Ms: ptr.To(resource.MustParse("100")),
 						},
 					},
 				},
@@ -231,7 +338,7 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 			}
 			ctx := context.Background()
 
-			result, algorithmUsed, _, requestedAlgoNotFound, requestedName := r.calculateDesiredReplicas(ctx, tt.policy, tt.currentReplicas, tt.currentMetrics)
+			result, algorithmUsed, _, requestedAlgoNotFound, requestedName, _ := r.calculateDesiredReplicas(ctx, tt.policy, tt.currentReplicas, tt.currentMetrics)
 			assert.Equal(t, tt.expected, result)
 			assert.Equal(t, tt.expectedAlgorithm, algorithmUsed)
 			assert.Equal(t, tt.expectedRequestedAlgoNotFound, requestedAlgoNotFound)
@@ -250,19 +357,346 @@ func TestMockMetricsClient(t *testing.T) {
 
 	ctx := context.Background()
 
-	latency, err := mock.GetLatencyP99(ctx, "")
+	latency, err := metrics.GetLatencyP99(ctx, mock, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 0.5, latency)
 
-	gpu, err := mock.GetGPUUtilization(ctx, "")
+	gpu, err := metrics.GetGPUUtilization(ctx, mock, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 75.0, gpu)
 
-	queue, err := mock.GetQueueDepth(ctx, "")
+	queue, err := metrics.GetQueueDepth(ctx, mock, "")
 	assert.NoError(t, err)
 	assert.Equal(t, int64(100), queue)
 }
 
+func TestCalculateDesiredReplicasSmoothing(t *testing.T) {
+	policy := lockTestPolicy("smoothed")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "AverageRatio", Tolerance: DefaultTolerance}
+	policy.Spec.Smoothing = &kubeaiv1alpha1.SmoothingSpec{Factor: 0.5, MaxUpPercent: 50}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+	r := NewReconciler(c, newTestScheme(t), nil, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+
+	// The increase is capped at 50% of the current replicas
+	desired, algorithm, decision, _, _, _ := r.calculateDesiredReplicas(ctx, policy, 4, &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 100})
+	assert.Equal(t, int32(6), desired)
+	assert.Equal(t, "AverageRatio", algorithm)
+	assert.Contains(t, decision.Reason, "smoothed from 8 to 6")
+
+	// The moving average damps a single quiet sample instead of halving the replicas
+	desired, _, _, _, _, _ = r.calculateDesiredReplicas(ctx, policy, 6, &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 25})
+	assert.Equal(t, int32(5), desired) // (3 + 8) / 2 = 5.5
+
+	// Deleting the policy drops its smoothing state
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "smoothed"}})
+	require.NoError(t, err)
+	_, ok := r.AlgorithmState.Get("default/smoothed", "smoothing")
+	assert.False(t, ok)
+}
+
+func TestCalculateDesiredReplicasMaxRatioScaleDown(t *testing.T) {
+	policy := lockTestPolicy("scale-down")
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+	quiet := &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 10}
+
+	// MaxRatio keeps its replicas by default
+	desired, _, _, _, _, _ := r.calculateDesiredReplicas(ctx, policy, 8, quiet)
+	assert.Equal(t, int32(8), desired)
+
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "MaxRatio", Tolerance: DefaultTolerance, ScaleDownEnabled: true}
+	desired, _, _, _, _, _ = r.calculateDesiredReplicas(ctx, policy, 8, quiet)
+	assert.Equal(t, int32(2), desired) // 8 * 10/50 = 1.6
+
+	// The shared registry instance is left untouched
+	shared, err := scaling.DefaultRegistry.Get("MaxRatio")
+	require.NoError(t, err)
+	assert.False(t, shared.(*scaling.MaxRatioAlgorithm).ScaleDownEnabled)
+}
+
+func TestCalculateDesiredReplicasDirectionAlgorithms(t *testing.T) {
+	policy := lockTestPolicy("directional")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "MaxRatio", Tolerance: DefaultTolerance, ScaleDownEnabled: true}
+	policy.Spec.ScaleDown = &kubeaiv1alpha1.ScaleBehavior{Algorithm: "AverageRatio"}
+	policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))}
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+
+	// Scale-up follows MaxRatio: GPU at 2x target wins over the empty queue
+	desired, algorithm, _, _, _, _ := r.calculateDesiredReplicas(ctx, policy, 4,
+		&kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 100, RequestQueueDepth: 0})
+	assert.Equal(t, int32(8), desired)
+	assert.Equal(t, "MaxRatio", algorithm)
+
+	// Scale-down averages the ratios instead: (0.5 + 0.1) / 2 x 10 replicas
+	desired, _, decision, _, _, _ := r.calculateDesiredReplicas(ctx, policy, 10,
+		&kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 25, RequestQueueDepth: 1})
+	assert.Equal(t, int32(3), desired)
+	assert.Contains(t, decision.Reason, "AverageRatio for scale-down")
+
+	field, name := r.unknownDirectionAlgorithm(policy)
+	assert.Empty(t, field+name)
+	policy.Spec.ScaleUp = &kubeaiv1alpha1.ScaleBehavior{Algorithm: "Missing"}
+	field, name = r.unknownDirectionAlgorithm(policy)
+	assert.Equal(t, "spec.scaleUp.algorithm", field)
+	assert.Equal(t, "Missing", name)
+}
+
+func TestCalculateDesiredReplicasUnusedCapacity(t *testing.T) {
+	policy := lockTestPolicy("unused-capacity")
+	policy.Spec.MinReplicas = int32Ptr(4)
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "AverageRatio", Tolerance: DefaultTolerance}
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+
+	// GPU at a fifth of target would need 2 of 8 replicas; minReplicas holds 4
+	desired, _, _, _, _, _ := r.calculateDesiredReplicas(context.Background(), policy, 8,
+		&kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 10})
+	assert.Equal(t, int32(4), desired)
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.UnconstrainedReplicas.WithLabelValues("default", "unused-capacity")))
+	assert.Equal(t, 6.0, testutil.ToFloat64(metrics.UnusedCapacity.WithLabelValues("default", "unused-capacity")))
+}
+
+func TestCalculateDesiredReplicasLittlesLaw(t *testing.T) {
+	mock := &metrics.MockClient{
+		QueryValues: map[string]float64{
+			metrics.DefaultArrivalRateQuery: 30,
+			metrics.DefaultServiceTimeQuery: 0.8,
+		},
+		QueueDepthValue: 6,
+	}
+	r := NewReconciler(nil, nil, mock, scaling.DefaultRegistry, nil)
+	policy := lockTestPolicy("queueing")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "LittlesLaw", Tolerance: DefaultTolerance}
+	policy.Spec.Metrics = kubeaiv1alpha1.MetricsSpec{
+		RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))},
+		Queueing:          &kubeaiv1alpha1.QueueingMetric{Enabled: true, TargetUtilization: 75},
+	}
+	policy.Status.DiscoveredCapacity = 4
+	ctx := context.Background()
+
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(30.0), current.ArrivalRate)
+	assert.Equal(t, ptr.To(800.0), current.ServiceTimeMs)
+	assert.Equal(t, map[string]float64{
+		scaling.MetricRequestQueueDepth:  6,
+		scaling.MetricArrivalRate:        30,
+		scaling.MetricServiceTimeSeconds: 0.8,
+	}, namedMetrics(policy, current))
+
+	// 30 req/s x 0.8s + 6 queued = 30 requests over 3 per replica (75% of the discovered 4)
+	desired, algorithm, decision, _, _, _ := r.calculateDesiredReplicas(ctx, policy, 2, current)
+	assert.Equal(t, int32(10), desired)
+	assert.Equal(t, "LittlesLaw", algorithm)
+	assert.Contains(t, decision.Reason, "Little's Law")
+
+	// An explicit concurrency overrides the discovered capacity
+	policy.Spec.Metrics.Queueing.ConcurrencyPerReplica = 10
+	desired, _, _, _, _, _ = r.calculateDesiredReplicas(ctx, policy, 2, current)
+	assert.Equal(t, int32(4), desired)
+}
+
+func TestCalculateDesiredReplicasLittlesLawIdle(t *testing.T) {
+	// With no requests arriving the service time query divides zero by zero
+	mock := &metrics.MockClient{
+		QueryValues: map[string]float64{
+			metrics.DefaultArrivalRateQuery: 0,
+			metrics.DefaultServiceTimeQuery: math.NaN(),
+		},
+	}
+	r := NewReconciler(nil, nil, mock, scaling.DefaultRegistry, nil)
+	policy := lockTestPolicy("idle-queueing")
+	policy.Spec.MinReplicas = int32Ptr(2)
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "LittlesLaw", Tolerance: DefaultTolerance}
+	policy.Spec.Metrics = kubeaiv1alpha1.MetricsSpec{
+		Queueing: &kubeaiv1alpha1.QueueingMetric{Enabled: true, ConcurrencyPerReplica: 4},
+	}
+	ctx := context.Background()
+
+	// A zero arrival rate is reported, not dropped as missing
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(0.0), current.ArrivalRate)
+	assert.Nil(t, current.ServiceTimeMs)
+	assert.Equal(t, map[string]float64{scaling.MetricArrivalRate: 0}, namedMetrics(policy, current))
+
+	desired, algorithm, decision, _, _, _ := r.calculateDesiredReplicas(ctx, policy, 6, current)
+	assert.Equal(t, int32(2), desired)
+	assert.Equal(t, "LittlesLaw", algorithm)
+	assert.Contains(t, decision.Reason, "Little's Law")
+
+	// Service times under a millisecond keep their precision
+	mock.QueryValues[metrics.DefaultArrivalRateQuery] = 100
+	mock.QueryValues[metrics.DefaultServiceTimeQuery] = 0.0004
+	current, err = r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(0.4), current.ServiceTimeMs)
+	assert.InDelta(t, 0.0004, namedMetrics(policy, current)[scaling.MetricServiceTimeSeconds], 1e-9)
+}
+
+func TestCalculateDesiredReplicasPredictive(t *testing.T) {
+	policy := lockTestPolicy("predictive")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{
+		Name:                     "Predictive",
+		Tolerance:                DefaultTolerance,
+		ForecastHorizonSeconds:   600,
+		SeasonalityPeriodSeconds: 3600,
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+	r := NewReconciler(c, newTestScheme(t), nil, scaling.DefaultRegistry, nil)
+
+	desired, algorithm, _, _, _, _ := r.calculateDesiredReplicas(context.Background(), policy, 4, &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 100})
+	assert.Equal(t, int32(8), desired)
+	assert.Equal(t, "Predictive", algorithm)
+
+	// The forecast, including a seasonal component for the configured period,
+	// is kept in the reconciler's state store rather than the shared default
+	state := r.AlgorithmState.Snapshot("default/predictive")["default/predictive"]
+	assert.Equal(t, 8.0, state["predictive.level"])
+	seasonal := 0
+	for name := range state {
+		if strings.HasPrefix(name, "predictive.season.") {
+			seasonal++
+		}
+	}
+	assert.Equal(t, 1, seasonal)
+	_, ok := scaling.DefaultStateStore.Get("default/predictive", "predictive.level")
+	assert.False(t, ok)
+}
+
+func TestWithParametersPredictive(t *testing.T) {
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	policy := lockTestPolicy("predictive")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{
+		Name:                   "Predictive",
+		ForecastHorizonSeconds: 600,
+		Parameters:             map[string]string{"levelSmoothing": "0.9"},
+	}
+	registered, err := scaling.DefaultRegistry.Get("Predictive")
+	require.NoError(t, err)
+	require.NoError(t, r.validateAlgorithmParameters(policy, "Predictive"))
+
+	// The parameters configure the copy the typed fields were applied to
+	configured, ok := r.withParameters(policy, registered).(*scaling.PredictiveAlgorithm)
+	require.True(t, ok)
+	assert.Equal(t, 10*time.Minute, configured.Horizon)
+	assert.Equal(t, 0.9, configured.LevelSmoothing)
+	assert.Zero(t, registered.(*scaling.PredictiveAlgorithm).LevelSmoothing)
+}
+
+func TestFetchCustomMetrics(t *testing.T) {
+	mock := &metrics.MockClient{
+		QueryValues: map[string]float64{
+			"sum(rate(vllm:generation_tokens_total[1m]))": 1800,
+			"vllm:gpu_cache_usage_perc":                   0.75,
+		},
+		QueryValue: 5,
+	}
+	r := NewReconciler(nil, nil, mock, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				CustomMetrics: []kubeaiv1alpha1.CustomMetric{
+					{Name: "tokens-per-second", Query: "sum(rate(vllm:generation_tokens_total[1m]))", TargetValue: 1000, Aggregation: "Sum"},
+					{Name: "kv-cache", Query: "vllm:gpu_cache_usage_perc", TargetValue: 0.5},
+				},
+			},
+		},
+	}
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, []kubeaiv1alpha1.CustomMetricValue{
+		{Name: "tokens-per-second", Value: 1800},
+		{Name: "kv-cache", Value: 0.75},
+	}, current.Custom)
+	assert.Equal(t, []float64{1.8, 1.5}, r.buildMetricRatios(policy, 2, current))
+
+	// An AverageValue target is per replica, like an HPA AverageValue target
+	policy.Spec.Metrics.CustomMetrics[0].TargetType = "AverageValue"
+	policy.Spec.Metrics.CustomMetrics[0].TargetValue = 600
+	assert.Equal(t, []float64{1.5, 1.5}, r.buildMetricRatios(policy, 2, current))
+}
+
+func TestFetchTokensPerSecond(t *testing.T) {
+	mock := &metrics.MockClient{TokensPerSecondValue: 2750.6}
+	r := NewReconciler(nil, nil, mock, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				TokensPerSecond: &kubeaiv1alpha1.TokensPerSecondMetric{
+					Enabled:          true,
+					TargetPerReplica: ptr.To(resource.MustParse("500")),
+					Preset:           "TGI",
+				},
+			},
+		},
+	}
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2750), current.TokensPerSecond)
+	assert.Equal(t, []float64{2.75}, r.buildMetricRatios(policy, 2, current))
+}
+
+func TestFractionalTargets(t *testing.T) {
+	mock := &metrics.MockClient{LatencyP99Value: 0.0004, InFlightValue: 3}
+	r := NewReconciler(nil, nil, mock, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Latency:          &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("0.5"))},
+				InFlightRequests: &kubeaiv1alpha1.InFlightRequestsMetric{Enabled: true, TargetPerReplica: ptr.To(resource.MustParse("500m"))},
+			},
+		},
+	}
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	// A 400µs latency is kept to the microsecond rather than truncated to 0 ms
+	assert.Equal(t, 0.4, current.LatencyP99Ms)
+	// 3 requests on 2 replicas serving half a request each
+	ratios := r.buildMetricRatios(policy, 2, current)
+	require.Len(t, ratios, 2)
+	assert.InDelta(t, 0.8, ratios[0], 1e-9)
+	assert.InDelta(t, 3.0, ratios[1], 1e-9)
+}
+
+func TestFetchMetricsTransforms(t *testing.T) {
+	mock := &metrics.MockClient{
+		LatencyP99Value:     420000, // microseconds
+		GPUUtilizationValue: 0.83,   // fraction
+		QueueDepthValue:     -3,
+		QueryValue:          0.6,
+	}
+	r := NewReconciler(nil, nil, mock, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Latency: &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500")),
+					Transform: &kubeaiv1alpha1.MetricTransform{Multiplier: ptr.To(0.000001)}},
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 50,
+					Transform: &kubeaiv1alpha1.MetricTransform{Multiplier: ptr.To(100.0), ClampMax: ptr.To(100.0)}},
+				RequestQueueDepth: &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10")),
+					Transform: &kubeaiv1alpha1.MetricTransform{ClampMin: ptr.To(0.0)}},
+				CustomMetrics: []kubeaiv1alpha1.CustomMetric{
+					{Name: "kv-cache", Query: "vllm:gpu_cache_usage_perc", TargetValue: 50,
+						Transform: &kubeaiv1alpha1.MetricTransform{Multiplier: ptr.To(100.0), Offset: -10}},
+				},
+			},
+		},
+	}
+
+	current, err := r.fetchMetrics(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, 420.0, current.LatencyP99Ms)
+	assert.Equal(t, int32(83), current.GPUUtilizationPercent)
+	assert.Equal(t, int32(0), current.RequestQueueDepth)
+	require.Len(t, current.Custom, 1)
+	assert.InDelta(t, 50.0, current.Custom[0].Value, 1e-9)
+}
+
 func TestPolicyDefaults(t *testing.T) {
 	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
 		ObjectMeta: metav1.ObjectMeta{
@@ -279,19 +713,478 @@ func TestPolicyDefaults(t *testing.T) {
 			Metrics: kubeaiv1alpha1.MetricsSpec{
 				Latency: &kubeaiv1alpha1.LatencyMetric{
 					Enabled:     true,
-					TargetP99Ms: 500,
+					TargetP99Ms: ptr.To(resource.MustParse("500")),
 				},
 			},
 		},
 	}
 
 	// Test that MinReplicas defaults to 1 when not set
-	assert.Equal(t, int32(0), policy.Spec.MinReplicas)
+	assert.Nil(t, policy.Spec.MinReplicas)
 
 	// In the reconciler, we handle this:
-	minReplicas := policy.Spec.MinReplicas
-	if minReplicas == 0 {
-		minReplicas = 1
+	minReplicas := int32(1)
+	if policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas > 0 {
+		minReplicas = *policy.Spec.MinReplicas
 	}
 	assert.Equal(t, int32(1), minReplicas)
 }
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, kubeaiv1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestDryRunScale(t *testing.T) {
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "test-deployment",
+			},
+			MaxReplicas: 10,
+			DryRun:      true,
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(deployment, policy).
+		WithStatusSubresource(policy).
+		Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+
+	ctx := context.Background()
+	r.dryRunScale(ctx, policy, 2, 4)
+
+	// The target must not have been modified
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test-deployment"}, updated))
+	assert.Equal(t, int32(2), *updated.Spec.Replicas)
+
+	assert.True(t, r.hasCondition(policy, ConditionTypeDryRun, metav1.ConditionTrue, ReasonDryRunAccepted))
+	assert.Empty(t, r.LastScaleTime)
+
+	select {
+	case event := <-fakeRecorder.Events:
+		assert.Contains(t, event, ReasonDryRunAccepted)
+		assert.Contains(t, event, "from 2 to 4")
+	default:
+		t.Fatal("Expected an event to be recorded")
+	}
+}
+
+func TestCooldownSurvivesRestart(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.CooldownPeriod = 60
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+	// Each reconciler stands in for a freshly started controller with no in-memory state
+	reconcileAt := func(at time.Time, gpu float64) {
+		r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: gpu}, scaling.DefaultRegistry, nil)
+		r.Clock = clocktesting.NewFakePassiveClock(at)
+		_, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+	}
+
+	reconcileAt(now, 100)
+	assert.Equal(t, int32(4), replicas())
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+	require.NotNil(t, stored.Status.LastScaleTime)
+	assert.True(t, stored.Status.LastScaleTime.Equal(&metav1.Time{Time: now}))
+
+	// The restarted controller still honors the cooldown started before the restart
+	reconcileAt(now.Add(30*time.Second), 100)
+	assert.Equal(t, int32(4), replicas())
+
+	reconcileAt(now.Add(90*time.Second), 100)
+	assert.Equal(t, int32(8), replicas())
+}
+
+func TestDryRunScaleRejected(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "missing",
+			},
+			MaxReplicas: 10,
+			DryRun:      true,
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(policy).
+		WithStatusSubresource(policy).
+		Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(nil))
+
+	r.dryRunScale(context.Background(), policy, 2, 4)
+	assert.True(t, r.hasCondition(policy, ConditionTypeDryRun, metav1.ConditionFalse, ReasonDryRunRejected))
+}
+
+// blockingAlgorithm never returns before the test deadline
+type blockingAlgorithm struct{}
+
+func (b *blockingAlgorithm) Name() string {
+	return "Blocking"
+}
+
+func (b *blockingAlgorithm) ComputeScale(_ context.Context, input scaling.ScalingInput) (scaling.ScalingResult, error) {
+	time.Sleep(time.Second)
+	return scaling.ScalingResult{DesiredReplicas: input.MaxReplicas}, nil
+}
+
+// fixedAlgorithm recommends the replica count set by its "replicas" parameter
+type fixedAlgorithm struct {
+	replicas int64
+}
+
+func (f *fixedAlgorithm) Name() string {
+	return "Fixed"
+}
+
+func (f *fixedAlgorithm) ComputeScale(_ context.Context, _ scaling.ScalingInput) (scaling.ScalingResult, error) {
+	return scaling.ScalingResult{DesiredReplicas: int32(f.replicas), Reason: "fixed"}, nil
+}
+
+func (f *fixedAlgorithm) Parameters() []scaling.ParameterSpec {
+	return []scaling.ParameterSpec{{Name: "replicas", Type: scaling.ParameterInt, Default: "2"}}
+}
+
+func (f *fixedAlgorithm) WithParameters(params scaling.Parameters) (scaling.ScalingAlgorithm, error) {
+	replicas, err := params.Int("replicas", f.replicas)
+	if err != nil {
+		return nil, err
+	}
+	return &fixedAlgorithm{replicas: replicas}, nil
+}
+
+// recordingAlgorithm keeps the input of its last computation
+type recordingAlgorithm struct {
+	input scaling.ScalingInput
+}
+
+func (a *recordingAlgorithm) Name() string {
+	return "Recording"
+}
+
+func (a *recordingAlgorithm) ComputeScale(_ context.Context, input scaling.ScalingInput) (scaling.ScalingResult, error) {
+	a.input = input
+	return scaling.ScalingResult{DesiredReplicas: input.CurrentReplicas}, nil
+}
+
+func TestCalculateDesiredReplicasInput(t *testing.T) {
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(3)},
+		Status:     appsv1.DeploymentStatus{Replicas: 3, ReadyReplicas: 2},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	algorithm := &recordingAlgorithm{}
+	registry := scaling.NewRegistry()
+	registry.MustRegister(algorithm)
+	r := NewReconciler(c, scheme, nil, registry, nil)
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	policy := lockTestPolicy("input")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "Recording"}
+	policy.Spec.Metrics.RequestQueueDepth = &kubeaiv1alpha1.QueueDepthMetric{Enabled: true, TargetDepth: ptr.To(resource.MustParse("10"))}
+	current := &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 90, RequestQueueDepth: 15}
+
+	r.calculateDesiredReplicas(context.Background(), policy, 3, current)
+	input := algorithm.input
+	assert.Equal(t, []float64{1.8, 0.5}, input.MetricRatios)
+	assert.Equal(t, map[string]scaling.MetricSample{
+		scaling.MetricGPUUtilization:    {Current: 90, Target: 50, Ratio: 1.8, Timestamp: now},
+		scaling.MetricRequestQueueDepth: {Current: 15, Target: 30, Ratio: 0.5, Timestamp: now},
+	}, input.MetricSamples)
+	assert.Equal(t, int32(2), input.ReadyReplicas)
+	assert.Equal(t, int32(1), input.UnavailableReplicas)
+
+	// A target that cannot be read is reported as fully ready
+	policy.Spec.TargetRef.Name = "missing"
+	r.calculateDesiredReplicas(context.Background(), policy, 3, current)
+	assert.Equal(t, int32(3), algorithm.input.ReadyReplicas)
+	assert.Zero(t, algorithm.input.UnavailableReplicas)
+}
+
+func TestCalculateDesiredReplicasAlgorithmParameters(t *testing.T) {
+	registry := scaling.NewRegistry()
+	registry.MustRegister(scaling.NewMaxRatioAlgorithm(scaling.DefaultTolerance))
+	registry.MustRegister(&fixedAlgorithm{replicas: 2})
+	r := NewReconciler(nil, nil, nil, registry, nil)
+	policy := lockTestPolicy("parameters")
+	ctx := context.Background()
+	current := &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 50}
+
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "Fixed", Parameters: map[string]string{"replicas": "7"}}
+	require.NoError(t, r.validateAlgorithmParameters(policy, "Fixed"))
+	desired, _, _, _, _, _ := r.calculateDesiredReplicas(ctx, policy, 3, current)
+	assert.Equal(t, int32(7), desired)
+
+	// Rejected parameters keep the algorithm's defaults
+	policy.Spec.Algorithm.Parameters = map[string]string{"replicas": "seven"}
+	assert.ErrorContains(t, r.validateAlgorithmParameters(policy, "Fixed"), `parameter "replicas"`)
+	desired, _, _, _, _, _ = r.calculateDesiredReplicas(ctx, policy, 3, current)
+	assert.Equal(t, int32(2), desired)
+
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "MaxRatio", Parameters: map[string]string{"replicas": "7"}}
+	assert.ErrorAs(t, r.validateAlgorithmParameters(policy, "MaxRatio"), &scaling.ErrParametersNotSupported{})
+}
+
+// failingAlgorithm always returns err
+type failingAlgorithm struct {
+	err error
+}
+
+func (f *failingAlgorithm) Name() string {
+	return "Failing"
+}
+
+func (f *failingAlgorithm) ComputeScale(_ context.Context, _ scaling.ScalingInput) (scaling.ScalingResult, error) {
+	return scaling.ScalingResult{}, f.err
+}
+
+func TestReconcileAlgorithmErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		reason    string
+		errorType string
+	}{
+		{
+			name:      "insufficient metrics",
+			err:       scaling.ErrInsufficientMetrics{Name: "Failing", Missing: []string{scaling.MetricArrivalRate}},
+			reason:    ReasonInsufficientMetrics,
+			errorType: scaling.ErrorTypeInsufficientMetrics,
+		},
+		{
+			name:      "invalid parameters",
+			err:       scaling.ErrInvalidParameters{Name: "Failing", Err: errors.New("window too short")},
+			reason:    ReasonInvalidAlgorithmParameters,
+			errorType: scaling.ErrorTypeInvalidParameters,
+		},
+		{
+			name:      "corrupt state",
+			err:       scaling.ErrStateCorrupt{Name: "Failing", Detail: "level is NaN"},
+			reason:    ReasonAlgorithmStateCorrupt,
+			errorType: scaling.ErrorTypeStateCorrupt,
+		},
+		{
+			name:      "untyped",
+			err:       errors.New("boom"),
+			reason:    ReasonAlgorithmFailed,
+			errorType: scaling.ErrorTypeUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := scaling.NewRegistry()
+			registry.MustRegister(scaling.NewMaxRatioAlgorithm(scaling.DefaultTolerance))
+			registry.MustRegister(&failingAlgorithm{err: tt.err})
+
+			scheme := newTestScheme(t)
+			policy := lockTestPolicy("failing-" + strings.ReplaceAll(tt.name, " ", "-"))
+			policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "Failing", Tolerance: DefaultTolerance}
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+				WithStatusSubresource(policy).Build()
+			fakeRecorder := record.NewFakeRecorder(10)
+			r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, registry, NewEventRecorder(fakeRecorder))
+			r.AlgorithmState.Set("default/"+policy.Name, "failing.level", 1)
+			ctx := context.Background()
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: policy.Name, Namespace: "default"}}
+			counter := metrics.ReconcileErrors.WithLabelValues("default", policy.Name, tt.errorType)
+
+			for range 2 {
+				_, err := r.Reconcile(ctx, req)
+				require.NoError(t, err)
+			}
+
+			// The current replicas are kept
+			updated := &appsv1.Deployment{}
+			require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+			assert.Equal(t, int32(2), *updated.Spec.Replicas)
+
+			stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+			require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+			assert.True(t, r.hasCondition(stored, ConditionTypeAlgorithmValid, metav1.ConditionFalse, tt.reason))
+			assert.Equal(t, 2.0, testutil.ToFloat64(counter))
+
+			// The event is emitted once, when the condition changes
+			require.Len(t, fakeRecorder.Events, 1)
+			assert.Contains(t, <-fakeRecorder.Events, tt.reason)
+
+			_, kept := r.AlgorithmState.Get("default/"+policy.Name, "failing.level")
+			assert.Equal(t, tt.reason != ReasonAlgorithmStateCorrupt, kept)
+		})
+	}
+}
+
+func TestCalculateDesiredReplicasAlgorithmTimeout(t *testing.T) {
+	registry := scaling.NewRegistry()
+	registry.MustRegister(scaling.NewMaxRatioAlgorithm(scaling.DefaultTolerance))
+	registry.MustRegister(&blockingAlgorithm{})
+
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := &AIInferenceAutoscalerPolicyReconciler{
+		AlgorithmRegistry: registry,
+		EventRecorder:     NewEventRecorder(fakeRecorder),
+		AlgorithmTimeout:  20 * time.Millisecond,
+	}
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+			Algorithm:   &kubeaiv1alpha1.AlgorithmSpec{Name: "Blocking", Tolerance: 0.1},
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Latency: &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("100"))},
+			},
+		},
+	}
+
+	desired, algorithmUsed, decision, notFound, _, _ := r.calculateDesiredReplicas(context.Background(), policy, 2, &kubeaiv1alpha1.CurrentMetrics{LatencyP99Ms: 200})
+	assert.Equal(t, int32(4), desired)
+	assert.Equal(t, "MaxRatio", algorithmUsed)
+	assert.Contains(t, decision.Reason, "Blocking")
+	assert.False(t, notFound)
+
+	select {
+	case event := <-fakeRecorder.Events:
+		assert.Contains(t, event, ReasonAlgorithmTimeout)
+	default:
+		t.Fatal("Expected an event to be recorded")
+	}
+}
+
+func TestCalculateDesiredReplicasFallbackAlgorithm(t *testing.T) {
+	tests := []struct {
+		name         string
+		algorithm    string
+		fallback     string
+		controller   string
+		wantUsed     string
+		wantNotFound bool
+	}{
+		{name: "default fallback", algorithm: "Missing", wantUsed: DefaultAlgorithmName, wantNotFound: true},
+		{name: "policy fallback", algorithm: "Missing", fallback: "Hold", wantUsed: "Hold", wantNotFound: true},
+		{name: "controller fallback", algorithm: "Missing", controller: "AverageRatio", wantUsed: "AverageRatio", wantNotFound: true},
+		{name: "policy fallback wins", algorithm: "Missing", fallback: "Hold", controller: "AverageRatio", wantUsed: "Hold", wantNotFound: true},
+		{name: "unknown policy fallback", algorithm: "Missing", fallback: "Unknown", controller: "AverageRatio", wantUsed: "AverageRatio", wantNotFound: true},
+		{name: "timeout", algorithm: "Blocking", fallback: "Hold", wantUsed: "Hold"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := scaling.NewRegistry()
+			registry.MustRegister(scaling.NewMaxRatioAlgorithm(scaling.DefaultTolerance))
+			registry.MustRegister(scaling.NewAverageRatioAlgorithm(scaling.DefaultTolerance))
+			registry.MustRegister(scaling.NewStubAlgorithm("Hold", scaling.NewMaxRatioAlgorithm(scaling.DefaultTolerance)))
+			registry.MustRegister(&blockingAlgorithm{})
+			r := &AIInferenceAutoscalerPolicyReconciler{
+				AlgorithmRegistry: registry,
+				AlgorithmTimeout:  20 * time.Millisecond,
+				FallbackAlgorithm: tt.controller,
+			}
+			policy := lockTestPolicy("policy")
+			policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: tt.algorithm, Fallback: tt.fallback, Tolerance: 0.1}
+
+			desired, algorithmUsed, _, notFound, _, err := r.calculateDesiredReplicas(context.Background(), policy, 2,
+				&kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 100})
+			require.NoError(t, err)
+			assert.Equal(t, int32(4), desired)
+			assert.Equal(t, tt.wantUsed, algorithmUsed)
+			assert.Equal(t, tt.wantNotFound, notFound)
+		})
+	}
+}
+
+func TestReconcileUnknownFallbackAlgorithm(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.Algorithm = &kubeaiv1alpha1.AlgorithmSpec{Name: "MaxRatio", Fallback: "Unknown", Tolerance: 0.1}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 50}, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+	assert.True(t, r.hasCondition(stored, ConditionTypeAlgorithmValid, metav1.ConditionFalse, ReasonUnknownAlgorithm))
+	assert.Contains(t, <-fakeRecorder.Events, `spec.algorithm.fallback="Unknown" is not registered`)
+}
+
+func TestReconcileRecordsDecisionDetails(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("details")
+	policy.Spec.MaxReplicas = 3
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "details", Namespace: "default"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+	assert.Equal(t, int32(3), stored.Status.DesiredReplicas)
+	assert.Equal(t, scaling.MetricGPUUtilization, stored.Status.LastDrivingMetric)
+	assert.Equal(t, int32(4), stored.Status.LastUnclampedReplicas)
+	assert.Zero(t, stored.Status.LastConfidence)
+	assert.Contains(t, <-fakeRecorder.Events, "from 2 to 3 replicas; driving metric: gpuUtilizationPercent; unclamped: 4")
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// replicaHealth returns the replicas of the target that are ready and those
+// that exist but are not ready yet, such as pods still loading a model. Kinds
+// other than Deployment and StatefulSet report every replica observed through
+// the scale subresource as ready. When the target cannot be read, all current
+// replicas are reported ready, so algorithms see the target as before; the
+// same holds for a reconciler without a client.
+func (r *AIInferenceAutoscalerPolicyReconciler) replicaHealth(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentReplicas int32) (ready, unavailable int32) {
+	if r.Client == nil {
+		return currentReplicas, 0
+	}
+	key := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Spec.TargetRef.Name}
+	var replicas int32
+	var err error
+	switch policy.Spec.TargetRef.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err = r.Get(ctx, key, deployment); err == nil {
+			replicas, ready = deployment.Status.Replicas, deployment.Status.ReadyReplicas
+		}
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err = r.Get(ctx, key, statefulSet); err == nil {
+			replicas, ready = statefulSet.Status.Replicas, statefulSet.Status.ReadyReplicas
+		}
+	default:
+		scale, scaleErr := r.getScale(ctx, policy)
+		if err = scaleErr; err == nil {
+			replicas, ready = scale.Status.Replicas, scale.Status.Replicas
+		}
+	}
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read replica health, assuming all replicas are ready")
+		return currentReplicas, 0
+	}
+	return ready, max(replicas-ready, 0)
+}
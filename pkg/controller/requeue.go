@@ -0,0 +1,109 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// DefaultConvergenceRequeueInterval is the requeue interval used right after a scale change
+	DefaultConvergenceRequeueInterval = 10 * time.Second
+	// DefaultConvergenceRequeueCount is the number of short requeues after a scale change
+	DefaultConvergenceRequeueCount = 3
+
+	// QueryWindowIntervals is the number of polling intervals covered by the query window
+	QueryWindowIntervals = 4
+	// MinQueryWindow keeps rate windows long enough to span several Prometheus scrapes
+	MinQueryWindow = time.Minute
+
+	// CooldownRequeueJitter is the most a requeue at cooldown expiry is delayed,
+	// so policies whose cooldowns end together do not reconcile in lockstep
+	CooldownRequeueJitter = time.Second
+)
+
+// pollingInterval returns how often the policy is reconciled
+func pollingInterval(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) time.Duration {
+	if policy.Spec.PollingInterval > 0 {
+		return time.Duration(policy.Spec.PollingInterval) * time.Second
+	}
+	return DefaultRequeueInterval
+}
+
+// queryWindow returns the rate and quantile window for the policy's metric
+// queries: a few polling intervals, so each decision sees fresh data without
+// following every spike
+func queryWindow(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) time.Duration {
+	return max(QueryWindowIntervals*pollingInterval(policy), MinQueryWindow)
+}
+
+// startConvergenceTracking schedules short requeues for the policy after a scale change
+func (r *AIInferenceAutoscalerPolicyReconciler) startConvergenceTracking(policyKey string) {
+	if r.ConvergenceRequeueCount <= 0 || r.ConvergenceRequeueInterval <= 0 {
+		return
+	}
+	r.requeueMu.Lock()
+	defer r.requeueMu.Unlock()
+	if r.fastRequeues == nil {
+		r.fastRequeues = make(map[string]int)
+	}
+	r.fastRequeues[policyKey] = r.ConvergenceRequeueCount
+}
+
+// nextRequeueInterval returns the short convergence interval while the policy has
+// fast requeues remaining, and the regular interval otherwise
+func (r *AIInferenceAutoscalerPolicyReconciler) nextRequeueInterval(policyKey string, interval time.Duration) time.Duration {
+	r.requeueMu.Lock()
+	defer r.requeueMu.Unlock()
+
+	remaining := r.fastRequeues[policyKey]
+	if remaining <= 0 {
+		return interval
+	}
+	if remaining == 1 {
+		delete(r.fastRequeues, policyKey)
+	} else {
+		r.fastRequeues[policyKey] = remaining - 1
+	}
+	return min(r.ConvergenceRequeueInterval, interval)
+}
+
+// forgetConvergence drops the remaining fast requeues of a deleted policy
+func (r *AIInferenceAutoscalerPolicyReconciler) forgetConvergence(policyKey string) {
+	r.requeueMu.Lock()
+	defer r.requeueMu.Unlock()
+	delete(r.fastRequeues, policyKey)
+}
+
+// cooldownRequeueInterval returns the requeue interval for a policy whose
+// scale was held back by cooldown: just after the cooldown expires, or
+// interval if that comes first
+func cooldownRequeueInterval(remaining, interval time.Duration) time.Duration {
+	return min(remaining+rand.N(CooldownRequeueJitter), interval)
+}
+
+// recordCooldownRemaining publishes how long cooldown holds back the policy's
+// scaling in its status and metrics, zero when it does not
+func (r *AIInferenceAutoscalerPolicyReconciler) recordCooldownRemaining(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, remaining time.Duration) {
+	metrics.RecordCooldownRemaining(policy.Namespace, policy.Name, remaining.Seconds())
+	policy.Status.CooldownRemainingSeconds = int32(math.Ceil(remaining.Seconds()))
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestConvergenceRequeue(t *testing.T) {
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	key := "default/test-policy"
+
+	// No scale change yet: regular cadence
+	assert.Equal(t, DefaultRequeueInterval, r.nextRequeueInterval(key, DefaultRequeueInterval))
+
+	r.startConvergenceTracking(key)
+	for i := 0; i < DefaultConvergenceRequeueCount; i++ {
+		assert.Equal(t, DefaultConvergenceRequeueInterval, r.nextRequeueInterval(key, DefaultRequeueInterval))
+	}
+	assert.Equal(t, DefaultRequeueInterval, r.nextRequeueInterval(key, DefaultRequeueInterval))
+}
+
+func TestConvergenceRequeueDisabled(t *testing.T) {
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	r.ConvergenceRequeueCount = 0
+	key := "default/test-policy"
+
+	r.startConvergenceTracking(key)
+	assert.Equal(t, DefaultRequeueInterval, r.nextRequeueInterval(key, DefaultRequeueInterval))
+}
+
+func TestConvergenceRequeueShortPolling(t *testing.T) {
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	key := "default/test-policy"
+
+	// Convergence requeues never wait longer than the polling interval
+	r.startConvergenceTracking(key)
+	assert.Equal(t, 5*time.Second, r.nextRequeueInterval(key, 5*time.Second))
+}
+
+func TestReconcileForgetsDeletedPolicy(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("deleted")
+	policy.Spec.ScaleUp = &kubeaiv1alpha1.ScaleBehavior{Policies: []kubeaiv1alpha1.ScalingPolicy{{Type: ScalingPolicyPods, Value: 4, PeriodSeconds: 60}}}
+	policy.Spec.ScaleDown = &kubeaiv1alpha1.ScaleBehavior{Policies: []kubeaiv1alpha1.ScalingPolicy{{Type: ScalingPolicyPods, Value: 1, PeriodSeconds: 60}}}
+	policy.Spec.Prometheus = &kubeaiv1alpha1.PrometheusSpec{Addresses: []string{"http://prometheus-a:9090"}}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	r.NewMetricsClient = func([]string, string) (metrics.Client, error) {
+		return &metrics.MockClient{GPUUtilizationValue: 100}, nil
+	}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "deleted", Namespace: "default"}}
+	key := req.String()
+
+	// Another policy shares the client of one of the deleted policy's servers
+	shared := lockTestPolicy("kept")
+	shared.Spec.Prometheus = &kubeaiv1alpha1.PrometheusSpec{Addresses: []string{"http://prometheus-b:9090"}}
+	_, err := r.metricsClientFor(shared)
+	require.NoError(t, err)
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	policy.Spec.Prometheus.Addresses = shared.Spec.Prometheus.Addresses
+	_, err = r.metricsClientFor(policy)
+	require.NoError(t, err)
+
+	r.recordScaleEvent(policy, key, 4, 3, r.now())
+	require.Contains(t, r.LastScaleTime, key)
+	require.Len(t, r.metricsClients, 2)
+	require.Contains(t, r.fastRequeues, key)
+	require.Contains(t, r.recommendations, key)
+	require.Contains(t, r.scaleUpEvents, key)
+	require.Contains(t, r.scaleDownEvents, key)
+
+	require.NoError(t, c.Delete(ctx, policy))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.NotContains(t, r.LastScaleTime, key)
+	assert.Equal(t, []string{"|http://prometheus-b:9090"}, sets.List(sets.KeySet(r.metricsClients)))
+	assert.NotContains(t, r.fastRequeues, key)
+	assert.NotContains(t, r.recommendations, key)
+	assert.NotContains(t, r.scaleUpEvents, key)
+	assert.NotContains(t, r.scaleDownEvents, key)
+}
+
+func TestQueryWindow(t *testing.T) {
+	tests := []struct {
+		name            string
+		pollingInterval int32
+		expectedPolling time.Duration
+		expectedWindow  time.Duration
+	}{
+		{name: "default", expectedPolling: 30 * time.Second, expectedWindow: 2 * time.Minute},
+		{name: "fast polling keeps the minimum window", pollingInterval: 10, expectedPolling: 10 * time.Second, expectedWindow: time.Minute},
+		{name: "slow polling", pollingInterval: 300, expectedPolling: 5 * time.Minute, expectedWindow: 20 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{PollingInterval: tt.pollingInterval},
+			}
+			assert.Equal(t, tt.expectedPolling, pollingInterval(policy))
+			assert.Equal(t, tt.expectedWindow, queryWindow(policy))
+		})
+	}
+}
+
+func TestCooldownRequeueInterval(t *testing.T) {
+	for range 10 {
+		interval := cooldownRequeueInterval(20*time.Second, DefaultRequeueInterval)
+		assert.GreaterOrEqual(t, interval, 20*time.Second)
+		assert.Less(t, interval, 20*time.Second+CooldownRequeueJitter)
+	}
+
+	// Polling still happens on schedule during a long cooldown
+	assert.Equal(t, DefaultRequeueInterval, cooldownRequeueInterval(5*time.Minute, DefaultRequeueInterval))
+}
+
+func TestReconcileRequeuesAtCooldownExpiry(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("cooldown")
+	policy.Spec.CooldownPeriod = 300
+	policy.Spec.PollingInterval = 120
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	r.ConvergenceRequeueCount = 0
+	clock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+	r.Clock = clock
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "cooldown", Namespace: "default"}}
+	stored := func() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		updated := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, updated))
+		return updated
+	}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	// Well inside the cooldown, polling continues on schedule
+	clock.SetTime(clock.Now().Add(time.Minute))
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 120*time.Second, result.RequeueAfter)
+	assert.Equal(t, int32(240), stored().Status.CooldownRemainingSeconds)
+	assert.Equal(t, 240.0, testutil.ToFloat64(metrics.CooldownRemaining.WithLabelValues("default", "cooldown")))
+
+	// Near its end, the next reconcile lands just after the cooldown expires
+	clock.SetTime(clock.Now().Add(200 * time.Second))
+	result, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, result.RequeueAfter, 40*time.Second)
+	assert.Less(t, result.RequeueAfter, 40*time.Second+CooldownRequeueJitter)
+	assert.Equal(t, int32(40), stored().Status.CooldownRemainingSeconds)
+
+	clock.SetTime(clock.Now().Add(result.RequeueAfter))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Zero(t, stored().Status.CooldownRemainingSeconds)
+	assert.Zero(t, testutil.ToFloat64(metrics.CooldownRemaining.WithLabelValues("default", "cooldown")))
+}
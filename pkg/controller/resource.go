@@ -0,0 +1,118 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// resolveResourceUtilization sets the CPU and memory utilization of the
+// target's pods, read from metrics-server whatever the policy's metric sources
+func (r *AIInferenceAutoscalerPolicyReconciler) resolveResourceUtilization(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentMetrics *kubeaiv1alpha1.CurrentMetrics, missing *missingMetrics) {
+	cpu, memory := policy.Spec.Metrics.CPUUtilization, policy.Spec.Metrics.MemoryUtilization
+	cpuEnabled, memoryEnabled := cpu != nil && cpu.Enabled, memory != nil && memory.Enabled
+	if !cpuEnabled && !memoryEnabled {
+		return
+	}
+
+	pods, usage, err := r.targetPodUsage(ctx, policy)
+	utilization := func(name corev1.ResourceName) (float64, error) {
+		if err != nil {
+			return 0, err
+		}
+		return resourceUtilization(pods, usage, name)
+	}
+	if cpuEnabled {
+		value, err := utilization(corev1.ResourceCPU)
+		if value, ok := missing.resolve(scaling.MetricCPUUtilization, cpu.OnMissing, cpu.MaxStalenessSeconds, value, err); ok {
+			currentMetrics.CPUUtilizationPercent = int32(value)
+		}
+	}
+	if memoryEnabled {
+		value, err := utilization(corev1.ResourceMemory)
+		if value, ok := missing.resolve(scaling.MetricMemoryUtilization, memory.OnMissing, memory.MaxStalenessSeconds, value, err); ok {
+			currentMetrics.MemoryUtilizationPercent = int32(value)
+		}
+	}
+}
+
+// targetPodUsage returns the running target pods and their usage
+func (r *AIInferenceAutoscalerPolicyReconciler) targetPodUsage(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) ([]corev1.Pod, map[string]corev1.ResourceList, error) {
+	if r.ResourceMetrics == nil {
+		return nil, nil, errors.New("the resource metrics API is not configured")
+	}
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+	if labelSelector == nil {
+		return nil, nil, fmt.Errorf("target %s/%s has no selector", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(policy.Namespace), client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return nil, nil, err
+	}
+	running := make([]corev1.Pod, 0, len(pods.Items))
+	for i := range pods.Items {
+		if pods.Items[i].DeletionTimestamp == nil && pods.Items[i].Status.Phase == corev1.PodRunning {
+			running = append(running, pods.Items[i])
+		}
+	}
+
+	usage, err := r.ResourceMetrics.PodUsage(ctx, policy.Namespace, labelSelector.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	return running, usage, nil
+}
+
+// resourceUtilization returns the usage of the resource summed over the pods
+// metrics-server has a sample of, as a percentage of their summed requests,
+// the way the HorizontalPodAutoscaler computes it. A container without a
+// request for the resource fails the metric, as a percentage of it is undefined.
+func resourceUtilization(pods []corev1.Pod, usage map[string]corev1.ResourceList, name corev1.ResourceName) (float64, error) {
+	var used, requested int64
+	for i := range pods {
+		pod := &pods[i]
+		podUsage, ok := usage[pod.Name][name]
+		if !ok {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			request, ok := container.Resources.Requests[name]
+			if !ok {
+				return 0, fmt.Errorf("container %s of pod %s has no %s request", container.Name, pod.Name, name)
+			}
+			requested += request.MilliValue()
+		}
+		used += podUsage.MilliValue()
+	}
+	if requested == 0 {
+		return 0, fmt.Errorf("%w: no %s usage of the target's pods", metrics.ErrNoData, name)
+	}
+	return float64(used) * 100 / float64(requested), nil
+}
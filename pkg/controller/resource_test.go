@@ -0,0 +1,129 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// resourceTestPod returns a running pod whose containers request cpu
+func resourceTestPod(name string, cpu ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "embeddings"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, request := range cpu {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+			Name: "server",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(request), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+		})
+	}
+	return pod
+}
+
+func TestResourceUtilization(t *testing.T) {
+	pods := []corev1.Pod{*resourceTestPod("embeddings-0", "1", "500m"), *resourceTestPod("embeddings-1", "1"), *resourceTestPod("embeddings-2", "1")}
+	usage := map[string]corev1.ResourceList{
+		"embeddings-0": {corev1.ResourceCPU: resource.MustParse("1200m"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+		"embeddings-1": {corev1.ResourceCPU: resource.MustParse("800m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}
+
+	// embeddings-2 has no sample yet and is left out
+	value, err := resourceUtilization(pods, usage, corev1.ResourceCPU)
+	require.NoError(t, err)
+	assert.InDelta(t, 80, value, 1e-9)
+	value, err = resourceUtilization(pods, usage, corev1.ResourceMemory)
+	require.NoError(t, err)
+	assert.InDelta(t, 50, value, 1e-9)
+
+	_, err = resourceUtilization(pods, nil, corev1.ResourceCPU)
+	assert.ErrorIs(t, err, metrics.ErrNoData)
+
+	pods[1].Spec.Containers[0].Resources.Requests = nil
+	_, err = resourceUtilization(pods, usage, corev1.ResourceCPU)
+	assert.ErrorContains(t, err, "container server of pod embeddings-1 has no cpu request")
+}
+
+func TestFetchResourceUtilization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apis/metrics.k8s.io/v1beta1/namespaces/default/pods", r.URL.Path)
+		assert.Equal(t, "app=embeddings", r.URL.Query().Get("labelSelector"))
+		_, _ = w.Write([]byte(`{"items": [
+			{"metadata": {"name": "embeddings-0"}, "containers": [{"name": "server", "usage": {"cpu": "1500m", "memory": "256Mi"}}]},
+			{"metadata": {"name": "embeddings-1"}, "containers": [{"name": "server", "usage": {"cpu": "900m", "memory": "768Mi"}}]}]}`))
+	}))
+	defer server.Close()
+
+	scheme := newTestScheme(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "embeddings", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(2),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "embeddings"}},
+		},
+	}
+	terminating := resourceTestPod("embeddings-old", "1")
+	terminating.Status.Phase = corev1.PodSucceeded
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(deployment, resourceTestPod("embeddings-0", "2"), resourceTestPod("embeddings-1", "2"), terminating).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "embeddings", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "embeddings"},
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				CPUUtilization:    &kubeaiv1alpha1.ResourceUtilizationMetric{Enabled: true, TargetAverageUtilization: 50},
+				MemoryUtilization: &kubeaiv1alpha1.ResourceUtilizationMetric{Enabled: true, TargetAverageUtilization: 80},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	// Without metrics-server both metrics are missing
+	_, err := r.fetchMetrics(ctx, policy)
+	assert.ErrorContains(t, err, "the resource metrics API is not configured")
+
+	r.ResourceMetrics, err = metrics.NewResourceMetricsClient(server.URL, server.Client())
+	require.NoError(t, err)
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(60), current.CPUUtilizationPercent)
+	assert.Equal(t, int32(50), current.MemoryUtilizationPercent)
+
+	// CPU at 60% of requests against a 50% target drives a scale-up
+	compared := compareMetrics(policy, 2, current)
+	require.Len(t, compared, 2)
+	assert.Equal(t, metricComparison{name: scaling.MetricCPUUtilization, current: 60, target: 50}, compared[0])
+	assert.Equal(t, metricComparison{name: scaling.MetricMemoryUtilization, current: 50, target: 80}, compared[1])
+}
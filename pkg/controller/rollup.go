@@ -0,0 +1,69 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+// PolicySummaries returns a metrics.PolicyLister reading policies through c,
+// normally the manager's cached client
+func PolicySummaries(c client.Reader) metrics.PolicyLister {
+	return func(ctx context.Context) ([]metrics.PolicySummary, error) {
+		policies := &kubeaiv1alpha1.AIInferenceAutoscalerPolicyList{}
+		if err := c.List(ctx, policies); err != nil {
+			return nil, err
+		}
+		summaries := make([]metrics.PolicySummary, 0, len(policies.Items))
+		for i := range policies.Items {
+			summaries = append(summaries, summarizePolicy(&policies.Items[i]))
+		}
+		return summaries, nil
+	}
+}
+
+// summarizePolicy extracts the rollup fields of a policy. The algorithm is the
+// one last used, falling back to the configured one before the first decision.
+func summarizePolicy(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) metrics.PolicySummary {
+	algorithm := policy.Status.LastAlgorithm
+	if algorithm == "" && policy.Spec.Algorithm != nil {
+		algorithm = policy.Spec.Algorithm.Name
+	}
+	if algorithm == "" {
+		algorithm = DefaultAlgorithmName
+	}
+
+	summary := metrics.PolicySummary{
+		Namespace:       policy.Namespace,
+		Algorithm:       algorithm,
+		CurrentReplicas: policy.Status.CurrentReplicas,
+		DesiredReplicas: policy.Status.DesiredReplicas,
+	}
+	for _, c := range policy.Status.Conditions {
+		if c.Type == ConditionTypeReady {
+			summary.Error = c.Status == metav1.ConditionFalse
+			break
+		}
+	}
+	return summary
+}
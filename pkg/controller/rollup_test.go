@@ -0,0 +1,68 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+func TestPolicySummaries(t *testing.T) {
+	scheme := newTestScheme(t)
+	scaled := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "scaled", Namespace: "llm"},
+		Status: kubeaiv1alpha1.AIInferenceAutoscalerPolicyStatus{
+			CurrentReplicas: 4,
+			DesiredReplicas: 6,
+			LastAlgorithm:   "AverageRatio",
+			Conditions: []metav1.Condition{
+				{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: "Ready"},
+			},
+		},
+	}
+	broken := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "broken", Namespace: "llm"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Algorithm: &kubeaiv1alpha1.AlgorithmSpec{Name: "WeightedRatio"},
+		},
+		Status: kubeaiv1alpha1.AIInferenceAutoscalerPolicyStatus{
+			Conditions: []metav1.Condition{
+				{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: "TargetNotFound"},
+			},
+		},
+	}
+	fresh := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "fresh", Namespace: "vision"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(scaled, broken, fresh).Build()
+
+	summaries, err := PolicySummaries(c)(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []metrics.PolicySummary{
+		{Namespace: "llm", Algorithm: "AverageRatio", CurrentReplicas: 4, DesiredReplicas: 6},
+		{Namespace: "llm", Algorithm: "WeightedRatio", Error: true},
+		{Namespace: "vision", Algorithm: DefaultAlgorithmName},
+	}, summaries)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+)
+
+const (
+	// ConditionTypeSaturatedAtMax indicates the policy has been pinned at maxReplicas while over target
+	ConditionTypeSaturatedAtMax = "SaturatedAtMax"
+	// DefaultSaturationThreshold is how long a policy must stay saturated before it is reported
+	DefaultSaturationThreshold = 10 * time.Minute
+)
+
+// isSaturated reports whether the policy wants maxReplicas while metrics remain above target
+func isSaturated(desiredReplicas, maxReplicas int32, ratios []float64) bool {
+	if desiredReplicas < maxReplicas {
+		return false
+	}
+	for _, ratio := range ratios {
+		if ratio > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// trackSaturation maintains status.saturatedSince and raises the SaturatedAtMax
+// condition once the policy has been saturated for longer than the threshold
+func (r *AIInferenceAutoscalerPolicyReconciler) trackSaturation(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	desiredReplicas int32,
+	ratios []float64,
+	now time.Time,
+) {
+	if !isSaturated(desiredReplicas, policy.Spec.MaxReplicas, ratios) {
+		policy.Status.SaturatedSince = nil
+		if r.hasCondition(policy, ConditionTypeSaturatedAtMax, metav1.ConditionTrue, ReasonSaturatedAtMax) {
+			r.updateCondition(ctx, policy, ConditionTypeSaturatedAtMax, metav1.ConditionFalse,
+				"NotSaturated", "Desired replicas are below maxReplicas or metrics are within target")
+		}
+		return
+	}
+
+	if policy.Status.SaturatedSince == nil {
+		since := metav1.NewTime(now)
+		policy.Status.SaturatedSince = &since
+	}
+
+	threshold := r.SaturationThreshold
+	if threshold <= 0 {
+		threshold = DefaultSaturationThreshold
+	}
+	saturatedFor := now.Sub(policy.Status.SaturatedSince.Time)
+	if saturatedFor < threshold {
+		return
+	}
+
+	// Only report on transition to avoid event spam
+	if r.hasCondition(policy, ConditionTypeSaturatedAtMax, metav1.ConditionTrue, ReasonSaturatedAtMax) {
+		return
+	}
+	metrics.RecordSaturatedAtMax(policy.Namespace, policy.Name)
+	if r.EventRecorder != nil {
+		r.EventRecorder.RecordSaturatedAtMax(policy, saturatedFor)
+	}
+	r.updateCondition(ctx, policy, ConditionTypeSaturatedAtMax, metav1.ConditionTrue, ReasonSaturatedAtMax,
+		fmt.Sprintf("Pinned at maxReplicas=%d with metrics above target for %s; maxReplicas or the GPU pool may be undersized",
+			policy.Spec.MaxReplicas, saturatedFor.Round(time.Second)))
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestIsSaturated(t *testing.T) {
+	assert.True(t, isSaturated(10, 10, []float64{1.5}))
+	assert.False(t, isSaturated(9, 10, []float64{1.5}))
+	assert.False(t, isSaturated(10, 10, []float64{0.8, 1.0}))
+	assert.False(t, isSaturated(10, 10, nil))
+}
+
+func TestTrackSaturation(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	r.SaturationThreshold = 5 * time.Minute
+
+	ctx := context.Background()
+	start := time.Now()
+
+	r.trackSaturation(ctx, policy, 10, []float64{2.0}, start)
+	require.NotNil(t, policy.Status.SaturatedSince)
+	assert.False(t, r.hasCondition(policy, ConditionTypeSaturatedAtMax, metav1.ConditionTrue, ReasonSaturatedAtMax))
+
+	r.trackSaturation(ctx, policy, 10, []float64{2.0}, start.Add(6*time.Minute))
+	assert.True(t, r.hasCondition(policy, ConditionTypeSaturatedAtMax, metav1.ConditionTrue, ReasonSaturatedAtMax))
+	select {
+	case event := <-fakeRecorder.Events:
+		assert.Contains(t, event, ReasonSaturatedAtMax)
+	default:
+		t.Fatal("Expected an event to be recorded")
+	}
+
+	// Still saturated: no duplicate event
+	r.trackSaturation(ctx, policy, 10, []float64{2.0}, start.Add(7*time.Minute))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// Load subsides
+	r.trackSaturation(ctx, policy, 8, []float64{0.8}, start.Add(8*time.Minute))
+	assert.Nil(t, policy.Status.SaturatedSince)
+	assert.True(t, r.hasCondition(policy, ConditionTypeSaturatedAtMax, metav1.ConditionFalse, "NotSaturated"))
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// scaleSubresource is the subresource used to read and write target replicas
+const scaleSubresource = "scale"
+
+// targetObject returns an object identifying the policy's target. Kinds known
+// to the scheme are typed; anything else, such as a CRD exposing /scale, is
+// addressed as unstructured.
+func (r *AIInferenceAutoscalerPolicyReconciler) targetObject(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (client.Object, error) {
+	apiVersion := policy.Spec.TargetRef.APIVersion
+	if apiVersion == "" {
+		apiVersion = "apps/v1"
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid targetRef.apiVersion: %w", err)
+	}
+	gvk := gv.WithKind(policy.Spec.TargetRef.Kind)
+
+	var obj client.Object
+	if r.Scheme != nil {
+		if typed, err := r.Scheme.New(gvk); err == nil {
+			obj, _ = typed.(client.Object)
+		}
+	}
+	if obj == nil {
+		obj = &unstructured.Unstructured{}
+	}
+	// Kinds registered as unstructured, as the fake client does for the
+	// objects it serves, come back from the scheme without their kind
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetNamespace(policy.Namespace)
+	obj.SetName(policy.Spec.TargetRef.Name)
+	return obj, nil
+}
+
+// targetReference returns a reference to the policy's target for recording
+// events on it. The UID comes from the target's scale subresource.
+func targetReference(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, uid types.UID) *corev1.ObjectReference {
+	apiVersion := policy.Spec.TargetRef.APIVersion
+	if apiVersion == "" {
+		apiVersion = "apps/v1"
+	}
+	return &corev1.ObjectReference{
+		APIVersion: apiVersion,
+		Kind:       policy.Spec.TargetRef.Kind,
+		Namespace:  policy.Namespace,
+		Name:       policy.Spec.TargetRef.Name,
+		UID:        uid,
+	}
+}
+
+// getScale reads the scale subresource of the policy's target
+func (r *AIInferenceAutoscalerPolicyReconciler) getScale(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (*autoscalingv1.Scale, error) {
+	obj, err := r.targetObject(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := obj.(*unstructured.Unstructured); !ok {
+		scale := &autoscalingv1.Scale{}
+		if err := r.SubResource(scaleSubresource).Get(ctx, obj, scale); err != nil {
+			return nil, err
+		}
+		return scale, nil
+	}
+
+	// The unstructured client only decodes into unstructured objects
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(autoscalingv1.SchemeGroupVersion.WithKind("Scale"))
+	if err := r.SubResource(scaleSubresource).Get(ctx, obj, u); err != nil {
+		return nil, err
+	}
+	scale := &autoscalingv1.Scale{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, scale); err != nil {
+		return nil, fmt.Errorf("failed to decode scale of %s/%s: %w", policy.Spec.TargetRef.Kind, policy.Spec.TargetRef.Name, err)
+	}
+	return scale, nil
+}
+
+// updateScale writes the replica count through the scale subresource of the policy's target
+func (r *AIInferenceAutoscalerPolicyReconciler) updateScale(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, scale *autoscalingv1.Scale, opts ...client.SubResourceUpdateOption) error {
+	obj, err := r.targetObject(policy)
+	if err != nil {
+		return err
+	}
+
+	var body client.Object = scale
+	if _, ok := obj.(*unstructured.Unstructured); ok {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(scale)
+		if err != nil {
+			return err
+		}
+		u := &unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(autoscalingv1.SchemeGroupVersion.WithKind("Scale"))
+		body = u
+	}
+	return r.SubResource(scaleSubresource).Update(ctx, obj, append(opts, client.WithSubResourceBody(body))...)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestTargetObject(t *testing.T) {
+	r := NewReconciler(nil, newTestScheme(t), nil, scaling.DefaultRegistry, nil)
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "llm"},
+		},
+	}
+	obj, err := r.targetObject(policy)
+	require.NoError(t, err)
+	assert.IsType(t, &appsv1.StatefulSet{}, obj)
+	assert.Equal(t, "llm", obj.GetName())
+
+	policy.Spec.TargetRef = kubeaiv1alpha1.TargetRef{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "llm"}
+	obj, err = r.targetObject(policy)
+	require.NoError(t, err)
+	u, ok := obj.(*unstructured.Unstructured)
+	require.True(t, ok)
+	assert.Equal(t, "argoproj.io/v1alpha1", u.GetAPIVersion())
+	assert.Equal(t, "Rollout", u.GetKind())
+	assert.Equal(t, "default", u.GetNamespace())
+}
+
+func TestScaleCustomResource(t *testing.T) {
+	// Emulate an Argo Rollout exposing /scale; the fake client only implements it for built-in kinds
+	replicas := int64(2)
+	var updatedKind string
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithInterceptorFuncs(interceptor.Funcs{
+		SubResourceGet: func(_ context.Context, _ client.Client, subResource string, obj, body client.Object, _ ...client.SubResourceGetOption) error {
+			require.Equal(t, "scale", subResource)
+			require.Equal(t, "Rollout", obj.GetObjectKind().GroupVersionKind().Kind)
+			u := body.(*unstructured.Unstructured)
+			u.Object["spec"] = map[string]interface{}{"replicas": replicas}
+			u.Object["status"] = map[string]interface{}{"replicas": replicas, "selector": "app=llm"}
+			return nil
+		},
+		SubResourceUpdate: func(_ context.Context, _ client.Client, _ string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			updateOpts := client.SubResourceUpdateOptions{}
+			updateOpts.ApplyOptions(opts)
+			u := updateOpts.SubResourceBody.(*unstructured.Unstructured)
+			updatedKind = obj.GetObjectKind().GroupVersionKind().Kind
+			replicas, _, _ = unstructured.NestedInt64(u.Object, "spec", "replicas")
+			return nil
+		},
+	}).Build()
+	r := NewReconciler(c, newTestScheme(t), nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef: kubeaiv1alpha1.TargetRef{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "llm"},
+		},
+	}
+	ctx := context.Background()
+
+	current, err := r.getCurrentReplicas(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), current)
+
+	_, err = r.scaleTarget(ctx, policy, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "Rollout", updatedKind)
+	assert.Equal(t, int64(5), replicas)
+
+	// The pod selector comes from the scale status
+	selector, err := r.getTargetSelector(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, "app=llm", selector.String())
+}
+
+// objectRecorder captures the objects events are recorded on
+type objectRecorder struct {
+	record.FakeRecorder
+	objects []runtime.Object
+}
+
+func (o *objectRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	o.objects = append(o.objects, object)
+	o.FakeRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+}
+
+func TestScaleEventsOnTarget(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default", UID: "llm-uid"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	recorder := &objectRecorder{FakeRecorder: *record.NewFakeRecorder(10)}
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, NewEventRecorder(recorder))
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "policy"}})
+	require.NoError(t, err)
+
+	// The policy records the scale-up and the Deployment records the rescale
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	require.Len(t, events, 2)
+	assert.Contains(t, events[0], "ScaledUp Scaled Deployment/llm from 2 to 4 replicas")
+	assert.Contains(t, events[1], "SuccessfulRescale New size: 4; reason:")
+	assert.Contains(t, events[1], "policy: policy")
+
+	target, ok := recorder.objects[1].(*corev1.ObjectReference)
+	require.True(t, ok)
+	assert.Equal(t, corev1.ObjectReference{
+		APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "llm", UID: "llm-uid",
+	}, *target)
+}
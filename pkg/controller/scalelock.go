@@ -0,0 +1,144 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// DefaultScaleLockDuration is how long a scale lock blocks other writers after a replica change
+	DefaultScaleLockDuration = 15 * time.Second
+
+	// scaleLockPrefix prefixes the name of the Lease guarding a target
+	scaleLockPrefix = "kubeai-scale-"
+)
+
+// defaultLockIdentity identifies this controller instance in scale locks
+func defaultLockIdentity() string {
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+	return "kubeai-autoscaler"
+}
+
+// scaleLockName returns the name of the Lease guarding the policy's target
+func scaleLockName(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) string {
+	return scaleLockPrefix + strings.ToLower(policy.Spec.TargetRef.Kind) + "-" + policy.Spec.TargetRef.Name
+}
+
+// scaleLockHolder identifies this controller instance acting for the given policy,
+// so two policies in one controller also exclude each other
+func (r *AIInferenceAutoscalerPolicyReconciler) scaleLockHolder(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) string {
+	return fmt.Sprintf("%s/%s/%s", r.LockIdentity, policy.Namespace, policy.Name)
+}
+
+// acquireScaleLock takes or renews the Lease guarding the policy's target before
+// its replicas are written. The lease is kept until it expires, so another
+// controller or policy cannot write the same target until ScaleLockDuration
+// after our last change. It returns false and the current holder when someone
+// else owns the lock.
+func (r *AIInferenceAutoscalerPolicyReconciler) acquireScaleLock(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, now time.Time) (bool, string, error) {
+	if r.ScaleLockDuration <= 0 {
+		return true, "", nil
+	}
+
+	holder := r.scaleLockHolder(policy)
+	duration := ptr.To(int32(r.ScaleLockDuration.Seconds()))
+	if *duration < 1 {
+		*duration = 1
+	}
+	stamp := metav1.NewMicroTime(now)
+
+	lease := &coordinationv1.Lease{}
+	key := types.NamespacedName{Namespace: policy.Namespace, Name: scaleLockName(policy)}
+	err := r.Get(ctx, key, lease)
+	if apierrors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(holder),
+				LeaseDurationSeconds: duration,
+				AcquireTime:          &stamp,
+				RenewTime:            &stamp,
+			},
+		}
+		if err := r.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				// Another writer created the lease first
+				return false, r.currentScaleLockHolder(ctx, key), nil
+			}
+			return false, "", fmt.Errorf("failed to create scale lock: %w", err)
+		}
+		return true, holder, nil
+	}
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get scale lock: %w", err)
+	}
+
+	current := ptr.Deref(lease.Spec.HolderIdentity, "")
+	if current != holder && current != "" && !scaleLockExpired(lease, now) {
+		return false, current, nil
+	}
+
+	if current != holder {
+		lease.Spec.HolderIdentity = ptr.To(holder)
+		lease.Spec.AcquireTime = &stamp
+		lease.Spec.LeaseTransitions = ptr.To(ptr.Deref(lease.Spec.LeaseTransitions, 0) + 1)
+	}
+	lease.Spec.LeaseDurationSeconds = duration
+	lease.Spec.RenewTime = &stamp
+	if err := r.Update(ctx, lease); err != nil {
+		if apierrors.IsConflict(err) {
+			// Another writer updated the lease first
+			return false, r.currentScaleLockHolder(ctx, key), nil
+		}
+		return false, "", fmt.Errorf("failed to update scale lock: %w", err)
+	}
+	return true, holder, nil
+}
+
+// currentScaleLockHolder reads the holder of the lease after another writer won
+// the race for it, falling back to "unknown" when the lease cannot be read
+func (r *AIInferenceAutoscalerPolicyReconciler) currentScaleLockHolder(ctx context.Context, key types.NamespacedName) string {
+	lease := &coordinationv1.Lease{}
+	if err := r.Get(ctx, key, lease); err != nil {
+		return "unknown"
+	}
+	return ptr.Deref(lease.Spec.HolderIdentity, "unknown")
+}
+
+// scaleLockExpired reports whether the lease was last renewed more than its duration ago
+func scaleLockExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return !now.Before(expiry)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func lockTestPolicy(name string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	return &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef:   kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 50},
+			},
+		},
+	}
+}
+
+func TestAcquireScaleLock(t *testing.T) {
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	controllerA := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	controllerA.LockIdentity = "controller-a"
+	controllerB := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	controllerB.LockIdentity = "controller-b"
+	policy := lockTestPolicy("policy")
+
+	// The first writer creates the lease
+	acquired, holder, err := controllerA.acquireScaleLock(ctx, policy, now)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	assert.Equal(t, "controller-a/default/policy", holder)
+
+	// A second controller is blocked while the lease is live
+	acquired, holder, err = controllerB.acquireScaleLock(ctx, policy, now.Add(time.Second))
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, "controller-a/default/policy", holder)
+
+	// So is a second policy in the same controller
+	acquired, _, err = controllerA.acquireScaleLock(ctx, lockTestPolicy("other-policy"), now.Add(time.Second))
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	// The holder renews freely
+	acquired, _, err = controllerA.acquireScaleLock(ctx, policy, now.Add(5*time.Second))
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// After expiry the lease is taken over
+	acquired, holder, err = controllerB.acquireScaleLock(ctx, policy, now.Add(5*time.Second+DefaultScaleLockDuration))
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	assert.Equal(t, "controller-b/default/policy", holder)
+
+	lease := &coordinationv1.Lease{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "default", Name: "kubeai-scale-deployment-llm"}, lease))
+	assert.Equal(t, int32(1), *lease.Spec.LeaseTransitions)
+	assert.Equal(t, int32(15), *lease.Spec.LeaseDurationSeconds)
+}
+
+func TestAcquireScaleLockLostRace(t *testing.T) {
+	scheme := newTestScheme(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	policy := lockTestPolicy("policy")
+	key := types.NamespacedName{Namespace: "default", Name: "kubeai-scale-deployment-llm"}
+
+	// Another controller creates the lease between our read and our create
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			winner := &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+				Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To("controller-b/default/policy")},
+			}
+			require.NoError(t, c.Create(ctx, winner))
+			return c.Create(ctx, obj, opts...)
+		},
+	}).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	r.LockIdentity = "controller-a"
+
+	acquired, holder, err := r.acquireScaleLock(ctx, policy, now)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, "controller-b/default/policy", holder)
+
+	// Another controller takes over the expired lease between our read and our update
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To("controller-c/default/policy")},
+	}).WithInterceptorFuncs(interceptor.Funcs{
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			winner := &coordinationv1.Lease{}
+			require.NoError(t, c.Get(ctx, key, winner))
+			winner.Spec.HolderIdentity = ptr.To("controller-b/default/policy")
+			require.NoError(t, c.Update(ctx, winner))
+			return c.Update(ctx, obj, opts...)
+		},
+	}).Build()
+	r.Client = c
+
+	acquired, holder, err = r.acquireScaleLock(ctx, policy, now)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Equal(t, "controller-b/default/policy", holder)
+}
+
+func TestReconcileSkipsScaleWhenLocked(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	renewed := metav1.NewMicroTime(time.Now())
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: "kubeai-scale-deployment-llm", Namespace: "default"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To("controller-old/default/policy"),
+			LeaseDurationSeconds: int32Ptr(60),
+			RenewTime:            &renewed,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, lease).
+		WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	r.LockIdentity = "controller-new"
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}})
+	require.NoError(t, err)
+
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(2), *updated.Spec.Replicas)
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "policy", Namespace: "default"}, stored))
+	assert.True(t, r.hasCondition(stored, ConditionTypeScaling, metav1.ConditionFalse, ReasonScaleLockHeld))
+	assert.Contains(t, <-fakeRecorder.Events, ReasonScaleLockHeld)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// DefaultIdlePeriod is how long a target with minReplicas 0 must stay idle before it is scaled to zero
+const DefaultIdlePeriod = 5 * time.Minute
+
+// scalesToZero reports whether the policy lets its target scale to zero
+func scalesToZero(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) bool {
+	return policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas == 0
+}
+
+// idlePeriod returns how long the target must be idle before it is scaled to zero
+func idlePeriod(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) time.Duration {
+	if policy.Spec.ScaleToZero != nil && policy.Spec.ScaleToZero.IdlePeriodSeconds > 0 {
+		return time.Duration(policy.Spec.ScaleToZero.IdlePeriodSeconds) * time.Second
+	}
+	return DefaultIdlePeriod
+}
+
+// metricsIdle reports whether every built-in and custom metric reports zero
+func metricsIdle(m *kubeaiv1alpha1.CurrentMetrics) bool {
+	if m == nil {
+		return false
+	}
+	if m.LatencyP99Ms != 0 || m.LatencyP95Ms != 0 || m.GPUUtilizationPercent != 0 ||
+		m.RequestQueueDepth != 0 || m.TokensPerSecond != 0 || m.InFlightRequests != 0 ||
+		(m.ArrivalRate != nil && *m.ArrivalRate != 0) {
+		return false
+	}
+	for _, custom := range m.Custom {
+		if custom.Value != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// applyScaleToZero scales an idle target with minReplicas 0 to zero once it has
+// been idle for the idle period, and keeps it there until load is reported again.
+// The target counts as idle while every enabled metric reports zero. When the
+// desired replicas are overridden the returned reason explains why.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyScaleToZero(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, desiredReplicas int32,
+	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
+	now time.Time,
+) (int32, string) {
+	if !scalesToZero(policy) || !metricsIdle(currentMetrics) {
+		policy.Status.IdleSince = nil
+		return desiredReplicas, ""
+	}
+
+	if currentReplicas == 0 {
+		// Stay at zero; the activator wakes the target on the next request
+		policy.Status.IdleSince = nil
+		return 0, "idle at zero replicas"
+	}
+
+	if policy.Status.IdleSince == nil {
+		since := metav1.NewTime(now)
+		policy.Status.IdleSince = &since
+	}
+	period := idlePeriod(policy)
+	if now.Sub(policy.Status.IdleSince.Time) < period {
+		return desiredReplicas, ""
+	}
+	return 0, fmt.Sprintf("idle for %s", period)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestApplyScaleToZero(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	idle := &kubeaiv1alpha1.CurrentMetrics{}
+	busy := &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 40}
+
+	tests := []struct {
+		name         string
+		minReplicas  *int32
+		idleSince    *time.Time
+		current      int32
+		metrics      *kubeaiv1alpha1.CurrentMetrics
+		wantReplicas int32
+		wantReason   string
+		wantIdle     bool
+	}{
+		{
+			name:         "minReplicas above zero",
+			minReplicas:  int32Ptr(1),
+			current:      2,
+			metrics:      idle,
+			wantReplicas: 2,
+		},
+		{
+			name:         "idle period starts",
+			minReplicas:  int32Ptr(0),
+			current:      2,
+			metrics:      idle,
+			wantReplicas: 2,
+			wantIdle:     true,
+		},
+		{
+			name:         "idle period not elapsed",
+			minReplicas:  int32Ptr(0),
+			idleSince:    ptr.To(now.Add(-time.Minute)),
+			current:      2,
+			metrics:      idle,
+			wantReplicas: 2,
+			wantIdle:     true,
+		},
+		{
+			name:         "idle period elapsed",
+			minReplicas:  int32Ptr(0),
+			idleSince:    ptr.To(now.Add(-DefaultIdlePeriod)),
+			current:      2,
+			metrics:      idle,
+			wantReplicas: 0,
+			wantReason:   "idle for 5m0s",
+			wantIdle:     true,
+		},
+		{
+			name:         "load resets the idle period",
+			minReplicas:  int32Ptr(0),
+			idleSince:    ptr.To(now.Add(-time.Hour)),
+			current:      2,
+			metrics:      busy,
+			wantReplicas: 2,
+		},
+		{
+			name:         "stays at zero while idle",
+			minReplicas:  int32Ptr(0),
+			current:      0,
+			metrics:      idle,
+			wantReplicas: 0,
+			wantReason:   "idle at zero replicas",
+		},
+		{
+			name:         "load wakes the target",
+			minReplicas:  int32Ptr(0),
+			current:      0,
+			metrics:      busy,
+			wantReplicas: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+				Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{MinReplicas: tt.minReplicas, MaxReplicas: 10},
+			}
+			if tt.idleSince != nil {
+				policy.Status.IdleSince = &metav1.Time{Time: *tt.idleSince}
+			}
+			r := &AIInferenceAutoscalerPolicyReconciler{}
+
+			// The algorithm never asks for fewer than one replica
+			desired := max(tt.current, 1)
+			replicas, reason := r.applyScaleToZero(policy, tt.current, desired, tt.metrics, now)
+			assert.Equal(t, tt.wantReplicas, replicas)
+			assert.Equal(t, tt.wantReason, reason)
+			assert.Equal(t, tt.wantIdle, policy.Status.IdleSince != nil)
+		})
+	}
+}
+
+func TestScaleToZeroReconcile(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.MinReplicas = int32Ptr(0)
+	policy.Spec.CooldownPeriod = 1
+	policy.Spec.ScaleToZero = &kubeaiv1alpha1.ScaleToZeroSpec{IdlePeriodSeconds: 60}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(1)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "policy", Namespace: "default"}}
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	source := &metrics.MockClient{}
+	r := NewReconciler(c, scheme, source, scaling.DefaultRegistry, nil)
+	fakeClock := clocktesting.NewFakePassiveClock(now)
+	r.Clock = fakeClock
+	reconcileAt := func(at time.Time) int32 {
+		fakeClock.SetTime(at)
+		_, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+
+	assert.Equal(t, int32(1), reconcileAt(now))
+	assert.Equal(t, int32(1), reconcileAt(now.Add(30*time.Second)))
+	assert.Equal(t, int32(0), reconcileAt(now.Add(time.Minute)))
+	assert.Equal(t, int32(0), reconcileAt(now.Add(2*time.Minute)))
+
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+	assert.Equal(t, "idle at zero replicas", stored.Status.LastScaleReason)
+
+	// Load reported while at zero wakes the target
+	source.GPUUtilizationValue = 80
+	assert.Equal(t, int32(1), reconcileAt(now.Add(3*time.Minute)))
+}
+
+func TestActivatorBackend(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("policy")
+	policy.Spec.MinReplicas = int32Ptr(0)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(0)},
+	}
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llm-abc",
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "llm"},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, slice).Build()
+	ctx := context.Background()
+	backend := NewActivatorBackend(c, scheme, types.NamespacedName{Name: "policy", Namespace: "default"}, "llm", nil)
+
+	ready, err := backend.Ready(ctx)
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	require.NoError(t, backend.Activate(ctx))
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(1), *updated.Spec.Replicas)
+
+	// Activating a running target leaves its replicas alone
+	updated.Spec.Replicas = int32Ptr(3)
+	require.NoError(t, c.Update(ctx, updated))
+	require.NoError(t, backend.Activate(ctx))
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(3), *updated.Spec.Replicas)
+
+	slice.Endpoints[0].Conditions.Ready = ptr.To(true)
+	require.NoError(t, c.Update(ctx, slice))
+	ready, err = backend.Ready(ctx)
+	require.NoError(t, err)
+	assert.True(t, ready)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+var updateGoldens = flag.Bool("update", false, "rewrite the scenario golden files")
+
+// scenario drives the reconciler through a series of metric samples over simulated time
+type scenario struct {
+	Description string                                         `json:"description"`
+	Policy      kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec `json:"policy"`
+	// Replicas is the initial replica count of the target
+	Replicas int32 `json:"replicas"`
+	// Start is the simulated time of the first step (defaults to 2026-01-05T12:00:00Z, a Monday)
+	Start *metav1.Time `json:"start,omitempty"`
+	// Interval is the simulated time between steps (defaults to 30s)
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	Steps    []scenarioStep   `json:"steps"`
+}
+
+// scenarioStep is one metric sample, optionally repeated over consecutive intervals
+type scenarioStep struct {
+	Metrics kubeaiv1alpha1.CurrentMetrics `json:"metrics"`
+	Repeat  int                           `json:"repeat,omitempty"`
+}
+
+// scenarioDecision is the outcome of one reconcile as recorded in the golden file
+type scenarioDecision struct {
+	At          string   `json:"at"`
+	Replicas    string   `json:"replicas"`
+	Recommended int32    `json:"recommended"`
+	Reason      string   `json:"reason,omitempty"`
+	Conditions  []string `json:"conditions,omitempty"`
+}
+
+func TestScenarios(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "scenarios", "*.yaml"))
+	require.NoError(t, err)
+	for _, file := range files {
+		if strings.HasSuffix(file, ".golden.yaml") {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(file), ".yaml")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			var sc scenario
+			require.NoError(t, yaml.UnmarshalStrict(data, &sc))
+
+			got, err := yaml.Marshal(runScenario(t, &sc))
+			require.NoError(t, err)
+
+			golden := strings.TrimSuffix(file, ".yaml") + ".golden.yaml"
+			if *updateGoldens {
+				require.NoError(t, os.WriteFile(golden, got, 0o600))
+				return
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err, "run go test ./pkg/controller -run TestScenarios -update to create the golden file")
+			assert.Equal(t, string(want), string(got), "decisions differ from %s", golden)
+		})
+	}
+}
+
+// runScenario simulates the scenario's policy over its steps and returns the
+// decision made at each step
+func runScenario(t *testing.T, sc *scenario) []scenarioDecision {
+	t.Helper()
+
+	start := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	if sc.Start != nil {
+		start = sc.Start.Time
+	}
+	interval := 30 * time.Second
+	if sc.Interval != nil {
+		interval = sc.Interval.Duration
+	}
+
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "scenario", Namespace: "default"},
+		Spec:       sc.Policy,
+	}
+	var steps []SimulationStep
+	now := start
+	for _, step := range sc.Steps {
+		for i := 0; i <= step.Repeat; i++ {
+			steps = append(steps, SimulationStep{Time: now, Metrics: step.Metrics})
+			now = now.Add(interval)
+		}
+	}
+
+	simulated, err := Simulate(context.Background(), scaling.DefaultRegistry, policy, sc.Replicas, steps)
+	require.NoError(t, err)
+	decisions := make([]scenarioDecision, 0, len(simulated))
+	for _, d := range simulated {
+		decisions = append(decisions, scenarioDecision{
+			At:          d.Time.Sub(start).String(),
+			Replicas:    fmt.Sprintf("%d -> %d", d.From, d.To),
+			Recommended: d.Recommended,
+			Reason:      d.Reason,
+			Conditions:  summarizeConditions(d.Conditions),
+		})
+	}
+	return decisions
+}
+
+// summarizeConditions renders conditions as Type=Status/Reason, sorted by type
+func summarizeConditions(conditions []metav1.Condition) []string {
+	out := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, fmt.Sprintf("%s=%s/%s", c.Type, c.Status, c.Reason))
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeSchedulingBlocked indicates replicas of the target cannot be scheduled
+	ConditionTypeSchedulingBlocked = "SchedulingBlocked"
+)
+
+// getTargetSelector returns the pod selector of the target. Deployments and
+// StatefulSets are read directly; other kinds report their selector through
+// the scale subresource. A nil selector means the target does not publish one.
+func (r *AIInferenceAutoscalerPolicyReconciler) getTargetSelector(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (labels.Selector, error) {
+	key := types.NamespacedName{
+		Namespace: policy.Namespace,
+		Name:      policy.Spec.TargetRef.Name,
+	}
+
+	var selector *metav1.LabelSelector
+	switch policy.Spec.TargetRef.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, key, deployment); err != nil {
+			return nil, err
+		}
+		selector = deployment.Spec.Selector
+
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, key, statefulSet); err != nil {
+			return nil, err
+		}
+		selector = statefulSet.Spec.Selector
+
+	default:
+		scale, err := r.getScale(ctx, policy)
+		if err != nil {
+			return nil, err
+		}
+		if scale.Status.Selector == "" {
+			return nil, nil
+		}
+		parsed, err := labels.Parse(scale.Status.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target selector: %w", err)
+		}
+		return parsed, nil
+	}
+
+	if selector == nil {
+		return nil, nil
+	}
+	parsed, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target selector: %w", err)
+	}
+	return parsed, nil
+}
+
+// unschedulablePods returns the number of target pods the scheduler could not
+// place, together with the scheduler messages aggregated by frequency
+func (r *AIInferenceAutoscalerPolicyReconciler) unschedulablePods(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int, string, error) {
+	labelSelector, err := r.getTargetSelector(ctx, policy)
+	if err != nil {
+		return 0, "", err
+	}
+	if labelSelector == nil {
+		return 0, "", nil
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(policy.Namespace), client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return 0, "", err
+	}
+
+	count := 0
+	messages := map[string]int{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+				count++
+				messages[cond.Message]++
+				break
+			}
+		}
+	}
+	return count, aggregateMessages(messages), nil
+}
+
+// aggregateMessages joins distinct messages, most frequent first
+func aggregateMessages(messages map[string]int) string {
+	distinct := make([]string, 0, len(messages))
+	for msg := range messages {
+		distinct = append(distinct, msg)
+	}
+	sort.Slice(distinct, func(i, j int) bool {
+		if messages[distinct[i]] != messages[distinct[j]] {
+			return messages[distinct[i]] > messages[distinct[j]]
+		}
+		return distinct[i] < distinct[j]
+	})
+
+	parts := make([]string, 0, len(distinct))
+	for _, msg := range distinct {
+		if msg == "" {
+			msg = "no scheduler message"
+		}
+		parts = append(parts, fmt.Sprintf("%d pod(s): %s", messages[msg], strings.TrimSpace(msg)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// applySchedulingBlock pauses scale-up while replicas of the target cannot be
+// scheduled, so capacity shortages surface as SchedulingBlocked rather than as
+// an ever-growing replica count
+func (r *AIInferenceAutoscalerPolicyReconciler) applySchedulingBlock(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, desiredReplicas int32,
+) int32 {
+	logger := log.FromContext(ctx)
+
+	pending, message, err := r.unschedulablePods(ctx, policy)
+	if err != nil {
+		logger.Error(err, "Failed to check target pods for scheduling failures")
+		return desiredReplicas
+	}
+
+	if pending == 0 {
+		if r.isConditionTrue(policy, ConditionTypeSchedulingBlocked) {
+			r.updateCondition(ctx, policy, ConditionTypeSchedulingBlocked, metav1.ConditionFalse,
+				"Schedulable", "All target pods have been scheduled")
+		}
+		return desiredReplicas
+	}
+
+	// Only report on transition to avoid event spam
+	if !r.isConditionTrue(policy, ConditionTypeSchedulingBlocked) && r.EventRecorder != nil {
+		r.EventRecorder.RecordSchedulingBlocked(policy, pending, message)
+	}
+	r.updateCondition(ctx, policy, ConditionTypeSchedulingBlocked, metav1.ConditionTrue,
+		corev1.PodReasonUnschedulable, message)
+
+	if desiredReplicas > currentReplicas {
+		logger.Info("Pausing scale-up while target pods are unschedulable",
+			"current", currentReplicas,
+			"desired", desiredReplicas,
+			"unschedulable", pending)
+		return currentReplicas
+	}
+	return desiredReplicas
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func unschedulablePod(name, message string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "llm"}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: message,
+			}},
+		},
+	}
+}
+
+func TestApplySchedulingBlock(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef:   kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			MinReplicas: int32Ptr(1),
+			MaxReplicas: 10,
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(4),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llm"}},
+		},
+	}
+	gpuMsg := "0/4 nodes are available: 4 Insufficient nvidia.com/gpu."
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(policy, deployment,
+			unschedulablePod("llm-a", gpuMsg),
+			unschedulablePod("llm-b", gpuMsg),
+			unschedulablePod("llm-c", "0/4 nodes are available: 4 node(s) had untolerated taint."),
+		).
+		WithStatusSubresource(policy).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, NewEventRecorder(fakeRecorder))
+	ctx := context.Background()
+
+	// Scale-up is paused and the scheduler messages are aggregated
+	assert.Equal(t, int32(4), r.applySchedulingBlock(ctx, policy, 4, 6))
+	require.True(t, r.hasCondition(policy, ConditionTypeSchedulingBlocked, metav1.ConditionTrue, corev1.PodReasonUnschedulable))
+	event := <-fakeRecorder.Events
+	assert.Contains(t, event, ReasonSchedulingBlocked)
+	assert.Contains(t, event, "2 pod(s): "+gpuMsg+"; 1 pod(s): 0/4 nodes are available")
+
+	// Scale-down is still allowed, and the event is not repeated
+	assert.Equal(t, int32(2), r.applySchedulingBlock(ctx, policy, 4, 2))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// Once the pods are scheduled the condition clears
+	for _, name := range []string{"llm-a", "llm-b", "llm-c"} {
+		require.NoError(t, c.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}))
+	}
+	assert.Equal(t, int32(6), r.applySchedulingBlock(ctx, policy, 4, 6))
+	assert.False(t, r.isConditionTrue(policy, ConditionTypeSchedulingBlocked))
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// DefaultShardCountKey is the ConfigMap data key read when shardParity.key is not set
+	DefaultShardCountKey = "shardCount"
+
+	// ConditionTypeShardMapSynced indicates the shard count in the ConfigMap matches the replicas
+	ConditionTypeShardMapSynced = "ShardMapSynced"
+)
+
+// shardCountRef returns the ConfigMap and data key holding the policy's shard count
+func shardCountRef(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (types.NamespacedName, string) {
+	key := policy.Spec.ShardParity.Key
+	if key == "" {
+		key = DefaultShardCountKey
+	}
+	return types.NamespacedName{Namespace: policy.Namespace, Name: policy.Spec.ShardParity.ConfigMapName}, key
+}
+
+// readShardCount reads the shard count from the policy's ConfigMap
+func (r *AIInferenceAutoscalerPolicyReconciler) readShardCount(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int32, *corev1.ConfigMap, error) {
+	ref, key := shardCountRef(policy)
+	cm := &corev1.ConfigMap{}
+	if err := r.Get(ctx, ref, cm); err != nil {
+		return 0, nil, fmt.Errorf("failed to read ConfigMap %s: %w", ref.Name, err)
+	}
+	value, ok := cm.Data[key]
+	if !ok {
+		return 0, nil, fmt.Errorf("ConfigMap %s has no key %q", ref.Name, key)
+	}
+	shards, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+	if err != nil || shards < 1 {
+		return 0, nil, fmt.Errorf("ConfigMap %s key %q: %q is not a positive shard count", ref.Name, key, value)
+	}
+	return int32(shards), cm, nil
+}
+
+// readyReplicas returns the replicas of the target that are ready. Kinds other
+// than Deployment and StatefulSet report the replicas observed through the
+// scale subresource.
+func (r *AIInferenceAutoscalerPolicyReconciler) readyReplicas(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) (int32, error) {
+	key := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Spec.TargetRef.Name}
+	switch policy.Spec.TargetRef.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, key, deployment); err != nil {
+			return 0, err
+		}
+		if deployment.Status.ObservedGeneration < deployment.Generation {
+			return 0, nil
+		}
+		return deployment.Status.ReadyReplicas, nil
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, key, statefulSet); err != nil {
+			return 0, err
+		}
+		if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+			return 0, nil
+		}
+		return statefulSet.Status.ReadyReplicas, nil
+	default:
+		scale, err := r.getScale(ctx, policy)
+		if err != nil {
+			return 0, err
+		}
+		return scale.Status.Replicas, nil
+	}
+}
+
+// shardCompatible reports whether replicas can take over a cache sharded for
+// shards replicas by splitting or merging whole shards
+func shardCompatible(shards, replicas int32) bool {
+	return replicas > 0 && (replicas%shards == 0 || shards%replicas == 0)
+}
+
+// shardAlignedReplicas returns the replica count closest to desired that keeps
+// the shard map valid. Counts are rounded up so the target never ends below
+// the recommendation; when no compatible count lies between current and
+// desired, or above desired within the bounds, the current replicas are kept.
+// Scaling to zero is always allowed.
+func shardAlignedReplicas(shards, current, desired, minReplicas, maxReplicas int32) int32 {
+	if desired == current || desired == 0 || shardCompatible(shards, desired) {
+		return desired
+	}
+	if desired > current {
+		for replicas := desired + 1; replicas <= maxReplicas; replicas++ {
+			if shardCompatible(shards, replicas) {
+				return replicas
+			}
+		}
+		for replicas := desired - 1; replicas > current; replicas-- {
+			if shardCompatible(shards, replicas) {
+				return replicas
+			}
+		}
+		return current
+	}
+	for replicas := max(desired+1, minReplicas); replicas < current; replicas++ {
+		if shardCompatible(shards, replicas) {
+			return replicas
+		}
+	}
+	return current
+}
+
+// applyShardParity keeps the replicas of a target with a replica-sharded cache
+// compatible with its shard map, in two phases: the replicas only move to
+// counts that split or merge whole shards, and once the target has rolled out
+// the new count is written to the ConfigMap so the servers rebuild the map.
+// Scaling is held while the ConfigMap is out of date or cannot be read.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyShardParity(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, desiredReplicas int32,
+) int32 {
+	if policy.Spec.ShardParity == nil {
+		return desiredReplicas
+	}
+	logger := log.FromContext(ctx)
+
+	shards, cm, err := r.readShardCount(ctx, policy)
+	if err != nil {
+		logger.Error(err, "Failed to read shard count, holding replicas")
+		if !r.hasCondition(policy, ConditionTypeShardMapSynced, metav1.ConditionFalse, ReasonShardCountUnavailable) && r.EventRecorder != nil {
+			r.EventRecorder.RecordShardCountUnavailable(policy, err)
+		}
+		r.updateCondition(ctx, policy, ConditionTypeShardMapSynced, metav1.ConditionFalse, ReasonShardCountUnavailable, err.Error())
+		return currentReplicas
+	}
+
+	// Second phase: publish the replica count once the target has rolled out.
+	// A target scaled to zero keeps its shard count for the next scale-up.
+	if shards != currentReplicas && currentReplicas > 0 && !policy.Spec.DryRun {
+		ready, err := r.readyReplicas(ctx, policy)
+		if err != nil {
+			logger.Error(err, "Failed to read ready replicas of target")
+			return currentReplicas
+		}
+		if ready < currentReplicas {
+			r.updateCondition(ctx, policy, ConditionTypeShardMapSynced, metav1.ConditionFalse, "ShardMapPending",
+				fmt.Sprintf("Waiting for %d/%d replicas to be ready before updating the shard count from %d", ready, currentReplicas, shards))
+			return currentReplicas
+		}
+
+		_, key := shardCountRef(policy)
+		cm.Data[key] = strconv.Itoa(int(currentReplicas))
+		if err := r.Update(ctx, cm); err != nil {
+			logger.Error(err, "Failed to update shard count, holding replicas")
+			return currentReplicas
+		}
+		logger.Info("Updated shard count", "from", shards, "to", currentReplicas)
+		if r.EventRecorder != nil {
+			r.EventRecorder.RecordShardMapUpdated(policy, cm.Name, shards, currentReplicas)
+		}
+		shards = currentReplicas
+	}
+
+	if shards == currentReplicas {
+		r.updateCondition(ctx, policy, ConditionTypeShardMapSynced, metav1.ConditionTrue, "ShardMapSynced",
+			fmt.Sprintf("Shard count %d matches the replicas", shards))
+	}
+
+	minReplicas := int32(1)
+	if policy.Spec.MinReplicas != nil && *policy.Spec.MinReplicas > 0 {
+		minReplicas = *policy.Spec.MinReplicas
+	}
+	aligned := shardAlignedReplicas(shards, currentReplicas, desiredReplicas, minReplicas, policy.Spec.MaxReplicas)
+	if aligned != desiredReplicas {
+		logger.Info("Aligned replicas with cache shards", "shards", shards, "desired", desiredReplicas, "aligned", aligned)
+	}
+	return aligned
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestShardAlignedReplicas(t *testing.T) {
+	tests := []struct {
+		name                     string
+		shards, current, desired int32
+		want                     int32
+	}{
+		{name: "multiple of shards", shards: 4, current: 4, desired: 8, want: 8},
+		{name: "divisor of shards", shards: 8, current: 8, desired: 4, want: 4},
+		{name: "scale-up rounds up", shards: 4, current: 4, desired: 6, want: 8},
+		{name: "scale-up above max rounds down", shards: 4, current: 4, desired: 9, want: 8},
+		{name: "scale-down rounds up", shards: 8, current: 8, desired: 3, want: 4},
+		{name: "no compatible count below current", shards: 3, current: 3, desired: 2, want: 3},
+		{name: "scale to zero", shards: 4, current: 4, desired: 0, want: 0},
+		{name: "no change", shards: 4, current: 6, desired: 6, want: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, shardAlignedReplicas(tt.shards, tt.current, tt.desired, 1, 10))
+		})
+	}
+}
+
+func TestReconcileShardParity(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("sharded")
+	policy.Spec.ShardParity = &kubeaiv1alpha1.ShardParitySpec{ConfigMapName: "cache-shards"}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: 2},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache-shards", Namespace: "default"},
+		Data:       map[string]string{DefaultShardCountKey: "2"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment, cm).
+		WithStatusSubresource(policy, deployment).Build()
+	// GPU at 1.5x target recommends 3 replicas
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 75}, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "sharded", Namespace: "default"}}
+
+	replicas := func() int32 {
+		updated := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+		return *updated.Spec.Replicas
+	}
+	shardCount := func() string {
+		updated := &corev1.ConfigMap{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "cache-shards", Namespace: "default"}, updated))
+		return updated.Data[DefaultShardCountKey]
+	}
+	synced := func(status metav1.ConditionStatus, reason string) bool {
+		stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+		return r.hasCondition(stored, ConditionTypeShardMapSynced, status, reason)
+	}
+
+	// First phase: 3 replicas would break the shard map of 2, so the target goes to 4
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), replicas())
+	assert.Equal(t, "2", shardCount())
+
+	// The shard count is only updated once the new replicas are ready
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "2", shardCount())
+	assert.True(t, synced(metav1.ConditionFalse, "ShardMapPending"))
+
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	updated.Status.ReadyReplicas = 4
+	require.NoError(t, c.Status().Update(ctx, updated))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "4", shardCount())
+	assert.True(t, synced(metav1.ConditionTrue, "ShardMapSynced"))
+	assert.Equal(t, int32(4), replicas())
+}
+
+func TestReconcileShardParityUnavailable(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("sharded")
+	policy.Spec.ShardParity = &kubeaiv1alpha1.ShardParitySpec{ConfigMapName: "missing"}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "sharded", Namespace: "default"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	updated := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "default"}, updated))
+	assert.Equal(t, int32(2), *updated.Spec.Replicas)
+
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+	assert.True(t, r.hasCondition(stored, ConditionTypeShardMapSynced, metav1.ConditionFalse, ReasonShardCountUnavailable))
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+const (
+	// DefaultShutdownGracePeriod bounds how long shutdown waits for in-flight
+	// reconciles and the final state write
+	DefaultShutdownGracePeriod = 30 * time.Second
+	// DefaultStateConfigMap is the ConfigMap holding algorithm state across restarts
+	DefaultStateConfigMap = "kubeai-autoscaler-state"
+	// StateConfigMapKey is the ConfigMap data key holding the state as JSON
+	StateConfigMapKey = "state.json"
+	// DefaultStateCheckpointInterval is how often algorithm state is saved while leading
+	DefaultStateCheckpointInterval = 5 * time.Minute
+)
+
+// reconcileTracker counts the reconciles in progress so shutdown can wait for
+// them, and turns new reconciles away once shutdown has begun. The zero value
+// is ready to use.
+type reconcileTracker struct {
+	mu       sync.Mutex
+	active   int
+	draining bool
+	idle     chan struct{}
+}
+
+// start registers a reconcile; it returns false once draining has begun
+func (t *reconcileTracker) start() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.draining {
+		return false
+	}
+	t.active++
+	return true
+}
+
+// done unregisters a reconcile started with start
+func (t *reconcileTracker) done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.active--
+	if t.draining && t.active == 0 {
+		close(t.idle)
+	}
+}
+
+// drain stops new reconciles and waits until those in progress have finished
+func (t *reconcileTracker) drain(ctx context.Context) error {
+	t.mu.Lock()
+	if !t.draining {
+		t.draining = true
+		t.idle = make(chan struct{})
+		if t.active == 0 {
+			close(t.idle)
+		}
+	}
+	idle := t.idle
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("reconciles still in progress: %w", ctx.Err())
+	}
+}
+
+// Drain stops the reconciler from starting new reconciles and waits for those
+// in progress to finish or for ctx to expire
+func (r *AIInferenceAutoscalerPolicyReconciler) Drain(ctx context.Context) error {
+	return r.reconciles.drain(ctx)
+}
+
+// StatePersister keeps algorithm state across controller restarts. When the
+// controller becomes leader it restores the state store from its backend and
+// seeds the forecasts the saved state lacks; it then saves the store every
+// checkpoint interval and, on shutdown, once in-flight reconciles are done, so
+// smoothing, forecasts and other learned state survive rolling upgrades and crashes.
+type StatePersister struct {
+	// Backend keeps the state
+	Backend StateBackend
+	// Store is the state store to restore and save
+	Store *scaling.StateStore
+	// Reconciler is drained before the state is saved
+	Reconciler *AIInferenceAutoscalerPolicyReconciler
+	// GracePeriod bounds the drain and the final write
+	GracePeriod time.Duration
+	// CheckpointInterval is how often the state is saved while leading, so a
+	// crash loses at most this much learning; zero saves only on shutdown
+	CheckpointInterval time.Duration
+	// ReadOnly restores the state but never saves it, as in Recommend mode
+	ReadOnly bool
+	// Warmup seeds the forecasts of policies the restored state has none for;
+	// nil disables it
+	Warmup *ForecastWarmup
+}
+
+var _ manager.LeaderElectionRunnable = &StatePersister{}
+
+// NeedLeaderElection restricts the persister to the leader, which owns the state
+func (p *StatePersister) NeedLeaderElection() bool {
+	return true
+}
+
+// Start restores the state, checkpoints it, then saves it once ctx is cancelled
+func (p *StatePersister) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithValues("backend", p.Backend.String())
+
+	restored, err := p.restore(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to restore algorithm state, starting empty")
+	} else if restored > 0 {
+		logger.Info("Restored algorithm state", "policies", restored)
+	}
+	if p.Warmup != nil {
+		warmed, err := p.Warmup.Warm(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to replay forecast history for some policies")
+		}
+		if warmed > 0 {
+			logger.Info("Replayed forecast history", "policies", warmed)
+		}
+	}
+
+	var checkpoints <-chan time.Time
+	if p.CheckpointInterval > 0 && !p.ReadOnly {
+		ticker := time.NewTicker(p.CheckpointInterval)
+		defer ticker.Stop()
+		checkpoints = ticker.C
+	}
+	for done := false; !done; {
+		select {
+		case <-checkpoints:
+			if err := p.save(ctx); err != nil {
+				logger.Error(err, "Failed to checkpoint algorithm state")
+			}
+		case <-ctx.Done():
+			done = true
+		}
+	}
+
+	gracePeriod := p.GracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultShutdownGracePeriod
+	}
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), gracePeriod)
+	defer cancel()
+	if p.Reconciler != nil {
+		if err := p.Reconciler.Drain(saveCtx); err != nil {
+			logger.Error(err, "Saving algorithm state before reconciles finished")
+		}
+	}
+	if p.ReadOnly {
+		return nil
+	}
+	if err := p.save(saveCtx); err != nil {
+		return fmt.Errorf("failed to save algorithm state: %w", err)
+	}
+	logger.Info("Saved algorithm state")
+	return nil
+}
+
+// restore loads the saved state into the store and returns the number of policies restored
+func (p *StatePersister) restore(ctx context.Context) (int, error) {
+	state, err := p.Backend.Load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	p.Store.Restore(state)
+	return len(state), nil
+}
+
+// save writes the current state to the backend
+func (p *StatePersister) save(ctx context.Context) error {
+	return p.Backend.Save(ctx, p.Store.Snapshot(""))
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestReconcileTrackerDrain(t *testing.T) {
+	var tracker reconcileTracker
+	require.True(t, tracker.start())
+
+	// Drain waits for the reconcile in progress
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, tracker.drain(ctx))
+
+	// and turns new ones away
+	assert.False(t, tracker.start())
+
+	tracker.done()
+	assert.NoError(t, tracker.drain(context.Background()))
+}
+
+func TestReconcileAfterDrain(t *testing.T) {
+	r := NewReconciler(nil, nil, nil, scaling.DefaultRegistry, nil)
+	require.NoError(t, r.Drain(context.Background()))
+
+	// The nil client would panic if the reconcile went ahead
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "llm"}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+}
+
+func TestStatePersister(t *testing.T) {
+	key := types.NamespacedName{Namespace: "kubeai-system", Name: DefaultStateConfigMap}
+	saved := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Data:       map[string]string{StateConfigMapKey: `{"default/llm":{"smoothing":5.5}}`},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(saved).Build()
+	store := scaling.NewStateStore()
+	p := &StatePersister{
+		Backend:     &ConfigMapStateBackend{Client: c, Reader: c, Key: key},
+		Store:       store,
+		Reconciler:  NewReconciler(c, nil, nil, scaling.DefaultRegistry, nil),
+		GracePeriod: time.Second,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Start(ctx) }()
+
+	// State saved by the previous leader is restored on start
+	require.Eventually(t, func() bool {
+		_, ok := store.Get("default/llm", "smoothing")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	value, _ := store.Get("default/llm", "smoothing")
+	assert.Equal(t, 5.5, value)
+
+	// and the latest state is written back on shutdown
+	store.Set("default/llm", "smoothing", 6.25)
+	store.Set("prod/chat", "smoothing", 2)
+	cancel()
+	require.NoError(t, <-done)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(context.Background(), key, cm))
+	assert.JSONEq(t, `{"default/llm":{"smoothing":6.25},"prod/chat":{"smoothing":2}}`, cm.Data[StateConfigMapKey])
+}
+
+func TestStatePersisterCreatesConfigMap(t *testing.T) {
+	key := types.NamespacedName{Namespace: "kubeai-system", Name: DefaultStateConfigMap}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+	store := scaling.NewStateStore()
+	store.Set("default/llm", "smoothing", 3)
+	p := &StatePersister{Backend: &ConfigMapStateBackend{Client: c, Reader: c, Key: key}, Store: store}
+
+	require.NoError(t, p.save(context.Background()))
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(context.Background(), key, cm))
+	assert.JSONEq(t, `{"default/llm":{"smoothing":3}}`, cm.Data[StateConfigMapKey])
+}
+
+func TestStatePersisterCheckpoints(t *testing.T) {
+	backend := &FileStateBackend{Path: filepath.Join(t.TempDir(), "state.json")}
+	store := scaling.NewStateStore()
+	store.Set("default/llm", "smoothing", 3)
+	p := &StatePersister{Backend: backend, Store: store, CheckpointInterval: 10 * time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Start(ctx) }()
+
+	// The state is saved while leading, before any shutdown
+	require.Eventually(t, func() bool {
+		state, err := backend.Load(context.Background())
+		return err == nil && state["default/llm"]["smoothing"] == 3
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestStatePersisterReadOnly(t *testing.T) {
+	backend := &FileStateBackend{Path: filepath.Join(t.TempDir(), "state.json")}
+	require.NoError(t, backend.Save(context.Background(), map[string]map[string]float64{"default/llm": {"smoothing": 5.5}}))
+	store := scaling.NewStateStore()
+	p := &StatePersister{Backend: backend, Store: store, CheckpointInterval: time.Millisecond, ReadOnly: true}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Start(ctx) }()
+	require.Eventually(t, func() bool {
+		_, ok := store.Get("default/llm", "smoothing")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	// The restored state is used but never written back
+	store.Set("default/llm", "smoothing", 7)
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+	state, err := backend.Load(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 5.5, state["default/llm"]["smoothing"])
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// SimulationStep is one observation of a policy's metrics replayed by Simulate
+type SimulationStep struct {
+	// Time is when the metrics were observed
+	Time time.Time
+	// Metrics are the metrics observed at Time
+	Metrics kubeaiv1alpha1.CurrentMetrics
+	// Replicas is the replica count the metrics were observed at. Latencies and
+	// utilization are rescaled to the simulated replicas; zero replays them as
+	// observed.
+	Replicas int32
+}
+
+// SimulatedDecision is the outcome of one reconcile of a simulation
+type SimulatedDecision struct {
+	// Time is the simulated time of the reconcile
+	Time time.Time
+	// From is the replica count before the reconcile, To the one after it
+	From int32
+	To   int32
+	// Recommended is the recommendation before behavior and constraints
+	Recommended int32
+	// Reason is the reason of the last scale
+	Reason string
+	// Conditions are the policy's conditions after the reconcile
+	Conditions []metav1.Condition
+	// OverTarget reports whether a metric was above its target at the
+	// replicas it was served by
+	OverTarget bool
+}
+
+// Simulate reconciles the policy once per step against a fake client and
+// clock, starting from a target at replicas, and returns the decision made at
+// each step. The steps' metrics stand in for the policy's metric sources.
+//
+// The target is simulated as a Deployment of the target's name, with the GPUs
+// per replica of the policy's status; the discovered capacity in the status
+// is kept, as capacity is not probed. When a step's metrics were observed at
+// another replica count, latencies and utilization are assumed to spread
+// evenly over the replicas, which only holds for load-bound metrics.
+func Simulate(ctx context.Context, registry *scaling.Registry, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, replicas int32, steps []SimulationStep) ([]SimulatedDecision, error) {
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := kubeaiv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	simulated := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: policy.Name, Namespace: policy.Namespace},
+		Spec:       *policy.Spec.DeepCopy(),
+		Status: kubeaiv1alpha1.AIInferenceAutoscalerPolicyStatus{
+			DiscoveredCapacity: policy.Status.DiscoveredCapacity,
+			GPUsPerReplica:     policy.Status.GPUsPerReplica,
+		},
+	}
+	simulated.Spec.TargetRef = kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: policy.Spec.TargetRef.Name}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(simulated, simulatedTarget(simulated, replicas)).
+		WithStatusSubresource(simulated).Build()
+
+	fakeClock := clocktesting.NewFakePassiveClock(steps[0].Time)
+	r := NewReconciler(c, scheme, nil, registry, nil)
+	r.Clock = fakeClock
+	r.LockIdentity = "simulation"
+	r.CapacityProber = nil
+	r.SyntheticProber = nil
+
+	key := client.ObjectKeyFromObject(simulated)
+	decisions := make([]SimulatedDecision, 0, len(steps))
+	for _, step := range steps {
+		fakeClock.SetTime(step.Time)
+		before, err := r.getCurrentReplicas(ctx, simulated)
+		if err != nil {
+			return nil, err
+		}
+		observed := rescaleMetrics(step.Metrics, step.Replicas, before)
+		r.simulatedMetrics = &observed
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			return nil, fmt.Errorf("reconcile at %s: %w", step.Time.Format(time.RFC3339), err)
+		}
+		after, err := r.getCurrentReplicas(ctx, simulated)
+		if err != nil {
+			return nil, err
+		}
+
+		stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		if err := c.Get(ctx, key, stored); err != nil {
+			return nil, err
+		}
+		decision := SimulatedDecision{
+			Time:        step.Time,
+			From:        before,
+			To:          after,
+			Recommended: stored.Status.RecommendedReplicas,
+			Reason:      stored.Status.LastScaleReason,
+			Conditions:  stored.Status.Conditions,
+		}
+		for _, m := range compareMetrics(stored, before, &observed) {
+			if m.current > m.target {
+				decision.OverTarget = true
+			}
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, nil
+}
+
+// simulatedTarget returns the Deployment standing in for the policy's target
+func simulatedTarget(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, replicas int32) *appsv1.Deployment {
+	labels := map[string]string{"app": policy.Spec.TargetRef.Name}
+	container := corev1.Container{Name: "server"}
+	if gpus := policy.Status.GPUsPerReplica; gpus > 0 {
+		container.Resources.Requests = corev1.ResourceList{GPUResourceName: *resource.NewQuantity(int64(gpus), resource.DecimalSI)}
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: policy.Spec.TargetRef.Name, Namespace: policy.Namespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{container}},
+			},
+		},
+	}
+}
+
+// rescaleMetrics spreads the latencies and utilization observed at observed
+// replicas over replicas; totals such as queue depth are left as observed,
+// since the per-replica targets they are compared against are rescaled
+func rescaleMetrics(m kubeaiv1alpha1.CurrentMetrics, observed, replicas int32) kubeaiv1alpha1.CurrentMetrics {
+	if observed <= 0 || replicas <= 0 || observed == replicas {
+		return m
+	}
+	factor := float64(observed) / float64(replicas)
+	m.LatencyP99Ms *= factor
+	m.LatencyP95Ms *= factor
+	m.SyntheticLatencyMs = int32(math.Round(float64(m.SyntheticLatencyMs) * factor))
+	m.GPUUtilizationPercent = rescalePercent(m.GPUUtilizationPercent, factor)
+	m.CPUUtilizationPercent = rescalePercent(m.CPUUtilizationPercent, factor)
+	m.MemoryUtilizationPercent = rescalePercent(m.MemoryUtilizationPercent, factor)
+	return m
+}
+
+// rescalePercent scales a utilization, which cannot exceed 100 percent
+func rescalePercent(percent int32, factor float64) int32 {
+	return int32(min(math.Round(float64(percent)*factor), 100))
+}
+
+// MetricNames returns the names the metrics of the policy are exported under
+// as kubeai_autoscaler_metric_value, which keys them like namedMetrics
+func MetricNames(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) []string {
+	spec := policy.Spec.Metrics
+	var names []string
+	if spec.Latency != nil && spec.Latency.Enabled {
+		names = append(names, scaling.MetricLatencyP99Ms, scaling.MetricLatencyP95Ms)
+	}
+	add := func(name string, enabled bool) {
+		if enabled {
+			names = append(names, name)
+		}
+	}
+	add(scaling.MetricGPUUtilization, spec.GPUUtilization != nil && spec.GPUUtilization.Enabled)
+	add(scaling.MetricCPUUtilization, spec.CPUUtilization != nil && spec.CPUUtilization.Enabled)
+	add(scaling.MetricMemoryUtilization, spec.MemoryUtilization != nil && spec.MemoryUtilization.Enabled)
+	add(scaling.MetricRequestQueueDepth, spec.RequestQueueDepth != nil && spec.RequestQueueDepth.Enabled)
+	add(scaling.MetricTokensPerSecond, spec.TokensPerSecond != nil && spec.TokensPerSecond.Enabled)
+	add(scaling.MetricInFlightRequests, spec.InFlightRequests != nil && spec.InFlightRequests.Enabled)
+	add(scaling.MetricSyntheticLatencyMs, spec.SyntheticProbe != nil && spec.SyntheticProbe.Enabled)
+	if spec.Queueing != nil && spec.Queueing.Enabled {
+		names = append(names, scaling.MetricArrivalRate, scaling.MetricServiceTimeSeconds)
+	}
+	for _, custom := range spec.CustomMetrics {
+		names = append(names, custom.Name)
+	}
+	return append(names, scaling.MetricCostPerReplicaHour, scaling.MetricCostPer1kRequests)
+}
+
+// MetricsFromNamed returns the current metrics holding the named values, as
+// exported under the names of MetricNames; names that are not built in are
+// custom metrics
+func MetricsFromNamed(named map[string]float64) kubeaiv1alpha1.CurrentMetrics {
+	var m kubeaiv1alpha1.CurrentMetrics
+	round := func(value float64) int32 {
+		return int32(math.Round(value))
+	}
+	for name, value := range named {
+		switch name {
+		case scaling.MetricLatencyP99Ms:
+			m.LatencyP99Ms = value
+		case scaling.MetricLatencyP95Ms:
+			m.LatencyP95Ms = value
+		case scaling.MetricGPUUtilization:
+			m.GPUUtilizationPercent = round(value)
+		case scaling.MetricCPUUtilization:
+			m.CPUUtilizationPercent = round(value)
+		case scaling.MetricMemoryUtilization:
+			m.MemoryUtilizationPercent = round(value)
+		case scaling.MetricRequestQueueDepth:
+			m.RequestQueueDepth = round(value)
+		case scaling.MetricTokensPerSecond:
+			m.TokensPerSecond = round(value)
+		case scaling.MetricInFlightRequests:
+			m.InFlightRequests = round(value)
+		case scaling.MetricSyntheticLatencyMs:
+			m.SyntheticLatencyMs = round(value)
+		case scaling.MetricArrivalRate:
+			m.ArrivalRate = &value
+		case scaling.MetricServiceTimeSeconds:
+			m.ServiceTimeMs = ptr.To(secondsToMs(value))
+		case scaling.MetricCostPerReplicaHour:
+			m.CostPerReplicaHour = value
+		case scaling.MetricCostPer1kRequests:
+			m.CostPer1kRequests = value
+		default:
+			m.Custom = append(m.Custom, kubeaiv1alpha1.CustomMetricValue{Name: name, Value: value})
+		}
+	}
+	sort.Slice(m.Custom, func(i, j int) bool { return m.Custom[i].Name < m.Custom[j].Name })
+	return m
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestSimulateRescalesObservedMetrics(t *testing.T) {
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef:      kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "llm"},
+			MinReplicas:    int32Ptr(1),
+			MaxReplicas:    10,
+			CooldownPeriod: 60,
+			Algorithm:      &kubeaiv1alpha1.AlgorithmSpec{Name: "AverageRatio"},
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 50},
+			},
+		},
+	}
+
+	// Every step was observed at 2 replicas running at 100%; the simulated
+	// target doubles its replicas and then serves the same load at 50%
+	start := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	var steps []SimulationStep
+	for i := range 4 {
+		steps = append(steps, SimulationStep{
+			Time:     start.Add(time.Duration(i) * time.Minute),
+			Metrics:  kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 100},
+			Replicas: 2,
+		})
+	}
+	decisions, err := Simulate(context.Background(), scaling.DefaultRegistry, policy, 2, steps)
+	require.NoError(t, err)
+	require.Len(t, decisions, 4)
+
+	assert.Equal(t, int32(2), decisions[0].From)
+	assert.Equal(t, int32(4), decisions[0].To)
+	assert.True(t, decisions[0].OverTarget)
+	for _, d := range decisions[1:] {
+		assert.Equal(t, int32(4), d.From)
+		assert.Equal(t, int32(4), d.To)
+		assert.False(t, d.OverTarget)
+	}
+}
+
+func TestRescaleMetrics(t *testing.T) {
+	observed := kubeaiv1alpha1.CurrentMetrics{LatencyP99Ms: 400, GPUUtilizationPercent: 60, RequestQueueDepth: 12}
+
+	assert.Equal(t, observed, rescaleMetrics(observed, 0, 4), "metrics without an observed replica count are replayed as observed")
+
+	halved := rescaleMetrics(observed, 2, 4)
+	assert.Equal(t, 200.0, halved.LatencyP99Ms)
+	assert.Equal(t, int32(30), halved.GPUUtilizationPercent)
+	assert.Equal(t, int32(12), halved.RequestQueueDepth, "totals are left as observed")
+
+	assert.Equal(t, int32(100), rescaleMetrics(observed, 4, 1).GPUUtilizationPercent, "utilization is capped at 100%")
+}
+
+func TestMetricsFromNamed(t *testing.T) {
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Latency:        &kubeaiv1alpha1.LatencyMetric{Enabled: true},
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true},
+				Queueing:       &kubeaiv1alpha1.QueueingMetric{Enabled: true},
+				CustomMetrics:  []kubeaiv1alpha1.CustomMetric{{Name: "kv_cache"}},
+			},
+		},
+	}
+	assert.Equal(t, []string{
+		scaling.MetricLatencyP99Ms, scaling.MetricLatencyP95Ms, scaling.MetricGPUUtilization,
+		scaling.MetricArrivalRate, scaling.MetricServiceTimeSeconds, "kv_cache",
+		scaling.MetricCostPerReplicaHour, scaling.MetricCostPer1kRequests,
+	}, MetricNames(policy))
+
+	current := kubeaiv1alpha1.CurrentMetrics{
+		LatencyP99Ms:          512.5,
+		GPUUtilizationPercent: 70,
+		ArrivalRate:           new(float64),
+		ServiceTimeMs:         ptr.To(0.4),
+		Custom:                []kubeaiv1alpha1.CustomMetricValue{{Name: "kv_cache", Value: 0.8}},
+	}
+	// Replaying the exported values gives back the metrics they were exported from
+	assert.Equal(t, current, MetricsFromNamed(namedMetrics(policy, &current)))
+}
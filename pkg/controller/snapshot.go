@@ -0,0 +1,185 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+const (
+	// DecisionSnapshotVersion is the schema version of the snapshot written to
+	// status.lastDecision. It changes whenever a field is renamed or removed.
+	DecisionSnapshotVersion = "v1"
+	// MaxDecisionSnapshotBytes caps the size of status.lastDecision
+	MaxDecisionSnapshotBytes = 4096
+	// maxSnapshotReasonLength caps the reason kept in a truncated snapshot
+	maxSnapshotReasonLength = 256
+)
+
+// Constraints recorded in a decision snapshot when they change the replicas
+const (
+	ConstraintMinReplicas         = "minReplicas"
+	ConstraintMaxReplicas         = "maxReplicas"
+	ConstraintScaleToZero         = "scaleToZero"
+	ConstraintOptimizer           = "optimizer"
+	ConstraintStabilizationWindow = "stabilizationWindow"
+	ConstraintScalingPolicy       = "scalingPolicy"
+	ConstraintDirectionDisabled   = "directionDisabled"
+	ConstraintNamespaceDisabled   = "namespaceDisabled"
+	ConstraintSuspended           = "suspended"
+	ConstraintSchedulingBlocked   = "schedulingBlocked"
+	ConstraintNodeDrain           = "nodeDrain"
+	ConstraintShardParity         = "shardParity"
+	ConstraintUpdatePartition     = "updatePartition"
+	ConstraintEmergencyStop       = "emergencyStop"
+	ConstraintCooldown            = "cooldown"
+)
+
+// decisionSnapshot is the JSON form of a scaling decision written to
+// status.lastDecision for audit tooling
+type decisionSnapshot struct {
+	Version           string                    `json:"version"`
+	Time              metav1.Time               `json:"time"`
+	Algorithm         string                    `json:"algorithm"`
+	Reason            string                    `json:"reason,omitempty"`
+	DrivingMetric     string                    `json:"drivingMetric,omitempty"`
+	Confidence        float64                   `json:"confidence,omitempty"`
+	Metrics           map[string]snapshotMetric `json:"metrics,omitempty"`
+	CurrentReplicas   int32                     `json:"currentReplicas"`
+	UnclampedReplicas int32                     `json:"unclampedReplicas"`
+	DesiredReplicas   int32                     `json:"desiredReplicas"`
+	Constraints       []snapshotConstraint      `json:"constraints,omitempty"`
+	// Truncated is set when metrics were dropped to stay within MaxDecisionSnapshotBytes
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// snapshotMetric is a metric input of a decision
+type snapshotMetric struct {
+	Current float64 `json:"current"`
+	Target  float64 `json:"target"`
+}
+
+// snapshotConstraint is a step of the decision that changed the replicas
+type snapshotConstraint struct {
+	Name  string `json:"name"`
+	From  int32  `json:"from"`
+	To    int32  `json:"to"`
+	Delta int32  `json:"delta"`
+}
+
+// newDecisionSnapshot records the algorithm's decision and its inputs, noting
+// the min or max replicas the decision was clamped to
+func newDecisionSnapshot(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas int32,
+	currentMetrics *kubeaiv1alpha1.CurrentMetrics,
+	algorithm string,
+	decision scaling.ScalingResult,
+	now time.Time,
+) *decisionSnapshot {
+	unclamped := decision.UnclampedReplicas
+	if unclamped == 0 {
+		unclamped = decision.DesiredReplicas
+	}
+	snapshot := &decisionSnapshot{
+		Version:           DecisionSnapshotVersion,
+		Time:              metav1.NewTime(now),
+		Algorithm:         algorithm,
+		Reason:            decision.Reason,
+		DrivingMetric:     decision.DrivingMetric,
+		Confidence:        decision.Confidence,
+		CurrentReplicas:   currentReplicas,
+		UnclampedReplicas: unclamped,
+		DesiredReplicas:   unclamped,
+	}
+	for _, m := range compareMetrics(policy, currentReplicas, currentMetrics) {
+		if snapshot.Metrics == nil {
+			snapshot.Metrics = make(map[string]snapshotMetric)
+		}
+		snapshot.Metrics[m.name] = snapshotMetric{Current: m.current, Target: m.target}
+	}
+
+	if decision.DesiredReplicas > unclamped {
+		snapshot.constrain(ConstraintMinReplicas, decision.DesiredReplicas)
+	} else if decision.DesiredReplicas < unclamped {
+		snapshot.constrain(ConstraintMaxReplicas, decision.DesiredReplicas)
+	}
+	return snapshot
+}
+
+// constrain records the replicas a step of the decision moved to, if it
+// changed them
+func (s *decisionSnapshot) constrain(name string, replicas int32) {
+	if s.DesiredReplicas == replicas {
+		return
+	}
+	s.Constraints = append(s.Constraints, snapshotConstraint{
+		Name:  name,
+		From:  s.DesiredReplicas,
+		To:    replicas,
+		Delta: replicas - s.DesiredReplicas,
+	})
+	s.DesiredReplicas = replicas
+}
+
+// marshal encodes the snapshot within MaxDecisionSnapshotBytes, dropping the
+// metric inputs and shortening the reason when it does not fit
+func (s *decisionSnapshot) marshal() (string, error) {
+	data, err := json.Marshal(s)
+	if err != nil || len(data) <= MaxDecisionSnapshotBytes {
+		return string(data), err
+	}
+
+	truncated := *s
+	truncated.Metrics = nil
+	truncated.Truncated = true
+	if len(truncated.Reason) > maxSnapshotReasonLength {
+		truncated.Reason = truncated.Reason[:maxSnapshotReasonLength]
+	}
+	data, err = json.Marshal(truncated)
+	return string(data), err
+}
+
+// setDecisionSnapshot reports the constraints of the decision in
+// status.decisionConstraints, and writes the snapshot to status.lastDecision
+// when the policy asks for it, clearing the field otherwise
+func (r *AIInferenceAutoscalerPolicyReconciler) setDecisionSnapshot(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, snapshot *decisionSnapshot) {
+	policy.Status.DecisionConstraints = nil
+	for _, c := range snapshot.Constraints {
+		policy.Status.DecisionConstraints = append(policy.Status.DecisionConstraints, kubeaiv1alpha1.DecisionConstraint{
+			Name:  c.Name,
+			From:  c.From,
+			To:    c.To,
+			Delta: c.Delta,
+		})
+	}
+
+	if !policy.Spec.DecisionSnapshot {
+		policy.Status.LastDecision = ""
+		return
+	}
+	// A snapshot that cannot be encoded, such as one with a NaN confidence
+	// from an external algorithm, is left out rather than leaving a stale one
+	data, _ := snapshot.marshal()
+	policy.Status.LastDecision = data
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestDecisionSnapshotConstraints(t *testing.T) {
+	policy := lockTestPolicy("snapshot")
+	current := &kubeaiv1alpha1.CurrentMetrics{GPUUtilizationPercent: 100}
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	snapshot := newDecisionSnapshot(policy, 4, current, "MaxRatio",
+		scaling.ScalingResult{DesiredReplicas: 10, UnclampedReplicas: 12, Reason: "scaled based on max ratio"}, now)
+	snapshot.constrain(ConstraintScalingPolicy, 8)
+	snapshot.constrain(ConstraintShardParity, 8)
+
+	assert.Equal(t, int32(12), snapshot.UnclampedReplicas)
+	assert.Equal(t, int32(8), snapshot.DesiredReplicas)
+	assert.Equal(t, []snapshotConstraint{
+		{Name: ConstraintMaxReplicas, From: 12, To: 10, Delta: -2},
+		{Name: ConstraintScalingPolicy, From: 10, To: 8, Delta: -2},
+	}, snapshot.Constraints)
+	assert.Equal(t, snapshotMetric{Current: 100, Target: 50}, snapshot.Metrics[scaling.MetricGPUUtilization])
+
+	// Results without an unclamped recommendation are not reported as clamped
+	snapshot = newDecisionSnapshot(policy, 4, current, "MaxRatio", scaling.ScalingResult{DesiredReplicas: 4}, now)
+	assert.Empty(t, snapshot.Constraints)
+}
+
+func TestDecisionSnapshotSizeCap(t *testing.T) {
+	policy := lockTestPolicy("snapshot")
+	current := &kubeaiv1alpha1.CurrentMetrics{}
+	for i := range 200 {
+		name := fmt.Sprintf("custom_metric_%03d", i)
+		policy.Spec.Metrics.CustomMetrics = append(policy.Spec.Metrics.CustomMetrics,
+			kubeaiv1alpha1.CustomMetric{Name: name, Query: "up", TargetValue: 1})
+		current.Custom = append(current.Custom, kubeaiv1alpha1.CustomMetricValue{Name: name, Value: 2})
+	}
+	snapshot := newDecisionSnapshot(policy, 2, current, "MaxRatio",
+		scaling.ScalingResult{DesiredReplicas: 4, Reason: strings.Repeat("x", 1000)}, time.Now())
+	require.Len(t, snapshot.Metrics, 200)
+
+	data, err := snapshot.marshal()
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(data), MaxDecisionSnapshotBytes)
+
+	var decoded decisionSnapshot
+	require.NoError(t, json.Unmarshal([]byte(data), &decoded))
+	assert.True(t, decoded.Truncated)
+	assert.Empty(t, decoded.Metrics)
+	assert.Len(t, decoded.Reason, maxSnapshotReasonLength)
+	assert.Equal(t, int32(4), decoded.DesiredReplicas)
+}
+
+func TestReconcileWritesDecisionSnapshot(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("snapshot")
+	policy.Spec.DecisionSnapshot = true
+	policy.Spec.CooldownPeriod = 60
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 100}, scaling.DefaultRegistry, nil)
+	clock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+	r.Clock = clock
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "snapshot", Namespace: "default"}}
+	lastDecision := func() decisionSnapshot {
+		stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+		var snapshot decisionSnapshot
+		require.NoError(t, json.Unmarshal([]byte(stored.Status.LastDecision), &snapshot))
+		return snapshot
+	}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	snapshot := lastDecision()
+	assert.Equal(t, DecisionSnapshotVersion, snapshot.Version)
+	assert.Equal(t, DefaultAlgorithmName, snapshot.Algorithm)
+	assert.Equal(t, scaling.MetricGPUUtilization, snapshot.DrivingMetric)
+	assert.Equal(t, int32(2), snapshot.CurrentReplicas)
+	assert.Equal(t, int32(4), snapshot.DesiredReplicas)
+	assert.Empty(t, snapshot.Constraints)
+
+	// A decision held back by cooldown records the constraint
+	clock.SetTime(clock.Now().Add(30 * time.Second))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	snapshot = lastDecision()
+	assert.Equal(t, int32(4), snapshot.DesiredReplicas)
+	assert.Equal(t, []snapshotConstraint{{Name: ConstraintCooldown, From: 8, To: 4, Delta: -4}}, snapshot.Constraints)
+}
+
+func TestReconcileReportsDecisionConstraints(t *testing.T) {
+	scheme := newTestScheme(t)
+	policy := lockTestPolicy("constraints")
+	policy.Spec.MaxReplicas = 6
+	policy.Spec.ScaleUp = &kubeaiv1alpha1.ScaleBehavior{Policies: []kubeaiv1alpha1.ScalingPolicy{
+		{Type: ScalingPolicyPods, Value: 1, PeriodSeconds: 60},
+	}}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, deployment).
+		WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{GPUUtilizationValue: 400}, scaling.DefaultRegistry, nil)
+	r.Clock = clocktesting.NewFakePassiveClock(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "constraints", Namespace: "default"}}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	stored := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, stored))
+
+	// Reported without spec.decisionSnapshot, which only controls lastDecision
+	assert.Empty(t, stored.Status.LastDecision)
+	assert.Equal(t, []kubeaiv1alpha1.DecisionConstraint{
+		{Name: ConstraintMaxReplicas, From: 16, To: 6, Delta: -10},
+		{Name: ConstraintScalingPolicy, From: 6, To: 3, Delta: -3},
+	}, stored.Status.DecisionConstraints)
+	assert.Equal(t, int32(3), stored.Status.DesiredReplicas)
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// ScalingPolicyPods limits the change to a number of replicas per period
+	ScalingPolicyPods = "Pods"
+	// ScalingPolicyPercent limits the change to a percentage of the replicas per period
+	ScalingPolicyPercent = "Percent"
+
+	// SelectPolicyMax applies the policy allowing the largest change
+	SelectPolicyMax = "Max"
+	// SelectPolicyMin applies the policy allowing the smallest change
+	SelectPolicyMin = "Min"
+	// SelectPolicyDisabled disables scaling in the direction
+	SelectPolicyDisabled = "Disabled"
+
+	// ReasonScaleUpStabilized indicates scale-up was held to the lowest recommendation in the window
+	ReasonScaleUpStabilized = "ScaleUpStabilized"
+	// ReasonScaleDownStabilized indicates scale-down was held to the highest recommendation in the window
+	ReasonScaleDownStabilized = "ScaleDownStabilized"
+	// ReasonScaleUpLimited indicates scale-up was capped by the scaleUp policies
+	ReasonScaleUpLimited = "ScaleUpLimited"
+	// ReasonScaleDownLimited indicates scale-down was capped by the scaleDown policies
+	ReasonScaleDownLimited = "ScaleDownLimited"
+)
+
+// timestampedRecommendation is a recommendation kept for the stabilization windows
+type timestampedRecommendation struct {
+	replicas  int32
+	timestamp time.Time
+}
+
+// timestampedScaleEvent is a replica change kept for the rate policies
+type timestampedScaleEvent struct {
+	change    int32
+	timestamp time.Time
+}
+
+// stabilizationWindow returns the window of a behavior, or zero when none is configured
+func stabilizationWindow(behavior *kubeaiv1alpha1.ScaleBehavior) time.Duration {
+	if behavior == nil {
+		return 0
+	}
+	return time.Duration(behavior.StabilizationWindowSeconds) * time.Second
+}
+
+// longestPeriod returns the longest period of a behavior's policies
+func longestPeriod(behavior *kubeaiv1alpha1.ScaleBehavior) time.Duration {
+	var longest time.Duration
+	if behavior == nil {
+		return longest
+	}
+	for _, p := range behavior.Policies {
+		longest = max(longest, time.Duration(p.PeriodSeconds)*time.Second)
+	}
+	return longest
+}
+
+// applyBehavior applies the scaleUp and scaleDown behavior to the recommendation:
+// the stabilization windows, then the rate policies, then disabled directions.
+// Like the HorizontalPodAutoscaler, scale-up uses the lowest recommendation seen
+// within its window and scale-down the highest, and when several policies apply
+// selectPolicy picks the one allowing the largest or the smallest change. The behavior is read from decision,
+// which carries the defaults of the model profile, and conditions are set on policy.
+// Each step that changes the replicas is recorded in snapshot.
+func (r *AIInferenceAutoscalerPolicyReconciler) applyBehavior(
+	ctx context.Context,
+	policy, decision *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	policyKey string,
+	currentReplicas, recommendedReplicas int32,
+	now time.Time,
+	snapshot *decisionSnapshot,
+) int32 {
+	desiredReplicas := r.stabilize(decision, policyKey, currentReplicas, recommendedReplicas, now)
+	snapshot.constrain(ConstraintStabilizationWindow, desiredReplicas)
+
+	var reason, message string
+	if desiredReplicas != recommendedReplicas {
+		direction := scaleDirection(currentReplicas, recommendedReplicas)
+		reason = ReasonScaleUpStabilized
+		if direction == "down" {
+			reason = ReasonScaleDownStabilized
+		}
+		message = fmt.Sprintf("Recommendation of %d replicas held at %d by the scale %s stabilization window",
+			recommendedReplicas, desiredReplicas, direction)
+	}
+	if limited := r.limitRate(decision, policyKey, currentReplicas, desiredReplicas, now); limited != desiredReplicas {
+		direction := scaleDirection(currentReplicas, desiredReplicas)
+		reason = ReasonScaleUpLimited
+		if direction == "down" {
+			reason = ReasonScaleDownLimited
+		}
+		message = fmt.Sprintf("Scale %s from %d to %d replicas capped at %d by the scale %s policies",
+			direction, currentReplicas, desiredReplicas, limited, direction)
+		desiredReplicas = limited
+		snapshot.constrain(ConstraintScalingPolicy, desiredReplicas)
+	}
+
+	if reason == "" {
+		desiredReplicas = r.applyDisabledDirections(ctx, policy, currentReplicas, desiredReplicas)
+		snapshot.constrain(ConstraintDirectionDisabled, desiredReplicas)
+		return desiredReplicas
+	}
+	if behavior := behaviorFor(decision, currentReplicas, desiredReplicas); directionDisabled(behavior) {
+		desiredReplicas = r.applyDisabledDirections(ctx, policy, currentReplicas, desiredReplicas)
+		snapshot.constrain(ConstraintDirectionDisabled, desiredReplicas)
+		return desiredReplicas
+	}
+	r.updateCondition(ctx, policy, ConditionTypeScalingLimited, metav1.ConditionTrue, reason, message)
+	return desiredReplicas
+}
+
+// stabilize records the recommendation and returns the replicas allowed by the
+// stabilization windows
+func (r *AIInferenceAutoscalerPolicyReconciler) stabilize(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	policyKey string,
+	currentReplicas, recommendedReplicas int32,
+	now time.Time,
+) int32 {
+	upWindow := stabilizationWindow(policy.Spec.ScaleUp)
+	downWindow := stabilizationWindow(policy.Spec.ScaleDown)
+
+	r.behaviorMu.Lock()
+	defer r.behaviorMu.Unlock()
+	if r.recommendations == nil {
+		r.recommendations = make(map[string][]timestampedRecommendation)
+	}
+
+	upRecommendation, downRecommendation := recommendedReplicas, recommendedReplicas
+	keep := r.recommendations[policyKey][:0]
+	for _, rec := range r.recommendations[policyKey] {
+		if rec.timestamp.After(now.Add(-upWindow)) {
+			upRecommendation = min(upRecommendation, rec.replicas)
+		}
+		if rec.timestamp.After(now.Add(-downWindow)) {
+			downRecommendation = max(downRecommendation, rec.replicas)
+		}
+		if rec.timestamp.After(now.Add(-max(upWindow, downWindow))) {
+			keep = append(keep, rec)
+		}
+	}
+	r.recommendations[policyKey] = append(keep, timestampedRecommendation{replicas: recommendedReplicas, timestamp: now})
+
+	desiredReplicas := currentReplicas
+	if desiredReplicas < upRecommendation {
+		desiredReplicas = upRecommendation
+	}
+	if desiredReplicas > downRecommendation {
+		desiredReplicas = downRecommendation
+	}
+	return desiredReplicas
+}
+
+// limitRate caps the change to what the policies of its direction allow in their periods
+func (r *AIInferenceAutoscalerPolicyReconciler) limitRate(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	policyKey string,
+	currentReplicas, desiredReplicas int32,
+	now time.Time,
+) int32 {
+	behavior := behaviorFor(policy, currentReplicas, desiredReplicas)
+	if behavior == nil || len(behavior.Policies) == 0 {
+		return desiredReplicas
+	}
+
+	r.behaviorMu.Lock()
+	defer r.behaviorMu.Unlock()
+	upEvents, downEvents := r.scaleUpEvents[policyKey], r.scaleDownEvents[policyKey]
+
+	// Max keeps the most permissive limit, Min the most restrictive
+	selectMin := behavior.SelectPolicy == SelectPolicyMin
+	if desiredReplicas > currentReplicas {
+		limit := int32(math.MinInt32)
+		if selectMin {
+			limit = math.MaxInt32
+		}
+		for _, p := range behavior.Policies {
+			period := time.Duration(p.PeriodSeconds) * time.Second
+			periodStart := currentReplicas - replicaChangeSince(upEvents, now, period) + replicaChangeSince(downEvents, now, period)
+			proposed := periodStart + p.Value
+			if p.Type == ScalingPolicyPercent {
+				proposed = int32(math.Ceil(float64(periodStart) * (1 + float64(p.Value)/100)))
+			}
+			if selectMin {
+				limit = min(limit, proposed)
+			} else {
+				limit = max(limit, proposed)
+			}
+		}
+		return min(desiredReplicas, max(limit, currentReplicas))
+	}
+
+	limit := int32(math.MaxInt32)
+	if selectMin {
+		limit = math.MinInt32
+	}
+	for _, p := range behavior.Policies {
+		period := time.Duration(p.PeriodSeconds) * time.Second
+		periodStart := currentReplicas + replicaChangeSince(downEvents, now, period) - replicaChangeSince(upEvents, now, period)
+		proposed := periodStart - p.Value
+		if p.Type == ScalingPolicyPercent {
+			proposed = int32(float64(periodStart) * (1 - float64(p.Value)/100))
+		}
+		if selectMin {
+			limit = max(limit, proposed)
+		} else {
+			limit = min(limit, proposed)
+		}
+	}
+	return max(desiredReplicas, min(limit, currentReplicas))
+}
+
+// replicaChangeSince sums the replica changes within the period before now
+func replicaChangeSince(events []timestampedScaleEvent, now time.Time, period time.Duration) int32 {
+	var change int32
+	for _, event := range events {
+		if event.timestamp.After(now.Add(-period)) {
+			change += event.change
+		}
+	}
+	return change
+}
+
+// forgetBehavior drops the recommendations and scale events of a deleted policy
+func (r *AIInferenceAutoscalerPolicyReconciler) forgetBehavior(policyKey string) {
+	r.behaviorMu.Lock()
+	defer r.behaviorMu.Unlock()
+	delete(r.recommendations, policyKey)
+	delete(r.scaleUpEvents, policyKey)
+	delete(r.scaleDownEvents, policyKey)
+}
+
+// recordScaleEvent remembers a replica change for the rate policies of its direction
+func (r *AIInferenceAutoscalerPolicyReconciler) recordScaleEvent(
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	policyKey string,
+	currentReplicas, desiredReplicas int32,
+	now time.Time,
+) {
+	r.behaviorMu.Lock()
+	defer r.behaviorMu.Unlock()
+	if r.scaleUpEvents == nil {
+		r.scaleUpEvents = make(map[string][]timestampedScaleEvent)
+		r.scaleDownEvents = make(map[string][]timestampedScaleEvent)
+	}
+
+	record := func(events map[string][]timestampedScaleEvent, behavior *kubeaiv1alpha1.ScaleBehavior, change int32) {
+		period := longestPeriod(behavior)
+		keep := events[policyKey][:0]
+		for _, event := range events[policyKey] {
+			if event.timestamp.After(now.Add(-period)) {
+				keep = append(keep, event)
+			}
+		}
+		if change > 0 && period > 0 {
+			keep = append(keep, timestampedScaleEvent{change: change, timestamp: now})
+		}
+		if len(keep) == 0 {
+			delete(events, policyKey)
+			return
+		}
+		events[policyKey] = keep
+	}
+	record(r.scaleUpEvents, policy.Spec.ScaleUp, max(desiredReplicas-currentReplicas, 0))
+	record(r.scaleDownEvents, policy.Spec.ScaleDown, max(currentReplicas-desiredReplicas, 0))
+}
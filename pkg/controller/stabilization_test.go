@@ -0,0 +1,159 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestStabilize(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			ScaleUp:   &kubeaiv1alpha1.ScaleBehavior{StabilizationWindowSeconds: 60},
+			ScaleDown: &kubeaiv1alpha1.ScaleBehavior{StabilizationWindowSeconds: 300},
+		},
+	}
+	r := &AIInferenceAutoscalerPolicyReconciler{}
+	const key = "default/policy"
+
+	// A spike is held back until it has persisted for the scale-up window
+	assert.Equal(t, int32(4), r.stabilize(policy, key, 4, 4, now))
+	assert.Equal(t, int32(4), r.stabilize(policy, key, 4, 8, now.Add(30*time.Second)))
+	assert.Equal(t, int32(6), r.stabilize(policy, key, 4, 6, now.Add(60*time.Second)))
+	assert.Equal(t, int32(6), r.stabilize(policy, key, 4, 8, now.Add(91*time.Second)))
+	assert.Equal(t, int32(8), r.stabilize(policy, key, 6, 8, now.Add(121*time.Second)))
+
+	// Scale-down follows the highest recommendation of the last five minutes
+	assert.Equal(t, int32(8), r.stabilize(policy, key, 8, 2, now.Add(3*time.Minute)))
+	assert.Equal(t, int32(8), r.stabilize(policy, key, 8, 2, now.Add(6*time.Minute)))
+	assert.Equal(t, int32(2), r.stabilize(policy, key, 8, 2, now.Add(7*time.Minute+30*time.Second)))
+
+	// Recommendations older than both windows are dropped
+	assert.Len(t, r.recommendations[key], 3)
+}
+
+func TestLimitRate(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			ScaleUp: &kubeaiv1alpha1.ScaleBehavior{Policies: []kubeaiv1alpha1.ScalingPolicy{
+				{Type: ScalingPolicyPods, Value: 4, PeriodSeconds: 60},
+				{Type: ScalingPolicyPercent, Value: 100, PeriodSeconds: 60},
+			}},
+			ScaleDown: &kubeaiv1alpha1.ScaleBehavior{Policies: []kubeaiv1alpha1.ScalingPolicy{
+				{Type: ScalingPolicyPercent, Value: 50, PeriodSeconds: 120},
+			}},
+		},
+	}
+	r := &AIInferenceAutoscalerPolicyReconciler{}
+	const key = "default/policy"
+
+	// The policy allowing the largest change wins: +4 pods beats +100% of 2
+	assert.Equal(t, int32(6), r.limitRate(policy, key, 2, 20, now))
+	// ...and +100% beats +4 pods from 10
+	assert.Equal(t, int32(20), r.limitRate(policy, key, 10, 30, now))
+
+	// Changes made earlier in the period count against the limit
+	r.recordScaleEvent(policy, key, 2, 6, now)
+	assert.Equal(t, int32(6), r.limitRate(policy, key, 6, 20, now.Add(30*time.Second)))
+	assert.Equal(t, int32(12), r.limitRate(policy, key, 6, 20, now.Add(61*time.Second)))
+
+	// Scale-down may remove half the replicas per two minutes
+	r = &AIInferenceAutoscalerPolicyReconciler{}
+	assert.Equal(t, int32(5), r.limitRate(policy, key, 10, 1, now))
+	r.recordScaleEvent(policy, key, 10, 5, now)
+	assert.Equal(t, int32(5), r.limitRate(policy, key, 5, 1, now.Add(time.Minute)))
+	assert.Equal(t, int32(2), r.limitRate(policy, key, 5, 1, now.Add(121*time.Second)))
+
+	// Directions without policies are not limited
+	policy.Spec.ScaleDown = nil
+	assert.Equal(t, int32(1), r.limitRate(policy, key, 5, 1, now))
+}
+
+func TestLimitRateSelectMin(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			ScaleUp: &kubeaiv1alpha1.ScaleBehavior{SelectPolicy: SelectPolicyMin, Policies: []kubeaiv1alpha1.ScalingPolicy{
+				{Type: ScalingPolicyPods, Value: 4, PeriodSeconds: 60},
+				{Type: ScalingPolicyPercent, Value: 100, PeriodSeconds: 60},
+			}},
+			ScaleDown: &kubeaiv1alpha1.ScaleBehavior{SelectPolicy: SelectPolicyMin, Policies: []kubeaiv1alpha1.ScalingPolicy{
+				{Type: ScalingPolicyPods, Value: 1, PeriodSeconds: 60},
+				{Type: ScalingPolicyPercent, Value: 50, PeriodSeconds: 60},
+			}},
+		},
+	}
+	r := &AIInferenceAutoscalerPolicyReconciler{}
+	const key = "default/policy"
+
+	// The policy allowing the smallest change wins: +100% of 2 beats +4 pods
+	assert.Equal(t, int32(4), r.limitRate(policy, key, 2, 20, now))
+	// ...and +4 pods beats +100% from 10
+	assert.Equal(t, int32(14), r.limitRate(policy, key, 10, 30, now))
+	// -1 pod beats -50% of 10
+	assert.Equal(t, int32(9), r.limitRate(policy, key, 10, 1, now))
+}
+
+func TestApplyBehaviorConditions(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			ScaleUp: &kubeaiv1alpha1.ScaleBehavior{Policies: []kubeaiv1alpha1.ScalingPolicy{
+				{Type: ScalingPolicyPods, Value: 2, PeriodSeconds: 60},
+			}},
+			ScaleDown: &kubeaiv1alpha1.ScaleBehavior{StabilizationWindowSeconds: 300},
+		},
+	}
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).WithStatusSubresource(policy).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	const key = "default/policy"
+
+	snapshot := &decisionSnapshot{DesiredReplicas: 10}
+	assert.Equal(t, int32(6), r.applyBehavior(ctx, policy, policy, key, 4, 10, now, snapshot))
+	assert.True(t, r.hasCondition(policy, ConditionTypeScalingLimited, metav1.ConditionTrue, ReasonScaleUpLimited))
+	assert.Equal(t, []snapshotConstraint{{Name: ConstraintScalingPolicy, From: 10, To: 6, Delta: -4}}, snapshot.Constraints)
+
+	snapshot = &decisionSnapshot{DesiredReplicas: 3}
+	assert.Equal(t, int32(6), r.applyBehavior(ctx, policy, policy, key, 6, 3, now.Add(time.Minute), snapshot))
+	assert.True(t, r.hasCondition(policy, ConditionTypeScalingLimited, metav1.ConditionTrue, ReasonScaleDownStabilized))
+	assert.Equal(t, []snapshotConstraint{{Name: ConstraintStabilizationWindow, From: 3, To: 6, Delta: 3}}, snapshot.Constraints)
+
+	policy.Spec.ScaleDown = nil
+	snapshot = &decisionSnapshot{DesiredReplicas: 3}
+	assert.Equal(t, int32(3), r.applyBehavior(ctx, policy, policy, key, 6, 3, now.Add(2*time.Minute), snapshot))
+	assert.True(t, r.hasCondition(policy, ConditionTypeScalingLimited, metav1.ConditionFalse, "NotLimited"))
+	assert.Empty(t, snapshot.Constraints)
+
+	policy.Spec.ScaleDown = &kubeaiv1alpha1.ScaleBehavior{Disabled: true}
+	snapshot = &decisionSnapshot{DesiredReplicas: 3}
+	assert.Equal(t, int32(6), r.applyBehavior(ctx, policy, policy, key, 6, 3, now.Add(3*time.Minute), snapshot))
+	assert.Equal(t, []snapshotConstraint{{Name: ConstraintDirectionDisabled, From: 3, To: 6, Delta: 3}}, snapshot.Constraints)
+}
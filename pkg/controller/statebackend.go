@@ -0,0 +1,254 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MaxStateChunkBytes caps the state held by one ConfigMap, leaving room
+	// below the 1MiB object limit for metadata
+	MaxStateChunkBytes = 900 << 10
+	// StateChunksAnnotation on the state ConfigMap counts the ConfigMaps the
+	// state is split across: the ConfigMap itself, then <name>-1, <name>-2 and so on
+	StateChunksAnnotation = "kubeai.io/state-chunks"
+)
+
+// StateBackend stores the algorithm state store between controller instances
+type StateBackend interface {
+	// Load returns the saved state, or nil when none has been saved
+	Load(ctx context.Context) (map[string]map[string]float64, error)
+	// Save replaces the saved state
+	Save(ctx context.Context, state map[string]map[string]float64) error
+	// String identifies where the state is kept, for logs
+	String() string
+}
+
+// ConfigMapStateBackend keeps the state as JSON in ConfigMaps. State larger
+// than a ConfigMap can hold, such as the seasonal forecasts of many policies,
+// is split into chunks across several ConfigMaps.
+type ConfigMapStateBackend struct {
+	// Client writes the ConfigMaps
+	Client client.Client
+	// Reader reads the ConfigMaps, bypassing the cache
+	Reader client.Reader
+	// Key names the first ConfigMap
+	Key types.NamespacedName
+	// ChunkBytes caps the state held by one ConfigMap; MaxStateChunkBytes when zero
+	ChunkBytes int
+}
+
+var _ StateBackend = &ConfigMapStateBackend{}
+
+// String returns the key of the first ConfigMap
+func (b *ConfigMapStateBackend) String() string {
+	return "configmap " + b.Key.String()
+}
+
+// chunkKey names the ConfigMap holding the i-th chunk of the state
+func (b *ConfigMapStateBackend) chunkKey(i int) types.NamespacedName {
+	if i == 0 {
+		return b.Key
+	}
+	return types.NamespacedName{Namespace: b.Key.Namespace, Name: fmt.Sprintf("%s-%d", b.Key.Name, i)}
+}
+
+// chunks returns the number of ConfigMaps the state in cm is split across
+func chunks(cm *corev1.ConfigMap) (int, error) {
+	value, ok := cm.Annotations[StateChunksAnnotation]
+	if !ok {
+		return 1, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid %s annotation %q", StateChunksAnnotation, value)
+	}
+	return n, nil
+}
+
+// Load joins the chunks of the state and decodes it
+func (b *ConfigMapStateBackend) Load(ctx context.Context) (map[string]map[string]float64, error) {
+	cm := &corev1.ConfigMap{}
+	if err := b.Reader.Get(ctx, b.Key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	first, ok := cm.Data[StateConfigMapKey]
+	if !ok {
+		return nil, nil
+	}
+	n, err := chunks(cm)
+	if err != nil {
+		return nil, err
+	}
+
+	var data strings.Builder
+	data.WriteString(first)
+	for i := 1; i < n; i++ {
+		chunk := &corev1.ConfigMap{}
+		if err := b.Reader.Get(ctx, b.chunkKey(i), chunk); err != nil {
+			return nil, fmt.Errorf("reading state chunk %d: %w", i, err)
+		}
+		data.WriteString(chunk.Data[StateConfigMapKey])
+	}
+
+	var state map[string]map[string]float64
+	if err := json.Unmarshal([]byte(data.String()), &state); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", StateConfigMapKey, err)
+	}
+	return state, nil
+}
+
+// Save encodes the state and writes it in chunks. The first ConfigMap, which
+// counts the chunks, is written last so it never counts chunks not yet
+// written; chunks left over from a larger state are emptied.
+func (b *ConfigMapStateBackend) Save(ctx context.Context, state map[string]map[string]float64) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	size := b.ChunkBytes
+	if size <= 0 {
+		size = MaxStateChunkBytes
+	}
+	var parts []string
+	for len(data) > size {
+		parts = append(parts, string(data[:size]))
+		data = data[size:]
+	}
+	parts = append(parts, string(data))
+
+	previous := 1
+	cm := &corev1.ConfigMap{}
+	if err := b.Reader.Get(ctx, b.Key, cm); err == nil {
+		// An invalid count is overwritten below; only valid chunks are emptied
+		previous, _ = chunks(cm)
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+	for i := previous - 1; i >= len(parts); i-- {
+		if err := b.write(ctx, i, "", nil); err != nil {
+			return fmt.Errorf("emptying state chunk %d: %w", i, err)
+		}
+	}
+	for i := len(parts) - 1; i > 0; i-- {
+		if err := b.write(ctx, i, parts[i], nil); err != nil {
+			return fmt.Errorf("writing state chunk %d: %w", i, err)
+		}
+	}
+	return b.write(ctx, 0, parts[0], map[string]string{StateChunksAnnotation: strconv.Itoa(len(parts))})
+}
+
+// write stores a chunk of the state in its ConfigMap, creating it if needed
+func (b *ConfigMapStateBackend) write(ctx context.Context, i int, data string, annotations map[string]string) error {
+	key := b.chunkKey(i)
+	cm := &corev1.ConfigMap{}
+	err := b.Reader.Get(ctx, key, cm)
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, Annotations: annotations},
+			Data:       map[string]string{StateConfigMapKey: data},
+		}
+		return b.Client.Create(ctx, cm)
+	}
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[StateConfigMapKey] = data
+	for name, value := range annotations {
+		if cm.Annotations == nil {
+			cm.Annotations = map[string]string{}
+		}
+		cm.Annotations[name] = value
+	}
+	return b.Client.Update(ctx, cm)
+}
+
+// FileStateBackend keeps the state as JSON in a file, typically on a
+// PersistentVolume mounted by the controller, so it is not bound by the size
+// of a ConfigMap
+type FileStateBackend struct {
+	Path string
+}
+
+var _ StateBackend = &FileStateBackend{}
+
+// String returns the path of the file
+func (b *FileStateBackend) String() string {
+	return "file " + b.Path
+}
+
+// Load reads and decodes the file
+func (b *FileStateBackend) Load(_ context.Context) (map[string]map[string]float64, error) {
+	data, err := os.ReadFile(b.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state map[string]map[string]float64
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", b.Path, err)
+	}
+	return state, nil
+}
+
+// Save writes the state to a temporary file that then replaces the file, so
+// a crash mid-write leaves the previous state intact
+func (b *FileStateBackend) Save(_ context.Context, state map[string]map[string]float64) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(b.Path), "."+filepath.Base(b.Path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), b.Path)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigMapStateBackendChunks(t *testing.T) {
+	key := types.NamespacedName{Namespace: "kubeai-system", Name: DefaultStateConfigMap}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+	backend := &ConfigMapStateBackend{Client: c, Reader: c, Key: key, ChunkBytes: 16}
+	ctx := context.Background()
+
+	// Nothing saved yet
+	state, err := backend.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	large := map[string]map[string]float64{
+		"default/llm": {"predictive.level": 4, "predictive.trend": 0.5},
+		"prod/chat":   {"smoothing": 2},
+	}
+	require.NoError(t, backend.Save(ctx, large))
+	first := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, key, first))
+	assert.Len(t, first.Data[StateConfigMapKey], 16)
+	assert.NotEqual(t, "1", first.Annotations[StateChunksAnnotation])
+	state, err = backend.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, large, state)
+
+	// Chunks a smaller state no longer needs are emptied
+	small := map[string]map[string]float64{"a": {"b": 1}}
+	require.NoError(t, backend.Save(ctx, small))
+	require.NoError(t, c.Get(ctx, key, first))
+	assert.Equal(t, "1", first.Annotations[StateChunksAnnotation])
+	last := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, backend.chunkKey(2), last))
+	assert.Empty(t, last.Data[StateConfigMapKey])
+	state, err = backend.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, small, state)
+}
+
+func TestConfigMapStateBackendMissingChunk(t *testing.T) {
+	key := types.NamespacedName{Namespace: "kubeai-system", Name: DefaultStateConfigMap}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+	backend := &ConfigMapStateBackend{Client: c, Reader: c, Key: key, ChunkBytes: 16}
+	ctx := context.Background()
+	require.NoError(t, backend.Save(ctx, map[string]map[string]float64{"default/llm": {"smoothing": 2}}))
+
+	chunk := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, backend.chunkKey(1), chunk))
+	require.NoError(t, c.Delete(ctx, chunk))
+	_, err := backend.Load(ctx)
+	assert.ErrorContains(t, err, "reading state chunk 1")
+}
+
+func TestFileStateBackend(t *testing.T) {
+	backend := &FileStateBackend{Path: filepath.Join(t.TempDir(), "state.json")}
+	ctx := context.Background()
+
+	state, err := backend.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	saved := map[string]map[string]float64{"default/llm": {"predictive.season.3": 1.5}}
+	require.NoError(t, backend.Save(ctx, saved))
+	state, err = backend.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, saved, state)
+
+	// Only the state file is left behind
+	entries, err := filepath.Glob(filepath.Join(filepath.Dir(backend.Path), "*"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{backend.Path}, entries)
+}
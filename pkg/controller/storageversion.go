@@ -0,0 +1,105 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/migration"
+)
+
+const (
+	// ConditionTypeStorageVersionOutdated indicates the policy may still be
+	// stored at a version the CRD is migrating away from
+	ConditionTypeStorageVersionOutdated = "StorageVersionOutdated"
+
+	// DefaultStorageVersionCheckInterval is how long the stored versions of the
+	// CRD are reused before they are read again
+	DefaultStorageVersionCheckInterval = 10 * time.Minute
+)
+
+// StorageVersionChecker reads the versions policies are stored at from the
+// CRD, at most once per interval, so every reconcile can report policies
+// that still need a storage migration without reading the CRD each time
+type StorageVersionChecker struct {
+	reader   client.Reader
+	interval time.Duration
+	clock    clock.PassiveClock
+
+	mu       sync.Mutex
+	versions []string
+	checked  time.Time
+}
+
+// NewStorageVersionChecker reads the CRD with reader, which should bypass
+// the cache so the controller does not watch every CRD
+func NewStorageVersionChecker(reader client.Reader) *StorageVersionChecker {
+	return &StorageVersionChecker{reader: reader, interval: DefaultStorageVersionCheckInterval, clock: clock.RealClock{}}
+}
+
+// StoredVersions returns the stored versions of the CRD. When the CRD
+// cannot be read the last versions read are returned with the error.
+func (c *StorageVersionChecker) StoredVersions(ctx context.Context) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	if !c.checked.IsZero() && now.Sub(c.checked) < c.interval {
+		return c.versions, nil
+	}
+	versions, err := migration.StoredVersions(ctx, c.reader)
+	if err != nil {
+		return c.versions, err
+	}
+	c.versions = versions
+	c.checked = now
+	return versions, nil
+}
+
+// reportStorageVersion sets the StorageVersionOutdated condition while old
+// stored versions remain and the policy has not been migrated, and clears it
+// once it has
+func (r *AIInferenceAutoscalerPolicyReconciler) reportStorageVersion(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) {
+	if r.StorageVersions == nil {
+		return
+	}
+	storedVersions, err := r.StorageVersions.StoredVersions(ctx)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read the stored versions of the CRD")
+	}
+	if !migration.Outdated(policy, storedVersions) {
+		if r.isConditionTrue(policy, ConditionTypeStorageVersionOutdated) {
+			r.updateCondition(ctx, policy, ConditionTypeStorageVersionOutdated, metav1.ConditionFalse, "Migrated",
+				fmt.Sprintf("Policy is stored at %s", migration.StorageVersion))
+		}
+		return
+	}
+	if !r.isConditionTrue(policy, ConditionTypeStorageVersionOutdated) {
+		r.updateCondition(ctx, policy, ConditionTypeStorageVersionOutdated, metav1.ConditionTrue, "MigrationPending",
+			fmt.Sprintf("Policies are stored at %s and this one has not been rewritten at %s; run kubectl kubeai migrate-storage",
+				strings.Join(storedVersions, ", "), migration.StorageVersion))
+	}
+}
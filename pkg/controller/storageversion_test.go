@@ -0,0 +1,78 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/pmady/kubeai-autoscaler/pkg/metrics"
+	"github.com/pmady/kubeai-autoscaler/pkg/migration"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestReportStorageVersion(t *testing.T) {
+	scheme := newTestScheme(t)
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: migration.CRDName},
+		Status:     apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha0", migration.StorageVersion}},
+	}
+	policy := lockTestPolicy("policy")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd, policy).
+		WithStatusSubresource(crd, policy).Build()
+	r := NewReconciler(c, scheme, &metrics.MockClient{}, scaling.DefaultRegistry, nil)
+	checker := NewStorageVersionChecker(c)
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC))
+	checker.clock = fakeClock
+	r.StorageVersions = checker
+	ctx := context.Background()
+
+	r.reportStorageVersion(ctx, policy)
+	assert.True(t, r.isConditionTrue(policy, ConditionTypeStorageVersionOutdated))
+
+	// A migrated policy is current even while others are not
+	policy.Annotations = map[string]string{migration.StorageVersionAnnotation: migration.StorageVersion}
+	r.reportStorageVersion(ctx, policy)
+	assert.False(t, r.isConditionTrue(policy, ConditionTypeStorageVersionOutdated))
+
+	// Once the migration completes every policy is current, after the stored
+	// versions are read again
+	policy.Annotations = nil
+	require.NoError(t, migration.Complete(ctx, c))
+	r.reportStorageVersion(ctx, policy)
+	assert.True(t, r.isConditionTrue(policy, ConditionTypeStorageVersionOutdated))
+	fakeClock.SetTime(fakeClock.Now().Add(DefaultStorageVersionCheckInterval))
+	r.reportStorageVersion(ctx, policy)
+	assert.False(t, r.isConditionTrue(policy, ConditionTypeStorageVersionOutdated))
+}
+
+func TestReportStorageVersionDisabled(t *testing.T) {
+	policy := lockTestPolicy("policy")
+	r := NewReconciler(nil, newTestScheme(t), &metrics.MockClient{}, scaling.DefaultRegistry, nil)
+
+	r.reportStorageVersion(context.Background(), policy)
+	assert.Empty(t, policy.Status.Conditions)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// ConditionTypeSuspended indicates the policy is suspended by spec.suspend
+const ConditionTypeSuspended = "Suspended"
+
+// applySuspend holds replicas at the current count while the policy is
+// suspended. Metrics are still fetched and the recommendation is still
+// reported, so operators can see what would happen once it is resumed.
+func (r *AIInferenceAutoscalerPolicyReconciler) applySuspend(
+	ctx context.Context,
+	policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy,
+	currentReplicas, recommendedReplicas int32,
+) int32 {
+	if !policy.Spec.Suspend {
+		if r.isConditionTrue(policy, ConditionTypeSuspended) {
+			r.updateCondition(ctx, policy, ConditionTypeSuspended, metav1.ConditionFalse,
+				"Resumed", "Policy is no longer suspended")
+		}
+		return recommendedReplicas
+	}
+
+	log.FromContext(ctx).Info("Policy is suspended, holding replicas",
+		"current", currentReplicas,
+		"recommended", recommendedReplicas)
+	r.updateCondition(ctx, policy, ConditionTypeSuspended, metav1.ConditionTrue, "PolicySuspended",
+		fmt.Sprintf("spec.suspend is true; recommending %d replicas, holding at %d", recommendedReplicas, currentReplicas))
+	return currentReplicas
+}
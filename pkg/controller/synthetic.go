@@ -0,0 +1,90 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+	"github.com/pmady/kubeai-autoscaler/pkg/synthetic"
+)
+
+// DefaultSyntheticProbeInterval is how often a synthetic request is sent when the policy sets no interval
+const DefaultSyntheticProbeInterval = 60 * time.Second
+
+// resolveSyntheticLatency sets the latency of the policy's latest synthetic
+// request. The request runs in the background, so a probe whose first request
+// has not completed yet is left out of the fetch instead of counting as missing.
+func (r *AIInferenceAutoscalerPolicyReconciler) resolveSyntheticLatency(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, currentMetrics *kubeaiv1alpha1.CurrentMetrics, missing *missingMetrics) {
+	if r.SyntheticProber == nil {
+		return
+	}
+	policyKey := fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)
+	probe := policy.Spec.Metrics.SyntheticProbe
+	if probe == nil || !probe.Enabled {
+		r.SyntheticProber.Forget(policyKey)
+		return
+	}
+
+	interval := DefaultSyntheticProbeInterval
+	if probe.IntervalSeconds > 0 {
+		interval = time.Duration(probe.IntervalSeconds) * time.Second
+	}
+	latency, err := r.SyntheticProber.Latency(policyKey, r.syntheticRequest(policy.Namespace, probe), interval)
+	if errors.Is(err, synthetic.ErrNoResult) {
+		return
+	}
+	if value, ok := missing.resolve(scaling.MetricSyntheticLatencyMs, probe.OnMissing, probe.MaxStalenessSeconds,
+		float64(latency.Milliseconds()), err); ok {
+		currentMetrics.SyntheticLatencyMs = int32(value) // #nosec G115 - bounded by the 300s timeout
+	}
+}
+
+// syntheticRequest builds the request of a synthetic probe. The payload is
+// read from its ConfigMap when the request is sent, so edits apply to the next one.
+func (r *AIInferenceAutoscalerPolicyReconciler) syntheticRequest(namespace string, probe *kubeaiv1alpha1.SyntheticProbeMetric) synthetic.Request {
+	req := synthetic.Request{
+		URL:         probe.Endpoint,
+		Method:      probe.Method,
+		ContentType: probe.ContentType,
+		Timeout:     time.Duration(probe.TimeoutSeconds) * time.Second,
+	}
+	if ref := probe.PayloadRef; ref != nil {
+		name, key := ref.Name, ref.Key
+		req.Body = func(ctx context.Context) ([]byte, error) {
+			configMap := &corev1.ConfigMap{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, configMap); err != nil {
+				return nil, err
+			}
+			if payload, ok := configMap.Data[key]; ok {
+				return []byte(payload), nil
+			}
+			if payload, ok := configMap.BinaryData[key]; ok {
+				return payload, nil
+			}
+			return nil, fmt.Errorf("ConfigMap %s/%s has no key %q", namespace, name, key)
+		}
+	}
+	return req
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+func TestSyntheticProbeLatency(t *testing.T) {
+	bodies := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		bodies <- req.Header.Get("Content-Type") + " " + string(body)
+		time.Sleep(20 * time.Millisecond)
+		_, _ = io.WriteString(w, `{"choices": [{"text": "ok"}]}`)
+	}))
+	defer server.Close()
+
+	scheme := newTestScheme(t)
+	payload := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"},
+		Data:       map[string]string{"request.json": `{"prompt": "ping", "max_tokens": 8}`},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(payload).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				SyntheticProbe: &kubeaiv1alpha1.SyntheticProbeMetric{
+					Enabled:         true,
+					Endpoint:        server.URL + "/v1/completions",
+					PayloadRef:      &kubeaiv1alpha1.PayloadReference{Name: "probe", Key: "request.json"},
+					TargetLatencyMs: ptr.To(resource.MustParse("2000")),
+				},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	// The first request runs in the background and does not fail the fetch
+	current, err := r.fetchMetrics(ctx, policy)
+	require.NoError(t, err)
+	assert.Zero(t, current.SyntheticLatencyMs)
+
+	assert.Eventually(t, func() bool {
+		current, err = r.fetchMetrics(ctx, policy)
+		return err == nil && current.SyntheticLatencyMs > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.GreaterOrEqual(t, current.SyntheticLatencyMs, int32(20))
+	assert.Equal(t, `application/json {"prompt": "ping", "max_tokens": 8}`, <-bodies)
+}
+
+func TestSyntheticProbeFailure(t *testing.T) {
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := NewReconciler(c, scheme, nil, scaling.DefaultRegistry, nil)
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				SyntheticProbe: &kubeaiv1alpha1.SyntheticProbeMetric{
+					Enabled:         true,
+					Endpoint:        "http://127.0.0.1:1/v1/completions",
+					PayloadRef:      &kubeaiv1alpha1.PayloadReference{Name: "missing", Key: "request.json"},
+					TargetLatencyMs: ptr.To(resource.MustParse("2000")),
+					OnMissing:       MissingFailClosed,
+				},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	// A payload that cannot be read fails the request like an unreachable endpoint
+	assert.Eventually(t, func() bool {
+		_, err := r.fetchMetrics(ctx, policy)
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err := r.fetchMetrics(ctx, policy)
+	assert.ErrorContains(t, err, "reading synthetic request payload")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// tokenCookie holds the token entered on the login page
+const tokenCookie = "kubeai-dashboard-token"
+
+var (
+	// ErrUnauthenticated is returned for a token the API server does not accept
+	ErrUnauthenticated = errors.New("token is invalid or expired")
+	// ErrForbidden is returned for a user who may not access policies
+	ErrForbidden = errors.New("not allowed to access aiinferenceautoscalerpolicies")
+)
+
+// Authenticator checks the bearer token of a dashboard request and returns
+// the name of its user
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// AuthenticatorFunc adapts a function to Authenticator
+type AuthenticatorFunc func(ctx context.Context, token string) (string, error)
+
+// Authenticate calls f
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (string, error) {
+	return f(ctx, token)
+}
+
+// APIServerAuthenticator delegates to the kube-apiserver: a TokenReview
+// authenticates the token and a SubjectAccessReview checks that its user may
+// list policies, so the dashboard shows nothing kubectl would not
+type APIServerAuthenticator struct {
+	Client client.Client
+	// Audiences the token must be issued for; empty accepts the API server's own
+	Audiences []string
+	// Verb the user must be allowed on policies in every namespace; list when empty
+	Verb string
+}
+
+var _ Authenticator = &APIServerAuthenticator{}
+
+// Authenticate reviews the token and the access of its user
+func (a *APIServerAuthenticator) Authenticate(ctx context.Context, token string) (string, error) {
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token, Audiences: a.Audiences},
+	}
+	if err := a.Client.Create(ctx, review); err != nil {
+		return "", fmt.Errorf("token review failed: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return "", ErrUnauthenticated
+	}
+
+	verb := a.Verb
+	if verb == "" {
+		verb = "list"
+	}
+	user := review.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for key, values := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(values)
+	}
+	access := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    kubeaiv1alpha1.GroupVersion.Group,
+				Resource: "aiinferenceautoscalerpolicies",
+				Verb:     verb,
+			},
+		},
+	}
+	if err := a.Client.Create(ctx, access); err != nil {
+		return "", fmt.Errorf("subject access review failed: %w", err)
+	}
+	if !access.Status.Allowed {
+		return "", fmt.Errorf("%w: %s may not %s them", ErrForbidden, user.Username, verb)
+	}
+	return user.Username, nil
+}
+
+// requestToken returns the bearer token of the Authorization header, or the
+// token entered on the login page
+func requestToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		if token, ok := strings.CutPrefix(header, "Bearer "); ok {
+			return strings.TrimSpace(token)
+		}
+		return ""
+	}
+	if cookie, err := r.Cookie(tokenCookie); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
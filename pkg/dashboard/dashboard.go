@@ -0,0 +1,167 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dashboard serves a read-only web view of the autoscaler policies:
+// their metrics against targets, recent decisions and conditions
+package dashboard
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"html/template"
+	"net/http"
+	"time"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=list
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// Server serves the dashboard. Every page but the login page needs a token
+// accepted by the Authenticator.
+type Server struct {
+	addr          string
+	reader        client.Reader
+	authenticator Authenticator
+	clock         clock.PassiveClock
+	pages         map[string]*template.Template
+}
+
+var _ manager.Runnable = &Server{}
+var _ manager.LeaderElectionRunnable = &Server{}
+
+// NewServer creates a dashboard bound to addr that reads policies and their
+// events with reader
+func NewServer(addr string, reader client.Reader, authenticator Authenticator) *Server {
+	s := &Server{addr: addr, reader: reader, authenticator: authenticator, clock: clock.RealClock{}}
+	funcs := template.FuncMap{"age": s.age}
+	s.pages = make(map[string]*template.Template)
+	for _, page := range []string{"index.html", "policy.html", "login.html"} {
+		s.pages[page] = template.Must(template.New(page).Funcs(funcs).ParseFS(templateFS, "templates/layout.html", "templates/"+page))
+	}
+	return s
+}
+
+// Handler returns the dashboard routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /{$}", s.authenticate(s.listPolicies))
+	mux.Handle("GET /policies/{namespace}/{name}", s.authenticate(s.showPolicy))
+	mux.HandleFunc("GET /login", s.loginForm)
+	mux.HandleFunc("POST /login", s.login)
+	return mux
+}
+
+// NeedLeaderElection is false so every controller replica serves the dashboard
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the dashboard until ctx is done
+func (s *Server) Start(ctx context.Context) error {
+	server := &http.Server{Addr: s.addr, Handler: s.Handler(), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	log.FromContext(ctx).Info("Serving dashboard", "address", s.addr)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// authenticate serves next only for requests with an accepted token. Requests
+// without one are sent to the login page.
+func (s *Server) authenticate(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := requestToken(r)
+		if token == "" {
+			http.Redirect(w, r, "/login", http.StatusSeeOther)
+			return
+		}
+		user, err := s.authenticator.Authenticate(r.Context(), token)
+		if err != nil {
+			s.authError(w, r, err)
+			return
+		}
+		log.FromContext(r.Context()).V(1).Info("Dashboard request", "user", user, "path", r.URL.Path)
+		next(w, r)
+	})
+}
+
+// authError renders the login page with the reason a token was refused
+func (s *Server) authError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusUnauthorized
+	switch {
+	case errors.Is(err, ErrForbidden):
+		status = http.StatusForbidden
+	case !errors.Is(err, ErrUnauthenticated):
+		log.FromContext(r.Context()).Error(err, "Dashboard authentication failed")
+		status = http.StatusInternalServerError
+	}
+	w.WriteHeader(status)
+	s.render(w, r, "login.html", loginView{Error: err.Error()})
+}
+
+// loginView is the data of the login page
+type loginView struct {
+	Error string
+}
+
+func (s *Server) loginForm(w http.ResponseWriter, r *http.Request) {
+	s.render(w, r, "login.html", loginView{})
+}
+
+// login checks the entered token and keeps it in a cookie for later requests
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	token := r.PostFormValue("token")
+	if token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		s.render(w, r, "login.html", loginView{Error: "a token is required"})
+		return
+	}
+	if _, err := s.authenticator.Authenticate(r.Context(), token); err != nil {
+		s.authError(w, r, err)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     tokenCookie,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// render executes a page, logging failures the response can no longer report
+func (s *Server) render(w http.ResponseWriter, r *http.Request, page string, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := s.pages[page].ExecuteTemplate(w, "layout", data); err != nil {
+		log.FromContext(r.Context()).Error(err, "Failed to render dashboard page", "page", page)
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+var now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// testAuthenticator accepts "good", refuses "denied" access and rejects the rest
+var testAuthenticator = AuthenticatorFunc(func(_ context.Context, token string) (string, error) {
+	switch token {
+	case "good":
+		return "alice", nil
+	case "denied":
+		return "", fmt.Errorf("%w: bob", ErrForbidden)
+	default:
+		return "", ErrUnauthenticated
+	}
+})
+
+func newTestServer(t *testing.T, objects ...client.Object) *Server {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, kubeaiv1alpha1.AddToScheme(scheme))
+	reader := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithIndex(&corev1.Event{}, "involvedObject.uid", func(o client.Object) []string {
+			return []string{string(o.(*corev1.Event).InvolvedObject.UID)}
+		}).
+		Build()
+	s := NewServer(":0", reader, testAuthenticator)
+	s.clock = clocktesting.NewFakePassiveClock(now)
+	return s
+}
+
+func get(s *Server, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func testPolicy() *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	return &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default", UID: "policy-uid"},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef:   kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			MaxReplicas: 10,
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				Latency:        &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{Enabled: true, TargetPercentage: 70},
+			},
+		},
+		Status: kubeaiv1alpha1.AIInferenceAutoscalerPolicyStatus{
+			CurrentReplicas:  2,
+			DesiredReplicas:  3,
+			CurrentMetrics:   &kubeaiv1alpha1.CurrentMetrics{LatencyP99Ms: 620, GPUUtilizationPercent: 85},
+			EffectiveTargets: &kubeaiv1alpha1.MetricTargets{GPUUtilizationPercent: 60},
+			MetricHistory: []kubeaiv1alpha1.MetricHistory{
+				kubeaiv1alpha1.NewMetricHistory("gpuUtilizationPercent", 0, []float64{60, 70, 85}),
+			},
+			LastDecision: "ScaleUp",
+			Conditions: []metav1.Condition{{
+				Type: "Ready", Status: metav1.ConditionTrue, Reason: "Scaled",
+				LastTransitionTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+			}},
+		},
+	}
+}
+
+func TestDashboardAuthentication(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := get(s, "/", "")
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, "/login", rec.Header().Get("Location"))
+
+	rec = get(s, "/", "expired")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrUnauthenticated.Error())
+
+	rec = get(s, "/", "denied")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = get(s, "/login", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `name="token"`)
+}
+
+func TestDashboardLogin(t *testing.T) {
+	s := newTestServer(t)
+	login := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(url.Values{"token": {token}}.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		s.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := login("expired")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, rec.Result().Cookies())
+
+	rec = login("good")
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, tokenCookie, cookies[0].Name)
+	assert.True(t, cookies[0].HttpOnly)
+
+	// The cookie authenticates later requests
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(cookies[0])
+	rec = httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestDashboardListsPolicies(t *testing.T) {
+	s := newTestServer(t, testPolicy())
+
+	rec := get(s, "/", "good")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, `<a href="/policies/default/llm">llm</a>`)
+	assert.Contains(t, body, "Deployment/llm")
+	assert.Contains(t, body, "latencyP99Ms: 620 ms / 500 ms")
+	// The adjusted target of the last decision is shown
+	assert.Contains(t, body, "gpuUtilizationPercent: 85% / 60%")
+	assert.Contains(t, body, `<td class="True">True</td>`)
+}
+
+func TestDashboardShowsPolicy(t *testing.T) {
+	event := func(name, reason string, age time.Duration) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "AIInferenceAutoscalerPolicy", Name: "llm", UID: "policy-uid"},
+			Reason:         reason,
+			Type:           corev1.EventTypeNormal,
+			LastTimestamp:  metav1.NewTime(now.Add(-age)),
+		}
+	}
+	other := event("other", "NotOurs", time.Minute)
+	other.InvolvedObject.UID = "other-uid"
+	s := newTestServer(t, testPolicy(),
+		event("older", "ScaledDown", time.Hour), event("newer", "ScaledUp", 2*time.Minute), other)
+
+	rec := get(s, "/policies/default/llm", "good")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "<polyline points=")
+	assert.Contains(t, body, "<td>Scaled</td>")
+	assert.Contains(t, body, "<td>5m ago</td>")
+	assert.NotContains(t, body, "NotOurs")
+	// Newest decision first
+	assert.Less(t, strings.Index(body, "ScaledUp"), strings.Index(body, "ScaledDown"))
+
+	assert.Equal(t, http.StatusNotFound, get(s, "/policies/default/missing", "good").Code)
+}
+
+func TestAPIServerAuthenticator(t *testing.T) {
+	var reviewedUser string
+	c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			switch review := obj.(type) {
+			case *authenticationv1.TokenReview:
+				if review.Spec.Token == "good" || review.Spec.Token == "denied" {
+					review.Status.Authenticated = true
+					review.Status.User = authenticationv1.UserInfo{Username: review.Spec.Token, Groups: []string{"ops"}}
+				}
+			case *authorizationv1.SubjectAccessReview:
+				reviewedUser = review.Spec.User
+				attrs := review.Spec.ResourceAttributes
+				review.Status.Allowed = review.Spec.User == "good" &&
+					attrs.Group == "kubeai.io" && attrs.Resource == "aiinferenceautoscalerpolicies" && attrs.Verb == "list"
+			}
+			return nil
+		},
+	}).Build()
+	a := &APIServerAuthenticator{Client: c}
+	ctx := context.Background()
+
+	user, err := a.Authenticate(ctx, "good")
+	require.NoError(t, err)
+	assert.Equal(t, "good", user)
+
+	_, err = a.Authenticate(ctx, "denied")
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Equal(t, "denied", reviewedUser)
+
+	_, err = a.Authenticate(ctx, "expired")
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	// Another verb is checked in place of list
+	a.Verb = "update"
+	_, err = a.Authenticate(ctx, "good")
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Empty(t, sparkline([]float64{5}))
+	assert.Equal(t, "0.0,24.0 60.0,0.0 120.0,12.0", sparkline([]float64{0, 10, 5}))
+	assert.Equal(t, "0.0,12.0 120.0,12.0", sparkline([]float64{3, 3}))
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/controller"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
+)
+
+// maxEvents bounds the decisions shown for a policy
+const maxEvents = 50
+
+// policyRow summarizes a policy in the policy list
+type policyRow struct {
+	Namespace       string
+	Name            string
+	Target          string
+	CurrentReplicas int32
+	DesiredReplicas int32
+	// Ready is the status of the Ready condition, Unknown before the first reconcile
+	Ready        string
+	LastDecision string
+	Metrics      []metricRow
+}
+
+// metricRow is the current value of an enabled metric and its target
+type metricRow struct {
+	Name    string
+	Current string
+	// Target is empty for metrics without one, such as the arrival rate
+	Target string
+	// Points are the SVG polyline points of the metric's recorded history
+	Points string
+}
+
+// policyView is the data of a policy page
+type policyView struct {
+	policyRow
+	Algorithm       string
+	LastScaleTime   *metav1.Time
+	LastScaleReason string
+	Conditions      []metav1.Condition
+	Events          []corev1.Event
+}
+
+func (s *Server) listPolicies(w http.ResponseWriter, r *http.Request) {
+	policies := &kubeaiv1alpha1.AIInferenceAutoscalerPolicyList{}
+	if err := s.reader.List(r.Context(), policies); err != nil {
+		log.FromContext(r.Context()).Error(err, "Failed to list policies for the dashboard")
+		http.Error(w, "failed to list policies", http.StatusInternalServerError)
+		return
+	}
+	sort.Slice(policies.Items, func(i, j int) bool {
+		a, b := policies.Items[i], policies.Items[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	rows := make([]policyRow, 0, len(policies.Items))
+	for i := range policies.Items {
+		rows = append(rows, newPolicyRow(&policies.Items[i]))
+	}
+	s.render(w, r, "index.html", rows)
+}
+
+func (s *Server) showPolicy(w http.ResponseWriter, r *http.Request) {
+	key := types.NamespacedName{Namespace: r.PathValue("namespace"), Name: r.PathValue("name")}
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	if err := s.reader.Get(r.Context(), key, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("policy %s not found", key), http.StatusNotFound)
+			return
+		}
+		log.FromContext(r.Context()).Error(err, "Failed to get policy for the dashboard", "policy", key)
+		http.Error(w, "failed to get policy", http.StatusInternalServerError)
+		return
+	}
+
+	// Decisions are recorded as events on the policy; a failure to read them
+	// still shows the rest of the page
+	events := &corev1.EventList{}
+	if err := s.reader.List(r.Context(), events, client.InNamespace(policy.Namespace),
+		client.MatchingFields{"involvedObject.uid": string(policy.UID)}); err != nil {
+		log.FromContext(r.Context()).Error(err, "Failed to list policy events for the dashboard", "policy", key)
+	}
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return eventTime(&events.Items[i]).After(eventTime(&events.Items[j]))
+	})
+	if len(events.Items) > maxEvents {
+		events.Items = events.Items[:maxEvents]
+	}
+
+	algorithm := policy.Status.LastAlgorithm
+	if algorithm == "" && policy.Spec.Algorithm != nil {
+		algorithm = policy.Spec.Algorithm.Name
+	}
+	if algorithm == "" {
+		algorithm = controller.DefaultAlgorithmName
+	}
+	s.render(w, r, "policy.html", policyView{
+		policyRow:       newPolicyRow(policy),
+		Algorithm:       algorithm,
+		LastScaleTime:   policy.Status.LastScaleTime,
+		LastScaleReason: policy.Status.LastScaleReason,
+		Conditions:      policy.Status.Conditions,
+		Events:          events.Items,
+	})
+}
+
+// newPolicyRow summarizes a policy
+func newPolicyRow(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) policyRow {
+	ready := string(metav1.ConditionUnknown)
+	if condition := meta.FindStatusCondition(policy.Status.Conditions, controller.ConditionTypeReady); condition != nil {
+		ready = string(condition.Status)
+	}
+	return policyRow{
+		Namespace:       policy.Namespace,
+		Name:            policy.Name,
+		Target:          policy.Spec.TargetRef.Kind + "/" + policy.Spec.TargetRef.Name,
+		CurrentReplicas: policy.Status.CurrentReplicas,
+		DesiredReplicas: policy.Status.DesiredReplicas,
+		Ready:           ready,
+		LastDecision:    policy.Status.LastDecision,
+		Metrics:         metricRows(policy),
+	}
+}
+
+// metricRows returns the enabled metrics of a policy with their current
+// values and targets. Targets adjusted by the last decision, such as by a
+// targetModulation window, are shown as adjusted.
+func metricRows(policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) []metricRow {
+	spec := policy.Spec.Metrics
+	current := policy.Status.CurrentMetrics
+	if current == nil {
+		current = &kubeaiv1alpha1.CurrentMetrics{}
+	}
+	effective := policy.Status.EffectiveTargets
+	if effective == nil {
+		effective = &kubeaiv1alpha1.MetricTargets{}
+	}
+	target := func(configured, adjusted int32) int32 {
+		if adjusted > 0 {
+			return adjusted
+		}
+		return configured
+	}
+	quantityTarget := func(configured, adjusted *resource.Quantity) string {
+		if value := kubeaiv1alpha1.QuantityFloat64(adjusted); value > 0 {
+			return formatFloat(value)
+		}
+		return formatFloat(kubeaiv1alpha1.QuantityFloat64(configured))
+	}
+
+	var rows []metricRow
+	add := func(name, currentValue, targetValue string) {
+		rows = append(rows, metricRow{Name: name, Current: currentValue, Target: targetValue})
+	}
+	if l := spec.Latency; l != nil && l.Enabled {
+		if kubeaiv1alpha1.QuantityFloat64(l.TargetP99Ms) > 0 {
+			add(scaling.MetricLatencyP99Ms, formatFloat(current.LatencyP99Ms)+" ms",
+				quantityTarget(l.TargetP99Ms, effective.LatencyP99Ms)+" ms")
+		}
+		if kubeaiv1alpha1.QuantityFloat64(l.TargetP95Ms) > 0 {
+			add(scaling.MetricLatencyP95Ms, formatFloat(current.LatencyP95Ms)+" ms",
+				quantityTarget(l.TargetP95Ms, effective.LatencyP95Ms)+" ms")
+		}
+	}
+	if g := spec.GPUUtilization; g != nil && g.Enabled {
+		add(scaling.MetricGPUUtilization, fmt.Sprintf("%d%%", current.GPUUtilizationPercent),
+			fmt.Sprintf("%d%%", target(g.TargetPercentage, effective.GPUUtilizationPercent)))
+	}
+	if c := spec.CPUUtilization; c != nil && c.Enabled {
+		add(scaling.MetricCPUUtilization, fmt.Sprintf("%d%%", current.CPUUtilizationPercent),
+			fmt.Sprintf("%d%%", c.TargetAverageUtilization))
+	}
+	if m := spec.MemoryUtilization; m != nil && m.Enabled {
+		add(scaling.MetricMemoryUtilization, fmt.Sprintf("%d%%", current.MemoryUtilizationPercent),
+			fmt.Sprintf("%d%%", m.TargetAverageUtilization))
+	}
+	if q := spec.RequestQueueDepth; q != nil && q.Enabled {
+		add(scaling.MetricRequestQueueDepth, strconv.Itoa(int(current.RequestQueueDepth)),
+			quantityTarget(q.TargetDepth, effective.RequestQueueDepth)+" per replica")
+	}
+	if t := spec.TokensPerSecond; t != nil && t.Enabled {
+		add(scaling.MetricTokensPerSecond, strconv.Itoa(int(current.TokensPerSecond)),
+			quantityTarget(t.TargetPerReplica, nil)+" per replica")
+	}
+	if f := spec.InFlightRequests; f != nil && f.Enabled {
+		add(scaling.MetricInFlightRequests, strconv.Itoa(int(current.InFlightRequests)),
+			quantityTarget(f.TargetPerReplica, nil)+" per replica")
+	}
+	if s := spec.SyntheticProbe; s != nil && s.Enabled {
+		add(scaling.MetricSyntheticLatencyMs, fmt.Sprintf("%d ms", current.SyntheticLatencyMs),
+			quantityTarget(s.TargetLatencyMs, nil)+" ms")
+	}
+	if q := spec.Queueing; q != nil && q.Enabled {
+		arrivalRate, serviceTime := "-", "-"
+		if current.ArrivalRate != nil {
+			arrivalRate = strconv.FormatFloat(*current.ArrivalRate, 'f', 2, 64) + " /s"
+		}
+		if current.ServiceTimeMs != nil {
+			serviceTime = formatFloat(*current.ServiceTimeMs) + " ms"
+		}
+		add(scaling.MetricArrivalRate, arrivalRate, "")
+		add("serviceTimeMs", serviceTime, "")
+	}
+	for i := range spec.CustomMetrics {
+		metric := &spec.CustomMetrics[i]
+		value := "-"
+		for _, v := range current.Custom {
+			if v.Name == metric.Name {
+				value = formatFloat(v.Value)
+				break
+			}
+		}
+		add(metric.Name, value, formatFloat(metric.TargetValue))
+	}
+
+	for i := range rows {
+		for j := range policy.Status.MetricHistory {
+			if history := &policy.Status.MetricHistory[j]; history.Name == rows[i].Name {
+				// A history that cannot be decoded is not drawn
+				values, _ := history.Values()
+				rows[i].Points = sparkline(values)
+				break
+			}
+		}
+	}
+	return rows
+}
+
+// Size of the metric history sparklines
+const (
+	sparklineWidth  = 120
+	sparklineHeight = 24
+)
+
+// formatFloat formats a metric value or target without trailing zeros
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', 6, 64)
+}
+
+// sparkline returns the SVG polyline points drawing values, scaled to the
+// sparkline size; empty for fewer than two values
+func sparkline(values []float64) string {
+	if len(values) < 2 {
+		return ""
+	}
+	low, high := values[0], values[0]
+	for _, v := range values {
+		low, high = math.Min(low, v), math.Max(high, v)
+	}
+	points := make([]string, len(values))
+	for i, v := range values {
+		x := float64(i) * sparklineWidth / float64(len(values)-1)
+		// A flat history is drawn through the middle
+		y := sparklineHeight / 2.0
+		if high > low {
+			y = sparklineHeight - (v-low)*sparklineHeight/(high-low)
+		}
+		points[i] = strconv.FormatFloat(x, 'f', 1, 64) + "," + strconv.FormatFloat(y, 'f', 1, 64)
+	}
+	return strings.Join(points, " ")
+}
+
+// eventTime is when an event last occurred
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// age formats how long ago a time was, as kubectl does
+func (s *Server) age(t any) string {
+	var at time.Time
+	switch v := t.(type) {
+	case metav1.Time:
+		at = v.Time
+	case *metav1.Time:
+		if v == nil {
+			return "never"
+		}
+		at = v.Time
+	case corev1.Event:
+		at = eventTime(&v)
+	default:
+		return ""
+	}
+	if at.IsZero() {
+		return "never"
+	}
+	return duration.HumanDuration(s.clock.Since(at)) + " ago"
+}
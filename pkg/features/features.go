@@ -0,0 +1,86 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package features defines the feature gates of the controller, which turn
+// on features that are not yet enabled for everyone
+package features
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MetricHistory records the last samples of every metric in policy status
+	MetricHistory = "MetricHistory"
+	// ManagedPodMonitors creates the PodMonitors requested by spec.podMonitor
+	ManagedPodMonitors = "ManagedPodMonitors"
+)
+
+// defaults lists every feature gate and whether it is enabled by default
+var defaults = map[string]bool{
+	MetricHistory:      false,
+	ManagedPodMonitors: false,
+}
+
+// Gates records the feature gates set explicitly; the others keep their default
+type Gates map[string]bool
+
+// Enabled reports whether the feature is enabled
+func (g Gates) Enabled(feature string) bool {
+	if enabled, ok := g[feature]; ok {
+		return enabled
+	}
+	return defaults[feature]
+}
+
+// Known returns the names of all feature gates, sorted
+func Known() []string {
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Parse parses a comma-separated list of Name=true|false pairs, such as
+// MetricHistory=true. Unknown names are rejected.
+func Parse(value string) (Gates, error) {
+	gates := Gates{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, setting, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("feature gate %q must be Name=true or Name=false", pair)
+		}
+		name = strings.TrimSpace(name)
+		if _, known := defaults[name]; !known {
+			return nil, fmt.Errorf("unknown feature gate %q (known: %s)", name, strings.Join(Known(), ", "))
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(setting))
+		if err != nil {
+			return nil, fmt.Errorf("feature gate %s: %w", name, err)
+		}
+		gates[name] = enabled
+	}
+	return gates, nil
+}
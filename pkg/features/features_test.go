@@ -0,0 +1,47 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	gates, err := Parse("")
+	require.NoError(t, err)
+	assert.False(t, gates.Enabled(MetricHistory), "disabled by default")
+
+	gates, err = Parse(" MetricHistory=true ")
+	require.NoError(t, err)
+	assert.True(t, gates.Enabled(MetricHistory))
+
+	_, err = Parse("MetricHistory")
+	assert.ErrorContains(t, err, "must be Name=true or Name=false")
+	_, err = Parse("MetricHistory=yes")
+	assert.ErrorContains(t, err, "feature gate MetricHistory")
+	_, err = Parse("Unknown=true")
+	assert.ErrorContains(t, err, `unknown feature gate "Unknown" (known: ManagedPodMonitors, MetricHistory)`)
+}
+
+func TestNilGatesUseDefaults(t *testing.T) {
+	var gates Gates
+	assert.False(t, gates.Enabled(MetricHistory))
+	assert.False(t, gates.Enabled("Unknown"))
+}
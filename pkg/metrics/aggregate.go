@@ -0,0 +1,91 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+)
+
+// Aggregations combining the samples returned by a custom metric query
+const (
+	AggregationAverage = "Average"
+	AggregationSum     = "Sum"
+	AggregationMax     = "Max"
+	AggregationMin     = "Min"
+)
+
+// VectorQuerier is implemented by clients that can return every sample of a
+// query rather than only the first one
+type VectorQuerier interface {
+	QueryVector(ctx context.Context, query string) ([]float64, error)
+}
+
+// aggregatedQuerier is implemented by clients that aggregate over their own backends
+type aggregatedQuerier interface {
+	QueryAggregated(ctx context.Context, query, aggregation string) (float64, error)
+}
+
+// QueryAggregated runs query on c and combines its samples with aggregation.
+// Clients that do not implement VectorQuerier answer with their first series.
+func QueryAggregated(ctx context.Context, c Client, query, aggregation string) (float64, error) {
+	switch q := c.(type) {
+	case aggregatedQuerier:
+		return q.QueryAggregated(ctx, query, aggregation)
+	case VectorQuerier:
+		values, err := q.QueryVector(ctx, query)
+		if err != nil {
+			return 0, err
+		}
+		return Aggregate(values, aggregation)
+	default:
+		return Query(ctx, c, query)
+	}
+}
+
+// Aggregate combines values with aggregation; an empty aggregation averages
+func Aggregate(values []float64, aggregation string) (float64, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("no samples to aggregate")
+	}
+
+	switch aggregation {
+	case AggregationSum, AggregationAverage, "":
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		if aggregation == AggregationSum {
+			return sum, nil
+		}
+		return sum / float64(len(values)), nil
+	case AggregationMax:
+		result := values[0]
+		for _, v := range values[1:] {
+			result = max(result, v)
+		}
+		return result, nil
+	case AggregationMin:
+		result := values[0]
+		for _, v := range values[1:] {
+			result = min(result, v)
+		}
+		return result, nil
+	default:
+		return 0, fmt.Errorf("unsupported aggregation: %s", aggregation)
+	}
+}
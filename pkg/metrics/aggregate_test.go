@@ -0,0 +1,86 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type vectorClient struct {
+	MockClient
+	values []float64
+}
+
+func (c *vectorClient) QueryVector(_ context.Context, _ string) ([]float64, error) {
+	return c.values, c.Error
+}
+
+func TestAggregate(t *testing.T) {
+	values := []float64{4, 1, 7}
+	tests := []struct {
+		aggregation string
+		expected    float64
+	}{
+		{aggregation: "", expected: 4},
+		{aggregation: AggregationAverage, expected: 4},
+		{aggregation: AggregationSum, expected: 12},
+		{aggregation: AggregationMax, expected: 7},
+		{aggregation: AggregationMin, expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.aggregation, func(t *testing.T) {
+			value, err := Aggregate(values, tt.aggregation)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+
+	_, err := Aggregate(nil, AggregationSum)
+	assert.Error(t, err)
+	_, err = Aggregate(values, "P99")
+	assert.Error(t, err)
+}
+
+func TestQueryAggregated(t *testing.T) {
+	ctx := context.Background()
+
+	// Vector-capable clients are aggregated across every sample
+	value, err := QueryAggregated(ctx, &vectorClient{values: []float64{10, 30}}, "q", AggregationSum)
+	require.NoError(t, err)
+	assert.Equal(t, 40.0, value)
+
+	// Other clients answer with their single query value
+	value, err = QueryAggregated(ctx, &MockClient{QueryValue: 3}, "q", AggregationSum)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, value)
+
+	// Several servers aggregate independently before their results are merged
+	multi, err := NewMultiClient([]Client{
+		&vectorClient{MockClient: MockClient{Error: errors.New("connection refused")}},
+		&vectorClient{values: []float64{2, 6}},
+		&vectorClient{values: []float64{8, 12}},
+	}, MergeMax)
+	require.NoError(t, err)
+	value, err = QueryAggregated(ctx, multi, "q", AggregationAverage)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, value)
+}
@@ -0,0 +1,441 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Environment variables read by DefaultAWSCredentials, as set by the AWS SDKs'
+// conventions, IAM roles for service accounts and EKS Pod Identity
+const (
+	envAWSAccessKeyID        = "AWS_ACCESS_KEY_ID"
+	envAWSSecretAccessKey    = "AWS_SECRET_ACCESS_KEY"
+	envAWSSessionToken       = "AWS_SESSION_TOKEN"
+	envAWSRoleARN            = "AWS_ROLE_ARN"
+	envAWSWebIdentityToken   = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	envAWSRoleSessionName    = "AWS_ROLE_SESSION_NAME"
+	envAWSContainerCredsURI  = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
+	envAWSContainerAuthToken = "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"
+)
+
+// defaultRoleSessionName names the sessions of roles the controller assumes
+const defaultRoleSessionName = "kubeai-autoscaler"
+
+// credentialsRefreshMargin is how long before they expire temporary
+// credentials are refreshed
+const credentialsRefreshMargin = 5 * time.Minute
+
+// AWSCredentials sign requests to AWS APIs
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set for temporary credentials
+	SessionToken string
+	// Expires is when temporary credentials stop working; zero for long-lived keys
+	Expires time.Time
+}
+
+// AWSCredentialsProvider supplies the credentials requests are signed with
+type AWSCredentialsProvider interface {
+	Retrieve(ctx context.Context) (AWSCredentials, error)
+}
+
+// AWSCredentialsFunc adapts a function to AWSCredentialsProvider
+type AWSCredentialsFunc func(ctx context.Context) (AWSCredentials, error)
+
+// Retrieve calls f
+func (f AWSCredentialsFunc) Retrieve(ctx context.Context) (AWSCredentials, error) {
+	return f(ctx)
+}
+
+// StaticAWSCredentials returns a provider of fixed credentials
+func StaticAWSCredentials(creds AWSCredentials) AWSCredentialsProvider {
+	return AWSCredentialsFunc(func(context.Context) (AWSCredentials, error) {
+		return creds, nil
+	})
+}
+
+// DefaultAWSCredentials returns the credentials configured in the environment
+// of the controller, in the order the AWS SDKs look for them: access keys,
+// then a web identity token of IAM roles for service accounts, then the
+// credentials endpoint of EKS Pod Identity. STS calls use the endpoint of region.
+func DefaultAWSCredentials(region string) (AWSCredentialsProvider, error) {
+	if id := os.Getenv(envAWSAccessKeyID); id != "" {
+		return StaticAWSCredentials(AWSCredentials{
+			AccessKeyID:     id,
+			SecretAccessKey: os.Getenv(envAWSSecretAccessKey),
+			SessionToken:    os.Getenv(envAWSSessionToken),
+		}), nil
+	}
+	if role, tokenFile := os.Getenv(envAWSRoleARN), os.Getenv(envAWSWebIdentityToken); role != "" && tokenFile != "" {
+		return NewCachingAWSCredentials(&WebIdentityCredentials{
+			RoleARN:     role,
+			TokenFile:   tokenFile,
+			SessionName: os.Getenv(envAWSRoleSessionName),
+			STSEndpoint: stsEndpoint(region),
+		}), nil
+	}
+	if uri := os.Getenv(envAWSContainerCredsURI); uri != "" {
+		return NewCachingAWSCredentials(&ContainerCredentials{
+			URI:       uri,
+			TokenFile: os.Getenv(envAWSContainerAuthToken),
+		}), nil
+	}
+	return nil, fmt.Errorf("no AWS credentials: set %s, use IAM roles for service accounts or EKS Pod Identity", envAWSAccessKeyID)
+}
+
+// stsEndpoint returns the regional STS endpoint, or the global one without a region
+func stsEndpoint(region string) string {
+	if region == "" {
+		return "https://sts.amazonaws.com"
+	}
+	return "https://sts." + region + ".amazonaws.com"
+}
+
+// CachingAWSCredentials reuses the credentials of a provider until shortly
+// before they expire
+type CachingAWSCredentials struct {
+	provider AWSCredentialsProvider
+	now      func() time.Time
+
+	mu    sync.Mutex
+	creds AWSCredentials
+}
+
+// NewCachingAWSCredentials caches the credentials of provider
+func NewCachingAWSCredentials(provider AWSCredentialsProvider) *CachingAWSCredentials {
+	return &CachingAWSCredentials{provider: provider, now: time.Now}
+}
+
+// Retrieve returns the cached credentials, refreshing them when they are about to expire
+func (c *CachingAWSCredentials) Retrieve(ctx context.Context) (AWSCredentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.creds.AccessKeyID != "" && (c.creds.Expires.IsZero() || c.now().Before(c.creds.Expires.Add(-credentialsRefreshMargin))) {
+		return c.creds, nil
+	}
+	creds, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		return AWSCredentials{}, err
+	}
+	c.creds = creds
+	return creds, nil
+}
+
+// WebIdentityCredentials exchanges the projected service account token of
+// IAM roles for service accounts for credentials of RoleARN
+type WebIdentityCredentials struct {
+	RoleARN string
+	// TokenFile is re-read on every exchange, as the kubelet rotates it
+	TokenFile   string
+	SessionName string
+	STSEndpoint string
+	HTTPClient  *http.Client
+}
+
+// Retrieve calls AssumeRoleWithWebIdentity, which needs no signature
+func (w *WebIdentityCredentials) Retrieve(ctx context.Context) (AWSCredentials, error) {
+	token, err := os.ReadFile(w.TokenFile) // #nosec G304 - path is set by the pod identity webhook
+	if err != nil {
+		return AWSCredentials{}, fmt.Errorf("reading web identity token: %w", err)
+	}
+	form := url.Values{}
+	form.Set("Action", "AssumeRoleWithWebIdentity")
+	form.Set("Version", "2011-06-15")
+	form.Set("RoleArn", w.RoleARN)
+	form.Set("RoleSessionName", sessionName(w.SessionName))
+	form.Set("WebIdentityToken", strings.TrimSpace(string(token)))
+	endpoint := w.STSEndpoint
+	if endpoint == "" {
+		endpoint = stsEndpoint("")
+	}
+	req, err := newAWSQueryRequest(ctx, endpoint, form)
+	if err != nil {
+		return AWSCredentials{}, err
+	}
+	var resp struct {
+		Credentials stsCredentials `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+	}
+	if err := doAWSQuery(httpClientOrDefault(w.HTTPClient), req, &resp); err != nil {
+		return AWSCredentials{}, fmt.Errorf("assuming role %s with web identity: %w", w.RoleARN, err)
+	}
+	return resp.Credentials.credentials(), nil
+}
+
+// AssumeRoleCredentials assumes RoleARN with the credentials of Base, such as
+// a role in another account trusting the controller's role
+type AssumeRoleCredentials struct {
+	Base        AWSCredentialsProvider
+	RoleARN     string
+	SessionName string
+	Region      string
+	STSEndpoint string
+	HTTPClient  *http.Client
+	now         func() time.Time
+}
+
+// Retrieve calls AssumeRole signed with the base credentials
+func (a *AssumeRoleCredentials) Retrieve(ctx context.Context) (AWSCredentials, error) {
+	base, err := a.Base.Retrieve(ctx)
+	if err != nil {
+		return AWSCredentials{}, err
+	}
+	form := url.Values{}
+	form.Set("Action", "AssumeRole")
+	form.Set("Version", "2011-06-15")
+	form.Set("RoleArn", a.RoleARN)
+	form.Set("RoleSessionName", sessionName(a.SessionName))
+	endpoint := a.STSEndpoint
+	if endpoint == "" {
+		endpoint = stsEndpoint(a.Region)
+	}
+	req, err := newAWSQueryRequest(ctx, endpoint, form)
+	if err != nil {
+		return AWSCredentials{}, err
+	}
+	region := a.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	now := time.Now
+	if a.now != nil {
+		now = a.now
+	}
+	if err := SignAWSRequest(req, []byte(form.Encode()), base, region, "sts", now()); err != nil {
+		return AWSCredentials{}, err
+	}
+	var resp struct {
+		Credentials stsCredentials `xml:"AssumeRoleResult>Credentials"`
+	}
+	if err := doAWSQuery(httpClientOrDefault(a.HTTPClient), req, &resp); err != nil {
+		return AWSCredentials{}, fmt.Errorf("assuming role %s: %w", a.RoleARN, err)
+	}
+	return resp.Credentials.credentials(), nil
+}
+
+// ContainerCredentials reads credentials from the endpoint of EKS Pod Identity
+// or an ECS task role
+type ContainerCredentials struct {
+	URI string
+	// TokenFile holds the authorization token of the endpoint, if it needs one
+	TokenFile  string
+	HTTPClient *http.Client
+}
+
+// Retrieve fetches the credentials from the endpoint
+func (c *ContainerCredentials) Retrieve(ctx context.Context) (AWSCredentials, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URI, nil)
+	if err != nil {
+		return AWSCredentials{}, err
+	}
+	if c.TokenFile != "" {
+		token, err := os.ReadFile(c.TokenFile) // #nosec G304 - path is set by the pod identity agent
+		if err != nil {
+			return AWSCredentials{}, fmt.Errorf("reading container credentials token: %w", err)
+		}
+		req.Header.Set("Authorization", strings.TrimSpace(string(token)))
+	}
+	resp, err := httpClientOrDefault(c.HTTPClient).Do(req)
+	if err != nil {
+		return AWSCredentials{}, fmt.Errorf("fetching container credentials: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return AWSCredentials{}, fmt.Errorf("container credentials endpoint returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	var creds struct {
+		AccessKeyID     string    `json:"AccessKeyId"`
+		SecretAccessKey string    `json:"SecretAccessKey"`
+		Token           string    `json:"Token"`
+		Expiration      time.Time `json:"Expiration"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&creds); err != nil {
+		return AWSCredentials{}, fmt.Errorf("decoding container credentials: %w", err)
+	}
+	return AWSCredentials{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.Token,
+		Expires:         creds.Expiration,
+	}, nil
+}
+
+// stsCredentials are the credentials in an STS response
+type stsCredentials struct {
+	AccessKeyID     string    `xml:"AccessKeyId"`
+	SecretAccessKey string    `xml:"SecretAccessKey"`
+	SessionToken    string    `xml:"SessionToken"`
+	Expiration      time.Time `xml:"Expiration"`
+}
+
+func (c stsCredentials) credentials() AWSCredentials {
+	return AWSCredentials{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		Expires:         c.Expiration,
+	}
+}
+
+func sessionName(name string) string {
+	if name == "" {
+		return defaultRoleSessionName
+	}
+	return name
+}
+
+func httpClientOrDefault(c *http.Client) *http.Client {
+	if c == nil {
+		return &http.Client{Timeout: 10 * time.Second}
+	}
+	return c
+}
+
+// newAWSQueryRequest builds a POST of form to an AWS Query API endpoint, at
+// its root unless it has a path, such as an SQS queue URL
+func newAWSQueryRequest(ctx context.Context, endpoint string, form url.Values) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	return req, nil
+}
+
+// awsErrorResponse is the body of a failed AWS Query API call
+type awsErrorResponse struct {
+	Error struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+}
+
+// doAWSQuery sends an AWS Query API request and decodes its XML response into out
+func doAWSQuery(c *http.Client, req *http.Request, out any) error {
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr awsErrorResponse
+		if xml.Unmarshal(body, &apiErr) == nil && apiErr.Error.Code != "" {
+			return fmt.Errorf("%s: %s", apiErr.Error.Code, apiErr.Error.Message)
+		}
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body[:min(len(body), 512)])))
+	}
+	if err := xml.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+// SignAWSRequest signs req with Signature Version 4 for service in region.
+// body must be the request body, which is hashed into the signature.
+func SignAWSRequest(req *http.Request, body []byte, creds AWSCredentials, region, service string, now time.Time) error {
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return errors.New("AWS credentials have no access key")
+	}
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+	return nil
+}
+
+// canonicalQuery encodes query parameters sorted by name, escaping spaces as %20
+func canonicalQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
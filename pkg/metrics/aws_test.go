@@ -0,0 +1,213 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var exampleAWSCredentials = AWSCredentials{
+	AccessKeyID:     "AKIDEXAMPLE",
+	SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+}
+
+func TestSignAWSRequest(t *testing.T) {
+	// The example request of the AWS Signature Version 4 documentation
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	require.NoError(t, SignAWSRequest(req, nil, exampleAWSCredentials, "us-east-1", "iam",
+		time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)))
+
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date, "+
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7", req.Header.Get("Authorization"))
+
+	// Session tokens are sent and signed
+	req, err = http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/", nil)
+	require.NoError(t, err)
+	creds := exampleAWSCredentials
+	creds.SessionToken = "session"
+	require.NoError(t, SignAWSRequest(req, nil, creds, "us-east-1", "iam", time.Now()))
+	assert.Equal(t, "session", req.Header.Get("X-Amz-Security-Token"))
+	assert.Contains(t, req.Header.Get("Authorization"), "SignedHeaders=host;x-amz-date;x-amz-security-token,")
+
+	assert.Error(t, SignAWSRequest(req, nil, AWSCredentials{}, "us-east-1", "iam", time.Now()))
+}
+
+const assumeRoleWithWebIdentityBody = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>ASIAWEB</AccessKeyId>
+      <SecretAccessKey>web-secret</SecretAccessKey>
+      <SessionToken>web-token</SessionToken>
+      <Expiration>2026-06-01T13:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`
+
+func TestWebIdentityCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("jwt\n"), 0o600))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "AssumeRoleWithWebIdentity", r.PostForm.Get("Action"))
+		assert.Equal(t, "arn:aws:iam::123456789012:role/autoscaler", r.PostForm.Get("RoleArn"))
+		assert.Equal(t, defaultRoleSessionName, r.PostForm.Get("RoleSessionName"))
+		assert.Equal(t, "jwt", r.PostForm.Get("WebIdentityToken"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(assumeRoleWithWebIdentityBody))
+	}))
+	defer server.Close()
+
+	provider := &WebIdentityCredentials{
+		RoleARN:     "arn:aws:iam::123456789012:role/autoscaler",
+		TokenFile:   tokenFile,
+		STSEndpoint: server.URL,
+	}
+	creds, err := provider.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, AWSCredentials{
+		AccessKeyID:     "ASIAWEB",
+		SecretAccessKey: "web-secret",
+		SessionToken:    "web-token",
+		Expires:         time.Date(2026, 6, 1, 13, 0, 0, 0, time.UTC),
+	}, creds)
+}
+
+func TestAssumeRoleCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("RoleArn") != "arn:aws:iam::210987654321:role/metrics-reader" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Code>AccessDenied</Code><Message>not allowed</Message></Error></ErrorResponse>`))
+			return
+		}
+		assert.Equal(t, "AssumeRole", r.PostForm.Get("Action"))
+		assert.Contains(t, r.Header.Get("Authorization"), "Credential=AKIDEXAMPLE/20260601/eu-west-1/sts/aws4_request")
+		_, _ = w.Write([]byte(`<AssumeRoleResponse><AssumeRoleResult><Credentials>
+			<AccessKeyId>ASIAROLE</AccessKeyId><SecretAccessKey>role-secret</SecretAccessKey>
+			<SessionToken>role-token</SessionToken><Expiration>2026-06-01T13:00:00Z</Expiration>
+		</Credentials></AssumeRoleResult></AssumeRoleResponse>`))
+	}))
+	defer server.Close()
+
+	provider := &AssumeRoleCredentials{
+		Base:        StaticAWSCredentials(exampleAWSCredentials),
+		RoleARN:     "arn:aws:iam::210987654321:role/metrics-reader",
+		Region:      "eu-west-1",
+		STSEndpoint: server.URL,
+		now:         func() time.Time { return time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC) },
+	}
+	creds, err := provider.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ASIAROLE", creds.AccessKeyID)
+	assert.Equal(t, "role-token", creds.SessionToken)
+
+	provider.RoleARN = "arn:aws:iam::210987654321:role/other"
+	_, err = provider.Retrieve(context.Background())
+	assert.ErrorContains(t, err, "AccessDenied: not allowed")
+}
+
+func TestContainerCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("pod-identity"), 0o600))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "pod-identity" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"AccessKeyId": "ASIAPOD", "SecretAccessKey": "pod-secret", "Token": "pod-token", "Expiration": "2026-06-01T13:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	creds, err := (&ContainerCredentials{URI: server.URL, TokenFile: tokenFile}).Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ASIAPOD", creds.AccessKeyID)
+	assert.Equal(t, "pod-token", creds.SessionToken)
+
+	_, err = (&ContainerCredentials{URI: server.URL}).Retrieve(context.Background())
+	assert.ErrorContains(t, err, "401")
+}
+
+func TestCachingAWSCredentials(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	var fail bool
+	cache := NewCachingAWSCredentials(AWSCredentialsFunc(func(context.Context) (AWSCredentials, error) {
+		if fail {
+			return AWSCredentials{}, errors.New("sts unavailable")
+		}
+		calls++
+		return AWSCredentials{AccessKeyID: "ASIA", SecretAccessKey: "secret", Expires: now.Add(time.Hour)}, nil
+	}))
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err := cache.Retrieve(ctx)
+	require.NoError(t, err)
+	_, err = cache.Retrieve(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// Credentials are refreshed shortly before they expire
+	now = now.Add(56 * time.Minute)
+	_, err = cache.Retrieve(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	now = now.Add(2 * time.Hour)
+	fail = true
+	_, err = cache.Retrieve(ctx)
+	assert.ErrorContains(t, err, "sts unavailable")
+}
+
+func TestDefaultAWSCredentials(t *testing.T) {
+	t.Setenv(envAWSAccessKeyID, "")
+	t.Setenv(envAWSRoleARN, "")
+	t.Setenv(envAWSContainerCredsURI, "")
+	_, err := DefaultAWSCredentials("us-east-1")
+	assert.Error(t, err)
+
+	t.Setenv(envAWSAccessKeyID, "AKID")
+	t.Setenv(envAWSSecretAccessKey, "secret")
+	provider, err := DefaultAWSCredentials("us-east-1")
+	require.NoError(t, err)
+	creds, err := provider.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, creds)
+
+	t.Setenv(envAWSAccessKeyID, "")
+	t.Setenv(envAWSRoleARN, "arn:aws:iam::123456789012:role/autoscaler")
+	t.Setenv(envAWSWebIdentityToken, "/var/run/secrets/eks.amazonaws.com/serviceaccount/token")
+	provider, err = DefaultAWSCredentials("us-east-1")
+	require.NoError(t, err)
+	web, ok := provider.(*CachingAWSCredentials).provider.(*WebIdentityCredentials)
+	require.True(t, ok)
+	assert.Equal(t, "https://sts.us-east-1.amazonaws.com", web.STSEndpoint)
+}
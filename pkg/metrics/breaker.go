@@ -0,0 +1,212 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+const (
+	// DefaultBreakerFailureThreshold is how many consecutive failed queries open the circuit
+	DefaultBreakerFailureThreshold = 5
+	// DefaultBreakerOpenDuration is how long an open circuit rejects queries before probing
+	DefaultBreakerOpenDuration = 30 * time.Second
+)
+
+// CircuitState is the state of a CircuitBreakerClient
+type CircuitState int
+
+// Circuit states, exported as the value of the circuit state gauge
+const (
+	// CircuitClosed passes queries to the backend
+	CircuitClosed CircuitState = iota
+	// CircuitHalfOpen lets a single probe query through to test the backend
+	CircuitHalfOpen
+	// CircuitOpen rejects queries without reaching the backend
+	CircuitOpen
+)
+
+// String returns the name of the state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitHalfOpen:
+		return "HalfOpen"
+	case CircuitOpen:
+		return "Open"
+	default:
+		return "Closed"
+	}
+}
+
+// ErrCircuitOpen is returned for queries rejected by an open circuit
+var ErrCircuitOpen = errors.New("metrics circuit breaker is open")
+
+// BreakerConfig controls when a CircuitBreakerClient opens
+type BreakerConfig struct {
+	// FailureThreshold is how many consecutive failed queries open the circuit
+	// (0 disables the breaker)
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before a probe query is
+	// let through
+	OpenDuration time.Duration
+}
+
+// DefaultBreakerConfig returns the breaker configuration used when none is set
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold: DefaultBreakerFailureThreshold,
+		OpenDuration:     DefaultBreakerOpenDuration,
+	}
+}
+
+// CircuitBreakerClient wraps a Client and stops querying it after a run of
+// consecutive failures, so an unreachable backend fails reconciles right away
+// instead of tying them up in timeouts and retries. Once OpenDuration has
+// passed a single probe query is let through; the circuit closes when it
+// succeeds and opens again when it fails. Queries that return no data and
+// queries abandoned by the caller do not count as failures.
+type CircuitBreakerClient struct {
+	client  Client
+	backend string
+	config  BreakerConfig
+	clock   clock.PassiveClock
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+}
+
+var _ Client = &CircuitBreakerClient{}
+var _ HealthChecker = &CircuitBreakerClient{}
+
+// NewCircuitBreakerClient wraps client in a circuit breaker; backend names the
+// client in the exported metrics
+func NewCircuitBreakerClient(client Client, backend string, config BreakerConfig) *CircuitBreakerClient {
+	b := &CircuitBreakerClient{
+		client:  client,
+		backend: backend,
+		config:  config,
+		clock:   clock.RealClock{},
+	}
+	RecordCircuitState(backend, CircuitClosed)
+	return b
+}
+
+// State returns the current state of the circuit
+func (b *CircuitBreakerClient) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow reports whether a query may reach the backend, moving an open circuit
+// to half-open once OpenDuration has passed
+func (b *CircuitBreakerClient) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitClosed:
+		return nil
+	case CircuitOpen:
+		if b.clock.Since(b.openedAt) >= b.config.OpenDuration {
+			b.setState(CircuitHalfOpen)
+			return nil
+		}
+	}
+	// Open, or half-open with the probe still in flight
+	RecordCircuitShortCircuit(b.backend)
+	return fmt.Errorf("%w for %s", ErrCircuitOpen, b.backend)
+}
+
+// done records the outcome of a query let through by allow
+func (b *CircuitBreakerClient) done(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case err == nil || errors.Is(err, ErrNoData):
+		b.failures = 0
+		b.setState(CircuitClosed)
+	case ctx.Err() != nil:
+		// The caller gave up; say nothing about the backend, but let the
+		// next query probe again
+		if b.state == CircuitHalfOpen {
+			b.setState(CircuitOpen)
+		}
+	default:
+		b.failures++
+		if b.state == CircuitHalfOpen || b.failures >= b.config.FailureThreshold {
+			b.openedAt = b.clock.Now()
+			b.setState(CircuitOpen)
+		}
+	}
+}
+
+// setState moves the circuit to state; callers hold mu
+func (b *CircuitBreakerClient) setState(state CircuitState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	RecordCircuitState(b.backend, state)
+}
+
+// call runs query through the breaker
+func call[T any](ctx context.Context, b *CircuitBreakerClient, query func() (T, error)) (T, error) {
+	if err := b.allow(); err != nil {
+		var zero T
+		return zero, err
+	}
+	value, err := query()
+	b.done(ctx, err)
+	return value, err
+}
+
+// Healthy reports an open circuit as unhealthy and checks the wrapped client
+// otherwise; health checks of the wrapped client count like queries
+func (b *CircuitBreakerClient) Healthy(ctx context.Context) error {
+	hc, ok := b.client.(HealthChecker)
+	if !ok {
+		if b.State() == CircuitOpen {
+			return fmt.Errorf("%w for %s", ErrCircuitOpen, b.backend)
+		}
+		return nil
+	}
+	_, err := call(ctx, b, func() (struct{}, error) {
+		return struct{}{}, hc.Healthy(ctx)
+	})
+	return err
+}
+
+// QueryAggregated runs query on the wrapped client through the breaker and
+// combines its samples with aggregation
+func (b *CircuitBreakerClient) QueryAggregated(ctx context.Context, query, aggregation string) (float64, error) {
+	return call(ctx, b, func() (float64, error) { return QueryAggregated(ctx, b.client, query, aggregation) })
+}
+
+// GetMetric fetches a metric through the breaker
+func (b *CircuitBreakerClient) GetMetric(ctx context.Context, query MetricQuery) (Sample, error) {
+	return call(ctx, b, func() (Sample, error) { return b.client.GetMetric(ctx, query) })
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// countingClient counts the queries that reach the mock
+type countingClient struct {
+	MockClient
+	queries int
+}
+
+func (c *countingClient) GetMetric(ctx context.Context, query MetricQuery) (Sample, error) {
+	c.queries++
+	return c.MockClient.GetMetric(ctx, query)
+}
+
+func newTestBreaker(t *testing.T, inner Client) (*CircuitBreakerClient, *clocktesting.FakePassiveClock) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+	b := NewCircuitBreakerClient(inner, t.Name(), BreakerConfig{FailureThreshold: 3, OpenDuration: 30 * time.Second})
+	b.clock = fakeClock
+	return b, fakeClock
+}
+
+func TestCircuitBreakerOpensAndRecovers(t *testing.T) {
+	inner := &countingClient{MockClient: MockClient{QueryValue: 0.5, Error: errors.New("connection refused")}}
+	b, fakeClock := newTestBreaker(t, inner)
+	ctx := context.Background()
+
+	// Consecutive failures open the circuit
+	for i := 0; i < 3; i++ {
+		_, err := Query(ctx, b, "up")
+		assert.ErrorContains(t, err, "connection refused")
+	}
+	assert.Equal(t, CircuitOpen, b.State())
+	assert.Equal(t, float64(CircuitOpen), testutil.ToFloat64(MetricsCircuitState.WithLabelValues(t.Name())))
+
+	// Queries are short-circuited while the circuit is open
+	shortCircuits := MetricsCircuitShortCircuits.WithLabelValues(t.Name())
+	before := testutil.ToFloat64(shortCircuits)
+	_, err := Query(ctx, b, "up")
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	_, err = GetGPUUtilization(ctx, b, "")
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 3, inner.queries)
+	assert.Equal(t, before+2, testutil.ToFloat64(shortCircuits))
+
+	// A failed probe opens the circuit again for another OpenDuration
+	fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+	_, err = Query(ctx, b, "up")
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, CircuitOpen, b.State())
+	_, err = Query(ctx, b, "up")
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 4, inner.queries)
+
+	// A successful probe closes it
+	fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+	inner.Error = nil
+	value, err := Query(ctx, b, "up")
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, value)
+	assert.Equal(t, CircuitClosed, b.State())
+	assert.Equal(t, float64(CircuitClosed), testutil.ToFloat64(MetricsCircuitState.WithLabelValues(t.Name())))
+}
+
+func TestCircuitBreakerIgnoredErrors(t *testing.T) {
+	inner := &MockClient{}
+	b, _ := newTestBreaker(t, inner)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Empty results and queries abandoned by the caller say nothing about the backend
+	inner.Error = fmt.Errorf("%w: up", ErrNoData)
+	for i := 0; i < 5; i++ {
+		_, _ = Query(context.Background(), b, "up")
+	}
+	inner.Error = context.Canceled
+	for i := 0; i < 5; i++ {
+		_, _ = Query(canceled, b, "up")
+	}
+	assert.Equal(t, CircuitClosed, b.State())
+
+	// A success resets the run of failures
+	inner.Error = errors.New("connection refused")
+	_, _ = Query(context.Background(), b, "up")
+	_, _ = Query(context.Background(), b, "up")
+	inner.Error = nil
+	_, _ = Query(context.Background(), b, "up")
+	inner.Error = errors.New("connection refused")
+	_, _ = Query(context.Background(), b, "up")
+	_, _ = Query(context.Background(), b, "up")
+	assert.Equal(t, CircuitClosed, b.State())
+}
+
+func TestCircuitBreakerHealthy(t *testing.T) {
+	inner := &MockClient{HealthError: errors.New("connection refused")}
+	b, fakeClock := newTestBreaker(t, inner)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		assert.ErrorContains(t, b.Healthy(ctx), "connection refused")
+	}
+	assert.ErrorIs(t, b.Healthy(ctx), ErrCircuitOpen)
+
+	fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+	inner.HealthError = nil
+	assert.NoError(t, b.Healthy(ctx))
+	assert.Equal(t, CircuitClosed, b.State())
+}
+
+func TestCircuitBreakerQueryAggregated(t *testing.T) {
+	inner := &vectorClient{values: []float64{1, 3}}
+	b, _ := newTestBreaker(t, inner)
+
+	value, err := QueryAggregated(context.Background(), b, "up", AggregationSum)
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, value)
+}
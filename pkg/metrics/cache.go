@@ -0,0 +1,160 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"k8s.io/utils/clock"
+)
+
+// DefaultQueryCacheTTL is how long a query result is shared between policies
+const DefaultQueryCacheTTL = 15 * time.Second
+
+// CachingClient wraps a Client and shares query results between callers for
+// a short TTL, so policies using the same query, such as the default GPU
+// utilization query, do not each send it to Prometheus on every reconcile.
+// Concurrent identical queries are sent once. Failed queries are not cached.
+type CachingClient struct {
+	client Client
+	ttl    time.Duration
+	clock  clock.PassiveClock
+	group  singleflight.Group
+
+	mu        sync.Mutex
+	entries   map[string]cacheEntry
+	lastSweep time.Time
+}
+
+// cacheEntry is a cached query result
+type cacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+var _ Client = &CachingClient{}
+var _ HealthChecker = &CachingClient{}
+
+// NewCachingClient wraps client in a cache holding results for ttl
+func NewCachingClient(client Client, ttl time.Duration) *CachingClient {
+	return &CachingClient{
+		client:  client,
+		ttl:     ttl,
+		clock:   clock.RealClock{},
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// lookup returns the unexpired result cached under key
+func (c *CachingClient) lookup(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.clock.Now().Before(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// store caches value under key, dropping expired entries at most once per TTL
+func (c *CachingClient) store(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+	c.entries[key] = cacheEntry{value: value, expires: now.Add(c.ttl)}
+}
+
+// cacheKey identifies a query of kind together with the query window and
+// range of ctx, since both change its result
+func cacheKey(ctx context.Context, kind, query string) string {
+	key := kind + "|" + QueryWindow(ctx).String()
+	if r, ok := QueryRangeFrom(ctx); ok {
+		key += "|" + r.String()
+	}
+	return key + "|" + query
+}
+
+// cached returns the result cached under key or runs query, sharing it with
+// concurrent callers of the same key
+func cached[T any](ctx context.Context, c *CachingClient, key string, query func(ctx context.Context) (T, error)) (T, error) {
+	if value, ok := c.lookup(key); ok {
+		RecordQueryCache("hit")
+		return value.(T), nil
+	}
+
+	result := c.group.DoChan(key, func() (any, error) {
+		value, err := query(ctx)
+		if err == nil {
+			c.store(key, value)
+		}
+		return value, err
+	})
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case r := <-result:
+		if r.Shared {
+			RecordQueryCache("shared")
+		} else {
+			RecordQueryCache("miss")
+		}
+		// A shared query abandoned by the caller that started it says nothing
+		// about the backend; run it again under this caller's context
+		if r.Err != nil && r.Shared && ctx.Err() == nil &&
+			(errors.Is(r.Err, context.Canceled) || errors.Is(r.Err, context.DeadlineExceeded)) {
+			return query(ctx)
+		}
+		if r.Err != nil {
+			return zero, r.Err
+		}
+		return r.Val.(T), nil
+	}
+}
+
+// Healthy checks the wrapped client; health checks are not cached
+func (c *CachingClient) Healthy(ctx context.Context) error {
+	return CheckHealth(ctx, c.client)
+}
+
+// QueryAggregated runs query on the wrapped client and combines its samples
+// with aggregation, sharing the result
+func (c *CachingClient) QueryAggregated(ctx context.Context, query, aggregation string) (float64, error) {
+	return cached(ctx, c, cacheKey(ctx, "aggregated|"+aggregation, query), func(ctx context.Context) (float64, error) {
+		return QueryAggregated(ctx, c.client, query, aggregation)
+	})
+}
+
+// GetMetric fetches a metric, sharing the result. Callers share the labels
+// of the sample and must not modify them.
+func (c *CachingClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	return cached(ctx, c, cacheKey(ctx, "metric|"+q.Metric, q.Query), func(ctx context.Context) (Sample, error) {
+		return c.client.GetMetric(ctx, q)
+	})
+}
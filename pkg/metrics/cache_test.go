@@ -0,0 +1,132 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// slowClient counts the GPU utilization queries that reach it and blocks them
+// until release is closed
+type slowClient struct {
+	MockClient
+	queries atomic.Int32
+	release chan struct{}
+}
+
+func (c *slowClient) GetMetric(ctx context.Context, query MetricQuery) (Sample, error) {
+	if query.Metric == MetricGPUUtilization {
+		c.queries.Add(1)
+		if c.release != nil {
+			<-c.release
+		}
+	}
+	return c.MockClient.GetMetric(ctx, query)
+}
+
+func newTestCache(inner Client) (*CachingClient, *clocktesting.FakePassiveClock) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+	c := NewCachingClient(inner, 15*time.Second)
+	c.clock = fakeClock
+	return c, fakeClock
+}
+
+func TestCachingClient(t *testing.T) {
+	inner := &slowClient{MockClient: MockClient{GPUUtilizationValue: 70, QueueDepthValue: 4}}
+	c, fakeClock := newTestCache(inner)
+	ctx := context.Background()
+
+	// Identical queries within the TTL are answered from the cache
+	for i := 0; i < 3; i++ {
+		value, err := GetGPUUtilization(ctx, c, "")
+		require.NoError(t, err)
+		assert.Equal(t, 70.0, value)
+	}
+	assert.Equal(t, int32(1), inner.queries.Load())
+
+	// Other queries are cached separately
+	_, err := GetGPUUtilization(ctx, c, "avg(custom_gpu)")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), inner.queries.Load())
+	depth, err := GetQueueDepth(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), depth)
+
+	// Results expire after the TTL
+	inner.GPUUtilizationValue = 90
+	fakeClock.SetTime(fakeClock.Now().Add(15 * time.Second))
+	value, err := GetGPUUtilization(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, 90.0, value)
+	assert.Equal(t, int32(3), inner.queries.Load())
+	assert.Len(t, c.entries, 1)
+}
+
+func TestCachingClientErrorsNotCached(t *testing.T) {
+	inner := &slowClient{MockClient: MockClient{Error: errors.New("connection refused")}}
+	c, _ := newTestCache(inner)
+
+	for i := 0; i < 2; i++ {
+		_, err := GetGPUUtilization(context.Background(), c, "")
+		assert.ErrorContains(t, err, "connection refused")
+	}
+	assert.Equal(t, int32(2), inner.queries.Load())
+}
+
+func TestCachingClientSharesConcurrentQueries(t *testing.T) {
+	inner := &slowClient{MockClient: MockClient{GPUUtilizationValue: 70}, release: make(chan struct{})}
+	c, _ := newTestCache(inner)
+
+	var wg sync.WaitGroup
+	values := make([]float64, 5)
+	for i := range values {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i], _ = GetGPUUtilization(context.Background(), c, "")
+		}()
+	}
+	require.Eventually(t, func() bool { return inner.queries.Load() == 1 }, time.Second, time.Millisecond)
+	// Let the other callers join the query in flight
+	time.Sleep(10 * time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), inner.queries.Load())
+	assert.Equal(t, []float64{70, 70, 70, 70, 70}, values)
+}
+
+func TestCachingClientQueryAggregated(t *testing.T) {
+	inner := &vectorClient{values: []float64{1, 3}}
+	c, _ := newTestCache(inner)
+
+	sum, err := QueryAggregated(context.Background(), c, "up", AggregationSum)
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, sum)
+	avg, err := QueryAggregated(context.Background(), c, "up", AggregationAverage)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, avg)
+}
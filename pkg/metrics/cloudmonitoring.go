@@ -0,0 +1,255 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCloudMonitoringEndpoint is the endpoint of the Cloud Monitoring API
+const DefaultCloudMonitoringEndpoint = "https://monitoring.googleapis.com"
+
+// CloudMonitoringConfig configures the Google Cloud Monitoring clients
+type CloudMonitoringConfig struct {
+	// Project is the ID of the project whose metrics are read, the scoping
+	// project when metrics scopes span several
+	Project string
+	// Endpoint replaces DefaultCloudMonitoringEndpoint, such as for Private Service Connect
+	Endpoint string
+	// TokenSource authorizes the requests; DefaultGoogleTokenSource when nil
+	TokenSource GoogleTokenSource
+}
+
+// endpoint returns the configured endpoint without a trailing slash
+func (cfg CloudMonitoringConfig) endpoint() string {
+	if cfg.Endpoint == "" {
+		return DefaultCloudMonitoringEndpoint
+	}
+	return strings.TrimSuffix(cfg.Endpoint, "/")
+}
+
+// tokenSource returns the configured token source or the default one
+func (cfg CloudMonitoringConfig) tokenSource() (GoogleTokenSource, error) {
+	if cfg.TokenSource != nil {
+		return cfg.TokenSource, nil
+	}
+	return DefaultGoogleTokenSource()
+}
+
+// NewCloudMonitoringPromQLClient creates a client running PromQL through the
+// Prometheus-compatible API of Cloud Monitoring, which serves Google Cloud
+// Managed Service for Prometheus and Google Cloud metrics alike. The built-in
+// metrics have the same default queries as with Prometheus.
+func NewCloudMonitoringPromQLClient(cfg CloudMonitoringConfig, retry RetryConfig) (*PrometheusClient, error) {
+	if cfg.Project == "" {
+		return nil, errors.New("Cloud Monitoring requires a project")
+	}
+	tokens, err := cfg.tokenSource()
+	if err != nil {
+		return nil, err
+	}
+	address := cfg.endpoint() + "/v1/projects/" + url.PathEscape(cfg.Project) + "/location/global/prometheus"
+	return newPrometheusClient(address, retry, &googleAuthTransport{tokens: tokens})
+}
+
+// CloudMonitoringMQLClient reads metrics from Google Cloud Monitoring with
+// Monitoring Query Language. There are no default queries for the built-in metrics.
+type CloudMonitoringMQLClient struct {
+	endpoint   string
+	project    string
+	tokens     GoogleTokenSource
+	httpClient *http.Client
+}
+
+var (
+	_ Client        = &CloudMonitoringMQLClient{}
+	_ HealthChecker = &CloudMonitoringMQLClient{}
+)
+
+// NewCloudMonitoringMQLClient creates an MQL client for the configured project
+func NewCloudMonitoringMQLClient(cfg CloudMonitoringConfig) (*CloudMonitoringMQLClient, error) {
+	if cfg.Project == "" {
+		return nil, errors.New("Cloud Monitoring requires a project")
+	}
+	tokens, err := cfg.tokenSource()
+	if err != nil {
+		return nil, err
+	}
+	return &CloudMonitoringMQLClient{
+		endpoint:   cfg.endpoint(),
+		project:    cfg.Project,
+		tokens:     tokens,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// mqlTypedValue is a label or point value of a time series query response
+type mqlTypedValue struct {
+	BoolValue         *bool    `json:"boolValue"`
+	Int64Value        *string  `json:"int64Value"`
+	DoubleValue       *float64 `json:"doubleValue"`
+	StringValue       *string  `json:"stringValue"`
+	DistributionValue *struct {
+		Mean float64 `json:"mean"`
+	} `json:"distributionValue"`
+}
+
+// number returns the value of a numeric point; distributions yield their mean
+func (v mqlTypedValue) number() (float64, bool) {
+	switch {
+	case v.DoubleValue != nil:
+		return *v.DoubleValue, true
+	case v.Int64Value != nil:
+		n, err := strconv.ParseInt(*v.Int64Value, 10, 64)
+		return float64(n), err == nil
+	case v.DistributionValue != nil:
+		return v.DistributionValue.Mean, true
+	case v.BoolValue != nil:
+		if *v.BoolValue {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// label returns the value of a label as a string
+func (v mqlTypedValue) label() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.Int64Value != nil:
+		return *v.Int64Value
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	}
+	return ""
+}
+
+// queryTimeSeriesResponse is the body of a timeSeries:query response
+type queryTimeSeriesResponse struct {
+	TimeSeriesDescriptor struct {
+		LabelDescriptors []struct {
+			Key string `json:"key"`
+		} `json:"labelDescriptors"`
+	} `json:"timeSeriesDescriptor"`
+	TimeSeriesData []struct {
+		LabelValues []mqlTypedValue `json:"labelValues"`
+		PointData   []struct {
+			Values       []mqlTypedValue `json:"values"`
+			TimeInterval struct {
+				EndTime time.Time `json:"endTime"`
+			} `json:"timeInterval"`
+		} `json:"pointData"`
+	} `json:"timeSeriesData"`
+}
+
+// GetMetric runs the MQL query in q.Query and returns the latest point of its
+// first series; a distribution yields its mean. Within a query range (see
+// WithQueryRange) the query is limited to the window with a within operation
+// and the points of the series are aggregated instead.
+func (c *CloudMonitoringMQLClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	if q.Query == "" {
+		if q.Metric == "" {
+			return Sample{}, errNoQuery(q.Metric)
+		}
+		return Sample{}, fmt.Errorf("metric %q has no default MQL query; set its query", q.Metric)
+	}
+	query := q.Query
+	queryRange, ranged := QueryRangeFrom(ctx)
+	if ranged {
+		query += fmt.Sprintf(" | within %ds", int(queryRange.Window.Seconds()))
+	}
+
+	var resp queryTimeSeriesResponse
+	if err := c.query(ctx, query, &resp); err != nil {
+		return Sample{}, fmt.Errorf("querying Cloud Monitoring: %w", err)
+	}
+	for _, series := range resp.TimeSeriesData {
+		var values []float64
+		var sample Sample
+		for _, point := range series.PointData {
+			if len(point.Values) == 0 {
+				continue
+			}
+			value, ok := point.Values[0].number()
+			if !ok {
+				continue
+			}
+			values = append(values, value)
+			if point.TimeInterval.EndTime.After(sample.Timestamp) || len(values) == 1 {
+				sample.Value, sample.Timestamp = value, point.TimeInterval.EndTime
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		if ranged {
+			value, err := AggregateOverTime(values, queryRange.Aggregation)
+			if err != nil {
+				continue
+			}
+			sample.Value = value
+		}
+		sample.Labels = make(map[string]string, len(series.LabelValues))
+		for i, label := range series.LabelValues {
+			if i < len(resp.TimeSeriesDescriptor.LabelDescriptors) {
+				sample.Labels[resp.TimeSeriesDescriptor.LabelDescriptors[i].Key] = label.label()
+			}
+		}
+		return sample, nil
+	}
+	return Sample{}, fmt.Errorf("%w: %s", ErrNoData, q.Query)
+}
+
+// Healthy checks that Google credentials can be obtained. Cloud Monitoring
+// itself is not called, to spare the project's read quota.
+func (c *CloudMonitoringMQLClient) Healthy(ctx context.Context) error {
+	if _, err := c.tokens.Token(ctx); err != nil {
+		return fmt.Errorf("Cloud Monitoring health check failed: %w", err)
+	}
+	return nil
+}
+
+// query sends an authorized timeSeries:query request
+func (c *CloudMonitoringMQLClient) query(ctx context.Context, query string, out any) error {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving Google credentials: %w", err)
+	}
+	body, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return err
+	}
+	endpoint := c.endpoint + "/v3/projects/" + url.PathEscape(c.project) + "/timeSeries:query"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return doGoogleJSON(c.httpClient, req, out)
+}
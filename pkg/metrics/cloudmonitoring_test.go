@@ -0,0 +1,137 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const queryTimeSeriesBody = `{
+  "timeSeriesDescriptor": {
+    "labelDescriptors": [{"key": "resource.cluster_name"}, {"key": "resource.namespace_name"}],
+    "pointDescriptors": [{"key": "value.request_count", "valueType": "INT64", "metricKind": "GAUGE"}]
+  },
+  "timeSeriesData": [{
+    "labelValues": [{"stringValue": "inference"}, {"stringValue": "models"}],
+    "pointData": [
+      {"values": [{"int64Value": "40"}], "timeInterval": {"endTime": "2026-06-01T11:59:00Z"}},
+      {"values": [{"int64Value": "10"}], "timeInterval": {"endTime": "2026-06-01T11:58:00Z"}},
+      {"values": [{"int64Value": "25"}], "timeInterval": {"endTime": "2026-06-01T11:57:00Z"}}
+    ]
+  }]
+}`
+
+const queueDepthMQL = `fetch k8s_container | metric 'workload.googleapis.com/inference_request_queue_depth' | group_by [], sum(val())`
+
+// newTestMQLClient returns an MQL client of a Cloud Monitoring answering with
+// status and body, recording the query of the last request
+func newTestMQLClient(t *testing.T, status int, body string) (*CloudMonitoringMQLClient, *string) {
+	query := new(string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/projects/llm-prod/timeSeries:query", r.URL.Path)
+		assert.Equal(t, "Bearer ya29.test", r.Header.Get("Authorization"))
+		var req struct {
+			Query string `json:"query"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		*query = req.Query
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewCloudMonitoringMQLClient(CloudMonitoringConfig{
+		Project:     "llm-prod",
+		Endpoint:    server.URL,
+		TokenSource: StaticGoogleToken("ya29.test"),
+	})
+	require.NoError(t, err)
+	return c, query
+}
+
+func TestCloudMonitoringMQLClientGetMetric(t *testing.T) {
+	c, query := newTestMQLClient(t, http.StatusOK, queryTimeSeriesBody)
+
+	sample, err := c.GetMetric(context.Background(), MetricQuery{Metric: MetricQueueDepth, Query: queueDepthMQL})
+	require.NoError(t, err)
+	assert.Equal(t, 40.0, sample.Value)
+	assert.Equal(t, time.Date(2026, 6, 1, 11, 59, 0, 0, time.UTC), sample.Timestamp)
+	assert.Equal(t, map[string]string{"resource.cluster_name": "inference", "resource.namespace_name": "models"}, sample.Labels)
+	assert.Equal(t, queueDepthMQL, *query)
+}
+
+func TestCloudMonitoringMQLClientQueryRange(t *testing.T) {
+	c, query := newTestMQLClient(t, http.StatusOK, queryTimeSeriesBody)
+
+	ctx := WithQueryRange(context.Background(), Range{Window: 10 * time.Minute, Aggregation: RangeMax})
+	sample, err := c.GetMetric(ctx, MetricQuery{Query: queueDepthMQL})
+	require.NoError(t, err)
+	assert.Equal(t, 40.0, sample.Value)
+	assert.Equal(t, queueDepthMQL+" | within 600s", *query)
+}
+
+func TestCloudMonitoringMQLClientErrors(t *testing.T) {
+	c, _ := newTestMQLClient(t, http.StatusOK, queryTimeSeriesBody)
+	_, err := c.GetMetric(context.Background(), MetricQuery{Metric: MetricGPUUtilization})
+	assert.ErrorContains(t, err, "no default MQL query")
+
+	c, _ = newTestMQLClient(t, http.StatusOK, `{"timeSeriesDescriptor": {}}`)
+	_, err = c.GetMetric(context.Background(), MetricQuery{Query: queueDepthMQL})
+	assert.True(t, errors.Is(err, ErrNoData))
+
+	c, _ = newTestMQLClient(t, http.StatusForbidden,
+		`{"error": {"code": 403, "message": "Permission monitoring.timeSeries.list denied", "status": "PERMISSION_DENIED"}}`)
+	_, err = c.GetMetric(context.Background(), MetricQuery{Query: queueDepthMQL})
+	assert.ErrorContains(t, err, "PERMISSION_DENIED: Permission monitoring.timeSeries.list denied")
+
+	_, err = NewCloudMonitoringMQLClient(CloudMonitoringConfig{TokenSource: StaticGoogleToken("ya29.test")})
+	assert.Error(t, err)
+}
+
+func TestCloudMonitoringPromQLClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/llm-prod/location/global/prometheus/api/v1/query", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer ya29.test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "success", "data": {"resultType": "vector", "result": [
+			{"metric": {}, "value": [1780315200, "0.75"]}]}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewCloudMonitoringPromQLClient(CloudMonitoringConfig{
+		Project:     "llm-prod",
+		Endpoint:    server.URL,
+		TokenSource: StaticGoogleToken("ya29.test"),
+	}, RetryConfig{})
+	require.NoError(t, err)
+
+	value, err := GetGPUUtilization(context.Background(), c, "")
+	require.NoError(t, err)
+	assert.Equal(t, 0.75, value)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultCloudWatchPeriod is the period of the datapoints CloudWatch
+	// queries return, the finest resolution of standard metrics
+	DefaultCloudWatchPeriod = time.Minute
+	// DefaultCloudWatchLookback is how far back the latest datapoint is looked
+	// for, covering the publishing delay of AWS service metrics
+	DefaultCloudWatchLookback = 10 * time.Minute
+
+	cloudWatchQueryID = "q"
+)
+
+// CloudWatchConfig configures a CloudWatchClient
+type CloudWatchConfig struct {
+	Region string
+	// Endpoint replaces the regional endpoint, such as for a VPC endpoint
+	Endpoint string
+	// Credentials sign the requests; DefaultAWSCredentials when nil
+	Credentials AWSCredentialsProvider
+	// Period of the datapoints; DefaultCloudWatchPeriod when zero
+	Period time.Duration
+	// Lookback is how far back the latest datapoint is looked for;
+	// DefaultCloudWatchLookback when zero
+	Lookback time.Duration
+}
+
+// CloudWatchClient reads metrics from Amazon CloudWatch with GetMetricData.
+// Queries are metric math expressions, such as SEARCH expressions or Metrics
+// Insights SELECT queries; there are no default queries for the built-in metrics.
+type CloudWatchClient struct {
+	region      string
+	endpoint    string
+	credentials AWSCredentialsProvider
+	period      time.Duration
+	lookback    time.Duration
+	httpClient  *http.Client
+	now         func() time.Time
+}
+
+var (
+	_ Client        = &CloudWatchClient{}
+	_ HealthChecker = &CloudWatchClient{}
+)
+
+// NewCloudWatchClient creates a client for CloudWatch in the configured region
+func NewCloudWatchClient(cfg CloudWatchConfig) (*CloudWatchClient, error) {
+	if cfg.Region == "" {
+		return nil, errors.New("CloudWatch requires a region")
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = "https://monitoring." + cfg.Region + ".amazonaws.com"
+	}
+	credentials := cfg.Credentials
+	if credentials == nil {
+		var err error
+		if credentials, err = DefaultAWSCredentials(cfg.Region); err != nil {
+			return nil, err
+		}
+	}
+	period := cfg.Period
+	if period <= 0 {
+		period = DefaultCloudWatchPeriod
+	}
+	lookback := cfg.Lookback
+	if lookback <= 0 {
+		lookback = DefaultCloudWatchLookback
+	}
+	return &CloudWatchClient{
+		region:      cfg.Region,
+		endpoint:    endpoint,
+		credentials: credentials,
+		period:      period,
+		lookback:    lookback,
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		now:         time.Now,
+	}, nil
+}
+
+// getMetricDataResponse is the body of a GetMetricData response
+type getMetricDataResponse struct {
+	Results []struct {
+		ID         string      `xml:"Id"`
+		Label      string      `xml:"Label"`
+		StatusCode string      `xml:"StatusCode"`
+		Timestamps []time.Time `xml:"Timestamps>member"`
+		Values     []float64   `xml:"Values>member"`
+	} `xml:"GetMetricDataResult>MetricDataResults>member"`
+}
+
+// GetMetric runs the expression in q.Query over the lookback window and
+// returns the latest datapoint of its first series. Within a query range
+// (see WithQueryRange) the datapoints of the window, at a period rounded up
+// from its step to whole minutes, are aggregated instead.
+func (c *CloudWatchClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	if q.Query == "" {
+		if q.Metric == "" {
+			return Sample{}, errNoQuery(q.Metric)
+		}
+		return Sample{}, fmt.Errorf("metric %q has no default CloudWatch query; set its query", q.Metric)
+	}
+
+	now := c.now()
+	window, period := c.lookback, c.period
+	queryRange, ranged := QueryRangeFrom(ctx)
+	if ranged {
+		window = queryRange.Window
+		// CloudWatch periods are whole minutes
+		period = (queryRange.step() + time.Minute - 1).Truncate(time.Minute)
+	}
+
+	form := url.Values{}
+	form.Set("Action", "GetMetricData")
+	form.Set("Version", "2010-08-01")
+	form.Set("StartTime", now.Add(-window).UTC().Format(time.RFC3339))
+	form.Set("EndTime", now.UTC().Format(time.RFC3339))
+	form.Set("ScanBy", "TimestampDescending")
+	form.Set("MetricDataQueries.member.1.Id", cloudWatchQueryID)
+	form.Set("MetricDataQueries.member.1.Expression", q.Query)
+	form.Set("MetricDataQueries.member.1.Period", strconv.Itoa(int(period.Seconds())))
+	form.Set("MetricDataQueries.member.1.ReturnData", "true")
+
+	var resp getMetricDataResponse
+	if err := c.call(ctx, form, &resp); err != nil {
+		return Sample{}, fmt.Errorf("querying CloudWatch: %w", err)
+	}
+	for _, result := range resp.Results {
+		if result.StatusCode == "InternalError" || result.StatusCode == "Forbidden" {
+			return Sample{}, fmt.Errorf("querying CloudWatch: %s: %s", result.StatusCode, q.Query)
+		}
+		if len(result.Values) == 0 {
+			continue
+		}
+		sample := Sample{Value: result.Values[0], Labels: map[string]string{"label": result.Label}}
+		if len(result.Timestamps) > 0 {
+			sample.Timestamp = result.Timestamps[0]
+		}
+		if ranged {
+			value, err := AggregateOverTime(result.Values, queryRange.Aggregation)
+			if err != nil {
+				continue
+			}
+			sample.Value = value
+		}
+		return sample, nil
+	}
+	return Sample{}, fmt.Errorf("%w: %s", ErrNoData, q.Query)
+}
+
+// Healthy checks that AWS credentials can be obtained. CloudWatch itself is
+// not called, as the role may allow nothing but GetMetricData.
+func (c *CloudWatchClient) Healthy(ctx context.Context) error {
+	if _, err := c.credentials.Retrieve(ctx); err != nil {
+		return fmt.Errorf("CloudWatch health check failed: %w", err)
+	}
+	return nil
+}
+
+// call sends a signed Query API request to CloudWatch
+func (c *CloudWatchClient) call(ctx context.Context, form url.Values, out any) error {
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+	req, err := newAWSQueryRequest(ctx, c.endpoint, form)
+	if err != nil {
+		return err
+	}
+	if err := SignAWSRequest(req, []byte(form.Encode()), creds, c.region, "monitoring", c.now()); err != nil {
+		return err
+	}
+	return doAWSQuery(c.httpClient, req, out)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const getMetricDataBody = `<GetMetricDataResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <GetMetricDataResult>
+    <MetricDataResults>
+      <member>
+        <Id>q</Id>
+        <Label>TargetResponseTime</Label>
+        <StatusCode>Complete</StatusCode>
+        <Timestamps>
+          <member>2026-06-01T11:59:00Z</member>
+          <member>2026-06-01T11:58:00Z</member>
+          <member>2026-06-01T11:57:00Z</member>
+        </Timestamps>
+        <Values>
+          <member>0.9</member>
+          <member>0.3</member>
+          <member>0.6</member>
+        </Values>
+      </member>
+    </MetricDataResults>
+  </GetMetricDataResult>
+</GetMetricDataResponse>`
+
+const albLatencyQuery = `SEARCH('{AWS/ApplicationELB,LoadBalancer} MetricName="TargetResponseTime" LoadBalancer="app/llm/50dc6c495c0c9188"', 'p99', 60)`
+
+// newTestCloudWatchClient returns a client of a CloudWatch answering with
+// status and body, recording the form of the last request
+func newTestCloudWatchClient(t *testing.T, status int, body string) (*CloudWatchClient, *url.Values) {
+	form := &url.Values{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-west-2/monitoring/aws4_request")
+		require.NoError(t, r.ParseForm())
+		*form = r.PostForm
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewCloudWatchClient(CloudWatchConfig{
+		Region:      "us-west-2",
+		Endpoint:    server.URL,
+		Credentials: StaticAWSCredentials(exampleAWSCredentials),
+	})
+	require.NoError(t, err)
+	c.now = func() time.Time { return time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC) }
+	return c, form
+}
+
+func TestCloudWatchClientGetMetric(t *testing.T) {
+	c, form := newTestCloudWatchClient(t, http.StatusOK, getMetricDataBody)
+
+	sample, err := c.GetMetric(context.Background(), MetricQuery{Metric: MetricLatencyP99, Query: albLatencyQuery})
+	require.NoError(t, err)
+	assert.Equal(t, 0.9, sample.Value)
+	assert.Equal(t, time.Date(2026, 6, 1, 11, 59, 0, 0, time.UTC), sample.Timestamp)
+	assert.Equal(t, map[string]string{"label": "TargetResponseTime"}, sample.Labels)
+
+	assert.Equal(t, "GetMetricData", form.Get("Action"))
+	assert.Equal(t, albLatencyQuery, form.Get("MetricDataQueries.member.1.Expression"))
+	assert.Equal(t, "60", form.Get("MetricDataQueries.member.1.Period"))
+	assert.Equal(t, "2026-06-01T11:50:00Z", form.Get("StartTime"))
+	assert.Equal(t, "2026-06-01T12:00:00Z", form.Get("EndTime"))
+	assert.Equal(t, "TimestampDescending", form.Get("ScanBy"))
+}
+
+func TestCloudWatchClientQueryRange(t *testing.T) {
+	c, form := newTestCloudWatchClient(t, http.StatusOK, getMetricDataBody)
+
+	ctx := WithQueryRange(context.Background(), Range{Window: 30 * time.Minute, Step: 90 * time.Second, Aggregation: RangeAverage})
+	sample, err := c.GetMetric(ctx, MetricQuery{Query: albLatencyQuery})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.6, sample.Value, 1e-9)
+	// The step is rounded up to whole minutes
+	assert.Equal(t, "120", form.Get("MetricDataQueries.member.1.Period"))
+	assert.Equal(t, "2026-06-01T11:30:00Z", form.Get("StartTime"))
+}
+
+func TestCloudWatchClientErrors(t *testing.T) {
+	c, _ := newTestCloudWatchClient(t, http.StatusOK, getMetricDataBody)
+	_, err := c.GetMetric(context.Background(), MetricQuery{Metric: MetricGPUUtilization})
+	assert.ErrorContains(t, err, "no default CloudWatch query")
+
+	c, _ = newTestCloudWatchClient(t, http.StatusOK, `<GetMetricDataResponse><GetMetricDataResult><MetricDataResults>
+		<member><Id>q</Id><StatusCode>Complete</StatusCode><Timestamps></Timestamps><Values></Values></member>
+	</MetricDataResults></GetMetricDataResult></GetMetricDataResponse>`)
+	_, err = c.GetMetric(context.Background(), MetricQuery{Query: albLatencyQuery})
+	assert.True(t, errors.Is(err, ErrNoData))
+
+	c, _ = newTestCloudWatchClient(t, http.StatusBadRequest,
+		`<ErrorResponse><Error><Type>Sender</Type><Code>ValidationError</Code><Message>bad expression</Message></Error></ErrorResponse>`)
+	_, err = c.GetMetric(context.Background(), MetricQuery{Query: "SEARCH("})
+	assert.ErrorContains(t, err, "ValidationError: bad expression")
+
+	_, err = NewCloudWatchClient(CloudWatchConfig{})
+	assert.Error(t, err)
+}
+
+func TestCloudWatchClientHealthy(t *testing.T) {
+	c, _ := newTestCloudWatchClient(t, http.StatusOK, "")
+	assert.NoError(t, c.Healthy(context.Background()))
+
+	c.credentials = AWSCredentialsFunc(func(context.Context) (AWSCredentials, error) {
+		return AWSCredentials{}, errors.New("token file missing")
+	})
+	assert.ErrorContains(t, c.Healthy(context.Background()), "token file missing")
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// TraceIDLabel is the exemplar label holding the trace ID of a scaling decision
+const TraceIDLabel = "trace_id"
+
+// TraceExemplar returns exemplar labels for the sampled span in ctx, or nil
+// when tracing is disabled or the span is not sampled.
+func TraceExemplar(ctx context.Context) prometheus.Labels {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() || !sc.IsSampled() {
+		return nil
+	}
+	return prometheus.Labels{TraceIDLabel: sc.TraceID().String()}
+}
+
+// RecordScalingDecisionContext records a scaling decision, attaching the trace
+// ID of the span in ctx as an exemplar when one is sampled
+func RecordScalingDecisionContext(ctx context.Context, namespace, policy, direction string) {
+	counter := ScalingDecisions.WithLabelValues(namespace, policy, direction)
+	if exemplar := TraceExemplar(ctx); exemplar != nil {
+		counter.(prometheus.ExemplarAdder).AddWithExemplar(1, exemplar)
+		return
+	}
+	counter.Inc()
+}
+
+// RecordReconcileLatencyContext records the duration of a reconciliation loop,
+// attaching the trace ID of the span in ctx as an exemplar when one is sampled
+func RecordReconcileLatencyContext(ctx context.Context, namespace, policy string, durationSeconds float64) {
+	observer := ReconcileLatency.WithLabelValues(namespace, policy)
+	if exemplar := TraceExemplar(ctx); exemplar != nil {
+		observer.(prometheus.ExemplarObserver).ObserveWithExemplar(durationSeconds, exemplar)
+		return
+	}
+	observer.Observe(durationSeconds)
+}
+
+// OpenMetricsHandler serves the controller metrics registry with OpenMetrics
+// negotiation enabled, which is required for exemplars to be exposed
+func OpenMetricsHandler() http.Handler {
+	return promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		ErrorHandling:     promhttp.HTTPErrorOnError,
+		EnableOpenMetrics: true,
+	})
+}
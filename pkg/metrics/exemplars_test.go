@@ -0,0 +1,67 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func sampledContext(t *testing.T) (context.Context, string) {
+	t.Helper()
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	return trace.ContextWithSpanContext(context.Background(), sc), traceID.String()
+}
+
+func TestTraceExemplar(t *testing.T) {
+	assert.Nil(t, TraceExemplar(context.Background()))
+
+	ctx, traceID := sampledContext(t)
+	assert.Equal(t, prometheus.Labels{TraceIDLabel: traceID}, TraceExemplar(ctx))
+}
+
+func TestScalingDecisionExemplar(t *testing.T) {
+	ctx, traceID := sampledContext(t)
+	RecordScalingDecisionContext(ctx, "default", "exemplar-policy", "up")
+	RecordReconcileLatencyContext(ctx, "default", "exemplar-policy", 0.25)
+	RecordScalingDecisionContext(context.Background(), "default", "exemplar-policy", "none")
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	rec := httptest.NewRecorder()
+	OpenMetricsHandler().ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, `kubeai_autoscaler_scaling_decisions_total{direction="up",namespace="default",policy="exemplar-policy"} 1.0 # {trace_id="`+traceID+`"}`)
+	assert.Contains(t, body, `trace_id="`+traceID+`"`)
+}
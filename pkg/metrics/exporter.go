@@ -96,6 +96,161 @@ var (
 		[]string{"namespace", "policy"},
 	)
 
+	// CooldownRemaining tracks how long cooldown holds back scaling for a policy
+	CooldownRemaining = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_cooldown_remaining_seconds",
+			Help: "Seconds until the cooldown period allows a scaling decision held back by it, 0 when none is",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// AlgorithmTimeouts tracks algorithm invocations that exceeded their deadline
+	AlgorithmTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_algorithm_timeouts_total",
+			Help: "Total number of scaling algorithm invocations that exceeded their deadline",
+		},
+		[]string{"namespace", "policy", "algorithm"},
+	)
+
+	// DecisionTimeouts tracks reconciles aborted for exceeding the decision budget
+	DecisionTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_decision_timeouts_total",
+			Help: "Total number of reconciles aborted because fetching metrics, computing or writing the decision exceeded its budget",
+		},
+		[]string{"namespace", "policy", "stage"}, // stage: target, metrics, algorithm, scale
+	)
+
+	// SaturatedAtMax counts transitions into the SaturatedAtMax condition
+	SaturatedAtMax = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_saturated_at_max_total",
+			Help: "Total number of times a policy was pinned at maxReplicas with metrics above target beyond the threshold",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// UnconstrainedReplicas tracks the algorithm's recommendation before minReplicas and scale behavior
+	UnconstrainedReplicas = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_unconstrained_replicas",
+			Help: "Replicas the scaling algorithm recommends before minReplicas and scale behavior are applied",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// UnusedCapacity tracks replicas kept above the unconstrained recommendation
+	UnusedCapacity = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_unused_capacity_replicas",
+			Help: "Current replicas above the unconstrained recommendation, held by minReplicas, scale behavior or cooldown",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// RecommendComparisons counts decisions of a Recommend mode controller
+	// compared against the active controller
+	RecommendComparisons = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_recommend_comparisons_total",
+			Help: "Total number of Recommend mode decisions compared with the active controller's decision",
+		},
+		[]string{"namespace", "policy", "result"}, // result: agree, disagree
+	)
+
+	// RecommendDifference tracks how far a Recommend mode decision is from the active controller's
+	RecommendDifference = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_recommend_replica_difference",
+			Help: "Replicas decided in Recommend mode minus the replicas decided by the active controller",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// BackpressureRatio tracks the load shedding signal published for saturated policies
+	BackpressureRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_backpressure_ratio",
+			Help: "Ratio of the most overloaded metric to its target while a policy at maxReplicas publishes a load shedding signal, 0 otherwise",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// OptimizerAdvice counts calls to the external optimizer by outcome
+	OptimizerAdvice = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_optimizer_advice_total",
+			Help: "Total number of external optimizer calls by result",
+		},
+		[]string{"namespace", "policy", "result"}, // result: applied, unchanged, error
+	)
+
+	// MetricMissing counts metric queries that failed, by how the missing
+	// value was handled
+	MetricMissing = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_metric_missing_total",
+			Help: "Total number of failed metric queries by the action taken",
+		},
+		[]string{"namespace", "policy", "metric", "action"}, // action: ignored, lastValue, failClosed
+	)
+
+	// CostPerReplicaHour tracks the realized cost of running one replica for an hour
+	CostPerReplicaHour = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_cost_per_replica_hour",
+			Help: "Realized cost of one replica of the target for an hour, from OpenCost",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// CostPer1kRequests tracks the realized cost of serving a thousand requests
+	CostPer1kRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_cost_per_1k_requests",
+			Help: "Realized cost of serving 1000 requests at the current arrival rate, from OpenCost",
+		},
+		[]string{"namespace", "policy"},
+	)
+
+	// MetricsCircuitState tracks the state of the circuit breaker around each metrics backend
+	MetricsCircuitState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_metrics_circuit_state",
+			Help: "State of the circuit breaker around a metrics backend: 0 closed, 1 half-open, 2 open",
+		},
+		[]string{"backend"},
+	)
+
+	// MetricsCircuitShortCircuits counts queries rejected by an open circuit breaker
+	MetricsCircuitShortCircuits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_metrics_circuit_short_circuits_total",
+			Help: "Total number of metric queries rejected without reaching the backend because its circuit was open",
+		},
+		[]string{"backend"},
+	)
+
+	// QueryCache counts metric queries answered from the shared query cache
+	QueryCache = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_metrics_query_cache_total",
+			Help: "Total number of metric queries by how the shared query cache answered them",
+		},
+		[]string{"result"}, // result: hit, miss, shared
+	)
+
+	// CloudEvents counts the scaling CloudEvents published by their delivery result
+	CloudEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubeai_autoscaler_cloudevents_total",
+			Help: "Total number of scaling CloudEvents by type and delivery result",
+		},
+		[]string{"type", "result"}, // result: sent, failed, dropped
+	)
+
 	// LastScaleTime tracks the timestamp of the last scaling event
 	LastScaleTime = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -104,6 +259,14 @@ var (
 		},
 		[]string{"namespace", "policy"},
 	)
+
+	// EmergencyStopActive tracks whether an emergency stop holds every policy
+	EmergencyStopActive = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kubeai_autoscaler_emergency_stop_active",
+			Help: "1 while an emergency stop halts the target changes of every policy",
+		},
+	)
 )
 
 func init() {
@@ -117,7 +280,25 @@ func init() {
 		ReconcileLatency,
 		ReconcileErrors,
 		CooldownActive,
+		CooldownRemaining,
 		LastScaleTime,
+		AlgorithmTimeouts,
+		DecisionTimeouts,
+		SaturatedAtMax,
+		UnconstrainedReplicas,
+		UnusedCapacity,
+		RecommendComparisons,
+		RecommendDifference,
+		BackpressureRatio,
+		OptimizerAdvice,
+		CostPerReplicaHour,
+		CostPer1kRequests,
+		MetricMissing,
+		MetricsCircuitState,
+		MetricsCircuitShortCircuits,
+		QueryCache,
+		CloudEvents,
+		EmergencyStopActive,
 	)
 }
 
@@ -138,6 +319,20 @@ func RecordMetricValues(namespace, policy, metricType string, value, target floa
 	MetricTarget.WithLabelValues(namespace, policy, metricType).Set(target)
 }
 
+// RecordPolicyMetrics replaces the metric values and targets exported for a
+// policy, so a metric that is no longer reported is not exported stale
+func RecordPolicyMetrics(namespace, policy string, values, targets map[string]float64) {
+	labels := prometheus.Labels{"namespace": namespace, "policy": policy}
+	MetricValue.DeletePartialMatch(labels)
+	MetricTarget.DeletePartialMatch(labels)
+	for metricType, value := range values {
+		MetricValue.WithLabelValues(namespace, policy, metricType).Set(value)
+	}
+	for metricType, target := range targets {
+		MetricTarget.WithLabelValues(namespace, policy, metricType).Set(target)
+	}
+}
+
 // RecordReconcileLatency records the duration of a reconciliation loop
 func RecordReconcileLatency(namespace, policy string, durationSeconds float64) {
 	ReconcileLatency.WithLabelValues(namespace, policy).Observe(durationSeconds)
@@ -157,7 +352,114 @@ func RecordCooldownStatus(namespace, policy string, active bool) {
 	CooldownActive.WithLabelValues(namespace, policy).Set(value)
 }
 
+// RecordCooldownRemaining records how long cooldown holds back scaling for a
+// policy, and whether it is active
+func RecordCooldownRemaining(namespace, policy string, seconds float64) {
+	CooldownRemaining.WithLabelValues(namespace, policy).Set(seconds)
+	RecordCooldownStatus(namespace, policy, seconds > 0)
+}
+
 // RecordLastScaleTime records the timestamp of the last scaling event
 func RecordLastScaleTime(namespace, policy string, timestamp float64) {
 	LastScaleTime.WithLabelValues(namespace, policy).Set(timestamp)
 }
+
+// RecordAlgorithmTimeout records an algorithm invocation that exceeded its deadline
+func RecordAlgorithmTimeout(namespace, policy, algorithm string) {
+	AlgorithmTimeouts.WithLabelValues(namespace, policy, algorithm).Inc()
+}
+
+// RecordDecisionTimeout records a reconcile aborted at stage for exceeding the decision budget
+func RecordDecisionTimeout(namespace, policy, stage string) {
+	DecisionTimeouts.WithLabelValues(namespace, policy, stage).Inc()
+}
+
+// RecordSaturatedAtMax records a policy entering the SaturatedAtMax condition
+func RecordSaturatedAtMax(namespace, policy string) {
+	SaturatedAtMax.WithLabelValues(namespace, policy).Inc()
+}
+
+// RecordUnusedCapacity records the unconstrained recommendation of a policy and
+// how many of its current replicas it would give up
+func RecordUnusedCapacity(namespace, policy string, current, unconstrained int32) {
+	UnconstrainedReplicas.WithLabelValues(namespace, policy).Set(float64(unconstrained))
+	UnusedCapacity.WithLabelValues(namespace, policy).Set(float64(max(current-unconstrained, 0)))
+}
+
+// RecordRecommendComparison records a Recommend mode decision against the
+// decision of the active controller
+func RecordRecommendComparison(namespace, policy string, recommended, active int32) {
+	result := "agree"
+	if recommended != active {
+		result = "disagree"
+	}
+	RecommendComparisons.WithLabelValues(namespace, policy, result).Inc()
+	RecommendDifference.WithLabelValues(namespace, policy).Set(float64(recommended - active))
+}
+
+// RecordOptimizerAdvice records an external optimizer call; result is
+// applied when the advice changed the decision, unchanged when it did not
+// and error when no advice was available
+func RecordOptimizerAdvice(namespace, policy, result string) {
+	OptimizerAdvice.WithLabelValues(namespace, policy, result).Inc()
+}
+
+// RecordBackpressure records the published load shedding ratio, 0 when no signal is published
+func RecordBackpressure(namespace, policy string, ratio float64) {
+	BackpressureRatio.WithLabelValues(namespace, policy).Set(ratio)
+}
+
+// RecordMetricMissing records a failed metric query and the action taken:
+// ignored, lastValue or failClosed
+func RecordMetricMissing(namespace, policy, metric, action string) {
+	MetricMissing.WithLabelValues(namespace, policy, metric, action).Inc()
+}
+
+// RecordCost records the realized cost of a policy's target; the cost per
+// 1000 requests is dropped when the arrival rate is unknown
+func RecordCost(namespace, policy string, perReplicaHour, per1kRequests float64) {
+	CostPerReplicaHour.WithLabelValues(namespace, policy).Set(perReplicaHour)
+	if per1kRequests > 0 {
+		CostPer1kRequests.WithLabelValues(namespace, policy).Set(per1kRequests)
+	} else {
+		CostPer1kRequests.DeleteLabelValues(namespace, policy)
+	}
+}
+
+// RecordCircuitState records the state of the circuit breaker around a metrics backend
+func RecordCircuitState(backend string, state CircuitState) {
+	MetricsCircuitState.WithLabelValues(backend).Set(float64(state))
+}
+
+// RecordCircuitShortCircuit records a query rejected by an open circuit breaker
+func RecordCircuitShortCircuit(backend string) {
+	MetricsCircuitShortCircuits.WithLabelValues(backend).Inc()
+}
+
+// RecordQueryCache records how the shared query cache answered a query: hit
+// when it was cached, shared when an identical query was in flight, miss otherwise
+func RecordQueryCache(result string) {
+	QueryCache.WithLabelValues(result).Inc()
+}
+
+// RecordCloudEvent records the delivery of a CloudEvent: sent, failed or dropped
+func RecordCloudEvent(eventType, result string) {
+	CloudEvents.WithLabelValues(eventType, result).Inc()
+}
+
+// ForgetPolicy drops the per-policy gauges of a deleted policy
+func ForgetPolicy(namespace, policy string) {
+	labels := prometheus.Labels{"namespace": namespace, "policy": policy}
+	CurrentReplicas.DeletePartialMatch(labels)
+	DesiredReplicas.DeletePartialMatch(labels)
+	MetricValue.DeletePartialMatch(labels)
+	MetricTarget.DeletePartialMatch(labels)
+	UnconstrainedReplicas.DeleteLabelValues(namespace, policy)
+	UnusedCapacity.DeleteLabelValues(namespace, policy)
+	RecommendDifference.DeleteLabelValues(namespace, policy)
+	BackpressureRatio.DeleteLabelValues(namespace, policy)
+	CooldownActive.DeleteLabelValues(namespace, policy)
+	CooldownRemaining.DeleteLabelValues(namespace, policy)
+	CostPerReplicaHour.DeleteLabelValues(namespace, policy)
+	CostPer1kRequests.DeleteLabelValues(namespace, policy)
+}
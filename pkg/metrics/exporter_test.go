@@ -18,6 +18,9 @@ package metrics
 
 import (
 	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestRecordScalingDecision(_ *testing.T) {
@@ -36,6 +39,25 @@ func TestRecordMetricValues(_ *testing.T) {
 	RecordMetricValues("default", "test-policy", "gpu_utilization", 85.0, 80.0)
 }
 
+func TestRecordPolicyMetrics(t *testing.T) {
+	RecordPolicyMetrics("default", "replay-policy",
+		map[string]float64{"latencyP99Ms": 600, "gpuUtilizationPercent": 70},
+		map[string]float64{"latencyP99Ms": 500})
+	RecordReplicaCounts("default", "replay-policy", "llm", 3, 4)
+	assert.Equal(t, 600.0, testutil.ToFloat64(MetricValue.WithLabelValues("default", "replay-policy", "latencyP99Ms")))
+
+	// Metrics no longer reported are dropped
+	RecordPolicyMetrics("default", "replay-policy", map[string]float64{"latencyP99Ms": 450}, nil)
+	assert.Equal(t, 450.0, testutil.ToFloat64(MetricValue.WithLabelValues("default", "replay-policy", "latencyP99Ms")))
+	assert.False(t, MetricValue.DeleteLabelValues("default", "replay-policy", "gpuUtilizationPercent"))
+	assert.False(t, MetricTarget.DeleteLabelValues("default", "replay-policy", "latencyP99Ms"))
+
+	RecordPolicyMetrics("default", "replay-policy", map[string]float64{"latencyP99Ms": 450}, nil)
+	ForgetPolicy("default", "replay-policy")
+	assert.False(t, MetricValue.DeleteLabelValues("default", "replay-policy", "latencyP99Ms"))
+	assert.False(t, CurrentReplicas.DeleteLabelValues("default", "replay-policy", "llm"))
+}
+
 func TestRecordReconcileLatency(_ *testing.T) {
 	RecordReconcileLatency("default", "test-policy", 0.5)
 }
@@ -53,3 +75,49 @@ func TestRecordCooldownStatus(_ *testing.T) {
 func TestRecordLastScaleTime(_ *testing.T) {
 	RecordLastScaleTime("default", "test-policy", 1703123456.0)
 }
+
+func TestRecordAlgorithmTimeout(_ *testing.T) {
+	RecordAlgorithmTimeout("default", "test-policy", "CustomAlgo")
+}
+
+func TestRecordSaturatedAtMax(_ *testing.T) {
+	RecordSaturatedAtMax("default", "test-policy")
+}
+
+func TestRecordUnusedCapacity(t *testing.T) {
+	RecordUnusedCapacity("default", "capacity-policy", 6, 2)
+	assert.Equal(t, 2.0, testutil.ToFloat64(UnconstrainedReplicas.WithLabelValues("default", "capacity-policy")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(UnusedCapacity.WithLabelValues("default", "capacity-policy")))
+
+	// Recommendations above the current replicas leave no unused capacity
+	RecordUnusedCapacity("default", "capacity-policy", 6, 9)
+	assert.Equal(t, 0.0, testutil.ToFloat64(UnusedCapacity.WithLabelValues("default", "capacity-policy")))
+
+	ForgetPolicy("default", "capacity-policy")
+	assert.Equal(t, 0, testutil.CollectAndCount(UnusedCapacity))
+}
+
+func TestRecordRecommendComparison(t *testing.T) {
+	RecordRecommendComparison("default", "recommend-policy", 4, 4)
+	RecordRecommendComparison("default", "recommend-policy", 6, 4)
+	assert.Equal(t, 1.0, testutil.ToFloat64(RecommendComparisons.WithLabelValues("default", "recommend-policy", "agree")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(RecommendComparisons.WithLabelValues("default", "recommend-policy", "disagree")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(RecommendDifference.WithLabelValues("default", "recommend-policy")))
+
+	ForgetPolicy("default", "recommend-policy")
+	assert.Equal(t, 0, testutil.CollectAndCount(RecommendDifference))
+}
+
+func TestRecordBackpressure(t *testing.T) {
+	RecordBackpressure("default", "backpressure-policy", 1.35)
+	assert.Equal(t, 1.35, testutil.ToFloat64(BackpressureRatio.WithLabelValues("default", "backpressure-policy")))
+
+	ForgetPolicy("default", "backpressure-policy")
+	assert.Equal(t, 0, testutil.CollectAndCount(BackpressureRatio))
+}
+
+func TestRecordDecisionTimeout(t *testing.T) {
+	before := testutil.ToFloat64(DecisionTimeouts.WithLabelValues("default", "test-policy", "metrics"))
+	RecordDecisionTimeout("default", "test-policy", "metrics")
+	assert.Equal(t, before+1, testutil.ToFloat64(DecisionTimeouts.WithLabelValues("default", "test-policy", "metrics")))
+}
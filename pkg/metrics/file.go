@@ -0,0 +1,132 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// FileValues is the content of a metrics file read by FileClient. The built-in
+// metrics use the units of the policy status; queries maps a PromQL query to
+// the raw value it returns, for policies that set their own prometheusQuery.
+type FileValues struct {
+	LatencyP99Ms          float64            `json:"latencyP99Ms,omitempty"`
+	LatencyP95Ms          float64            `json:"latencyP95Ms,omitempty"`
+	GPUUtilizationPercent float64            `json:"gpuUtilizationPercent,omitempty"`
+	RequestQueueDepth     int64              `json:"requestQueueDepth,omitempty"`
+	TokensPerSecond       float64            `json:"tokensPerSecond,omitempty"`
+	InFlightRequests      int64              `json:"inFlightRequests,omitempty"`
+	Queries               map[string]float64 `json:"queries,omitempty"`
+}
+
+// FileClient implements the Client interface with values read from a local
+// YAML file. The file is re-read whenever its modification time changes, so
+// values can be edited while the controller runs. It stands in for Prometheus
+// during local development.
+type FileClient struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	values  FileValues
+	err     error
+}
+
+// NewFileClient creates a client reading metric values from path
+func NewFileClient(path string) (*FileClient, error) {
+	c := &FileClient{path: path}
+	if _, err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// load returns the current file values, re-reading the file if it changed
+func (c *FileClient) load() (FileValues, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return FileValues{}, fmt.Errorf("failed to read metrics file: %w", err)
+	}
+	if !info.ModTime().Equal(c.modTime) {
+		c.modTime = info.ModTime()
+		c.values, c.err = readFileValues(c.path)
+	}
+	return c.values, c.err
+}
+
+func readFileValues(path string) (FileValues, error) {
+	data, err := os.ReadFile(path) // #nosec G304 - path is supplied by the operator
+	if err != nil {
+		return FileValues{}, fmt.Errorf("failed to read metrics file: %w", err)
+	}
+	var values FileValues
+	if err := yaml.UnmarshalStrict(data, &values); err != nil {
+		return FileValues{}, fmt.Errorf("invalid metrics file %s: %w", path, err)
+	}
+	return values, nil
+}
+
+// Healthy reports whether the metrics file can be read and parsed
+func (c *FileClient) Healthy(ctx context.Context) error {
+	_, err := c.load()
+	return err
+}
+
+// fileBuiltins read the built-in metrics from the file, in the units of GetMetric
+var fileBuiltins = map[string]func(FileValues) float64{
+	MetricLatencyP99:       func(v FileValues) float64 { return v.LatencyP99Ms / 1000 },
+	MetricLatencyP95:       func(v FileValues) float64 { return v.LatencyP95Ms / 1000 },
+	MetricGPUUtilization:   func(v FileValues) float64 { return v.GPUUtilizationPercent },
+	MetricQueueDepth:       func(v FileValues) float64 { return float64(v.RequestQueueDepth) },
+	MetricTokensPerSecond:  func(v FileValues) float64 { return v.TokensPerSecond },
+	MetricInFlightRequests: func(v FileValues) float64 { return float64(v.InFlightRequests) },
+}
+
+// GetMetric returns the value listed under queries for a custom query, or the
+// file's built-in value when the metric uses its default query. Tokens preset
+// queries read the built-in value too.
+func (c *FileClient) GetMetric(_ context.Context, q MetricQuery) (Sample, error) {
+	query := q.Query
+	if q.Metric == MetricTokensPerSecond && isTokensPresetQuery(query) {
+		query = ""
+	}
+	values, err := c.load()
+	if err != nil {
+		return Sample{}, err
+	}
+	if query != "" {
+		value, ok := values.Queries[query]
+		if !ok {
+			return Sample{}, fmt.Errorf("no value for query in metrics file: %s", query)
+		}
+		return Sample{Value: value}, nil
+	}
+	builtin, ok := fileBuiltins[q.Metric]
+	if !ok {
+		return Sample{}, errNoQuery(q.Metric)
+	}
+	return Sample{Value: builtin(values)}, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeMetricsFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestFileClient(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "metrics.yaml")
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	writeMetricsFile(t, path, `
+latencyP99Ms: 450
+latencyP95Ms: 300
+gpuUtilizationPercent: 85
+requestQueueDepth: 12
+tokensPerSecond: 2400
+inFlightRequests: 20
+queries:
+  custom_gpu: 70
+`, start)
+
+	c, err := NewFileClient(path)
+	require.NoError(t, err)
+	require.NoError(t, CheckHealth(ctx, c))
+
+	p99, err := GetLatencyP99(ctx, c, "")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.45, p99, 1e-9)
+	p95, err := GetLatencyP95(ctx, c, "")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.3, p95, 1e-9)
+	depth, err := GetQueueDepth(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), depth)
+	// Preset queries read the built-in value
+	tokens, err := GetTokensPerSecond(ctx, c, TokensPerSecondQuery(TokensPresetTGI))
+	require.NoError(t, err)
+	assert.Equal(t, 2400.0, tokens)
+	inFlight, err := GetInFlightRequests(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), inFlight)
+
+	// Custom queries are answered from the queries map
+	gpu, err := GetGPUUtilization(ctx, c, "custom_gpu")
+	require.NoError(t, err)
+	assert.Equal(t, 70.0, gpu)
+	_, err = GetGPUUtilization(ctx, c, "unknown_query")
+	assert.Error(t, err)
+
+	// Edits are picked up without recreating the client
+	writeMetricsFile(t, path, "gpuUtilizationPercent: 20\n", start.Add(time.Second))
+	gpu, err = GetGPUUtilization(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, 20.0, gpu)
+
+	// A broken edit makes the client unhealthy until it is fixed
+	writeMetricsFile(t, path, "gpuUtilization: [", start.Add(2*time.Second))
+	assert.Error(t, CheckHealth(ctx, c))
+	writeMetricsFile(t, path, "gpuUtilizationPercent: 30\n", start.Add(3*time.Second))
+	assert.NoError(t, CheckHealth(ctx, c))
+}
+
+func TestNewFileClientErrors(t *testing.T) {
+	_, err := NewFileClient(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+
+	// Unknown fields are rejected so typos do not silently read as zero
+	path := filepath.Join(t.TempDir(), "metrics.yaml")
+	writeMetricsFile(t, path, "gpuUtilisationPercent: 85\n", time.Now())
+	_, err = NewFileClient(path)
+	assert.Error(t, err)
+}
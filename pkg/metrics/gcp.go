@@ -0,0 +1,353 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Environment variables read by DefaultGoogleTokenSource, as set by the
+// Google Cloud client libraries' conventions
+const (
+	envGoogleCredentials = "GOOGLE_APPLICATION_CREDENTIALS"
+	envGCEMetadataHost   = "GCE_METADATA_HOST"
+)
+
+const (
+	// googleMonitoringReadScope is the OAuth scope tokens from a service account key are requested for
+	googleMonitoringReadScope = "https://www.googleapis.com/auth/monitoring.read"
+	// defaultGoogleTokenURI exchanges service account assertions for access tokens
+	defaultGoogleTokenURI = "https://oauth2.googleapis.com/token"
+	// defaultGCEMetadataHost serves workload identity tokens on GKE
+	defaultGCEMetadataHost = "metadata.google.internal"
+)
+
+// GoogleToken authorizes requests to Google Cloud APIs
+type GoogleToken struct {
+	AccessToken string
+	// Expires is when the token stops working; zero if unknown
+	Expires time.Time
+}
+
+// GoogleTokenSource supplies the access tokens requests are authorized with
+type GoogleTokenSource interface {
+	Token(ctx context.Context) (GoogleToken, error)
+}
+
+// GoogleTokenFunc adapts a function to GoogleTokenSource
+type GoogleTokenFunc func(ctx context.Context) (GoogleToken, error)
+
+// Token calls f
+func (f GoogleTokenFunc) Token(ctx context.Context) (GoogleToken, error) {
+	return f(ctx)
+}
+
+// StaticGoogleToken returns a source of a fixed access token
+func StaticGoogleToken(accessToken string) GoogleTokenSource {
+	return GoogleTokenFunc(func(context.Context) (GoogleToken, error) {
+		return GoogleToken{AccessToken: accessToken}, nil
+	})
+}
+
+// DefaultGoogleTokenSource returns the credentials configured in the
+// environment of the controller, in the order the Google Cloud client
+// libraries look for them: a service account key file, then the metadata
+// server, which serves the tokens of workload identity on GKE
+func DefaultGoogleTokenSource() (GoogleTokenSource, error) {
+	if path := os.Getenv(envGoogleCredentials); path != "" {
+		key, err := ReadGoogleServiceAccountKey(path)
+		if err != nil {
+			return nil, err
+		}
+		return NewCachingGoogleTokenSource(key), nil
+	}
+	return NewCachingGoogleTokenSource(&MetadataTokenSource{Host: os.Getenv(envGCEMetadataHost)}), nil
+}
+
+// CachingGoogleTokenSource reuses the token of a source until shortly before it expires
+type CachingGoogleTokenSource struct {
+	source GoogleTokenSource
+	now    func() time.Time
+
+	mu    sync.Mutex
+	token GoogleToken
+}
+
+// NewCachingGoogleTokenSource caches the tokens of source
+func NewCachingGoogleTokenSource(source GoogleTokenSource) *CachingGoogleTokenSource {
+	return &CachingGoogleTokenSource{source: source, now: time.Now}
+}
+
+// Token returns the cached token, refreshing it when it is about to expire
+func (c *CachingGoogleTokenSource) Token(ctx context.Context) (GoogleToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token.AccessToken != "" && (c.token.Expires.IsZero() || c.now().Before(c.token.Expires.Add(-credentialsRefreshMargin))) {
+		return c.token, nil
+	}
+	token, err := c.source.Token(ctx)
+	if err != nil {
+		return GoogleToken{}, err
+	}
+	c.token = token
+	return token, nil
+}
+
+// MetadataTokenSource fetches the tokens of the default service account from
+// the metadata server: on GKE with workload identity, those of the Google
+// service account bound to the controller's Kubernetes service account
+type MetadataTokenSource struct {
+	// Host of the metadata server; metadata.google.internal when empty
+	Host       string
+	HTTPClient *http.Client
+}
+
+// Token fetches a token from the metadata server
+func (m *MetadataTokenSource) Token(ctx context.Context) (GoogleToken, error) {
+	host := m.Host
+	if host == "" {
+		host = defaultGCEMetadataHost
+	}
+	endpoint := "http://" + host + "/computeMetadata/v1/instance/service-accounts/default/token"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return GoogleToken{}, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	token, err := doGoogleTokenRequest(httpClientOrDefault(m.HTTPClient), req)
+	if err != nil {
+		return GoogleToken{}, fmt.Errorf("fetching token from the metadata server: %w", err)
+	}
+	return token, nil
+}
+
+// GoogleServiceAccountKey exchanges a signed assertion of a service account
+// key for access tokens
+type GoogleServiceAccountKey struct {
+	ClientEmail string
+	PrivateKey  *rsa.PrivateKey
+	// TokenURI defaults to Google's OAuth 2.0 token endpoint
+	TokenURI   string
+	HTTPClient *http.Client
+
+	now func() time.Time
+}
+
+// ReadGoogleServiceAccountKey reads a JSON service account key file
+func ReadGoogleServiceAccountKey(path string) (*GoogleServiceAccountKey, error) {
+	data, err := os.ReadFile(path) // #nosec G304 - path is set by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading Google credentials: %w", err)
+	}
+	var file struct {
+		Type        string `json:"type"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+		TokenURI    string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("decoding Google credentials %s: %w", path, err)
+	}
+	if file.Type != "service_account" {
+		return nil, fmt.Errorf("Google credentials %s are of type %q; only service account keys are supported", path, file.Type)
+	}
+	key, err := parseRSAPrivateKey([]byte(file.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("Google credentials %s: %w", path, err)
+	}
+	return &GoogleServiceAccountKey{ClientEmail: file.ClientEmail, PrivateKey: key, TokenURI: file.TokenURI}, nil
+}
+
+// parseRSAPrivateKey decodes a PEM-encoded PKCS #8 or PKCS #1 RSA key
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("private key is not PEM-encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// Token exchanges a JWT signed with the key for a token scoped to reading monitoring data
+func (k *GoogleServiceAccountKey) Token(ctx context.Context) (GoogleToken, error) {
+	tokenURI := k.TokenURI
+	if tokenURI == "" {
+		tokenURI = defaultGoogleTokenURI
+	}
+	now := time.Now
+	if k.now != nil {
+		now = k.now
+	}
+	issued := now()
+	assertion, err := signJWT(k.PrivateKey, map[string]any{
+		"iss":   k.ClientEmail,
+		"scope": googleMonitoringReadScope,
+		"aud":   tokenURI,
+		"iat":   issued.Unix(),
+		"exp":   issued.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return GoogleToken{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return GoogleToken{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	token, err := doGoogleTokenRequest(httpClientOrDefault(k.HTTPClient), req)
+	if err != nil {
+		return GoogleToken{}, fmt.Errorf("exchanging key of %s for a token: %w", k.ClientEmail, err)
+	}
+	return token, nil
+}
+
+// signJWT encodes claims as a JWT signed with RS256
+func signJWT(key *rsa.PrivateKey, claims map[string]any) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing JWT: %w", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// doGoogleTokenRequest sends a request answered with an OAuth 2.0 token response
+func doGoogleTokenRequest(c *http.Client, req *http.Request) (GoogleToken, error) {
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := doGoogleJSON(c, req, &resp); err != nil {
+		return GoogleToken{}, err
+	}
+	if resp.AccessToken == "" {
+		return GoogleToken{}, errors.New("response has no access token")
+	}
+	token := GoogleToken{AccessToken: resp.AccessToken}
+	if resp.ExpiresIn > 0 {
+		token.Expires = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// googleErrorResponse is the body of a failed Google API or OAuth 2.0 call
+type googleErrorResponse struct {
+	Error json.RawMessage `json:"error"`
+	// ErrorDescription is set by the OAuth 2.0 token endpoint
+	ErrorDescription string `json:"error_description"`
+}
+
+// message returns the error of the response, or "" when it has none
+func (e googleErrorResponse) message() string {
+	var apiErr struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(e.Error, &apiErr) == nil && apiErr.Message != "" {
+		return apiErr.Status + ": " + apiErr.Message
+	}
+	var code string
+	if json.Unmarshal(e.Error, &code) == nil && code != "" {
+		return code + ": " + e.ErrorDescription
+	}
+	return ""
+}
+
+// doGoogleJSON sends a request to a Google API and decodes its JSON response into out
+func doGoogleJSON(c *http.Client, req *http.Request, out any) error {
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr googleErrorResponse
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.message() != "" {
+			return errors.New(apiErr.message())
+		}
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body[:min(len(body), 512)])))
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+// googleAuthTransport authorizes every request with a token of its source
+type googleAuthTransport struct {
+	tokens GoogleTokenSource
+	base   http.RoundTripper
+}
+
+// RoundTrip sends a copy of req carrying the bearer token
+func (t *googleAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokens.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Google credentials: %w", err)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/computeMetadata/v1/instance/service-accounts/default/token", r.URL.Path)
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token": "ya29.workload", "expires_in": 3599, "token_type": "Bearer"}`))
+	}))
+	defer server.Close()
+
+	source := &MetadataTokenSource{Host: strings.TrimPrefix(server.URL, "http://")}
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ya29.workload", token.AccessToken)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.Expires, time.Minute)
+}
+
+func TestGoogleServiceAccountKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issued := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+		parts := strings.Split(r.PostForm.Get("assertion"), ".")
+		require.Len(t, parts, 3)
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		if rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Invalid JWT Signature."}`))
+			return
+		}
+		payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		var claims map[string]any
+		require.NoError(t, json.Unmarshal(payload, &claims))
+		assert.Equal(t, "autoscaler@llm-prod.iam.gserviceaccount.com", claims["iss"])
+		assert.Equal(t, googleMonitoringReadScope, claims["scope"])
+		assert.Equal(t, "http://"+r.Host+"/token", claims["aud"])
+		assert.Equal(t, float64(issued.Unix()), claims["iat"])
+		_, _ = w.Write([]byte(`{"access_token": "ya29.key", "expires_in": 3599}`))
+	}))
+	defer server.Close()
+
+	// Key files hold PKCS #8 keys
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyFile, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "autoscaler@llm-prod.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":    server.URL + "/token",
+	})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(path, keyFile, 0o600))
+
+	t.Setenv(envGoogleCredentials, path)
+	source, err := DefaultGoogleTokenSource()
+	require.NoError(t, err)
+	account := source.(*CachingGoogleTokenSource).source.(*GoogleServiceAccountKey)
+	account.now = func() time.Time { return issued }
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ya29.key", token.AccessToken)
+
+	// A key the token endpoint rejects reports its error
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	account.PrivateKey = other
+	_, err = account.Token(context.Background())
+	assert.ErrorContains(t, err, "invalid_grant: Invalid JWT Signature.")
+}
+
+func TestReadGoogleServiceAccountKeyErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"type": "external_account"}`), 0o600))
+	_, err := ReadGoogleServiceAccountKey(path)
+	assert.ErrorContains(t, err, "only service account keys are supported")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"type": "service_account", "private_key": "not a key"}`), 0o600))
+	_, err = ReadGoogleServiceAccountKey(path)
+	assert.ErrorContains(t, err, "not PEM-encoded")
+}
+
+func TestCachingGoogleTokenSource(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	source := NewCachingGoogleTokenSource(GoogleTokenFunc(func(context.Context) (GoogleToken, error) {
+		calls++
+		if calls == 3 {
+			return GoogleToken{}, errors.New("metadata server unavailable")
+		}
+		return GoogleToken{AccessToken: "token", Expires: now.Add(time.Hour)}, nil
+	}))
+	source.now = func() time.Time { return now }
+
+	for range 3 {
+		_, err := source.Token(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, calls)
+
+	// Tokens are refreshed shortly before they expire
+	now = now.Add(56 * time.Minute)
+	_, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	now = now.Add(time.Hour)
+	_, err = source.Token(context.Background())
+	assert.ErrorContains(t, err, "metadata server unavailable")
+}
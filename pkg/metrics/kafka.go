@@ -0,0 +1,113 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KafkaBacklog reads the lag of Kafka consumer groups from the v3 API of a
+// Kafka REST Proxy, such as Confluent REST Proxy or Confluent Server
+type KafkaBacklog struct {
+	address    string
+	topic      string
+	auth       BasicAuthFunc
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	clusterID string
+}
+
+var _ BacklogReader = &KafkaBacklog{}
+
+// NewKafkaBacklog creates a reader for the REST Proxy at address, such as
+// http://kafka-rest.messaging:8082, authenticating with auth when it is set.
+// A non-empty topic restricts the lag to the partitions of that topic.
+func NewKafkaBacklog(address, topic string, auth BasicAuthFunc) *KafkaBacklog {
+	return &KafkaBacklog{
+		address:    strings.TrimSuffix(address, "/"),
+		topic:      topic,
+		auth:       auth,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Backlog returns the total lag of the consumer group named by group, summed
+// over the partitions it consumes
+func (k *KafkaBacklog) Backlog(ctx context.Context, group string) (int64, error) {
+	clusterID, err := k.cluster(ctx)
+	if err != nil {
+		return 0, err
+	}
+	endpoint := k.address + "/v3/clusters/" + url.PathEscape(clusterID) +
+		"/consumer-groups/" + url.PathEscape(group)
+	if k.topic == "" {
+		var summary struct {
+			TotalLag int64 `json:"total_lag"`
+		}
+		if err := getJSON(ctx, k.httpClient, endpoint+"/lag-summary", k.auth, &summary); err != nil {
+			return 0, err
+		}
+		return summary.TotalLag, nil
+	}
+
+	// The summary spans every topic, so sum the lags of the topic's partitions
+	var lags struct {
+		Data []struct {
+			TopicName string `json:"topic_name"`
+			Lag       int64  `json:"lag"`
+		} `json:"data"`
+	}
+	if err := getJSON(ctx, k.httpClient, endpoint+"/lags", k.auth, &lags); err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, lag := range lags.Data {
+		if lag.TopicName == k.topic {
+			total += lag.Lag
+		}
+	}
+	return total, nil
+}
+
+// cluster returns the ID of the cluster served by the proxy, looking it up once
+func (k *KafkaBacklog) cluster(ctx context.Context) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.clusterID != "" {
+		return k.clusterID, nil
+	}
+	var clusters struct {
+		Data []struct {
+			ClusterID string `json:"cluster_id"`
+		} `json:"data"`
+	}
+	if err := getJSON(ctx, k.httpClient, k.address+"/v3/clusters", k.auth, &clusters); err != nil {
+		return "", err
+	}
+	if len(clusters.Data) == 0 {
+		return "", fmt.Errorf("REST Proxy at %s serves no Kafka cluster", k.address)
+	}
+	k.clusterID = clusters.Data[0].ClusterID
+	return k.clusterID, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaBacklog(t *testing.T) {
+	clusterLookups := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/clusters":
+			clusterLookups++
+			_, _ = w.Write([]byte(`{"kind": "KafkaClusterList", "data": [{"cluster_id": "lkc-abc123"}]}`))
+		case "/v3/clusters/lkc-abc123/consumer-groups/inference-workers/lag-summary":
+			_, _ = w.Write([]byte(`{"consumer_group_id": "inference-workers", "max_lag": 80, "total_lag": 250}`))
+		case "/v3/clusters/lkc-abc123/consumer-groups/inference-workers/lags":
+			_, _ = w.Write([]byte(`{"kind": "KafkaConsumerLagList", "data": [` +
+				`{"topic_name": "requests", "partition_id": 0, "current_offset": 100, "log_end_offset": 180, "lag": 80},` +
+				`{"topic_name": "requests", "partition_id": 1, "current_offset": 50, "log_end_offset": 70, "lag": 20},` +
+				`{"topic_name": "retries", "partition_id": 0, "current_offset": 10, "log_end_offset": 160, "lag": 150}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code": 404, "message": "Consumer group not found"}`))
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	k := NewKafkaBacklog(server.URL, "", nil)
+	backlog, err := k.Backlog(ctx, "inference-workers")
+	require.NoError(t, err)
+	assert.Equal(t, int64(250), backlog)
+
+	_, err = k.Backlog(ctx, "missing")
+	assert.ErrorContains(t, err, "Consumer group not found")
+	// The cluster is looked up once
+	assert.Equal(t, 1, clusterLookups)
+
+	// A topic sums the lags of its partitions only
+	backlog, err = NewKafkaBacklog(server.URL, "requests", nil).Backlog(ctx, "inference-workers")
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), backlog)
+}
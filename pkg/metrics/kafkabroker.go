@@ -0,0 +1,110 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+)
+
+// kafkaClientID identifies the controller to the Kafka brokers
+const kafkaClientID = "kubeai-autoscaler"
+
+// KafkaBrokerBacklog reads the lag of Kafka consumer groups from the brokers
+// over the Kafka protocol, for clusters without a REST Proxy. The lag of a
+// group is the distance from its committed offsets to the ends of the
+// partitions, as computed by the franz-go admin client. Credentials are sent
+// with SASL/PLAIN, and only over TLS.
+type KafkaBrokerBacklog struct {
+	opts    []kgo.Opt
+	topic   string
+	timeout time.Duration
+}
+
+var _ BacklogReader = &KafkaBrokerBacklog{}
+
+// NewKafkaBrokerBacklog creates a reader bootstrapping from the brokers,
+// host:port addresses, connecting over TLS when tlsConfig is set and
+// authenticating with auth when it is set. auth requires tlsConfig. A
+// non-empty topic restricts the lag to the partitions of that topic.
+func NewKafkaBrokerBacklog(brokers []string, topic string, tlsConfig *tls.Config, auth BasicAuthFunc) (*KafkaBrokerBacklog, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("no Kafka brokers to bootstrap from")
+	}
+	opts := []kgo.Opt{kgo.SeedBrokers(brokers...), kgo.ClientID(kafkaClientID)}
+	if tlsConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+	if auth != nil {
+		if tlsConfig == nil {
+			return nil, errors.New("credentials are only sent to Kafka brokers over TLS")
+		}
+		opts = append(opts, kgo.SASL(plain.Plain(func(ctx context.Context) (plain.Auth, error) {
+			username, password, err := auth(ctx)
+			return plain.Auth{User: username, Pass: password}, err
+		})))
+	}
+	return &KafkaBrokerBacklog{opts: opts, topic: topic, timeout: 10 * time.Second}, nil
+}
+
+// Backlog returns the total lag of the consumer group named by group, summed
+// over the partitions it has committed offsets for
+func (k *KafkaBrokerBacklog) Backlog(ctx context.Context, group string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, k.timeout)
+	defer cancel()
+	cl, err := kgo.NewClient(k.opts...)
+	if err != nil {
+		return 0, err
+	}
+	defer cl.Close()
+
+	lags, err := kadm.NewClient(cl).Lag(ctx, group)
+	if err != nil {
+		return 0, err
+	}
+	lag := lags[group]
+	if errors.Is(lag.Error(), kerr.GroupIDNotFound) {
+		// A group that never committed offsets has no lag yet
+		return 0, nil
+	}
+	if err := lag.Error(); err != nil {
+		return 0, fmt.Errorf("reading the offsets of group %s: %w", group, err)
+	}
+	var total int64
+	for topic, partitions := range lag.Lag {
+		if k.topic != "" && topic != k.topic {
+			continue
+		}
+		for _, partition := range partitions {
+			if partition.Err != nil {
+				return 0, fmt.Errorf("partition %d of topic %s: %w", partition.Partition, topic, partition.Err)
+			}
+			if partition.Lag > 0 {
+				total += partition.Lag
+			}
+		}
+	}
+	return total, nil
+}
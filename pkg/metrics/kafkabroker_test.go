@@ -0,0 +1,129 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+)
+
+// newKafkaCluster starts an in-memory Kafka cluster of two brokers where the
+// group inference-workers lags 80 and 20 records behind on the two partitions
+// of requests and 150 on the partition of retries
+func newKafkaCluster(t *testing.T, opts ...kfake.Opt) (*kfake.Cluster, []kgo.Opt) {
+	cluster, err := kfake.NewCluster(append([]kfake.Opt{kfake.NumBrokers(2)}, opts...)...)
+	require.NoError(t, err)
+	t.Cleanup(cluster.Close)
+	return cluster, []kgo.Opt{kgo.SeedBrokers(cluster.ListenAddrs()...), kgo.RecordPartitioner(kgo.ManualPartitioner())}
+}
+
+// seedKafkaLag produces the records and commits the offsets of newKafkaCluster
+func seedKafkaLag(t *testing.T, opts ...kgo.Opt) {
+	ctx := context.Background()
+	cl, err := kgo.NewClient(opts...)
+	require.NoError(t, err)
+	defer cl.Close()
+	adm := kadm.NewClient(cl)
+	_, err = adm.CreateTopic(ctx, 2, 1, nil, "requests")
+	require.NoError(t, err)
+	_, err = adm.CreateTopic(ctx, 1, 1, nil, "retries")
+	require.NoError(t, err)
+
+	ends := map[string][]int64{"requests": {180, 70}, "retries": {160}}
+	committed := map[string][]int64{"requests": {100, 50}, "retries": {10}}
+	var records []*kgo.Record
+	offsets := make(kadm.Offsets)
+	for topic, partitions := range ends {
+		for partition, end := range partitions {
+			for range end {
+				records = append(records, &kgo.Record{Topic: topic, Partition: int32(partition), Value: []byte("request")})
+			}
+			offsets.AddOffset(topic, int32(partition), committed[topic][partition], -1)
+		}
+	}
+	require.NoError(t, cl.ProduceSync(ctx, records...).FirstErr())
+	require.NoError(t, adm.CommitAllOffsets(ctx, "inference-workers", offsets))
+}
+
+func TestKafkaBrokerBacklog(t *testing.T) {
+	cluster, opts := newKafkaCluster(t)
+	seedKafkaLag(t, opts...)
+	brokers := cluster.ListenAddrs()
+	ctx := context.Background()
+
+	reader, err := NewKafkaBrokerBacklog(brokers, "", nil, nil)
+	require.NoError(t, err)
+	backlog, err := reader.Backlog(ctx, "inference-workers")
+	require.NoError(t, err)
+	assert.Equal(t, int64(80+20+150), backlog)
+
+	reader, err = NewKafkaBrokerBacklog(brokers, "requests", nil, nil)
+	require.NoError(t, err)
+	backlog, err = reader.Backlog(ctx, "inference-workers")
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), backlog)
+
+	// A topic the group does not consume has no lag, nor does an unknown group
+	reader, err = NewKafkaBrokerBacklog(brokers, "other", nil, nil)
+	require.NoError(t, err)
+	backlog, err = reader.Backlog(ctx, "inference-workers")
+	require.NoError(t, err)
+	assert.Zero(t, backlog)
+	backlog, err = reader.Backlog(ctx, "batch-workers")
+	require.NoError(t, err)
+	assert.Zero(t, backlog)
+}
+
+func TestKafkaBrokerBacklogCredentials(t *testing.T) {
+	// Borrow the certificate of a TLS test server, valid for 127.0.0.1
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+	cluster, opts := newKafkaCluster(t,
+		kfake.TLS(&tls.Config{Certificates: server.TLS.Certificates}),
+		kfake.EnableSASL(), kfake.Superuser("PLAIN", "worker", "secret"))
+	clientTLS := &tls.Config{RootCAs: server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs}
+	seedKafkaLag(t, append(opts, kgo.DialTLSConfig(clientTLS),
+		kgo.SASL(plain.Auth{User: "worker", Pass: "secret"}.AsMechanism()))...)
+	brokers := cluster.ListenAddrs()
+	ctx := context.Background()
+
+	password := "secret"
+	auth := func(context.Context) (string, string, error) { return "worker", password, nil }
+	_, err := NewKafkaBrokerBacklog(brokers, "", nil, auth)
+	assert.EqualError(t, err, "credentials are only sent to Kafka brokers over TLS")
+
+	reader, err := NewKafkaBrokerBacklog(brokers, "retries", clientTLS, auth)
+	require.NoError(t, err)
+	backlog, err := reader.Backlog(ctx, "inference-workers")
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), backlog)
+
+	// Rotated credentials are read on every backlog; the brokers reject them
+	password = "rotated"
+	_, err = reader.Backlog(ctx, "inference-workers")
+	assert.Error(t, err)
+}
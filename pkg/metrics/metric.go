@@ -0,0 +1,112 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Built-in metrics fetched with GetMetric. Each has a default query on every
+// backend, used when the MetricQuery sets none.
+const (
+	// MetricLatencyP99 is the P99 request latency in seconds
+	MetricLatencyP99 = "latencyP99"
+	// MetricLatencyP95 is the P95 request latency in seconds
+	MetricLatencyP95 = "latencyP95"
+	// MetricGPUUtilization is the GPU utilization percentage
+	MetricGPUUtilization = "gpuUtilization"
+	// MetricQueueDepth is the number of queued requests
+	MetricQueueDepth = "queueDepth"
+	// MetricTokensPerSecond is the number of generated tokens per second
+	MetricTokensPerSecond = "tokensPerSecond"
+	// MetricInFlightRequests is the number of requests being served
+	MetricInFlightRequests = "inFlightRequests"
+)
+
+// MetricQuery selects the metric GetMetric fetches
+type MetricQuery struct {
+	// Metric names a built-in metric; empty runs Query as is
+	Metric string
+	// Query is PromQL for Prometheus and a metric name for pod scraping. It
+	// replaces the default query of Metric and is required without one.
+	Query string
+}
+
+// Sample is a metric value with the time it was observed and the labels of
+// the series it came from
+type Sample struct {
+	Value float64
+	// Timestamp is when the value was observed; zero when the backend does not say
+	Timestamp time.Time
+	// Labels of the series; nil when the value combines several series
+	Labels map[string]string
+}
+
+// errNoQuery is returned for a MetricQuery with neither a query nor a
+// built-in metric with a default query
+func errNoQuery(metric string) error {
+	if metric == "" {
+		return fmt.Errorf("metric query has neither a metric nor a query")
+	}
+	return fmt.Errorf("unknown metric %q", metric)
+}
+
+// sampleValue returns the value of the sample fetched by GetMetric
+func sampleValue(sample Sample, err error) (float64, error) {
+	return sample.Value, err
+}
+
+// Query runs query on c and returns the value of its first series
+func Query(ctx context.Context, c Client, query string) (float64, error) {
+	return sampleValue(c.GetMetric(ctx, MetricQuery{Query: query}))
+}
+
+// GetLatencyP99 fetches the P99 latency in seconds; an empty query uses the default
+func GetLatencyP99(ctx context.Context, c Client, query string) (float64, error) {
+	return sampleValue(c.GetMetric(ctx, MetricQuery{Metric: MetricLatencyP99, Query: query}))
+}
+
+// GetLatencyP95 fetches the P95 latency in seconds; an empty query uses the default
+func GetLatencyP95(ctx context.Context, c Client, query string) (float64, error) {
+	return sampleValue(c.GetMetric(ctx, MetricQuery{Metric: MetricLatencyP95, Query: query}))
+}
+
+// GetGPUUtilization fetches the GPU utilization percentage; an empty query uses the default
+func GetGPUUtilization(ctx context.Context, c Client, query string) (float64, error) {
+	return sampleValue(c.GetMetric(ctx, MetricQuery{Metric: MetricGPUUtilization, Query: query}))
+}
+
+// GetQueueDepth fetches the request queue depth; an empty query uses the default
+func GetQueueDepth(ctx context.Context, c Client, query string) (int64, error) {
+	depth, err := sampleValue(c.GetMetric(ctx, MetricQuery{Metric: MetricQueueDepth, Query: query}))
+	return int64(depth), err
+}
+
+// GetTokensPerSecond fetches the generated tokens per second; an empty query
+// uses the vLLM preset
+func GetTokensPerSecond(ctx context.Context, c Client, query string) (float64, error) {
+	return sampleValue(c.GetMetric(ctx, MetricQuery{Metric: MetricTokensPerSecond, Query: query}))
+}
+
+// GetInFlightRequests fetches the number of requests being served; an empty
+// query uses the default
+func GetInFlightRequests(ctx context.Context, c Client, query string) (int64, error) {
+	requests, err := sampleValue(c.GetMetric(ctx, MetricQuery{Metric: MetricInFlightRequests, Query: query}))
+	return int64(requests), err
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusClientGetMetric(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form = r.Form
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"pod":"a"},"value":[1700000000,"42"]},
+			{"metric":{"pod":"b"},"value":[1700000000,"7"]}]}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewPrometheusClient(server.URL, RetryConfig{})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	sample, err := c.GetMetric(ctx, MetricQuery{Metric: MetricGPUUtilization})
+	require.NoError(t, err)
+	assert.Equal(t, defaultQueries[MetricGPUUtilization], form.Get("query"))
+	assert.Equal(t, 42.0, sample.Value)
+	assert.Equal(t, time.Unix(1700000000, 0), sample.Timestamp)
+	assert.Equal(t, map[string]string{"pod": "a"}, sample.Labels)
+
+	_, err = c.GetMetric(ctx, MetricQuery{Metric: MetricGPUUtilization, Query: "max(custom_gpu)"})
+	require.NoError(t, err)
+	assert.Equal(t, "max(custom_gpu)", form.Get("query"))
+
+	_, err = c.GetMetric(ctx, MetricQuery{Metric: "unknown"})
+	assert.ErrorContains(t, err, `unknown metric "unknown"`)
+	_, err = c.GetMetric(ctx, MetricQuery{})
+	assert.ErrorContains(t, err, "neither a metric nor a query")
+}
+
+func TestMultiClientMergesSamples(t *testing.T) {
+	older, newer := time.Unix(100, 0), time.Unix(200, 0)
+	backends := []Client{
+		&sampleClient{sample: Sample{Value: 10, Timestamp: newer, Labels: map[string]string{"replica": "0"}}},
+		&sampleClient{sample: Sample{Value: 30, Timestamp: older, Labels: map[string]string{"replica": "1"}}},
+	}
+
+	m, err := NewMultiClient(backends, MergeMax)
+	require.NoError(t, err)
+	sample, err := m.GetMetric(context.Background(), MetricQuery{Query: "up"})
+	require.NoError(t, err)
+	assert.Equal(t, Sample{Value: 30, Timestamp: older, Labels: map[string]string{"replica": "1"}}, sample)
+
+	m, err = NewMultiClient(backends, MergeAvg)
+	require.NoError(t, err)
+	sample, err = m.GetMetric(context.Background(), MetricQuery{Query: "up"})
+	require.NoError(t, err)
+	assert.Equal(t, Sample{Value: 20, Timestamp: newer}, sample)
+}
+
+// sampleClient answers every query with sample
+type sampleClient struct {
+	sample Sample
+}
+
+func (c *sampleClient) GetMetric(_ context.Context, _ MetricQuery) (Sample, error) {
+	return c.sample, nil
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// customMetricsAPI is the path of the custom metrics API, served for
+	// example by prometheus-adapter
+	customMetricsAPI = "/apis/custom.metrics.k8s.io/v1beta2"
+	// externalMetricsAPI is the path of the external metrics API, served for
+	// example by KEDA
+	externalMetricsAPI = "/apis/external.metrics.k8s.io/v1beta1"
+)
+
+// MetricsAPIConfig configures a MetricsAPIClient
+type MetricsAPIConfig struct {
+	// Host is the address of the Kubernetes API server
+	Host string
+	// HTTPClient authenticates requests to the API server
+	HTTPClient *http.Client
+	// Namespace whose metrics are read
+	Namespace string
+	// PodSelector returns the label selector of the pods that pods/ queries
+	// average over, such as the target's
+	PodSelector func(ctx context.Context) (string, error)
+}
+
+// MetricsAPIClient reads metrics from the Kubernetes custom and external
+// metrics APIs, so that adapters already configured in the cluster, such as
+// prometheus-adapter or KEDA, serve the queries. A query is one of
+//
+//	pods/<metric>                 the custom metric averaged over the pods of PodSelector
+//	<resource>/<name>/<metric>    the custom metric of an object, e.g. services/llm/requests_per_second
+//	external/<metric>             the external metric, summed over its series
+//
+// optionally followed by a label selector of the metric series in braces,
+// e.g. external/queue_messages_ready{queue=inference}. There are no default
+// queries for the built-in metrics, and the APIs serve only current values,
+// so windows are not supported.
+type MetricsAPIClient struct {
+	cfg MetricsAPIConfig
+}
+
+var (
+	_ Client        = &MetricsAPIClient{}
+	_ HealthChecker = &MetricsAPIClient{}
+)
+
+// NewMetricsAPIClient creates a client for the metrics APIs in the configured namespace
+func NewMetricsAPIClient(cfg MetricsAPIConfig) (*MetricsAPIClient, error) {
+	if cfg.Host == "" || cfg.HTTPClient == nil {
+		return nil, errors.New("the metrics APIs require a Kubernetes API server")
+	}
+	if cfg.Namespace == "" {
+		return nil, errors.New("the metrics APIs require a namespace")
+	}
+	cfg.Host = strings.TrimSuffix(cfg.Host, "/")
+	return &MetricsAPIClient{cfg: cfg}, nil
+}
+
+// metricsAPIQuery is a parsed MetricsAPIClient query
+type metricsAPIQuery struct {
+	// external selects the external metrics API; resource and name are unset
+	external bool
+	resource string
+	name     string
+	metric   string
+	// selector selects the metric series
+	selector string
+}
+
+// parseMetricsAPIQuery parses a query in the syntax of MetricsAPIClient
+func parseMetricsAPIQuery(query string) (metricsAPIQuery, error) {
+	var q metricsAPIQuery
+	path := query
+	if open := strings.Index(query, "{"); open >= 0 {
+		if !strings.HasSuffix(query, "}") {
+			return q, fmt.Errorf("metrics API query %q has an unterminated selector", query)
+		}
+		path, q.selector = query[:open], query[open+1:len(query)-1]
+	}
+
+	parts := strings.Split(path, "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "external":
+		q.external, q.metric = true, parts[1]
+	case len(parts) == 2 && parts[0] == "pods":
+		q.resource, q.name, q.metric = "pods", "*", parts[1]
+	case len(parts) == 3:
+		q.resource, q.name, q.metric = parts[0], parts[1], parts[2]
+	default:
+		return q, fmt.Errorf("metrics API query %q is not pods/<metric>, <resource>/<name>/<metric> or external/<metric>", query)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return q, fmt.Errorf("metrics API query %q has an empty segment", query)
+		}
+	}
+	return q, nil
+}
+
+// metricValueList is a list of custom or external metric values; custom
+// values describe an object, external ones carry the labels of their series
+type metricValueList struct {
+	Items []struct {
+		DescribedObject struct {
+			Name string `json:"name"`
+		} `json:"describedObject"`
+		MetricLabels map[string]string `json:"metricLabels"`
+		Timestamp    time.Time         `json:"timestamp"`
+		Value        string            `json:"value"`
+	} `json:"items"`
+}
+
+// GetMetric runs the query in q.Query against the custom or external
+// metrics API. A pods/ query averages the values of the pods and an external
+// one sums its series; a single value keeps its labels.
+func (c *MetricsAPIClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	if q.Query == "" {
+		if q.Metric == "" {
+			return Sample{}, errNoQuery(q.Metric)
+		}
+		return Sample{}, fmt.Errorf("metric %q has no default metrics API query; set its query", q.Metric)
+	}
+	if _, ranged := QueryRangeFrom(ctx); ranged {
+		return Sample{}, errors.New("the metrics APIs serve only current values; remove the metric's window")
+	}
+	query, err := parseMetricsAPIQuery(q.Query)
+	if err != nil {
+		return Sample{}, err
+	}
+
+	endpoint, err := c.endpoint(ctx, query)
+	if err != nil {
+		return Sample{}, err
+	}
+	var list metricValueList
+	if err := getJSON(ctx, c.cfg.HTTPClient, endpoint, nil, &list); err != nil {
+		return Sample{}, fmt.Errorf("querying the metrics API: %w", err)
+	}
+	if len(list.Items) == 0 {
+		return Sample{}, fmt.Errorf("%w: %s", ErrNoData, q.Query)
+	}
+
+	var sample Sample
+	for _, item := range list.Items {
+		value, err := resource.ParseQuantity(item.Value)
+		if err != nil {
+			return Sample{}, fmt.Errorf("parsing value %q of %s: %w", item.Value, q.Query, err)
+		}
+		sample.Value += value.AsApproximateFloat64()
+		if item.Timestamp.After(sample.Timestamp) {
+			sample.Timestamp = item.Timestamp
+		}
+	}
+	if query.name == "*" {
+		sample.Value /= float64(len(list.Items))
+	}
+	if len(list.Items) == 1 {
+		sample.Labels = list.Items[0].MetricLabels
+	}
+	return sample, nil
+}
+
+// endpoint returns the URL serving query
+func (c *MetricsAPIClient) endpoint(ctx context.Context, query metricsAPIQuery) (string, error) {
+	params := url.Values{}
+	namespace := url.PathEscape(c.cfg.Namespace)
+	if query.external {
+		if query.selector != "" {
+			params.Set("labelSelector", query.selector)
+		}
+		return withQuery(c.cfg.Host+externalMetricsAPI+"/namespaces/"+namespace+"/"+url.PathEscape(query.metric), params), nil
+	}
+
+	if query.selector != "" {
+		params.Set("metricLabelSelector", query.selector)
+	}
+	name := url.PathEscape(query.name)
+	if query.name == "*" {
+		name = query.name
+		if c.cfg.PodSelector == nil {
+			return "", errors.New("pods/ metrics API queries require a pod selector")
+		}
+		selector, err := c.cfg.PodSelector(ctx)
+		if err != nil {
+			return "", fmt.Errorf("selecting pods: %w", err)
+		}
+		params.Set("labelSelector", selector)
+	}
+	return withQuery(c.cfg.Host+customMetricsAPI+"/namespaces/"+namespace+"/"+
+		url.PathEscape(query.resource)+"/"+name+"/"+url.PathEscape(query.metric), params), nil
+}
+
+// withQuery appends the encoded params to endpoint, if any
+func withQuery(endpoint string, params url.Values) string {
+	if len(params) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + params.Encode()
+}
+
+// Healthy checks that the custom or the external metrics API is served
+func (c *MetricsAPIClient) Healthy(ctx context.Context) error {
+	var errs []error
+	for _, api := range []string{customMetricsAPI, externalMetricsAPI} {
+		var discovery struct{}
+		err := getJSON(ctx, c.cfg.HTTPClient, c.cfg.Host+api, nil, &discovery)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("metrics API health check failed: %w", errors.Join(errs...))
+}
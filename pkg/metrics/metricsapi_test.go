@@ -0,0 +1,118 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsAPIClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/custom.metrics.k8s.io/v1beta2/namespaces/llm/pods/*/vllm_num_requests_waiting":
+			assert.Equal(t, "app=llm", r.URL.Query().Get("labelSelector"))
+			_, _ = w.Write([]byte(`{"kind": "MetricValueList", "items": [
+				{"describedObject": {"kind": "Pod", "name": "llm-0"}, "timestamp": "2026-03-04T12:00:00Z", "value": "4"},
+				{"describedObject": {"kind": "Pod", "name": "llm-1"}, "timestamp": "2026-03-04T12:00:05Z", "value": "1500m"}]}`))
+		case "/apis/custom.metrics.k8s.io/v1beta2/namespaces/llm/services/gateway/requests_per_second":
+			assert.Equal(t, "route=chat", r.URL.Query().Get("metricLabelSelector"))
+			_, _ = w.Write([]byte(`{"items": [{"describedObject": {"kind": "Service", "name": "gateway"}, "value": "42"}]}`))
+		case "/apis/external.metrics.k8s.io/v1beta1/namespaces/llm/queue_messages_ready":
+			assert.Equal(t, "queue=inference", r.URL.Query().Get("labelSelector"))
+			_, _ = w.Write([]byte(`{"items": [
+				{"metricName": "queue_messages_ready", "metricLabels": {"queue": "inference", "shard": "0"}, "value": "7"},
+				{"metricName": "queue_messages_ready", "metricLabels": {"queue": "inference", "shard": "1"}, "value": "5"}]}`))
+		case "/apis/external.metrics.k8s.io/v1beta1/namespaces/llm/empty":
+			_, _ = w.Write([]byte(`{"items": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	c, err := NewMetricsAPIClient(MetricsAPIConfig{
+		Host:        server.URL,
+		HTTPClient:  server.Client(),
+		Namespace:   "llm",
+		PodSelector: func(context.Context) (string, error) { return "app=llm", nil },
+	})
+	require.NoError(t, err)
+
+	// Pod values are averaged
+	sample, err := c.GetMetric(ctx, MetricQuery{Metric: MetricQueueDepth, Query: "pods/vllm_num_requests_waiting"})
+	require.NoError(t, err)
+	assert.InDelta(t, 2.75, sample.Value, 1e-9)
+	assert.Equal(t, time.Date(2026, 3, 4, 12, 0, 5, 0, time.UTC), sample.Timestamp)
+
+	value, err := Query(ctx, c, "services/gateway/requests_per_second{route=chat}")
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, value)
+
+	// External series are summed
+	sample, err = c.GetMetric(ctx, MetricQuery{Query: "external/queue_messages_ready{queue=inference}"})
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, sample.Value)
+	assert.Nil(t, sample.Labels)
+
+	_, err = Query(ctx, c, "external/empty")
+	assert.ErrorIs(t, err, ErrNoData)
+	_, err = Query(ctx, c, "external/missing")
+	assert.ErrorContains(t, err, "404")
+
+	_, err = GetQueueDepth(ctx, c, "")
+	assert.ErrorContains(t, err, "no default metrics API query")
+	_, err = c.GetMetric(WithQueryRange(ctx, Range{Window: time.Minute}), MetricQuery{Query: "external/queue_messages_ready"})
+	assert.ErrorContains(t, err, "only current values")
+}
+
+func TestParseMetricsAPIQuery(t *testing.T) {
+	q, err := parseMetricsAPIQuery("deployments/llm/tokens_per_second{model=llama}")
+	require.NoError(t, err)
+	assert.Equal(t, metricsAPIQuery{resource: "deployments", name: "llm", metric: "tokens_per_second", selector: "model=llama"}, q)
+
+	for _, query := range []string{"vllm_num_requests_waiting", "pods/", "a/b/c/d", "external/x{a=b"} {
+		_, err := parseMetricsAPIQuery(query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestMetricsAPIClientHealthy(t *testing.T) {
+	served := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !served[r.URL.Path] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"kind": "APIResourceList"}`))
+	}))
+	defer server.Close()
+	c, err := NewMetricsAPIClient(MetricsAPIConfig{Host: server.URL, HTTPClient: server.Client(), Namespace: "llm"})
+	require.NoError(t, err)
+
+	assert.Error(t, c.Healthy(context.Background()))
+	// KEDA serves only the external metrics API
+	served["/apis/external.metrics.k8s.io/v1beta1"] = true
+	assert.NoError(t, c.Healthy(context.Background()))
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Merge strategies for combining results from several metrics backends
+const (
+	// MergeFirstSuccess returns the first successful result in configuration order
+	MergeFirstSuccess = "FirstSuccess"
+	// MergeMax returns the highest successful result
+	MergeMax = "Max"
+	// MergeAvg returns the average of all successful results
+	MergeAvg = "Avg"
+)
+
+// MultiClient queries several metrics backends concurrently, such as the two
+// replicas of an HA Prometheus pair, and merges their results. A query only
+// fails when every backend fails, so a single replica outage is tolerated.
+type MultiClient struct {
+	clients []Client
+	merge   string
+}
+
+var _ Client = &MultiClient{}
+var _ HealthChecker = &MultiClient{}
+
+// NewMultiClient creates a MultiClient over the given clients
+func NewMultiClient(clients []Client, merge string) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("at least one metrics client is required")
+	}
+	switch merge {
+	case "":
+		merge = MergeFirstSuccess
+	case MergeFirstSuccess, MergeMax, MergeAvg:
+	default:
+		return nil, fmt.Errorf("unsupported merge strategy: %s", merge)
+	}
+	return &MultiClient{clients: clients, merge: merge}, nil
+}
+
+// NewMultiPrometheusClient creates a MultiClient over Prometheus servers at the given addresses
+func NewMultiPrometheusClient(addresses []string, merge string, retry RetryConfig) (*MultiClient, error) {
+	clients := make([]Client, 0, len(addresses))
+	for _, address := range addresses {
+		c, err := NewPrometheusClient(address, retry)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	return NewMultiClient(clients, merge)
+}
+
+type fanOutResult struct {
+	sample Sample
+	err    error
+}
+
+// fanOut runs fn against every backend concurrently and merges the successful
+// results. FirstSuccess returns as soon as the earliest backend in
+// configuration order succeeds, without waiting on the later ones, so a hung
+// replica does not hold up the query. fn runs under a context that is
+// cancelled when fanOut returns. An average keeps the latest timestamp and
+// drops the labels, which may differ between backends.
+func (m *MultiClient) fanOut(ctx context.Context, fn func(context.Context, Client) (Sample, error)) (Sample, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type indexedResult struct {
+		index int
+		fanOutResult
+	}
+	done := make(chan indexedResult, len(m.clients))
+	for i, c := range m.clients {
+		go func(i int, c Client) {
+			sample, err := fn(ctx, c)
+			done <- indexedResult{index: i, fanOutResult: fanOutResult{sample: sample, err: err}}
+		}(i, c)
+	}
+
+	results := make([]*fanOutResult, len(m.clients))
+	next := 0
+	for range m.clients {
+		res := <-done
+		results[res.index] = &res.fanOutResult
+		if m.merge != MergeFirstSuccess {
+			continue
+		}
+		// Skip past the backends that failed in configuration order
+		for next < len(results) && results[next] != nil && results[next].err != nil {
+			next++
+		}
+		if next < len(results) && results[next] != nil {
+			return results[next].sample, nil
+		}
+	}
+
+	var errs []error
+	var samples []Sample
+	for i, res := range results {
+		if res.err != nil {
+			errs = append(errs, fmt.Errorf("backend %d: %w", i, res.err))
+			continue
+		}
+		samples = append(samples, res.sample)
+	}
+	if len(samples) == 0 {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return Sample{}, ctxErr
+		}
+		return Sample{}, fmt.Errorf("all %d metrics backends failed: %w", len(m.clients), errors.Join(errs...))
+	}
+
+	switch m.merge {
+	case MergeMax:
+		result := samples[0]
+		for _, s := range samples[1:] {
+			if s.Value > result.Value {
+				result = s
+			}
+		}
+		return result, nil
+	case MergeAvg:
+		var result Sample
+		for _, s := range samples {
+			result.Value += s.Value
+			if s.Timestamp.After(result.Timestamp) {
+				result.Timestamp = s.Timestamp
+			}
+		}
+		result.Value /= float64(len(samples))
+		return result, nil
+	default:
+		return samples[0], nil
+	}
+}
+
+// QueryAggregated aggregates query on every backend and merges the results
+func (m *MultiClient) QueryAggregated(ctx context.Context, query, aggregation string) (float64, error) {
+	return sampleValue(m.fanOut(ctx, func(ctx context.Context, c Client) (Sample, error) {
+		value, err := QueryAggregated(ctx, c, query, aggregation)
+		return Sample{Value: value}, err
+	}))
+}
+
+// Healthy reports whether at least one backend is healthy
+func (m *MultiClient) Healthy(ctx context.Context) error {
+	_, err := m.fanOut(ctx, func(ctx context.Context, c Client) (Sample, error) {
+		return Sample{}, CheckHealth(ctx, c)
+	})
+	return err
+}
+
+// GetMetric fetches the metric from every backend and merges the results
+func (m *MultiClient) GetMetric(ctx context.Context, query MetricQuery) (Sample, error) {
+	return m.fanOut(ctx, func(ctx context.Context, c Client) (Sample, error) { return c.GetMetric(ctx, query) })
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiClientMerge(t *testing.T) {
+	healthyLow := &MockClient{GPUUtilizationValue: 40, QueueDepthValue: 10}
+	healthyHigh := &MockClient{GPUUtilizationValue: 80, QueueDepthValue: 30}
+	down := &MockClient{Error: errors.New("connection refused")}
+
+	tests := []struct {
+		name     string
+		clients  []Client
+		merge    string
+		expected float64
+	}{
+		{name: "first success skips failed replica", clients: []Client{down, healthyLow, healthyHigh}, merge: MergeFirstSuccess, expected: 40},
+		{name: "default is first success", clients: []Client{healthyHigh, healthyLow}, merge: "", expected: 80},
+		{name: "max", clients: []Client{healthyLow, down, healthyHigh}, merge: MergeMax, expected: 80},
+		{name: "avg ignores failed replica", clients: []Client{healthyLow, down, healthyHigh}, merge: MergeAvg, expected: 60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMultiClient(tt.clients, tt.merge)
+			require.NoError(t, err)
+			got, err := GetGPUUtilization(context.Background(), m, "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMultiClientQueueDepth(t *testing.T) {
+	m, err := NewMultiClient([]Client{&MockClient{QueueDepthValue: 10}, &MockClient{QueueDepthValue: 30}}, MergeMax)
+	require.NoError(t, err)
+	got, err := GetQueueDepth(context.Background(), m, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), got)
+}
+
+// hungClient blocks every query until its context is cancelled, like an
+// unresponsive Prometheus replica
+type hungClient struct {
+	cancelled chan struct{}
+}
+
+func (h *hungClient) GetMetric(ctx context.Context, _ MetricQuery) (Sample, error) {
+	<-ctx.Done()
+	close(h.cancelled)
+	return Sample{}, ctx.Err()
+}
+
+func TestMultiClientFirstSuccessDoesNotWaitForHungBackend(t *testing.T) {
+	hung := &hungClient{cancelled: make(chan struct{})}
+	m, err := NewMultiClient([]Client{&MockClient{GPUUtilizationValue: 40}, hung}, MergeFirstSuccess)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	start := time.Now()
+	got, err := GetGPUUtilization(ctx, m, "")
+	require.NoError(t, err)
+	assert.Equal(t, 40.0, got)
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	// The hung query is cancelled once the result is returned
+	select {
+	case <-hung.cancelled:
+	case <-time.After(10 * time.Second):
+		t.Fatal("query of the hung backend was not cancelled")
+	}
+}
+
+func TestMultiClientFirstSuccessKeepsConfigurationOrder(t *testing.T) {
+	// A later backend answering first is not returned while an earlier one may still succeed
+	slow := &slowClient{MockClient: MockClient{GPUUtilizationValue: 40}, release: make(chan struct{})}
+	time.AfterFunc(50*time.Millisecond, func() { close(slow.release) })
+	m, err := NewMultiClient([]Client{slow, &MockClient{GPUUtilizationValue: 80}}, MergeFirstSuccess)
+	require.NoError(t, err)
+	got, err := GetGPUUtilization(context.Background(), m, "")
+	require.NoError(t, err)
+	assert.Equal(t, 40.0, got)
+}
+
+func TestMultiClientAllFail(t *testing.T) {
+	m, err := NewMultiClient([]Client{
+		&MockClient{Error: errors.New("a down")},
+		&MockClient{Error: errors.New("b down")},
+	}, MergeMax)
+	require.NoError(t, err)
+
+	_, err = Query(context.Background(), m, "up")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a down")
+	assert.Contains(t, err.Error(), "b down")
+}
+
+func TestNewMultiClientValidation(t *testing.T) {
+	_, err := NewMultiClient(nil, MergeMax)
+	assert.Error(t, err)
+
+	_, err = NewMultiClient([]Client{&MockClient{}}, "Median")
+	assert.Error(t, err)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WorkloadCost is the realized cost of a workload's pods over a window
+type WorkloadCost struct {
+	// TotalCost is the cost of every pod of the workload in the window, in
+	// the currency OpenCost is configured with
+	TotalCost float64
+	// ReplicaHours is how long the pods ran in the window, summed over pods
+	ReplicaHours float64
+}
+
+// CostPerReplicaHour returns the average cost of running one replica for an
+// hour, or 0 when no pod ran in the window
+func (c WorkloadCost) CostPerReplicaHour() float64 {
+	if c.ReplicaHours <= 0 {
+		return 0
+	}
+	return c.TotalCost / c.ReplicaHours
+}
+
+// CostClient reports the realized cost of workloads
+type CostClient interface {
+	// WorkloadCost returns the cost of the pods controlled by the named
+	// workload over the window ending now
+	WorkloadCost(ctx context.Context, namespace, kind, name string, window time.Duration) (WorkloadCost, error)
+}
+
+// OpenCostClient reads realized cost from the allocation API served by
+// OpenCost and Kubecost
+type OpenCostClient struct {
+	address    string
+	httpClient *http.Client
+}
+
+var _ CostClient = &OpenCostClient{}
+
+// NewOpenCostClient creates a client for the allocation API at address, such
+// as http://opencost.opencost:9003 or, for Kubecost,
+// http://kubecost-cost-analyzer.kubecost:9090/model
+func NewOpenCostClient(address string) *OpenCostClient {
+	return &OpenCostClient{
+		address:    strings.TrimSuffix(address, "/"),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// allocationResponse is the body of an /allocation response: one set of
+// allocations per step, keyed by the aggregate, here the pod
+type allocationResponse struct {
+	Code    int                            `json:"code"`
+	Message string                         `json:"message"`
+	Data    []map[string]allocationSummary `json:"data"`
+}
+
+// allocationSummary holds the fields of an allocation the client reads
+type allocationSummary struct {
+	Properties struct {
+		Namespace      string `json:"namespace"`
+		ControllerKind string `json:"controllerKind"`
+		Controller     string `json:"controller"`
+	} `json:"properties"`
+	Minutes   float64 `json:"minutes"`
+	TotalCost float64 `json:"totalCost"`
+}
+
+// WorkloadCost queries the per-pod allocations of the workload over the
+// window and sums their cost and running time
+func (c *OpenCostClient) WorkloadCost(ctx context.Context, namespace, kind, name string, window time.Duration) (WorkloadCost, error) {
+	kind = strings.ToLower(kind)
+	query := url.Values{}
+	query.Set("window", fmt.Sprintf("%ds", int64(window.Seconds())))
+	query.Set("aggregate", "pod")
+	query.Set("accumulate", "true")
+	query.Set("filter", fmt.Sprintf(`namespace:"%s"+controllerKind:"%s"+controller:"%s"`, namespace, kind, name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.address+"/allocation?"+query.Encode(), nil)
+	if err != nil {
+		return WorkloadCost{}, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return WorkloadCost{}, fmt.Errorf("querying allocations: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return WorkloadCost{}, fmt.Errorf("allocation API returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var allocations allocationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&allocations); err != nil {
+		return WorkloadCost{}, fmt.Errorf("decoding allocations: %w", err)
+	}
+	if allocations.Code != 0 && allocations.Code != http.StatusOK {
+		return WorkloadCost{}, fmt.Errorf("allocation API returned code %d: %s", allocations.Code, allocations.Message)
+	}
+
+	var cost WorkloadCost
+	for _, step := range allocations.Data {
+		for _, allocation := range step {
+			// Older releases ignore the filter, so check each allocation
+			if allocation.Properties.Namespace != namespace ||
+				!strings.EqualFold(allocation.Properties.ControllerKind, kind) ||
+				allocation.Properties.Controller != name {
+				continue
+			}
+			cost.TotalCost += allocation.TotalCost
+			cost.ReplicaHours += allocation.Minutes / 60
+		}
+	}
+	if cost.ReplicaHours == 0 {
+		return WorkloadCost{}, fmt.Errorf("no allocations for %s %s/%s", kind, namespace, name)
+	}
+	return cost, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const allocationBody = `{
+  "code": 200,
+  "data": [{
+    "llm-7d9f-a": {"properties": {"namespace": "default", "controllerKind": "deployment", "controller": "llm"}, "minutes": 60, "totalCost": 2.5},
+    "llm-7d9f-b": {"properties": {"namespace": "default", "controllerKind": "deployment", "controller": "llm"}, "minutes": 30, "totalCost": 1.25},
+    "other-5c4d": {"properties": {"namespace": "default", "controllerKind": "deployment", "controller": "other"}, "minutes": 60, "totalCost": 9}
+  }]
+}`
+
+func TestOpenCostClientWorkloadCost(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/model/allocation", r.URL.Path)
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(allocationBody))
+	}))
+	defer server.Close()
+
+	client := NewOpenCostClient(server.URL + "/model/")
+	cost, err := client.WorkloadCost(context.Background(), "default", "Deployment", "llm", time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"3600s"}, query["window"])
+	assert.Equal(t, []string{"pod"}, query["aggregate"])
+	assert.Equal(t, []string{`namespace:"default"+controllerKind:"deployment"+controller:"llm"`}, query["filter"])
+	// Pods of other workloads are left out even when the API ignores the filter
+	assert.InDelta(t, 3.75, cost.TotalCost, 1e-9)
+	assert.InDelta(t, 1.5, cost.ReplicaHours, 1e-9)
+	assert.InDelta(t, 2.5, cost.CostPerReplicaHour(), 1e-9)
+}
+
+func TestOpenCostClientErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "http error", status: http.StatusInternalServerError, body: "boom"},
+		{name: "api error", status: http.StatusOK, body: `{"code": 400, "message": "bad window"}`},
+		{name: "no allocations", status: http.StatusOK, body: `{"code": 200, "data": [{}]}`},
+		{name: "invalid body", status: http.StatusOK, body: `not json`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := NewOpenCostClient(server.URL).WorkloadCost(context.Background(), "default", "Deployment", "llm", time.Hour)
+			assert.Error(t, err)
+		})
+	}
+}
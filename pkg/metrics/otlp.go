@@ -0,0 +1,590 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Resource attributes naming the pod a series is pushed from, as set by the
+// Kubernetes resource detection of the OpenTelemetry SDKs or the k8sattributes
+// processor of the OpenTelemetry Collector
+const (
+	OTLPNamespaceAttribute = "k8s.namespace.name"
+	OTLPPodAttribute       = "k8s.pod.name"
+)
+
+const (
+	// OTLPMetricsPath is the path OTLP/HTTP exporters send metrics to
+	OTLPMetricsPath = "/v1/metrics"
+	// DefaultOTLPStaleAfter is how long a series is kept without a new point,
+	// such as after its pod was deleted
+	DefaultOTLPStaleAfter = 2 * time.Minute
+
+	// maxOTLPRequestBytes caps the decompressed size of an OTLP/HTTP request
+	maxOTLPRequestBytes = 16 << 20
+)
+
+// otlpKind is the type of an OTLP metric
+type otlpKind int
+
+const (
+	otlpGauge otlpKind = iota
+	otlpSum
+	otlpHistogram
+)
+
+// otlpPoint is a data point of a series. Sums and gauges have a value;
+// histograms have explicit bounds and per-bucket counts, and their sum as value.
+type otlpPoint struct {
+	start, at time.Time
+	value     float64
+	bounds    []float64
+	counts    []uint64
+}
+
+// otlpSeries is the latest two points pushed for one metric and set of attributes
+type otlpSeries struct {
+	kind      otlpKind
+	delta     bool
+	namespace string
+	pod       string
+	last      otlpPoint
+	previous  *otlpPoint
+	received  time.Time
+}
+
+// OTLPReceiver accepts metrics pushed with the OpenTelemetry protocol, over
+// OTLP/HTTP (ServeHTTP) or OTLP/gRPC (Export), and keeps the latest points of
+// each series in memory. Queries then read the series directly instead of
+// waiting for a Prometheus scrape. Gauges, sums and explicit bucket
+// histograms are kept; exponential histograms and summaries are ignored.
+type OTLPReceiver struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+
+	// StaleAfter drops series without a new point for this long;
+	// DefaultOTLPStaleAfter when zero
+	StaleAfter time.Duration
+
+	now func() time.Time
+
+	mu sync.Mutex
+	// series holds the series of each metric name by their attributes
+	series map[string]map[string]*otlpSeries
+}
+
+var _ colmetricspb.MetricsServiceServer = &OTLPReceiver{}
+
+// NewOTLPReceiver creates an OTLPReceiver without series
+func NewOTLPReceiver() *OTLPReceiver {
+	return &OTLPReceiver{now: time.Now, series: make(map[string]map[string]*otlpSeries)}
+}
+
+// Export implements the OTLP/gRPC metrics service
+func (r *OTLPReceiver) Export(_ context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	r.ingest(req)
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+// ServeHTTP implements OTLP/HTTP: it accepts protobuf and JSON encoded
+// requests, optionally gzip-compressed, and answers in the same encoding
+func (r *OTLPReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	contentType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	var unmarshal func([]byte, proto.Message) error
+	var marshal func(proto.Message) ([]byte, error)
+	switch contentType {
+	case "application/x-protobuf":
+		unmarshal, marshal = proto.Unmarshal, proto.Marshal
+	case "application/json":
+		unmarshal, marshal = protojson.Unmarshal, protojson.Marshal
+	default:
+		http.Error(w, "unsupported content type "+contentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var body io.Reader = req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer func() { _ = gz.Close() }()
+		body = gz
+	}
+	data, err := io.ReadAll(io.LimitReader(body, maxOTLPRequestBytes+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(data) > maxOTLPRequestBytes {
+		http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	exportReq := &colmetricspb.ExportMetricsServiceRequest{}
+	if err := unmarshal(data, exportReq); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.ingest(exportReq)
+
+	resp, err := marshal(&colmetricspb.ExportMetricsServiceResponse{})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(resp)
+}
+
+// ingest stores the points of req and drops stale series
+func (r *OTLPReceiver) ingest(req *colmetricspb.ExportMetricsServiceRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.clock()
+	if r.series == nil {
+		r.series = make(map[string]map[string]*otlpSeries)
+	}
+
+	for _, resourceMetrics := range req.GetResourceMetrics() {
+		resource := otlpAttributes(nil, resourceMetrics.GetResource().GetAttributes())
+		for _, scopeMetrics := range resourceMetrics.GetScopeMetrics() {
+			for _, metric := range scopeMetrics.GetMetrics() {
+				switch data := metric.GetData().(type) {
+				case *metricspb.Metric_Gauge:
+					for _, dp := range data.Gauge.GetDataPoints() {
+						r.store(metric.GetName(), otlpGauge, false, otlpAttributes(resource, dp.GetAttributes()), numberPoint(dp), now)
+					}
+				case *metricspb.Metric_Sum:
+					delta := data.Sum.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+					for _, dp := range data.Sum.GetDataPoints() {
+						r.store(metric.GetName(), otlpSum, delta, otlpAttributes(resource, dp.GetAttributes()), numberPoint(dp), now)
+					}
+				case *metricspb.Metric_Histogram:
+					delta := data.Histogram.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+					for _, dp := range data.Histogram.GetDataPoints() {
+						if len(dp.GetBucketCounts()) != len(dp.GetExplicitBounds())+1 {
+							continue
+						}
+						point := otlpPoint{
+							start:  time.Unix(0, int64(dp.GetStartTimeUnixNano())),
+							at:     time.Unix(0, int64(dp.GetTimeUnixNano())),
+							value:  dp.GetSum(),
+							bounds: slices.Clone(dp.GetExplicitBounds()),
+							counts: slices.Clone(dp.GetBucketCounts()),
+						}
+						r.store(metric.GetName(), otlpHistogram, delta, otlpAttributes(resource, dp.GetAttributes()), point, now)
+					}
+				}
+			}
+		}
+	}
+
+	for name, byAttributes := range r.series {
+		for key, series := range byAttributes {
+			if now.Sub(series.received) > r.staleAfter() {
+				delete(byAttributes, key)
+			}
+		}
+		if len(byAttributes) == 0 {
+			delete(r.series, name)
+		}
+	}
+}
+
+// store adds a point to the series of the metric with the given attributes.
+// Points older than the latest one are ignored; a series whose type changed
+// starts over.
+func (r *OTLPReceiver) store(name string, kind otlpKind, delta bool, attributes map[string]string, point otlpPoint, now time.Time) {
+	key := otlpSeriesKey(attributes)
+	if r.series[name] == nil {
+		r.series[name] = make(map[string]*otlpSeries)
+	}
+	series, ok := r.series[name][key]
+	if !ok || series.kind != kind || series.delta != delta {
+		r.series[name][key] = &otlpSeries{
+			kind:      kind,
+			delta:     delta,
+			namespace: attributes[OTLPNamespaceAttribute],
+			pod:       attributes[OTLPPodAttribute],
+			last:      point,
+			received:  now,
+		}
+		return
+	}
+	if !point.at.After(series.last.at) {
+		return
+	}
+	previous := series.last
+	series.previous = &previous
+	series.last = point
+	series.received = now
+}
+
+// numberPoint converts a gauge or sum data point
+func numberPoint(dp *metricspb.NumberDataPoint) otlpPoint {
+	point := otlpPoint{
+		start: time.Unix(0, int64(dp.GetStartTimeUnixNano())),
+		at:    time.Unix(0, int64(dp.GetTimeUnixNano())),
+	}
+	switch value := dp.GetValue().(type) {
+	case *metricspb.NumberDataPoint_AsDouble:
+		point.value = value.AsDouble
+	case *metricspb.NumberDataPoint_AsInt:
+		point.value = float64(value.AsInt)
+	}
+	return point
+}
+
+// otlpAttributes returns base with the attributes added, leaving base unchanged
+func otlpAttributes(base map[string]string, attributes []*commonpb.KeyValue) map[string]string {
+	merged := make(map[string]string, len(base)+len(attributes))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for _, kv := range attributes {
+		value := kv.GetValue()
+		switch v := value.GetValue().(type) {
+		case *commonpb.AnyValue_StringValue:
+			merged[kv.GetKey()] = v.StringValue
+		case *commonpb.AnyValue_IntValue:
+			merged[kv.GetKey()] = strconv.FormatInt(v.IntValue, 10)
+		case *commonpb.AnyValue_DoubleValue:
+			merged[kv.GetKey()] = strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+		case *commonpb.AnyValue_BoolValue:
+			merged[kv.GetKey()] = strconv.FormatBool(v.BoolValue)
+		}
+	}
+	return merged
+}
+
+// otlpSeriesKey identifies a series of a metric by its sorted attributes
+func otlpSeriesKey(attributes map[string]string) string {
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%q=%q,", k, attributes[k])
+	}
+	return b.String()
+}
+
+// clock returns the current time
+func (r *OTLPReceiver) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// staleAfter returns the configured staleness or DefaultOTLPStaleAfter
+func (r *OTLPReceiver) staleAfter() time.Duration {
+	if r.StaleAfter <= 0 {
+		return DefaultOTLPStaleAfter
+	}
+	return r.StaleAfter
+}
+
+// matching returns copies of the fresh series of the named metric pushed from
+// the given pods of namespace
+func (r *OTLPReceiver) matching(name, namespace string, pods map[string]bool) []otlpSeries {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.clock()
+	var matched []otlpSeries
+	for _, series := range r.series[name] {
+		if series.namespace != namespace || !pods[series.pod] || now.Sub(series.received) > r.staleAfter() {
+			continue
+		}
+		matched = append(matched, *series)
+	}
+	return matched
+}
+
+// PodsFunc returns the names of the pods whose series a client reads
+type PodsFunc func(ctx context.Context) ([]string, error)
+
+// OTLPClient reads the series an OTLPReceiver holds for the pods of one
+// workload, identified by the k8s.namespace.name and k8s.pod.name attributes
+// of their points. Like ScrapeClient, queries are metric names rather than
+// PromQL and default to the same metrics.
+type OTLPClient struct {
+	receiver  *OTLPReceiver
+	namespace string
+	pods      PodsFunc
+}
+
+var (
+	_ Client        = &OTLPClient{}
+	_ HealthChecker = &OTLPClient{}
+	_ VectorQuerier = &OTLPClient{}
+)
+
+// Client returns a client reading the series pushed from the pods in
+// namespace returned by pods
+func (r *OTLPReceiver) Client(namespace string, pods PodsFunc) *OTLPClient {
+	return &OTLPClient{receiver: r, namespace: namespace, pods: pods}
+}
+
+// series returns the fresh series of the named metric pushed from the pods
+func (c *OTLPClient) series(ctx context.Context, name string) ([]otlpSeries, error) {
+	names, err := c.pods(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pods := make(map[string]bool, len(names))
+	for _, name := range names {
+		pods[name] = true
+	}
+	matched := c.receiver.matching(name, c.namespace, pods)
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("%w: no OTLP series of %s from pods in %s", ErrNoData, name, c.namespace)
+	}
+	return matched, nil
+}
+
+// Healthy reports whether any of the pods has pushed metrics recently
+func (c *OTLPClient) Healthy(ctx context.Context) error {
+	names, err := c.pods(ctx)
+	if err != nil {
+		return err
+	}
+	pods := make(map[string]bool, len(names))
+	for _, name := range names {
+		pods[name] = true
+	}
+	c.receiver.mu.Lock()
+	defer c.receiver.mu.Unlock()
+	now := c.receiver.clock()
+	for _, byAttributes := range c.receiver.series {
+		for _, series := range byAttributes {
+			if series.namespace == c.namespace && pods[series.pod] && now.Sub(series.received) <= c.receiver.staleAfter() {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("no OTLP metrics received from pods in %s", c.namespace)
+}
+
+// QueryVector returns the latest value of every series of the named gauge or sum
+func (c *OTLPClient) QueryVector(ctx context.Context, query string) ([]float64, error) {
+	values, _, err := c.values(ctx, query)
+	return values, err
+}
+
+// values returns the latest value of every gauge or sum series of the named
+// metric and the time of the most recent one
+func (c *OTLPClient) values(ctx context.Context, name string) ([]float64, time.Time, error) {
+	matched, err := c.series(ctx, name)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var values []float64
+	var latest time.Time
+	for _, series := range matched {
+		if series.kind == otlpHistogram {
+			continue
+		}
+		values = append(values, series.last.value)
+		if series.last.at.After(latest) {
+			latest = series.last.at
+		}
+	}
+	if len(values) == 0 {
+		return nil, time.Time{}, fmt.Errorf("metric %s is not a gauge or sum", name)
+	}
+	return values, latest, nil
+}
+
+// GetMetric evaluates the metric named by the query, or the default metric
+// when the query is not a metric name, against the pushed series:
+//   - latency quantiles are computed from the histogram buckets observed
+//     between the two latest points, or in the latest point of a delta histogram
+//   - GPU utilization is the average of the gauge
+//   - tokens per second is the rate of the counter between its two latest
+//     points, or over the latest point of a delta sum
+//   - the others, and raw queries, are the sum across pods
+//
+// The sample is stamped with the time of the latest point read.
+func (c *OTLPClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	var values []float64
+	var value float64
+	var at time.Time
+	var err error
+	switch q.Metric {
+	case MetricLatencyP99:
+		value, at, err = c.quantile(ctx, 0.99, otlpMetricName(q.Query, ScrapeLatencyMetric))
+	case MetricLatencyP95:
+		value, at, err = c.quantile(ctx, 0.95, otlpMetricName(q.Query, ScrapeLatencyMetric))
+	case MetricGPUUtilization:
+		values, at, err = c.values(ctx, otlpMetricName(q.Query, ScrapeGPUMetric))
+		if err == nil {
+			value, err = Aggregate(values, AggregationAverage)
+		}
+	case MetricQueueDepth:
+		values, at, err = c.values(ctx, otlpMetricName(q.Query, ScrapeQueueDepthMetric))
+		if err == nil {
+			value, err = Aggregate(values, AggregationSum)
+		}
+	case MetricInFlightRequests:
+		values, at, err = c.values(ctx, otlpMetricName(q.Query, ScrapeInFlightMetric))
+		if err == nil {
+			value, err = Aggregate(values, AggregationSum)
+		}
+	case MetricTokensPerSecond:
+		value, at, err = c.rate(ctx, otlpMetricName(q.Query, tokensCounter(q.Query)))
+	case "":
+		if q.Query == "" {
+			return Sample{}, errNoQuery(q.Metric)
+		}
+		values, at, err = c.values(ctx, q.Query)
+		if err == nil {
+			value, err = Aggregate(values, AggregationSum)
+		}
+	default:
+		return Sample{}, errNoQuery(q.Metric)
+	}
+	if err != nil {
+		return Sample{}, err
+	}
+	return Sample{Value: value, Timestamp: at}, nil
+}
+
+// rate returns the per-second increase of the named sum across pods.
+// Histograms contribute their sum.
+func (c *OTLPClient) rate(ctx context.Context, name string) (float64, time.Time, error) {
+	matched, err := c.series(ctx, name)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	total, found := 0.0, false
+	var latest time.Time
+	for _, series := range matched {
+		if series.kind == otlpGauge {
+			continue
+		}
+		var increase float64
+		var elapsed time.Duration
+		switch {
+		case series.delta:
+			increase, elapsed = series.last.value, series.last.at.Sub(series.last.start)
+		case series.previous != nil:
+			increase, elapsed = series.last.value-series.previous.value, series.last.at.Sub(series.previous.at)
+			if increase < 0 {
+				// Counter reset (pod restart): count from zero
+				increase = series.last.value
+			}
+		}
+		if elapsed <= 0 {
+			continue
+		}
+		total += increase / elapsed.Seconds()
+		found = true
+		if series.last.at.After(latest) {
+			latest = series.last.at
+		}
+	}
+	if !found {
+		return 0, time.Time{}, fmt.Errorf("no two points of %s to compute a rate", name)
+	}
+	return total, latest, nil
+}
+
+// quantile merges the recent buckets of the named histogram across pods and
+// estimates the quantile
+func (c *OTLPClient) quantile(ctx context.Context, q float64, name string) (float64, time.Time, error) {
+	matched, err := c.series(ctx, name)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	buckets := map[float64]float64{}
+	var latest time.Time
+	for _, series := range matched {
+		if series.kind != otlpHistogram {
+			continue
+		}
+		counts := series.last.counts
+		if !series.delta && series.previous != nil && slices.Equal(series.previous.bounds, series.last.bounds) {
+			counts = bucketIncrease(series.previous.counts, series.last.counts)
+		}
+		cumulative := 0.0
+		for i, count := range counts {
+			cumulative += float64(count)
+			le := math.Inf(1)
+			if i < len(series.last.bounds) {
+				le = series.last.bounds[i]
+			}
+			buckets[le] += cumulative
+		}
+		if series.last.at.After(latest) {
+			latest = series.last.at
+		}
+	}
+	if len(buckets) == 0 {
+		return 0, time.Time{}, fmt.Errorf("metric %s is not a histogram", name)
+	}
+	value, err := bucketQuantile(q, buckets)
+	return value, latest, err
+}
+
+// bucketIncrease returns the per-bucket counts observed between two
+// cumulative points, or the latest counts after a counter reset
+func bucketIncrease(previous, last []uint64) []uint64 {
+	increase := make([]uint64, len(last))
+	for i := range last {
+		if last[i] < previous[i] {
+			return last
+		}
+		increase[i] = last[i] - previous[i]
+	}
+	return increase
+}
+
+// otlpMetricName returns query when it is a metric name and def otherwise,
+// so PromQL written for a Prometheus source falls back to the default metric.
+// Unlike Prometheus names, OpenTelemetry names may contain dots.
+func otlpMetricName(query, def string) string {
+	if query == "" || strings.ContainsAny(query, " (){}[]\"'=,+*") {
+		return def
+	}
+	return query
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var otlpEpoch = time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+
+// otlpRequest wraps the metrics pushed by one pod in an export request
+func otlpRequest(namespace, pod string, metrics ...*metricspb.Metric) *colmetricspb.ExportMetricsServiceRequest {
+	attribute := func(key, value string) *commonpb.KeyValue {
+		return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+	}
+	return &colmetricspb.ExportMetricsServiceRequest{ResourceMetrics: []*metricspb.ResourceMetrics{{
+		Resource:     &resourcepb.Resource{Attributes: []*commonpb.KeyValue{attribute(OTLPNamespaceAttribute, namespace), attribute(OTLPPodAttribute, pod)}},
+		ScopeMetrics: []*metricspb.ScopeMetrics{{Metrics: metrics}},
+	}}}
+}
+
+func otlpGaugeMetric(name string, value float64, at time.Time) *metricspb.Metric {
+	return &metricspb.Metric{Name: name, Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: []*metricspb.NumberDataPoint{{
+		TimeUnixNano: uint64(at.UnixNano()),
+		Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+	}}}}}
+}
+
+func otlpSumMetric(name string, temporality metricspb.AggregationTemporality, value int64, start, at time.Time) *metricspb.Metric {
+	return &metricspb.Metric{Name: name, Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+		AggregationTemporality: temporality,
+		IsMonotonic:            true,
+		DataPoints: []*metricspb.NumberDataPoint{{
+			StartTimeUnixNano: uint64(start.UnixNano()),
+			TimeUnixNano:      uint64(at.UnixNano()),
+			Value:             &metricspb.NumberDataPoint_AsInt{AsInt: value},
+		}},
+	}}}
+}
+
+func otlpHistogramMetric(name string, temporality metricspb.AggregationTemporality, counts []uint64, at time.Time) *metricspb.Metric {
+	return &metricspb.Metric{Name: name, Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+		AggregationTemporality: temporality,
+		DataPoints: []*metricspb.HistogramDataPoint{{
+			TimeUnixNano:   uint64(at.UnixNano()),
+			ExplicitBounds: []float64{0.1, 0.5, 1},
+			BucketCounts:   counts,
+		}},
+	}}}
+}
+
+func newTestOTLPReceiver() (*OTLPReceiver, func(time.Duration)) {
+	now := otlpEpoch
+	r := NewOTLPReceiver()
+	r.now = func() time.Time { return now }
+	return r, func(d time.Duration) { now = now.Add(d) }
+}
+
+func staticPods(names ...string) PodsFunc {
+	return func(context.Context) ([]string, error) { return names, nil }
+}
+
+func TestOTLPReceiverHTTP(t *testing.T) {
+	r, _ := newTestOTLPReceiver()
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	// A gzip-compressed protobuf request, as sent by the Collector's otlphttp exporter
+	data, err := proto.Marshal(otlpRequest("default", "llm-0", otlpGaugeMetric(ScrapeQueueDepthMetric, 4, otlpEpoch)))
+	require.NoError(t, err)
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, _ = gz.Write(data)
+	require.NoError(t, gz.Close())
+	req, err := http.NewRequest(http.MethodPost, server.URL+OTLPMetricsPath, &compressed)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/x-protobuf", resp.Header.Get("Content-Type"))
+
+	// and a JSON request
+	data, err = protojson.Marshal(otlpRequest("default", "llm-1", otlpGaugeMetric(ScrapeQueueDepthMetric, 3, otlpEpoch.Add(time.Second))))
+	require.NoError(t, err)
+	resp, err = http.Post(server.URL+OTLPMetricsPath, "application/json", bytes.NewReader(data))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Post(server.URL+OTLPMetricsPath, "text/plain", bytes.NewReader(data))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+
+	sample, err := r.Client("default", staticPods("llm-0", "llm-1")).GetMetric(context.Background(), MetricQuery{Metric: MetricQueueDepth})
+	require.NoError(t, err)
+	assert.Equal(t, 7.0, sample.Value)
+	assert.Equal(t, otlpEpoch.Add(time.Second), sample.Timestamp.UTC())
+}
+
+func TestOTLPClientSelectsPods(t *testing.T) {
+	r, _ := newTestOTLPReceiver()
+	ctx := context.Background()
+	for _, req := range []*colmetricspb.ExportMetricsServiceRequest{
+		otlpRequest("default", "llm-0", otlpGaugeMetric("gpu.utilization", 60, otlpEpoch)),
+		otlpRequest("default", "llm-1", otlpGaugeMetric("gpu.utilization", 80, otlpEpoch)),
+		otlpRequest("default", "other-0", otlpGaugeMetric("gpu.utilization", 10, otlpEpoch)),
+		otlpRequest("prod", "llm-0", otlpGaugeMetric("gpu.utilization", 10, otlpEpoch)),
+	} {
+		_, err := r.Export(ctx, req)
+		require.NoError(t, err)
+	}
+
+	c := r.Client("default", staticPods("llm-0", "llm-1"))
+	sample, err := c.GetMetric(ctx, MetricQuery{Metric: MetricGPUUtilization, Query: "gpu.utilization"})
+	require.NoError(t, err)
+	assert.Equal(t, 70.0, sample.Value)
+	values, err := c.QueryVector(ctx, "gpu.utilization")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []float64{60, 80}, values)
+
+	// PromQL falls back to the default metric, which nothing has pushed
+	_, err = c.GetMetric(ctx, MetricQuery{Metric: MetricGPUUtilization, Query: "avg(DCGM_FI_DEV_GPU_UTIL)"})
+	assert.ErrorIs(t, err, ErrNoData)
+}
+
+func TestOTLPClientLatency(t *testing.T) {
+	r, advance := newTestOTLPReceiver()
+	ctx := context.Background()
+	c := r.Client("default", staticPods("llm-0"))
+	cumulative := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+
+	// The first point of a cumulative histogram covers the pod's lifetime
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpHistogramMetric(ScrapeLatencyMetric, cumulative, []uint64{100, 0, 0, 0}, otlpEpoch)))
+	sample, err := c.GetMetric(ctx, MetricQuery{Metric: MetricLatencyP99})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.099, sample.Value, 0.001)
+
+	// then the quantile covers the observations between the two latest points
+	advance(10 * time.Second)
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpHistogramMetric(ScrapeLatencyMetric, cumulative, []uint64{100, 0, 100, 0}, otlpEpoch.Add(10*time.Second))))
+	sample, err = c.GetMetric(ctx, MetricQuery{Metric: MetricLatencyP99})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.995, sample.Value, 0.001)
+
+	// A delta histogram holds only the observations since its previous point
+	delta := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpHistogramMetric("request.duration", delta, []uint64{0, 10, 0, 0}, otlpEpoch.Add(10*time.Second))))
+	sample, err = c.GetMetric(ctx, MetricQuery{Metric: MetricLatencyP95, Query: "request.duration"})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.48, sample.Value, 0.001)
+}
+
+func TestOTLPClientRate(t *testing.T) {
+	r, advance := newTestOTLPReceiver()
+	ctx := context.Background()
+	c := r.Client("default", staticPods("llm-0", "llm-1"))
+	cumulative := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+	delta := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+	tokens := "gen_ai.tokens"
+
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpSumMetric(tokens, cumulative, 1000, otlpEpoch.Add(-time.Hour), otlpEpoch)))
+	_, err := c.GetMetric(ctx, MetricQuery{Metric: MetricTokensPerSecond, Query: tokens})
+	assert.ErrorContains(t, err, "no two points")
+
+	advance(10 * time.Second)
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpSumMetric(tokens, cumulative, 1500, otlpEpoch.Add(-time.Hour), otlpEpoch.Add(10*time.Second))))
+	// A delta sum carries its own interval
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-1", otlpSumMetric(tokens, delta, 200, otlpEpoch, otlpEpoch.Add(10*time.Second))))
+	sample, err := c.GetMetric(ctx, MetricQuery{Metric: MetricTokensPerSecond, Query: tokens})
+	require.NoError(t, err)
+	assert.Equal(t, 70.0, sample.Value)
+
+	// A counter reset counts from zero
+	advance(10 * time.Second)
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpSumMetric(tokens, cumulative, 100, otlpEpoch.Add(15*time.Second), otlpEpoch.Add(20*time.Second))))
+	sample, err = c.GetMetric(ctx, MetricQuery{Metric: MetricTokensPerSecond, Query: tokens})
+	require.NoError(t, err)
+	assert.Equal(t, 30.0, sample.Value)
+}
+
+func TestOTLPReceiverStaleSeries(t *testing.T) {
+	r, advance := newTestOTLPReceiver()
+	ctx := context.Background()
+	c := r.Client("default", staticPods("llm-0"))
+	assert.Error(t, c.Healthy(ctx))
+
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpGaugeMetric(ScrapeInFlightMetric, 2, otlpEpoch)))
+	assert.NoError(t, c.Healthy(ctx))
+	// Older points are ignored
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-0", otlpGaugeMetric(ScrapeInFlightMetric, 9, otlpEpoch.Add(-time.Second))))
+	sample, err := c.GetMetric(ctx, MetricQuery{Metric: MetricInFlightRequests})
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, sample.Value)
+
+	// A pod that stops pushing, such as a deleted one, is no longer read
+	advance(DefaultOTLPStaleAfter + time.Second)
+	assert.Error(t, c.Healthy(ctx))
+	_, err = c.GetMetric(ctx, MetricQuery{Metric: MetricInFlightRequests})
+	assert.ErrorIs(t, err, ErrNoData)
+	_, _ = r.Export(ctx, otlpRequest("default", "llm-1", otlpGaugeMetric(ScrapeInFlightMetric, 1, otlpEpoch)))
+	assert.Len(t, r.series[ScrapeInFlightMetric], 1)
+}
+
+func TestOTLPMetricName(t *testing.T) {
+	assert.Equal(t, "http.server.request.duration", otlpMetricName("http.server.request.duration", "def"))
+	assert.Equal(t, "vllm:num_requests_waiting", otlpMetricName("vllm:num_requests_waiting", "def"))
+	assert.Equal(t, "def", otlpMetricName("", "def"))
+	assert.Equal(t, "def", otlpMetricName(`sum(rate(x[1m]))`, "def"))
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// Metrics presets, which fill in the queries of the built-in metrics from the
+// metrics a serving runtime exports
+const (
+	MetricsPresetVLLM   = "vLLM"
+	MetricsPresetTriton = "Triton"
+	MetricsPresetTGI    = "TGI"
+)
+
+// Keys of PresetQueries for the queueing queries, which are not built-in metrics
+const (
+	// PresetArrivalRate is the requests per second arriving across all replicas
+	PresetArrivalRate = "arrivalRate"
+	// PresetServiceTime is the mean time in seconds a request takes to serve
+	PresetServiceTime = "serviceTime"
+)
+
+// metricsPreset is the PromQL templates of a preset, keyed by metric. %[1]s is
+// the matcher of the runtime's per-model series, %[2]s the join restricting
+// them to the target pods, and %[3]s the matcher of series without a model.
+type metricsPreset struct {
+	// modelLabel names the model on the runtime's series; empty when a server
+	// serves a single model and its series carry none
+	modelLabel string
+	queries    map[string]string
+}
+
+var vllmPreset = metricsPreset{
+	modelLabel: "model_name",
+	queries: map[string]string{
+		// Time to first token, which is what queueing in front of a saturated server inflates
+		MetricLatencyP99: `histogram_quantile(0.99, sum by (le) (rate(vllm:time_to_first_token_seconds_bucket{%[1]s}[$__window]) %[2]s))`,
+		MetricLatencyP95: `histogram_quantile(0.95, sum by (le) (rate(vllm:time_to_first_token_seconds_bucket{%[1]s}[$__window]) %[2]s))`,
+		// vLLM preallocates GPU memory and keeps the GPU busy, so KV cache usage
+		// stands in for utilization; it was renamed in vLLM 0.10
+		MetricGPUUtilization:   `100 * avg((vllm:kv_cache_usage_perc{%[1]s} or vllm:gpu_cache_usage_perc{%[1]s}) %[2]s)`,
+		MetricQueueDepth:       `sum(vllm:num_requests_waiting{%[1]s} %[2]s)`,
+		MetricInFlightRequests: `sum(vllm:num_requests_running{%[1]s} %[2]s)`,
+		MetricTokensPerSecond:  `sum(rate(vllm:generation_tokens_total{%[1]s}[$__window]) %[2]s)`,
+	},
+}
+
+var tritonPreset = metricsPreset{
+	modelLabel: "model",
+	queries: map[string]string{
+		// Triton exports no latency histograms by default, so both latencies are
+		// the mean time requests wait in the scheduler queue
+		MetricLatencyP99: `sum(rate(nv_inference_queue_duration_us{%[1]s}[$__window]) %[2]s) / sum(rate(nv_inference_request_success{%[1]s}[$__window]) %[2]s) / 1e6`,
+		MetricLatencyP95: `sum(rate(nv_inference_queue_duration_us{%[1]s}[$__window]) %[2]s) / sum(rate(nv_inference_request_success{%[1]s}[$__window]) %[2]s) / 1e6`,
+		// GPU series carry no model, only the GPU's UUID
+		MetricGPUUtilization: `100 * avg(nv_gpu_utilization{%[3]s} %[2]s)`,
+		MetricQueueDepth:     `sum(nv_inference_pending_request_count{%[1]s} %[2]s)`,
+		PresetArrivalRate:    `sum(rate(nv_inference_request_success{%[1]s}[$__window]) %[2]s)`,
+		PresetServiceTime:    `sum(rate(nv_inference_compute_infer_duration_us{%[1]s}[$__window]) %[2]s) / sum(rate(nv_inference_request_success{%[1]s}[$__window]) %[2]s) / 1e6`,
+	},
+}
+
+var tgiPreset = metricsPreset{
+	queries: map[string]string{
+		// End-to-end request duration, including the time spent in the queue
+		MetricLatencyP99:       `histogram_quantile(0.99, sum by (le) (rate(tgi_request_duration_bucket{%[1]s}[$__window]) %[2]s))`,
+		MetricLatencyP95:       `histogram_quantile(0.95, sum by (le) (rate(tgi_request_duration_bucket{%[1]s}[$__window]) %[2]s))`,
+		MetricQueueDepth:       `sum(tgi_queue_size{%[1]s} %[2]s)`,
+		MetricInFlightRequests: `sum(tgi_batch_current_size{%[1]s} %[2]s)`,
+		MetricTokensPerSecond:  `sum(rate(tgi_request_generated_tokens_sum{%[1]s}[$__window]) %[2]s)`,
+	},
+}
+
+// PresetQueries returns the PromQL of the built-in metrics for the named
+// metrics preset, keyed by metric. The runtime's series are expected to carry
+// the namespace and pod labels Prometheus adds to pod targets; they are
+// restricted to the pods matching selector in namespace by joining them with
+// kube_pod_labels from kube-state-metrics. A non-empty model is a regular
+// expression the runtime's model label must match.
+func PresetQueries(preset, namespace, model string, selector k8slabels.Selector) (map[string]string, error) {
+	var templates metricsPreset
+	switch preset {
+	case MetricsPresetVLLM:
+		templates = vllmPreset
+	case MetricsPresetTriton:
+		templates = tritonPreset
+	case MetricsPresetTGI:
+		templates = tgiPreset
+	default:
+		return nil, fmt.Errorf("unknown metrics preset %q", preset)
+	}
+	if model != "" && templates.modelLabel == "" {
+		return nil, fmt.Errorf("metrics preset %s cannot be restricted to a model", preset)
+	}
+	podLabels, err := KubePodLabelsSelector(namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	join := "* on (namespace, pod) group_left () max by (namespace, pod) (" + podLabels + ")"
+	namespaceMatcher := "namespace=" + strconv.Quote(namespace)
+	seriesMatcher := namespaceMatcher
+	if model != "" {
+		seriesMatcher += "," + templates.modelLabel + "=~" + strconv.Quote(model)
+	}
+	queries := make(map[string]string, len(templates.queries))
+	for metric, template := range templates.queries {
+		queries[metric] = fmt.Sprintf(template, seriesMatcher, join, namespaceMatcher)
+	}
+	return queries, nil
+}
+
+// invalidLabelChars are replaced by kube-state-metrics when it turns pod
+// labels into label_<name> labels of kube_pod_labels
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// KubePodLabelsSelector returns a kube_pod_labels selector of the pods in
+// namespace matching selector. Only the pod labels kube-state-metrics is
+// allowed to export (--metric-labels-allowlist) can be matched; set-based
+// requirements become regular expressions.
+func KubePodLabelsSelector(namespace string, selector k8slabels.Selector) (string, error) {
+	matchers := []string{"namespace=" + strconv.Quote(namespace)}
+	requirements, _ := selector.Requirements()
+	for _, requirement := range requirements {
+		name := "label_" + invalidLabelChars.ReplaceAllString(requirement.Key(), "_")
+		values := requirement.Values().List()
+		quoted := make([]string, len(values))
+		for i, value := range values {
+			quoted[i] = regexp.QuoteMeta(value)
+		}
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals:
+			matchers = append(matchers, name+"="+strconv.Quote(values[0]))
+		case selection.NotEquals:
+			matchers = append(matchers, name+"!="+strconv.Quote(values[0]))
+		case selection.In:
+			matchers = append(matchers, name+"=~"+strconv.Quote(strings.Join(quoted, "|")))
+		case selection.NotIn:
+			matchers = append(matchers, name+"!~"+strconv.Quote(strings.Join(quoted, "|")))
+		case selection.Exists:
+			matchers = append(matchers, name+`!=""`)
+		case selection.DoesNotExist:
+			matchers = append(matchers, name+`=""`)
+		default:
+			return "", fmt.Errorf("selector requirement %q cannot be matched in PromQL", requirement.String())
+		}
+	}
+	return "kube_pod_labels{" + strings.Join(matchers, ",") + "}", nil
+}
+
+// DCGMTargetQuery returns the GPU utilization query of the NVIDIA DCGM exporter
+// averaged over the GPUs of the pods matching selector in namespace, instead of
+// every GPU in the cluster. The exporter labels a GPU with the pod using it,
+// which Prometheus renames to exported_namespace and exported_pod as they clash
+// with the labels of the exporter's own pod; they are joined with
+// kube_pod_labels from kube-state-metrics.
+func DCGMTargetQuery(namespace string, selector k8slabels.Selector) (string, error) {
+	podLabels, err := KubePodLabelsSelector(namespace, selector)
+	if err != nil {
+		return "", err
+	}
+	exportedPodLabels := `label_replace(label_replace(` + podLabels + `, "exported_namespace", "$1", "namespace", "(.*)"), "exported_pod", "$1", "pod", "(.*)")`
+	return "avg(DCGM_FI_DEV_GPU_UTIL{exported_namespace=" + strconv.Quote(namespace) + "}" +
+		" * on (exported_namespace, exported_pod) group_left () max by (exported_namespace, exported_pod) (" + exportedPodLabels + "))", nil
+}
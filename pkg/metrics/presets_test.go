@@ -0,0 +1,104 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+)
+
+func TestKubePodLabelsSelector(t *testing.T) {
+	selector, err := k8slabels.Parse("app=llm,app.kubernetes.io/component!=router,tier in (gpu,spot),canary,!legacy")
+	require.NoError(t, err)
+	got, err := KubePodLabelsSelector("default", selector)
+	require.NoError(t, err)
+	assert.Equal(t, `kube_pod_labels{namespace="default",label_app="llm",label_app_kubernetes_io_component!="router",`+
+		`label_canary!="",label_legacy="",label_tier=~"gpu|spot"}`, got)
+
+	got, err = KubePodLabelsSelector("default", k8slabels.Everything())
+	require.NoError(t, err)
+	assert.Equal(t, `kube_pod_labels{namespace="default"}`, got)
+
+	selector, err = k8slabels.Parse("generation>2")
+	require.NoError(t, err)
+	_, err = KubePodLabelsSelector("default", selector)
+	assert.Error(t, err)
+}
+
+func TestPresetQueries(t *testing.T) {
+	queries, err := PresetQueries(MetricsPresetVLLM, "llm", "", k8slabels.SelectorFromSet(k8slabels.Set{"app": "vllm"}))
+	require.NoError(t, err)
+	join := `* on (namespace, pod) group_left () max by (namespace, pod) (kube_pod_labels{namespace="llm",label_app="vllm"})`
+	assert.Equal(t, `sum(vllm:num_requests_waiting{namespace="llm"} `+join+`)`, queries[MetricQueueDepth])
+	assert.Equal(t, `sum(vllm:num_requests_running{namespace="llm"} `+join+`)`, queries[MetricInFlightRequests])
+	assert.Equal(t, `histogram_quantile(0.99, sum by (le) (rate(vllm:time_to_first_token_seconds_bucket{namespace="llm"}[$__window]) `+
+		join+`))`, queries[MetricLatencyP99])
+	assert.Contains(t, queries[MetricGPUUtilization], `vllm:kv_cache_usage_perc{namespace="llm"} or vllm:gpu_cache_usage_perc{namespace="llm"}`)
+	assert.Len(t, queries, 6)
+
+	_, err = PresetQueries("SGLang", "llm", "", k8slabels.Everything())
+	assert.ErrorContains(t, err, "unknown metrics preset")
+}
+
+func TestPresetQueriesTriton(t *testing.T) {
+	queries, err := PresetQueries(MetricsPresetTriton, "llm", "resnet.*", k8slabels.SelectorFromSet(k8slabels.Set{"app": "triton"}))
+	require.NoError(t, err)
+	join := `* on (namespace, pod) group_left () max by (namespace, pod) (kube_pod_labels{namespace="llm",label_app="triton"})`
+	assert.Equal(t, `sum(nv_inference_pending_request_count{namespace="llm",model=~"resnet.*"} `+join+`)`, queries[MetricQueueDepth])
+	assert.Equal(t, `sum(rate(nv_inference_request_success{namespace="llm",model=~"resnet.*"}[$__window]) `+join+`)`, queries[PresetArrivalRate])
+	assert.Equal(t, `sum(rate(nv_inference_queue_duration_us{namespace="llm",model=~"resnet.*"}[$__window]) `+join+`) / `+
+		`sum(rate(nv_inference_request_success{namespace="llm",model=~"resnet.*"}[$__window]) `+join+`) / 1e6`, queries[MetricLatencyP99])
+	// GPU series have no model label to filter on
+	assert.Equal(t, `100 * avg(nv_gpu_utilization{namespace="llm"} `+join+`)`, queries[MetricGPUUtilization])
+	assert.Contains(t, queries[PresetServiceTime], `nv_inference_compute_infer_duration_us{namespace="llm",model=~"resnet.*"}`)
+	assert.NotContains(t, queries, MetricTokensPerSecond)
+
+	queries, err = PresetQueries(MetricsPresetVLLM, "llm", "llama-3", k8slabels.Everything())
+	require.NoError(t, err)
+	assert.Contains(t, queries[MetricQueueDepth], `vllm:num_requests_waiting{namespace="llm",model_name=~"llama-3"}`)
+}
+
+func TestPresetQueriesTGI(t *testing.T) {
+	queries, err := PresetQueries(MetricsPresetTGI, "llm", "", k8slabels.SelectorFromSet(k8slabels.Set{"app": "tgi"}))
+	require.NoError(t, err)
+	join := `* on (namespace, pod) group_left () max by (namespace, pod) (kube_pod_labels{namespace="llm",label_app="tgi"})`
+	assert.Equal(t, `sum(tgi_queue_size{namespace="llm"} `+join+`)`, queries[MetricQueueDepth])
+	assert.Equal(t, `sum(tgi_batch_current_size{namespace="llm"} `+join+`)`, queries[MetricInFlightRequests])
+	assert.Equal(t, `histogram_quantile(0.95, sum by (le) (rate(tgi_request_duration_bucket{namespace="llm"}[$__window]) `+
+		join+`))`, queries[MetricLatencyP95])
+	assert.NotContains(t, queries, MetricGPUUtilization)
+
+	// TGI serves one model and does not label its series with it
+	_, err = PresetQueries(MetricsPresetTGI, "llm", "llama-3", k8slabels.Everything())
+	assert.ErrorContains(t, err, "cannot be restricted to a model")
+}
+
+func TestDCGMTargetQuery(t *testing.T) {
+	query, err := DCGMTargetQuery("llm", k8slabels.SelectorFromSet(k8slabels.Set{"app": "vllm"}))
+	require.NoError(t, err)
+	assert.Equal(t, `avg(DCGM_FI_DEV_GPU_UTIL{exported_namespace="llm"} * on (exported_namespace, exported_pod) group_left () `+
+		`max by (exported_namespace, exported_pod) (label_replace(label_replace(kube_pod_labels{namespace="llm",label_app="vllm"}, `+
+		`"exported_namespace", "$1", "namespace", "(.*)"), "exported_pod", "$1", "pod", "(.*)")))`, query)
+
+	selector, err := k8slabels.Parse("generation>2")
+	require.NoError(t, err)
+	_, err = DCGMTargetQuery("llm", selector)
+	assert.Error(t, err)
+}
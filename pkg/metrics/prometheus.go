@@ -18,7 +18,10 @@ package metrics
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/api"
@@ -26,39 +29,171 @@ import (
 	"github.com/prometheus/common/model"
 )
 
-// Client interface for fetching metrics
+// ErrNoData is returned when a query succeeds without returning any samples
+var ErrNoData = errors.New("no data returned from query")
+
+// Client fetches metrics. Clients that support range queries, such as
+// PrometheusClient, evaluate queries over the range carried by the context
+// (see WithQueryRange); the others answer with the latest sample. The
+// functions GetLatencyP99, GetQueueDepth, Query and so on wrap GetMetric for
+// each built-in metric.
 type Client interface {
-	GetLatencyP99(ctx context.Context, query string) (float64, error)
-	GetLatencyP95(ctx context.Context, query string) (float64, error)
-	GetGPUUtilization(ctx context.Context, query string) (float64, error)
-	GetQueueDepth(ctx context.Context, query string) (int64, error)
-	Query(ctx context.Context, query string) (float64, error)
+	// GetMetric fetches the metric selected by query. When query matches
+	// several series the first is returned.
+	GetMetric(ctx context.Context, query MetricQuery) (Sample, error)
+}
+
+// HealthChecker is implemented by clients that can report whether their
+// backend is reachable before it is used for a reconcile
+type HealthChecker interface {
+	Healthy(ctx context.Context) error
+}
+
+// CheckHealth reports whether c can serve queries. Clients that do not
+// implement HealthChecker are assumed healthy.
+func CheckHealth(ctx context.Context, c Client) error {
+	if hc, ok := c.(HealthChecker); ok {
+		return hc.Healthy(ctx)
+	}
+	return nil
 }
 
 // PrometheusClient implements the Client interface using Prometheus
 type PrometheusClient struct {
-	api v1.API
+	api   v1.API
+	retry RetryConfig
+}
+
+// NewPrometheusClient creates a new Prometheus client that retries transient
+// query failures according to retry
+func NewPrometheusClient(address string, retry RetryConfig) (*PrometheusClient, error) {
+	return newPrometheusClient(address, retry, nil)
 }
 
-// NewPrometheusClient creates a new Prometheus client
-func NewPrometheusClient(address string) (*PrometheusClient, error) {
+// newPrometheusClient creates a Prometheus client sending its requests
+// through roundTripper, or the default transport when it is nil
+func newPrometheusClient(address string, retry RetryConfig, roundTripper http.RoundTripper) (*PrometheusClient, error) {
 	client, err := api.NewClient(api.Config{
-		Address: address,
+		Address:      address,
+		RoundTripper: roundTripper,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Prometheus client: %w", err)
 	}
 
 	return &PrometheusClient{
-		api: v1.NewAPI(client),
+		api:   v1.NewAPI(client),
+		retry: retry,
 	}, nil
 }
 
-// Query executes a Prometheus query and returns the result as a float64
-func (c *PrometheusClient) Query(ctx context.Context, query string) (float64, error) {
-	result, warnings, err := c.api.Query(ctx, query, time.Now())
+// defaultQueries are the PromQL queries of the built-in metrics
+var defaultQueries = map[string]string{
+	MetricLatencyP99:       `histogram_quantile(0.99, sum(rate(inference_request_duration_seconds_bucket[$__window])) by (le))`,
+	MetricLatencyP95:       `histogram_quantile(0.95, sum(rate(inference_request_duration_seconds_bucket[$__window])) by (le))`,
+	MetricGPUUtilization:   `avg(DCGM_FI_DEV_GPU_UTIL)`,
+	MetricQueueDepth:       `sum(inference_request_queue_depth)`,
+	MetricTokensPerSecond:  TokensPerSecondQuery(TokensPresetVLLM),
+	MetricInFlightRequests: `sum(inference_requests_in_flight)`,
+}
+
+// GetMetric runs the query of the metric, or its default query when it has
+// none, and returns the first returned series
+func (c *PrometheusClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	query := q.Query
+	if query == "" {
+		query = defaultQueries[q.Metric]
+	}
+	if query == "" {
+		return Sample{}, errNoQuery(q.Metric)
+	}
+	samples, err := c.querySamples(ctx, query)
 	if err != nil {
-		return 0, fmt.Errorf("prometheus query failed: %w", err)
+		return Sample{}, err
+	}
+	return samples[0], nil
+}
+
+// QueryVector executes a Prometheus query and returns the value of every
+// returned series. QueryWindowVariable is expanded to the query window of ctx.
+// When ctx carries a query range, it is run as a range query and each series
+// is reduced to the aggregation of its samples.
+func (c *PrometheusClient) QueryVector(ctx context.Context, query string) ([]float64, error) {
+	samples, err := c.querySamples(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]float64, len(samples))
+	for i, sample := range samples {
+		values[i] = sample.Value
+	}
+	return values, nil
+}
+
+// History runs query as a range query from start to end and returns the
+// samples of the first series that has any, oldest first. NaN samples are
+// left out.
+func (c *PrometheusClient) History(ctx context.Context, query string, start, end time.Time, step time.Duration) ([]Sample, error) {
+	var result model.Value
+	attempts, err := c.retry.retry(ctx, func(ctx context.Context) error {
+		var err error
+		result, _, err = c.api.QueryRange(ctx, query, v1.Range{Start: start, End: end, Step: step})
+		return err
+	})
+	if err != nil {
+		if attempts > 1 {
+			return nil, fmt.Errorf("prometheus range query failed after %d attempts: %w", attempts, err)
+		}
+		return nil, fmt.Errorf("prometheus range query failed: %w", err)
+	}
+
+	matrix, ok := result.(model.Matrix)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type: %T", result)
+	}
+	for _, series := range matrix {
+		samples := make([]Sample, 0, len(series.Values))
+		for _, pair := range series.Values {
+			if math.IsNaN(float64(pair.Value)) {
+				continue
+			}
+			samples = append(samples, Sample{Value: float64(pair.Value), Timestamp: pair.Timestamp.Time(), Labels: labels(series.Metric)})
+		}
+		if len(samples) > 0 {
+			return samples, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNoData, query)
+}
+
+// querySamples executes a Prometheus query as described by QueryVector and
+// returns a sample per series. A range query stamps its samples with the end
+// of the range.
+func (c *PrometheusClient) querySamples(ctx context.Context, query string) ([]Sample, error) {
+	query = ExpandQuery(ctx, query)
+	queryRange, ranged := QueryRangeFrom(ctx)
+	var result model.Value
+	var warnings v1.Warnings
+	var end time.Time
+	attempts, err := c.retry.retry(ctx, func(ctx context.Context) error {
+		var err error
+		end = time.Now()
+		if ranged {
+			result, warnings, err = c.api.QueryRange(ctx, query, v1.Range{
+				Start: end.Add(-queryRange.Window),
+				End:   end,
+				Step:  queryRange.step(),
+			})
+		} else {
+			result, warnings, err = c.api.Query(ctx, query, end)
+		}
+		return err
+	})
+	if err != nil {
+		if attempts > 1 {
+			return nil, fmt.Errorf("prometheus query failed after %d attempts: %w", attempts, err)
+		}
+		return nil, fmt.Errorf("prometheus query failed: %w", err)
 	}
 
 	if len(warnings) > 0 {
@@ -69,83 +204,108 @@ func (c *PrometheusClient) Query(ctx context.Context, query string) (float64, er
 	switch v := result.(type) {
 	case model.Vector:
 		if len(v) == 0 {
-			return 0, fmt.Errorf("no data returned from query: %s", query)
+			return nil, fmt.Errorf("%w: %s", ErrNoData, query)
+		}
+		samples := make([]Sample, len(v))
+		for i, sample := range v {
+			samples[i] = Sample{
+				Value:     float64(sample.Value),
+				Timestamp: sample.Timestamp.Time(),
+				Labels:    labels(sample.Metric),
+			}
+		}
+		return samples, nil
+	case model.Matrix:
+		samples := make([]Sample, 0, len(v))
+		for _, series := range v {
+			values := make([]float64, len(series.Values))
+			for i, sample := range series.Values {
+				values[i] = float64(sample.Value)
+			}
+			value, err := AggregateOverTime(values, queryRange.Aggregation)
+			if errors.Is(err, errNoSamples) {
+				// Every sample of the series was NaN; it has no data
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			samples = append(samples, Sample{Value: value, Timestamp: end, Labels: labels(series.Metric)})
 		}
-		return float64(v[0].Value), nil
+		if len(samples) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrNoData, query)
+		}
+		return samples, nil
 	case *model.Scalar:
-		return float64(v.Value), nil
+		return []Sample{{Value: float64(v.Value), Timestamp: v.Timestamp.Time()}}, nil
 	default:
-		return 0, fmt.Errorf("unexpected result type: %T", result)
+		return nil, fmt.Errorf("unexpected result type: %T", result)
 	}
 }
 
-// GetLatencyP99 fetches P99 latency metric
-func (c *PrometheusClient) GetLatencyP99(ctx context.Context, query string) (float64, error) {
-	if query == "" {
-		query = `histogram_quantile(0.99, sum(rate(inference_request_duration_seconds_bucket[5m])) by (le))`
+// labels converts the labels of a Prometheus series, returning nil for none
+func labels(metric model.Metric) map[string]string {
+	if len(metric) == 0 {
+		return nil
 	}
-	return c.Query(ctx, query)
-}
-
-// GetLatencyP95 fetches P95 latency metric
-func (c *PrometheusClient) GetLatencyP95(ctx context.Context, query string) (float64, error) {
-	if query == "" {
-		query = `histogram_quantile(0.95, sum(rate(inference_request_duration_seconds_bucket[5m])) by (le))`
-	}
-	return c.Query(ctx, query)
-}
-
-// GetGPUUtilization fetches GPU utilization metric
-func (c *PrometheusClient) GetGPUUtilization(ctx context.Context, query string) (float64, error) {
-	if query == "" {
-		query = `avg(DCGM_FI_DEV_GPU_UTIL)`
+	converted := make(map[string]string, len(metric))
+	for name, value := range metric {
+		converted[string(name)] = string(value)
 	}
-	return c.Query(ctx, query)
+	return converted
 }
 
-// GetQueueDepth fetches request queue depth metric
-func (c *PrometheusClient) GetQueueDepth(ctx context.Context, query string) (int64, error) {
-	if query == "" {
-		query = `sum(inference_request_queue_depth)`
-	}
-	value, err := c.Query(ctx, query)
-	if err != nil {
-		return 0, err
-	}
-	return int64(value), nil
+// Healthy reports whether the Prometheus server answers a trivial query
+func (c *PrometheusClient) Healthy(ctx context.Context) error {
+	_, err := c.querySamples(ctx, "vector(1)")
+	return err
 }
 
 // MockClient is a mock implementation for testing
 type MockClient struct {
-	LatencyP99Value     float64
-	LatencyP95Value     float64
-	GPUUtilizationValue float64
-	QueueDepthValue     int64
-	QueryValue          float64
-	Error               error
-}
-
-// Query returns the mock query value
-func (m *MockClient) Query(_ context.Context, _ string) (float64, error) {
-	return m.QueryValue, m.Error
+	LatencyP99Value      float64
+	LatencyP95Value      float64
+	GPUUtilizationValue  float64
+	QueueDepthValue      int64
+	TokensPerSecondValue float64
+	InFlightValue        int64
+	QueryValue           float64
+	// QueryValues maps queries to their values; QueryValue answers the rest
+	QueryValues map[string]float64
+	Error       error
+	// HealthError is returned by Healthy
+	HealthError error
 }
 
-// GetLatencyP99 returns the mock P99 latency value
-func (m *MockClient) GetLatencyP99(_ context.Context, _ string) (float64, error) {
-	return m.LatencyP99Value, m.Error
+// Healthy returns the mock health error
+func (m *MockClient) Healthy(_ context.Context) error {
+	return m.HealthError
 }
 
-// GetLatencyP95 returns the mock P95 latency value
-func (m *MockClient) GetLatencyP95(_ context.Context, _ string) (float64, error) {
-	return m.LatencyP95Value, m.Error
-}
-
-// GetGPUUtilization returns the mock GPU utilization value
-func (m *MockClient) GetGPUUtilization(_ context.Context, _ string) (float64, error) {
-	return m.GPUUtilizationValue, m.Error
-}
-
-// GetQueueDepth returns the mock queue depth value
-func (m *MockClient) GetQueueDepth(_ context.Context, _ string) (int64, error) {
-	return m.QueueDepthValue, m.Error
+// GetMetric returns the mock value of the metric. Raw queries are answered
+// from QueryValues, falling back to QueryValue.
+func (m *MockClient) GetMetric(_ context.Context, q MetricQuery) (Sample, error) {
+	var value float64
+	switch q.Metric {
+	case MetricLatencyP99:
+		value = m.LatencyP99Value
+	case MetricLatencyP95:
+		value = m.LatencyP95Value
+	case MetricGPUUtilization:
+		value = m.GPUUtilizationValue
+	case MetricQueueDepth:
+		value = float64(m.QueueDepthValue)
+	case MetricTokensPerSecond:
+		value = m.TokensPerSecondValue
+	case MetricInFlightRequests:
+		value = float64(m.InFlightValue)
+	case "":
+		var ok bool
+		if value, ok = m.QueryValues[q.Query]; !ok {
+			value = m.QueryValue
+		}
+	default:
+		return Sample{}, errNoQuery(q.Metric)
+	}
+	return Sample{Value: value}, m.Error
 }
@@ -36,7 +36,7 @@ func TestMockClient(t *testing.T) {
 				LatencyP99Value: 0.5,
 			},
 			testFunc: func(ctx context.Context, client *MockClient) error {
-				val, err := client.GetLatencyP99(ctx, "")
+				val, err := GetLatencyP99(ctx, client, "")
 				if err != nil {
 					return err
 				}
@@ -52,7 +52,7 @@ func TestMockClient(t *testing.T) {
 				LatencyP95Value: 0.3,
 			},
 			testFunc: func(ctx context.Context, client *MockClient) error {
-				val, err := client.GetLatencyP95(ctx, "")
+				val, err := GetLatencyP95(ctx, client, "")
 				if err != nil {
 					return err
 				}
@@ -68,7 +68,7 @@ func TestMockClient(t *testing.T) {
 				GPUUtilizationValue: 75.5,
 			},
 			testFunc: func(ctx context.Context, client *MockClient) error {
-				val, err := client.GetGPUUtilization(ctx, "")
+				val, err := GetGPUUtilization(ctx, client, "")
 				if err != nil {
 					return err
 				}
@@ -84,7 +84,7 @@ func TestMockClient(t *testing.T) {
 				QueueDepthValue: 100,
 			},
 			testFunc: func(ctx context.Context, client *MockClient) error {
-				val, err := client.GetQueueDepth(ctx, "")
+				val, err := GetQueueDepth(ctx, client, "")
 				if err != nil {
 					return err
 				}
@@ -100,7 +100,7 @@ func TestMockClient(t *testing.T) {
 				Error: errors.New("test error"),
 			},
 			testFunc: func(ctx context.Context, client *MockClient) error {
-				_, err := client.GetLatencyP99(ctx, "")
+				_, err := GetLatencyP99(ctx, client, "")
 				if err == nil {
 					return errors.New("expected error")
 				}
@@ -130,15 +130,27 @@ func TestDefaultQueries(t *testing.T) {
 	ctx := context.Background()
 
 	// These should work with empty queries
-	_, err := mock.GetLatencyP99(ctx, "")
+	_, err := GetLatencyP99(ctx, mock, "")
 	assert.NoError(t, err)
 
-	_, err = mock.GetLatencyP95(ctx, "")
+	_, err = GetLatencyP95(ctx, mock, "")
 	assert.NoError(t, err)
 
-	_, err = mock.GetGPUUtilization(ctx, "")
+	_, err = GetGPUUtilization(ctx, mock, "")
 	assert.NoError(t, err)
 
-	_, err = mock.GetQueueDepth(ctx, "")
+	_, err = GetQueueDepth(ctx, mock, "")
 	assert.NoError(t, err)
+
+	_, err = GetTokensPerSecond(ctx, mock, "")
+	assert.NoError(t, err)
+
+	_, err = GetInFlightRequests(ctx, mock, "")
+	assert.NoError(t, err)
+}
+
+func TestTokensPerSecondQuery(t *testing.T) {
+	assert.Equal(t, `sum(rate(vllm:generation_tokens_total[$__window]))`, TokensPerSecondQuery(TokensPresetVLLM))
+	assert.Equal(t, `sum(rate(tgi_request_generated_tokens_sum[$__window]))`, TokensPerSecondQuery(TokensPresetTGI))
+	assert.Equal(t, TokensPerSecondQuery(TokensPresetVLLM), TokensPerSecondQuery(""))
 }
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// BacklogReader reads the number of messages waiting in a queue of a message broker
+type BacklogReader interface {
+	Backlog(ctx context.Context, queue string) (int64, error)
+}
+
+// BasicAuthFunc returns the username and password a request is sent with.
+// It is called for every request, so rotated credentials are picked up.
+type BasicAuthFunc func(ctx context.Context) (username, password string, err error)
+
+// QueueClient serves the backlog of a message queue, for inference pipelines
+// whose work arrives through a queue rather than over HTTP. The queue depth
+// metric reads the default queue; any metric whose query names a queue reads
+// that queue instead.
+type QueueClient struct {
+	reader BacklogReader
+	queue  string
+}
+
+var (
+	_ Client        = &QueueClient{}
+	_ HealthChecker = &QueueClient{}
+)
+
+// NewQueueClient creates a client reading backlogs with reader, of queue by default
+func NewQueueClient(reader BacklogReader, queue string) *QueueClient {
+	return &QueueClient{reader: reader, queue: queue}
+}
+
+// GetMetric returns the backlog of the queue named by q.Query, or of the
+// default queue for the queue depth metric
+func (c *QueueClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	queue := q.Query
+	if queue == "" {
+		if q.Metric != MetricQueueDepth {
+			if q.Metric == "" {
+				return Sample{}, errNoQuery(q.Metric)
+			}
+			return Sample{}, fmt.Errorf("metric %q is not available from a queue; set its query to a queue", q.Metric)
+		}
+		queue = c.queue
+	}
+	backlog, err := c.reader.Backlog(ctx, queue)
+	if err != nil {
+		return Sample{}, fmt.Errorf("reading backlog of queue %s: %w", queue, err)
+	}
+	return Sample{Value: float64(backlog)}, nil
+}
+
+// Healthy reads the backlog of the default queue
+func (c *QueueClient) Healthy(ctx context.Context) error {
+	if _, err := c.reader.Backlog(ctx, c.queue); err != nil {
+		return fmt.Errorf("queue health check failed: %w", err)
+	}
+	return nil
+}
+
+// getJSON fetches url with the credentials of auth, if any, and decodes its JSON body into out
+func getJSON(ctx context.Context, c *http.Client, url string, auth BasicAuthFunc, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if auth != nil {
+		username, password, err := auth(ctx)
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(username, password)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s returned %s: %s", req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding %s: %w", req.URL.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBacklog returns the backlog of known queues
+type fakeBacklog map[string]int64
+
+func (f fakeBacklog) Backlog(_ context.Context, queue string) (int64, error) {
+	backlog, ok := f[queue]
+	if !ok {
+		return 0, errors.New("queue not found")
+	}
+	return backlog, nil
+}
+
+func TestQueueClient(t *testing.T) {
+	c := NewQueueClient(fakeBacklog{"requests": 42, "priority": 3}, "requests")
+	ctx := context.Background()
+
+	depth, err := GetQueueDepth(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), depth)
+
+	// A query names another queue
+	value, err := Query(ctx, c, "priority")
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, value)
+
+	_, err = GetLatencyP99(ctx, c, "")
+	assert.ErrorContains(t, err, "not available from a queue")
+	_, err = Query(ctx, c, "missing")
+	assert.ErrorContains(t, err, "queue missing")
+
+	assert.NoError(t, c.Healthy(ctx))
+	assert.Error(t, NewQueueClient(fakeBacklog{}, "requests").Healthy(ctx))
+}
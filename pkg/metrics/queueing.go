@@ -0,0 +1,26 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+// Default queries for queueing-based scaling, over the histogram and counter
+// exported by the inference server
+const (
+	// DefaultArrivalRateQuery returns requests per second arriving across all replicas
+	DefaultArrivalRateQuery = `sum(rate(inference_request_total[$__window]))`
+	// DefaultServiceTimeQuery returns the mean time in seconds a request takes to serve
+	DefaultServiceTimeQuery = `sum(rate(inference_request_duration_seconds_sum[$__window])) / sum(rate(inference_request_duration_seconds_count[$__window]))`
+)
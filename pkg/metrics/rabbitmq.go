@@ -0,0 +1,62 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RabbitMQBacklog reads the length of RabbitMQ queues from the management API
+type RabbitMQBacklog struct {
+	address    string
+	auth       BasicAuthFunc
+	httpClient *http.Client
+}
+
+var _ BacklogReader = &RabbitMQBacklog{}
+
+// NewRabbitMQBacklog creates a reader for the management API at address,
+// such as http://rabbitmq.messaging:15672, authenticating with auth
+func NewRabbitMQBacklog(address string, auth BasicAuthFunc) *RabbitMQBacklog {
+	return &RabbitMQBacklog{
+		address:    strings.TrimSuffix(address, "/"),
+		auth:       auth,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Backlog returns the messages ready for delivery in queue, which is named
+// "vhost/name" or, in the default virtual host, "name". Messages delivered but
+// not yet acknowledged are being worked on and not counted.
+func (r *RabbitMQBacklog) Backlog(ctx context.Context, queue string) (int64, error) {
+	vhost, name := "/", queue
+	if i := strings.Index(queue, "/"); i >= 0 {
+		vhost, name = queue[:i], queue[i+1:]
+	}
+	var stats struct {
+		MessagesReady int64 `json:"messages_ready"`
+	}
+	endpoint := r.address + "/api/queues/" + url.PathEscape(vhost) + "/" + url.PathEscape(name)
+	if err := getJSON(ctx, r.httpClient, endpoint, r.auth, &stats); err != nil {
+		return 0, err
+	}
+	return stats.MessagesReady, nil
+}
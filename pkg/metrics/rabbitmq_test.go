@@ -0,0 +1,64 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRabbitMQBacklog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "autoscaler" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.EscapedPath() {
+		case "/api/queues/%2F/inference":
+			_, _ = w.Write([]byte(`{"name": "inference", "messages": 12, "messages_ready": 9, "messages_unacknowledged": 3}`))
+		case "/api/queues/batch/embeddings":
+			_, _ = w.Write([]byte(`{"name": "embeddings", "messages_ready": 4}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "Object Not Found"}`))
+		}
+	}))
+	defer server.Close()
+	auth := func(context.Context) (string, string, error) { return "autoscaler", "secret", nil }
+	ctx := context.Background()
+
+	r := NewRabbitMQBacklog(server.URL+"/", auth)
+	backlog, err := r.Backlog(ctx, "inference")
+	require.NoError(t, err)
+	// Unacknowledged messages are being worked on
+	assert.Equal(t, int64(9), backlog)
+
+	backlog, err = r.Backlog(ctx, "batch/embeddings")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), backlog)
+
+	_, err = r.Backlog(ctx, "missing")
+	assert.ErrorContains(t, err, "404")
+
+	_, err = NewRabbitMQBacklog(server.URL, nil).Backlog(ctx, "inference")
+	assert.ErrorContains(t, err, "401")
+}
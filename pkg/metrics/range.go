@@ -0,0 +1,126 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// Aggregations combining the samples of a series over a query range
+const (
+	RangeAverage = "Avg"
+	RangeMax     = "Max"
+	RangeP90     = "P90"
+)
+
+// DefaultRangeStep is the resolution of range queries that set none
+const DefaultRangeStep = 15 * time.Second
+
+// Range evaluates a query over the Window ending now instead of at a single
+// instant. Each series returned is reduced to one value by combining its
+// samples, taken every Step, with Aggregation.
+type Range struct {
+	Window      time.Duration
+	Step        time.Duration
+	Aggregation string
+}
+
+// step returns the resolution of the range, never coarser than its window
+func (r Range) step() time.Duration {
+	step := r.Step
+	if step <= 0 {
+		step = DefaultRangeStep
+	}
+	return min(step, r.Window)
+}
+
+// String identifies the range, e.g. 5m0s/15s/P90
+func (r Range) String() string {
+	return fmt.Sprintf("%s/%s/%s", r.Window, r.step(), r.Aggregation)
+}
+
+// HistoryReader is implemented by clients that return every sample of a
+// series over a past interval, such as to replay history on startup
+type HistoryReader interface {
+	// History returns the samples of the first series query returns between
+	// start and end, one every step, oldest first
+	History(ctx context.Context, query string, start, end time.Time, step time.Duration) ([]Sample, error)
+}
+
+// errNoSamples is returned when a series has no sample to aggregate
+var errNoSamples = errors.New("no samples to aggregate")
+
+type queryRangeKey struct{}
+
+// WithQueryRange returns a context whose queries are evaluated over r by
+// clients that support range queries; others keep answering with the latest sample
+func WithQueryRange(ctx context.Context, r Range) context.Context {
+	return context.WithValue(ctx, queryRangeKey{}, r)
+}
+
+// QueryRangeFrom returns the query range carried by ctx, if any
+func QueryRangeFrom(ctx context.Context) (Range, bool) {
+	r, ok := ctx.Value(queryRangeKey{}).(Range)
+	return r, ok && r.Window > 0
+}
+
+// AggregateOverTime combines the samples of one series with aggregation; an
+// empty aggregation averages. NaN samples, such as quantiles of steps without
+// traffic, are left out.
+func AggregateOverTime(samples []float64, aggregation string) (float64, error) {
+	values := make([]float64, 0, len(samples))
+	for _, v := range samples {
+		if !math.IsNaN(v) {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		return 0, errNoSamples
+	}
+
+	switch aggregation {
+	case RangeAverage, "":
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values)), nil
+	case RangeMax:
+		return slices.Max(values), nil
+	case RangeP90:
+		return quantile(0.9, values), nil
+	default:
+		return 0, fmt.Errorf("unsupported range aggregation: %s", aggregation)
+	}
+}
+
+// quantile returns the q-quantile of values, interpolating linearly between
+// the nearest ranks like PromQL's quantile_over_time
+func quantile(q float64, values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	rank := q * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+	return sorted[lower]*(1-weight) + sorted[upper]*weight
+}
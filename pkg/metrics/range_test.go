@@ -0,0 +1,147 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateOverTime(t *testing.T) {
+	samples := []float64{10, 50, 20, math.NaN(), 40, 30, 60, 70, 80, 90, 100}
+
+	value, err := AggregateOverTime(samples, RangeAverage)
+	require.NoError(t, err)
+	assert.Equal(t, 55.0, value)
+
+	value, err = AggregateOverTime(samples, "")
+	require.NoError(t, err)
+	assert.Equal(t, 55.0, value)
+
+	value, err = AggregateOverTime(samples, RangeMax)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, value)
+
+	value, err = AggregateOverTime(samples, RangeP90)
+	require.NoError(t, err)
+	assert.InDelta(t, 91.0, value, 1e-9)
+
+	_, err = AggregateOverTime([]float64{math.NaN()}, RangeAverage)
+	assert.ErrorIs(t, err, errNoSamples)
+	_, err = AggregateOverTime(samples, "P50")
+	assert.ErrorContains(t, err, "unsupported range aggregation")
+}
+
+func TestQueryRangeFrom(t *testing.T) {
+	_, ok := QueryRangeFrom(context.Background())
+	assert.False(t, ok)
+
+	r, ok := QueryRangeFrom(WithQueryRange(context.Background(), Range{Window: 5 * time.Minute, Aggregation: RangeMax}))
+	require.True(t, ok)
+	assert.Equal(t, DefaultRangeStep, r.step())
+	assert.Equal(t, "5m0s/15s/Max", r.String())
+
+	// The step never exceeds the window
+	assert.Equal(t, 10*time.Second, Range{Window: 10 * time.Second, Step: time.Minute}.step())
+}
+
+func TestPrometheusClientQueryRange(t *testing.T) {
+	var form url.Values
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		path, form = r.URL.Path, r.Form
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"pod":"a"},"values":[[0,"10"],[15,"90"],[30,"20"]]},
+			{"metric":{"pod":"b"},"values":[[0,"NaN"],[15,"30"],[30,"NaN"]]},
+			{"metric":{"pod":"c"},"values":[[0,"NaN"]]}]}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewPrometheusClient(server.URL, RetryConfig{})
+	require.NoError(t, err)
+	ctx := WithQueryRange(context.Background(), Range{Window: 2 * time.Minute, Step: 30 * time.Second, Aggregation: RangeMax})
+
+	values, err := c.QueryVector(ctx, "avg by (pod) (DCGM_FI_DEV_GPU_UTIL)")
+	require.NoError(t, err)
+	assert.Equal(t, []float64{90, 30}, values)
+	assert.Equal(t, "/api/v1/query_range", path)
+	assert.Equal(t, "30", form.Get("step"))
+	start, err := strconv.ParseFloat(form.Get("start"), 64)
+	require.NoError(t, err)
+	end, err := strconv.ParseFloat(form.Get("end"), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 120, end-start, 0.001)
+
+	// Queries without a range stay instant
+	_, _ = c.QueryVector(context.Background(), "avg(DCGM_FI_DEV_GPU_UTIL)")
+	assert.Equal(t, "/api/v1/query", path)
+}
+
+func TestPrometheusClientHistory(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form = r.Form
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"policy":"a"},"values":[[0,"NaN"]]},
+			{"metric":{"policy":"b"},"values":[[0,"2"],[60,"NaN"],[120,"5"]]}]}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewPrometheusClient(server.URL, RetryConfig{})
+	require.NoError(t, err)
+	var _ HistoryReader = c
+
+	samples, err := c.History(context.Background(), "kubeai_autoscaler_unconstrained_replicas", time.Unix(0, 0), time.Unix(120, 0), time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "60", form.Get("step"))
+	// The series without data is skipped and NaN samples are left out
+	require.Len(t, samples, 2)
+	assert.Equal(t, 2.0, samples[0].Value)
+	assert.Equal(t, time.Unix(120, 0), samples[1].Timestamp)
+	assert.Equal(t, map[string]string{"policy": "b"}, samples[1].Labels)
+}
+
+func TestCachingClientKeysByRange(t *testing.T) {
+	inner := &slowClient{MockClient: MockClient{GPUUtilizationValue: 70}}
+	c, _ := newTestCache(inner)
+	ctx := context.Background()
+
+	_, err := GetGPUUtilization(ctx, c, "")
+	require.NoError(t, err)
+	_, err = GetGPUUtilization(WithQueryRange(ctx, Range{Window: 5 * time.Minute, Aggregation: RangeP90}), c, "")
+	require.NoError(t, err)
+	_, err = GetGPUUtilization(WithQueryWindow(ctx, time.Minute), c, "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), inner.queries.Load())
+
+	_, err = GetGPUUtilization(WithQueryRange(ctx, Range{Window: 5 * time.Minute, Aggregation: RangeP90}), c, "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), inner.queries.Load())
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// resourceMetricsAPI is the path of the resource metrics API served by metrics-server
+const resourceMetricsAPI = "/apis/metrics.k8s.io/v1beta1"
+
+// ResourceMetricsClient reads the CPU and memory usage of pods from the
+// resource metrics API, served by metrics-server
+type ResourceMetricsClient struct {
+	host       string
+	httpClient *http.Client
+}
+
+// NewResourceMetricsClient creates a client for the resource metrics API of
+// the Kubernetes API server at host
+func NewResourceMetricsClient(host string, httpClient *http.Client) (*ResourceMetricsClient, error) {
+	if host == "" || httpClient == nil {
+		return nil, errors.New("the resource metrics API requires a Kubernetes API server")
+	}
+	return &ResourceMetricsClient{host: strings.TrimSuffix(host, "/"), httpClient: httpClient}, nil
+}
+
+// podMetricsList is a list of pod metrics of the resource metrics API
+type podMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Containers []struct {
+			Name  string              `json:"name"`
+			Usage corev1.ResourceList `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// PodUsage returns the usage of the pods in namespace matched by selector,
+// summed over each pod's containers and keyed by pod name. Pods metrics-server
+// has no sample of yet are left out.
+func (c *ResourceMetricsClient) PodUsage(ctx context.Context, namespace, selector string) (map[string]corev1.ResourceList, error) {
+	endpoint := withQuery(c.host+resourceMetricsAPI+"/namespaces/"+url.PathEscape(namespace)+"/pods",
+		url.Values{"labelSelector": []string{selector}})
+	var list podMetricsList
+	if err := getJSON(ctx, c.httpClient, endpoint, nil, &list); err != nil {
+		return nil, fmt.Errorf("querying the resource metrics API: %w", err)
+	}
+
+	usage := make(map[string]corev1.ResourceList, len(list.Items))
+	for _, item := range list.Items {
+		total := corev1.ResourceList{}
+		for _, container := range item.Containers {
+			for name, quantity := range container.Usage {
+				sum := total[name]
+				sum.Add(quantity)
+				total[name] = sum
+			}
+		}
+		usage[item.Metadata.Name] = total
+	}
+	return usage, nil
+}
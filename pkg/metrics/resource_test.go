@@ -0,0 +1,67 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestResourceMetricsClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/metrics.k8s.io/v1beta1/namespaces/llm/pods":
+			assert.Equal(t, "app=embeddings", r.URL.Query().Get("labelSelector"))
+			_, _ = w.Write([]byte(`{"kind": "PodMetricsList", "items": [
+				{"metadata": {"name": "embeddings-0"}, "containers": [
+					{"name": "server", "usage": {"cpu": "1500m", "memory": "2Gi"}},
+					{"name": "sidecar", "usage": {"cpu": "250m", "memory": "64Mi"}}]},
+				{"metadata": {"name": "embeddings-1"}, "containers": [
+					{"name": "server", "usage": {"cpu": "2", "memory": "1Gi"}}]}]}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	c, err := NewResourceMetricsClient(server.URL+"/", server.Client())
+	require.NoError(t, err)
+
+	usage, err := c.PodUsage(ctx, "llm", "app=embeddings")
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+	cpu := usage["embeddings-0"][corev1.ResourceCPU]
+	assert.Equal(t, int64(1750), cpu.MilliValue(), "usage is summed over containers")
+	memory := usage["embeddings-0"][corev1.ResourceMemory]
+	assert.Equal(t, int64(2<<30+64<<20), memory.Value())
+	cpu = usage["embeddings-1"][corev1.ResourceCPU]
+	assert.Equal(t, int64(2000), cpu.MilliValue())
+
+	// metrics-server is not installed
+	_, err = c.PodUsage(ctx, "other", "app=embeddings")
+	assert.ErrorContains(t, err, "503")
+
+	_, err = NewResourceMetricsClient("", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+const (
+	// DefaultQueryRetries is how many times a failed Prometheus query is retried
+	DefaultQueryRetries = 2
+	// DefaultRetryBackoff is the wait before the first retry
+	DefaultRetryBackoff = 100 * time.Millisecond
+	// DefaultMaxRetryBackoff caps the wait between retries
+	DefaultMaxRetryBackoff = 2 * time.Second
+	// DefaultQueryTimeout bounds a single query attempt
+	DefaultQueryTimeout = 5 * time.Second
+)
+
+// RetryConfig controls how failed Prometheus queries are retried. Only
+// transient failures are retried: network errors, timeouts and server
+// errors. Bad queries and empty results fail right away.
+type RetryConfig struct {
+	// Retries is how many times a failed query is retried (0 disables retries)
+	Retries int
+	// InitialBackoff is the wait before the first retry; it doubles on every
+	// retry and is jittered so replicas do not retry in lockstep
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+	// AttemptTimeout bounds a single attempt, so a hung request leaves time
+	// to retry within the caller's deadline (0 disables)
+	AttemptTimeout time.Duration
+}
+
+// DefaultRetryConfig returns the retry configuration used when none is set
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		Retries:        DefaultQueryRetries,
+		InitialBackoff: DefaultRetryBackoff,
+		MaxBackoff:     DefaultMaxRetryBackoff,
+		AttemptTimeout: DefaultQueryTimeout,
+	}
+}
+
+// retry runs attempt until it succeeds, fails with an error that is not
+// transient, or the retries are used up. The backoff is cut short when ctx
+// is done, and no retry is started that could not wait out its backoff
+// before the deadline of ctx.
+func (cfg RetryConfig) retry(ctx context.Context, attempt func(ctx context.Context) error) (attempts int, err error) {
+	backoff := cfg.InitialBackoff
+	for {
+		attempts++
+		err = cfg.attempt(ctx, attempt)
+		if err == nil || attempts > cfg.Retries || !retryable(ctx, err) {
+			return attempts, err
+		}
+
+		wait := jitter(backoff)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= wait {
+			return attempts, err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if cfg.MaxBackoff > 0 && backoff > cfg.MaxBackoff {
+			backoff = cfg.MaxBackoff
+		}
+	}
+}
+
+// attempt runs a single attempt under the attempt timeout
+func (cfg RetryConfig) attempt(ctx context.Context, attempt func(ctx context.Context) error) error {
+	if cfg.AttemptTimeout <= 0 {
+		return attempt(ctx)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, cfg.AttemptTimeout)
+	defer cancel()
+	return attempt(attemptCtx)
+}
+
+// retryable reports whether a failed query may succeed when retried. Errors
+// reported by the Prometheus API are only transient when they are timeouts or
+// server errors; every other error, such as a refused connection or an attempt
+// that timed out, is, unless ctx itself is done.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *v1.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Type == v1.ErrTimeout || apiErr.Type == v1.ErrServer
+	}
+	return true
+}
+
+// jitter returns a wait between half of and the full backoff
+func jitter(backoff time.Duration) time.Duration {
+	if backoff <= 0 {
+		return 0
+	}
+	half := backoff / 2
+	return half + rand.N(backoff-half+1)
+}
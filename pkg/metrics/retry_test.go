@@ -0,0 +1,142 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scalarBody = `{"status":"success","data":{"resultType":"scalar","result":[0,"0.25"]}}`
+
+// flakyServer fails the first failures requests with fail and answers the rest
+func flakyServer(t *testing.T, failures int32, fail http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			fail(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(scalarBody))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func unavailable(w http.ResponseWriter, _ *http.Request) {
+	http.Error(w, "unavailable", http.StatusServiceUnavailable)
+}
+
+func TestPrometheusClientRetries(t *testing.T) {
+	retry := RetryConfig{Retries: 2, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+	tests := []struct {
+		name         string
+		failures     int32
+		fail         http.HandlerFunc
+		wantRequests int32
+		wantErr      string
+	}{
+		{name: "transient failures", failures: 2, fail: unavailable, wantRequests: 3},
+		{name: "retries used up", failures: 3, fail: unavailable, wantRequests: 3, wantErr: "failed after 3 attempts"},
+		{
+			name:     "bad query not retried",
+			failures: 1,
+			fail: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+			},
+			wantRequests: 1,
+			wantErr:      "parse error",
+		},
+		{
+			name:     "hung attempt retried",
+			failures: 1,
+			fail: func(_ http.ResponseWriter, r *http.Request) {
+				// The request is only canceled once its body has been read
+				_ = r.ParseForm()
+				<-r.Context().Done()
+			},
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := flakyServer(t, tt.failures, tt.fail)
+			cfg := retry
+			cfg.AttemptTimeout = 100 * time.Millisecond
+			c, err := NewPrometheusClient(server.URL, cfg)
+			require.NoError(t, err)
+
+			value, err := Query(context.Background(), c, "up")
+			assert.Equal(t, tt.wantRequests, requests.Load())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 0.25, value)
+		})
+	}
+}
+
+func TestPrometheusClientRetryDeadline(t *testing.T) {
+	server, requests := flakyServer(t, 1, unavailable)
+	c, err := NewPrometheusClient(server.URL, RetryConfig{Retries: 2, InitialBackoff: time.Second})
+	require.NoError(t, err)
+
+	// A retry that could not wait out its backoff before the deadline is not started
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = Query(ctx, c, "up")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestRetryable(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.True(t, retryable(context.Background(), errors.New("connection refused")))
+	assert.True(t, retryable(context.Background(), &v1.Error{Type: v1.ErrServer}))
+	assert.True(t, retryable(context.Background(), &v1.Error{Type: v1.ErrTimeout}))
+	assert.False(t, retryable(context.Background(), &v1.Error{Type: v1.ErrBadData}))
+	assert.False(t, retryable(context.Background(), &v1.Error{Type: v1.ErrClient}))
+	assert.False(t, retryable(canceled, errors.New("connection refused")))
+}
+
+func TestJitter(t *testing.T) {
+	assert.Zero(t, jitter(0))
+	for i := 0; i < 100; i++ {
+		wait := jitter(100 * time.Millisecond)
+		assert.GreaterOrEqual(t, wait, 50*time.Millisecond)
+		assert.LessOrEqual(t, wait, 100*time.Millisecond)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// rollupListTimeout bounds the policy listing done on each scrape
+const rollupListTimeout = 10 * time.Second
+
+// PolicySummary is the per-policy state aggregated by RollupCollector
+type PolicySummary struct {
+	Namespace       string
+	Algorithm       string
+	CurrentReplicas int32
+	DesiredReplicas int32
+	// Error is true when the policy's Ready condition is False
+	Error bool
+}
+
+// PolicyLister returns a summary of every policy
+type PolicyLister func(ctx context.Context) ([]PolicySummary, error)
+
+var (
+	rollupPolicies = prometheus.NewDesc(
+		"kubeai_autoscaler_policies",
+		"Number of autoscaling policies",
+		[]string{"namespace", "algorithm"}, nil,
+	)
+	rollupManagedReplicas = prometheus.NewDesc(
+		"kubeai_autoscaler_managed_replicas",
+		"Total current replicas of the workloads managed by autoscaling policies",
+		[]string{"namespace", "algorithm"}, nil,
+	)
+	rollupDesiredReplicas = prometheus.NewDesc(
+		"kubeai_autoscaler_managed_desired_replicas",
+		"Total desired replicas of the workloads managed by autoscaling policies",
+		[]string{"namespace", "algorithm"}, nil,
+	)
+	rollupPoliciesInError = prometheus.NewDesc(
+		"kubeai_autoscaler_policies_in_error",
+		"Number of autoscaling policies whose Ready condition is False",
+		[]string{"namespace", "algorithm"}, nil,
+	)
+)
+
+// RollupCollector exports policy counts and replica totals aggregated by
+// namespace and algorithm. The aggregates are computed from the policy list at
+// scrape time, so their cardinality does not grow with the number of policies.
+type RollupCollector struct {
+	list PolicyLister
+}
+
+var _ prometheus.Collector = &RollupCollector{}
+
+// NewRollupCollector creates a RollupCollector over the policies returned by list
+func NewRollupCollector(list PolicyLister) *RollupCollector {
+	return &RollupCollector{list: list}
+}
+
+// Describe implements prometheus.Collector
+func (c *RollupCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- rollupPolicies
+	ch <- rollupManagedReplicas
+	ch <- rollupDesiredReplicas
+	ch <- rollupPoliciesInError
+}
+
+type rollupKey struct {
+	namespace string
+	algorithm string
+}
+
+type rollup struct {
+	policies int
+	current  int64
+	desired  int64
+	errors   int
+}
+
+// Collect implements prometheus.Collector. When the policies cannot be listed
+// the rollups are omitted rather than failing the whole scrape.
+func (c *RollupCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), rollupListTimeout)
+	defer cancel()
+	summaries, err := c.list(ctx)
+	if err != nil {
+		return
+	}
+
+	rollups := make(map[rollupKey]*rollup)
+	for _, s := range summaries {
+		key := rollupKey{namespace: s.Namespace, algorithm: s.Algorithm}
+		agg, ok := rollups[key]
+		if !ok {
+			agg = &rollup{}
+			rollups[key] = agg
+		}
+		agg.policies++
+		agg.current += int64(s.CurrentReplicas)
+		agg.desired += int64(s.DesiredReplicas)
+		if s.Error {
+			agg.errors++
+		}
+	}
+
+	for key, agg := range rollups {
+		ch <- prometheus.MustNewConstMetric(rollupPolicies, prometheus.GaugeValue, float64(agg.policies), key.namespace, key.algorithm)
+		ch <- prometheus.MustNewConstMetric(rollupManagedReplicas, prometheus.GaugeValue, float64(agg.current), key.namespace, key.algorithm)
+		ch <- prometheus.MustNewConstMetric(rollupDesiredReplicas, prometheus.GaugeValue, float64(agg.desired), key.namespace, key.algorithm)
+		ch <- prometheus.MustNewConstMetric(rollupPoliciesInError, prometheus.GaugeValue, float64(agg.errors), key.namespace, key.algorithm)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupCollector(t *testing.T) {
+	collector := NewRollupCollector(func(_ context.Context) ([]PolicySummary, error) {
+		return []PolicySummary{
+			{Namespace: "llm", Algorithm: "MaxRatio", CurrentReplicas: 4, DesiredReplicas: 6},
+			{Namespace: "llm", Algorithm: "MaxRatio", CurrentReplicas: 2, DesiredReplicas: 2, Error: true},
+			{Namespace: "vision", Algorithm: "AverageRatio", CurrentReplicas: 1, DesiredReplicas: 1},
+		}, nil
+	})
+
+	expected := `
+# HELP kubeai_autoscaler_policies Number of autoscaling policies
+# TYPE kubeai_autoscaler_policies gauge
+kubeai_autoscaler_policies{algorithm="AverageRatio",namespace="vision"} 1
+kubeai_autoscaler_policies{algorithm="MaxRatio",namespace="llm"} 2
+# HELP kubeai_autoscaler_managed_replicas Total current replicas of the workloads managed by autoscaling policies
+# TYPE kubeai_autoscaler_managed_replicas gauge
+kubeai_autoscaler_managed_replicas{algorithm="AverageRatio",namespace="vision"} 1
+kubeai_autoscaler_managed_replicas{algorithm="MaxRatio",namespace="llm"} 6
+# HELP kubeai_autoscaler_managed_desired_replicas Total desired replicas of the workloads managed by autoscaling policies
+# TYPE kubeai_autoscaler_managed_desired_replicas gauge
+kubeai_autoscaler_managed_desired_replicas{algorithm="AverageRatio",namespace="vision"} 1
+kubeai_autoscaler_managed_desired_replicas{algorithm="MaxRatio",namespace="llm"} 8
+# HELP kubeai_autoscaler_policies_in_error Number of autoscaling policies whose Ready condition is False
+# TYPE kubeai_autoscaler_policies_in_error gauge
+kubeai_autoscaler_policies_in_error{algorithm="AverageRatio",namespace="vision"} 0
+kubeai_autoscaler_policies_in_error{algorithm="MaxRatio",namespace="llm"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestRollupCollectorListError(t *testing.T) {
+	collector := NewRollupCollector(func(_ context.Context) ([]PolicySummary, error) {
+		return nil, errors.New("cache not started")
+	})
+	assert.Equal(t, 0, testutil.CollectAndCount(collector))
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+)
+
+// Metric names read by ScrapeClient. They match the series behind the default
+// Prometheus queries, so the same exporters work with either source.
+const (
+	ScrapeLatencyMetric    = "inference_request_duration_seconds"
+	ScrapeGPUMetric        = "DCGM_FI_DEV_GPU_UTIL"
+	ScrapeQueueDepthMetric = "inference_request_queue_depth"
+	ScrapeInFlightMetric   = "inference_requests_in_flight"
+)
+
+// EndpointsFunc returns the metrics endpoint URLs to scrape
+type EndpointsFunc func(ctx context.Context) ([]string, error)
+
+// ScrapeClient reads metrics directly from the /metrics endpoints of the
+// target pods, without a Prometheus server in between. Queries are metric
+// names rather than PromQL; an empty query selects the default metric.
+type ScrapeClient struct {
+	endpoints  EndpointsFunc
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu sync.Mutex
+	// lastBuckets holds the previous cumulative histogram per metric so
+	// latency quantiles cover the interval between scrapes
+	lastBuckets map[string]map[float64]float64
+	// lastCounters holds the previous total per counter so rates cover the
+	// interval between scrapes
+	lastCounters map[string]counterSample
+}
+
+// counterSample is a counter total observed at a point in time
+type counterSample struct {
+	total float64
+	at    time.Time
+}
+
+var _ Client = &ScrapeClient{}
+var _ HealthChecker = &ScrapeClient{}
+
+// NewScrapeClient creates a ScrapeClient for the endpoints returned by endpoints
+func NewScrapeClient(endpoints EndpointsFunc) *ScrapeClient {
+	return &ScrapeClient{
+		endpoints:    endpoints,
+		httpClient:   &http.Client{Timeout: 5 * time.Second},
+		now:          time.Now,
+		lastBuckets:  make(map[string]map[float64]float64),
+		lastCounters: make(map[string]counterSample),
+	}
+}
+
+// scrape fetches the named metric family from every endpoint. Endpoints that
+// fail are skipped; an error is returned only when none succeed.
+func (c *ScrapeClient) scrape(ctx context.Context, name string) ([]*dto.MetricFamily, error) {
+	endpoints, err := c.endpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints to scrape")
+	}
+
+	var families []*dto.MetricFamily
+	var errs []error
+	for _, endpoint := range endpoints {
+		mf, err := c.scrapeEndpoint(ctx, endpoint)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if family, ok := mf[name]; ok {
+			families = append(families, family)
+		}
+	}
+	if len(errs) == len(endpoints) {
+		return nil, fmt.Errorf("all %d endpoints failed: %w", len(endpoints), errors.Join(errs...))
+	}
+	if len(families) == 0 {
+		return nil, fmt.Errorf("metric %s not exposed by any endpoint", name)
+	}
+	return families, nil
+}
+
+func (c *ScrapeClient) scrapeEndpoint(ctx context.Context, endpoint string) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("scrape %s: %w", endpoint, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("scrape %s: unexpected status %d", endpoint, resp.StatusCode)
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	mf, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("scrape %s: %w", endpoint, err)
+	}
+	return mf, nil
+}
+
+// Healthy reports whether at least one endpoint can be scraped
+func (c *ScrapeClient) Healthy(ctx context.Context) error {
+	endpoints, err := c.endpoints(ctx)
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return fmt.Errorf("no endpoints to scrape")
+	}
+	var errs []error
+	for _, endpoint := range endpoints {
+		if _, err := c.scrapeEndpoint(ctx, endpoint); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+// values returns the gauge, counter or untyped values of the named metric across all endpoints
+func (c *ScrapeClient) values(ctx context.Context, name string) ([]float64, error) {
+	families, err := c.scrape(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var values []float64
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			switch {
+			case m.Gauge != nil:
+				values = append(values, m.GetGauge().GetValue())
+			case m.Counter != nil:
+				values = append(values, m.GetCounter().GetValue())
+			case m.Untyped != nil:
+				values = append(values, m.GetUntyped().GetValue())
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("metric %s has no samples", name)
+	}
+	return values, nil
+}
+
+// sum returns the sum of the named metric across all endpoints
+func (c *ScrapeClient) sum(ctx context.Context, name string) (float64, error) {
+	values, err := c.values(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum, nil
+}
+
+// QueryVector returns every sample of the named metric across all endpoints
+func (c *ScrapeClient) QueryVector(ctx context.Context, query string) ([]float64, error) {
+	return c.values(ctx, query)
+}
+
+// GetMetric scrapes the metric named by the query, or the default metric when
+// the query is not a plain metric name:
+//   - latency quantiles are computed from the histogram
+//   - GPU utilization is the average of the gauge
+//   - tokens per second is the rate of the counter since the previous scrape;
+//     the first scrape of a counter only records its total and fails
+//   - the others, and raw queries, are the sum across endpoints
+func (c *ScrapeClient) GetMetric(ctx context.Context, q MetricQuery) (Sample, error) {
+	var value float64
+	var err error
+	switch q.Metric {
+	case MetricLatencyP99:
+		value, err = c.quantile(ctx, 0.99, nameOrDefault(q.Query, ScrapeLatencyMetric))
+	case MetricLatencyP95:
+		value, err = c.quantile(ctx, 0.95, nameOrDefault(q.Query, ScrapeLatencyMetric))
+	case MetricGPUUtilization:
+		var values []float64
+		values, err = c.values(ctx, nameOrDefault(q.Query, ScrapeGPUMetric))
+		for _, v := range values {
+			value += v
+		}
+		if err == nil {
+			value /= float64(len(values))
+		}
+	case MetricQueueDepth:
+		value, err = c.sum(ctx, nameOrDefault(q.Query, ScrapeQueueDepthMetric))
+	case MetricInFlightRequests:
+		value, err = c.sum(ctx, nameOrDefault(q.Query, ScrapeInFlightMetric))
+	case MetricTokensPerSecond:
+		value, err = c.rate(ctx, tokensCounter(q.Query))
+	case "":
+		if q.Query == "" {
+			return Sample{}, errNoQuery(q.Metric)
+		}
+		value, err = c.sum(ctx, q.Query)
+	default:
+		return Sample{}, errNoQuery(q.Metric)
+	}
+	if err != nil {
+		return Sample{}, err
+	}
+	return Sample{Value: value, Timestamp: c.now()}, nil
+}
+
+// rate sums the named counter across endpoints and returns its per-second
+// increase since the previous scrape. Histograms contribute their sample sum.
+func (c *ScrapeClient) rate(ctx context.Context, name string) (float64, error) {
+	families, err := c.scrape(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	total, found := 0.0, false
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			switch {
+			case m.Counter != nil:
+				total += m.GetCounter().GetValue()
+			case m.Untyped != nil:
+				total += m.GetUntyped().GetValue()
+			case m.Histogram != nil:
+				total += m.GetHistogram().GetSampleSum()
+			default:
+				continue
+			}
+			found = true
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("metric %s is not a counter", name)
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	previous, ok := c.lastCounters[name]
+	c.lastCounters[name] = counterSample{total: total, at: now}
+	c.mu.Unlock()
+
+	if !ok {
+		return 0, fmt.Errorf("no previous sample of %s to compute a rate", name)
+	}
+	elapsed := now.Sub(previous.at).Seconds()
+	if elapsed <= 0 {
+		return 0, fmt.Errorf("no time elapsed since previous sample of %s", name)
+	}
+	delta := total - previous.total
+	if delta < 0 {
+		// Counter reset (pod restart): count from zero
+		delta = total
+	}
+	return delta / elapsed, nil
+}
+
+// quantile aggregates the named histogram across endpoints and estimates the
+// quantile over the observations made since the previous scrape
+func (c *ScrapeClient) quantile(ctx context.Context, q float64, name string) (float64, error) {
+	families, err := c.scrape(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	current := map[float64]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			h := m.GetHistogram()
+			if h == nil {
+				continue
+			}
+			for _, b := range h.GetBucket() {
+				if math.IsInf(b.GetUpperBound(), 1) {
+					continue
+				}
+				current[b.GetUpperBound()] += float64(b.GetCumulativeCount())
+			}
+			current[math.Inf(1)] += float64(h.GetSampleCount())
+		}
+	}
+	if len(current) == 0 {
+		return 0, fmt.Errorf("metric %s is not a histogram", name)
+	}
+
+	c.mu.Lock()
+	previous := c.lastBuckets[name]
+	c.lastBuckets[name] = current
+	c.mu.Unlock()
+
+	window := current
+	if previous != nil {
+		window = make(map[float64]float64, len(current))
+		for le, count := range current {
+			delta := count - previous[le]
+			if delta < 0 {
+				// Counter reset (pod restart): fall back to the cumulative histogram
+				window = current
+				break
+			}
+			window[le] = delta
+		}
+	}
+	return bucketQuantile(q, window)
+}
+
+// bucketQuantile estimates a quantile from cumulative histogram buckets using
+// the same linear interpolation as PromQL's histogram_quantile
+func bucketQuantile(q float64, buckets map[float64]float64) (float64, error) {
+	bounds := make([]float64, 0, len(buckets))
+	for le := range buckets {
+		bounds = append(bounds, le)
+	}
+	sort.Float64s(bounds)
+
+	total := buckets[bounds[len(bounds)-1]]
+	if total == 0 {
+		return 0, fmt.Errorf("histogram has no observations")
+	}
+	rank := q * total
+	prevBound, prevCount := 0.0, 0.0
+	for _, le := range bounds {
+		count := buckets[le]
+		if count >= rank {
+			if math.IsInf(le, 1) {
+				// Quantile falls in the +Inf bucket: return the highest finite bound
+				return prevBound, nil
+			}
+			if count == prevCount {
+				return le, nil
+			}
+			return prevBound + (le-prevBound)*(rank-prevCount)/(count-prevCount), nil
+		}
+		prevBound, prevCount = le, count
+	}
+	return prevBound, nil
+}
+
+// nameOrDefault returns query when it is a plain metric name and def otherwise,
+// so PromQL written for a Prometheus source falls back to the default metric
+func nameOrDefault(query, def string) string {
+	if query != "" && model.LegacyValidation.IsValidMetricName(query) {
+		return query
+	}
+	return def
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticEndpoints(urls ...string) EndpointsFunc {
+	return func(_ context.Context) ([]string, error) {
+		return urls, nil
+	}
+}
+
+func TestScrapeClientGauges(t *testing.T) {
+	pod := func(gpu, queue float64) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintf(w, "# TYPE DCGM_FI_DEV_GPU_UTIL gauge\nDCGM_FI_DEV_GPU_UTIL{gpu=\"0\"} %g\n", gpu)
+			_, _ = fmt.Fprintf(w, "# TYPE inference_request_queue_depth gauge\ninference_request_queue_depth %g\n", queue)
+			_, _ = fmt.Fprintf(w, "# TYPE inference_requests_in_flight gauge\ninference_requests_in_flight %g\n", 2*queue)
+		}))
+	}
+	a, b := pod(60, 3), pod(80, 4)
+	defer a.Close()
+	defer b.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	c := NewScrapeClient(staticEndpoints(a.URL, b.URL, down.URL))
+	ctx := context.Background()
+	require.NoError(t, c.Healthy(ctx))
+
+	gpu, err := GetGPUUtilization(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, 70.0, gpu)
+
+	// PromQL meant for a Prometheus source falls back to the default metric
+	depth, err := GetQueueDepth(ctx, c, "sum(inference_request_queue_depth{service=\"llm\"})")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), depth)
+
+	inFlight, err := GetInFlightRequests(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(14), inFlight)
+
+	_, err = Query(ctx, c, "missing_metric")
+	assert.Error(t, err)
+}
+
+func TestScrapeClientLatencyWindow(t *testing.T) {
+	// The first scrape sees 100 fast requests; by the second, 100 slow ones were added
+	var scrapes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		slow := 0
+		if scrapes.Add(1) > 1 {
+			slow = 100
+		}
+		_, _ = fmt.Fprintln(w, "# TYPE inference_request_duration_seconds histogram")
+		_, _ = fmt.Fprintf(w, "inference_request_duration_seconds_bucket{le=\"0.1\"} 100\n")
+		_, _ = fmt.Fprintf(w, "inference_request_duration_seconds_bucket{le=\"1\"} 100\n")
+		_, _ = fmt.Fprintf(w, "inference_request_duration_seconds_bucket{le=\"2\"} %d\n", 100+slow)
+		_, _ = fmt.Fprintf(w, "inference_request_duration_seconds_bucket{le=\"+Inf\"} %d\n", 100+slow)
+		_, _ = fmt.Fprintf(w, "inference_request_duration_seconds_sum 0\n")
+		_, _ = fmt.Fprintf(w, "inference_request_duration_seconds_count %d\n", 100+slow)
+	}))
+	defer server.Close()
+
+	c := NewScrapeClient(staticEndpoints(server.URL))
+	ctx := context.Background()
+
+	p99, err := GetLatencyP99(ctx, c, "")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.099, p99, 1e-9)
+
+	// Only the observations since the last scrape count
+	p99, err = GetLatencyP99(ctx, c, "")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.99, p99, 1e-9)
+}
+
+func TestScrapeClientTokensPerSecond(t *testing.T) {
+	// Each scrape adds 3000 tokens to the vLLM counter and 600 to the TGI histogram sum
+	var scrapes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := scrapes.Add(1)
+		_, _ = fmt.Fprintln(w, "# TYPE vllm:generation_tokens_total counter")
+		_, _ = fmt.Fprintf(w, "vllm:generation_tokens_total{model=\"llama\"} %d\n", 3000*n)
+		_, _ = fmt.Fprintln(w, "# TYPE tgi_request_generated_tokens histogram")
+		_, _ = fmt.Fprintf(w, "tgi_request_generated_tokens_bucket{le=\"+Inf\"} %d\n", n)
+		_, _ = fmt.Fprintf(w, "tgi_request_generated_tokens_sum %d\n", 600*n)
+		_, _ = fmt.Fprintf(w, "tgi_request_generated_tokens_count %d\n", n)
+	}))
+	defer server.Close()
+
+	c := NewScrapeClient(staticEndpoints(server.URL))
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// The first scrape only records the counter total
+	_, err := GetTokensPerSecond(ctx, c, "")
+	assert.Error(t, err)
+	_, err = GetTokensPerSecond(ctx, c, TokensPerSecondQuery(TokensPresetTGI))
+	assert.Error(t, err)
+
+	now = now.Add(10 * time.Second)
+	tokens, err := GetTokensPerSecond(ctx, c, "")
+	require.NoError(t, err)
+	assert.InDelta(t, 600.0, tokens, 1e-9) // (9000 - 3000) / 10s
+	tokens, err = GetTokensPerSecond(ctx, c, TokensPerSecondQuery(TokensPresetTGI))
+	require.NoError(t, err)
+	assert.InDelta(t, 120.0, tokens, 1e-9) // (2400 - 1200) / 10s
+}
+
+func TestScrapeClientNoEndpoints(t *testing.T) {
+	c := NewScrapeClient(staticEndpoints())
+	assert.Error(t, c.Healthy(context.Background()))
+	_, err := GetGPUUtilization(context.Background(), c, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SQSBacklog reads the number of visible messages of Amazon SQS queues,
+// which are named by their URL
+type SQSBacklog struct {
+	region      string
+	credentials AWSCredentialsProvider
+	httpClient  *http.Client
+	now         func() time.Time
+}
+
+var _ BacklogReader = &SQSBacklog{}
+
+// NewSQSBacklog creates a reader of queues in region, signing requests with
+// credentials or, when nil, DefaultAWSCredentials
+func NewSQSBacklog(region string, credentials AWSCredentialsProvider) (*SQSBacklog, error) {
+	if region == "" {
+		return nil, errors.New("SQS requires a region")
+	}
+	if credentials == nil {
+		var err error
+		if credentials, err = DefaultAWSCredentials(region); err != nil {
+			return nil, err
+		}
+	}
+	return &SQSBacklog{
+		region:      region,
+		credentials: credentials,
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		now:         time.Now,
+	}, nil
+}
+
+// getQueueAttributesResponse is the body of a GetQueueAttributes response
+type getQueueAttributesResponse struct {
+	Attributes []struct {
+		Name  string `xml:"Name"`
+		Value string `xml:"Value"`
+	} `xml:"GetQueueAttributesResult>Attribute"`
+}
+
+// Backlog returns ApproximateNumberOfMessages of the queue at queueURL.
+// Messages received but not yet deleted are being worked on and not counted.
+func (s *SQSBacklog) Backlog(ctx context.Context, queueURL string) (int64, error) {
+	creds, err := s.credentials.Retrieve(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+	form := url.Values{}
+	form.Set("Action", "GetQueueAttributes")
+	form.Set("Version", "2012-11-05")
+	form.Set("AttributeName.1", "ApproximateNumberOfMessages")
+	req, err := newAWSQueryRequest(ctx, queueURL, form)
+	if err != nil {
+		return 0, err
+	}
+	if err := SignAWSRequest(req, []byte(form.Encode()), creds, s.region, "sqs", s.now()); err != nil {
+		return 0, err
+	}
+	var resp getQueueAttributesResponse
+	if err := doAWSQuery(s.httpClient, req, &resp); err != nil {
+		return 0, err
+	}
+	for _, attribute := range resp.Attributes {
+		if attribute.Name == "ApproximateNumberOfMessages" {
+			return strconv.ParseInt(attribute.Value, 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("%w: ApproximateNumberOfMessages", ErrNoData)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQSBacklog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "GetQueueAttributes", r.PostForm.Get("Action"))
+		assert.Equal(t, "ApproximateNumberOfMessages", r.PostForm.Get("AttributeName.1"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-east-1/sqs/aws4_request")
+		if r.URL.Path != "/123456789012/inference-requests" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Code>AWS.SimpleQueueService.NonExistentQueue</Code><Message>The specified queue does not exist.</Message></Error></ErrorResponse>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<GetQueueAttributesResponse><GetQueueAttributesResult>
+			<Attribute><Name>ApproximateNumberOfMessages</Name><Value>37</Value></Attribute>
+		</GetQueueAttributesResult></GetQueueAttributesResponse>`))
+	}))
+	defer server.Close()
+
+	s, err := NewSQSBacklog("us-east-1", StaticAWSCredentials(exampleAWSCredentials))
+	require.NoError(t, err)
+	backlog, err := s.Backlog(context.Background(), server.URL+"/123456789012/inference-requests")
+	require.NoError(t, err)
+	assert.Equal(t, int64(37), backlog)
+
+	_, err = s.Backlog(context.Background(), server.URL+"/123456789012/missing")
+	assert.ErrorContains(t, err, "NonExistentQueue")
+
+	_, err = NewSQSBacklog("", nil)
+	assert.Error(t, err)
+}
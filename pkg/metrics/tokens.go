@@ -0,0 +1,76 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+// Token throughput presets for common LLM serving runtimes
+const (
+	TokensPresetVLLM = "vLLM"
+	TokensPresetTGI  = "TGI"
+)
+
+// tokensPreset is the generated-token counter exported by a serving runtime
+// and the PromQL computing its per-second rate
+type tokensPreset struct {
+	query   string
+	counter string
+}
+
+var tokensPresets = map[string]tokensPreset{
+	TokensPresetVLLM: {
+		query:   `sum(rate(vllm:generation_tokens_total[$__window]))`,
+		counter: "vllm:generation_tokens_total",
+	},
+	TokensPresetTGI: {
+		// TGI exports generated tokens as a histogram; its sum counts every token
+		query:   `sum(rate(tgi_request_generated_tokens_sum[$__window]))`,
+		counter: "tgi_request_generated_tokens",
+	},
+}
+
+// TokensPerSecondQuery returns the default PromQL for the named preset.
+// Unknown or empty presets use the vLLM query.
+func TokensPerSecondQuery(preset string) string {
+	if p, ok := tokensPresets[preset]; ok {
+		return p.query
+	}
+	return tokensPresets[TokensPresetVLLM].query
+}
+
+// isTokensPresetQuery reports whether query is empty or one of the preset queries
+func isTokensPresetQuery(query string) bool {
+	if query == "" {
+		return true
+	}
+	for _, p := range tokensPresets {
+		if p.query == query {
+			return true
+		}
+	}
+	return false
+}
+
+// tokensCounter returns the counter to scrape for query: the counter behind a
+// preset query, query itself when it is a plain metric name, and the vLLM
+// counter otherwise
+func tokensCounter(query string) string {
+	for _, p := range tokensPresets {
+		if p.query == query {
+			return p.counter
+		}
+	}
+	return nameOrDefault(query, tokensPresets[TokensPresetVLLM].counter)
+}
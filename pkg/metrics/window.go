@@ -0,0 +1,56 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+// QueryWindowVariable is replaced in PromQL queries by the range of the query
+// window, e.g. rate(vllm:generation_tokens_total[$__window])
+const QueryWindowVariable = "$__window"
+
+// DefaultQueryWindow is the query window used when the context carries none
+const DefaultQueryWindow = 5 * time.Minute
+
+type queryWindowKey struct{}
+
+// WithQueryWindow returns a context whose queries use window for rate and
+// quantile ranges
+func WithQueryWindow(ctx context.Context, window time.Duration) context.Context {
+	return context.WithValue(ctx, queryWindowKey{}, window)
+}
+
+// QueryWindow returns the query window carried by ctx, or DefaultQueryWindow
+func QueryWindow(ctx context.Context) time.Duration {
+	if window, ok := ctx.Value(queryWindowKey{}).(time.Duration); ok && window > 0 {
+		return window
+	}
+	return DefaultQueryWindow
+}
+
+// ExpandQuery replaces QueryWindowVariable in query with the query window of ctx
+func ExpandQuery(ctx context.Context, query string) string {
+	if !strings.Contains(query, QueryWindowVariable) {
+		return query
+	}
+	return strings.ReplaceAll(query, QueryWindowVariable, model.Duration(QueryWindow(ctx)).String())
+}
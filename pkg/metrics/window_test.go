@@ -0,0 +1,63 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandQuery(t *testing.T) {
+	ctx := context.Background()
+	query := `sum(rate(vllm:generation_tokens_total[$__window]))`
+
+	assert.Equal(t, `sum(rate(vllm:generation_tokens_total[5m]))`, ExpandQuery(ctx, query))
+	assert.Equal(t, `sum(rate(vllm:generation_tokens_total[2m]))`, ExpandQuery(WithQueryWindow(ctx, 2*time.Minute), query))
+	assert.Equal(t, `sum(rate(vllm:generation_tokens_total[1m30s]))`, ExpandQuery(WithQueryWindow(ctx, 90*time.Second), query))
+	assert.Equal(t, "avg(DCGM_FI_DEV_GPU_UTIL)", ExpandQuery(ctx, "avg(DCGM_FI_DEV_GPU_UTIL)"))
+}
+
+func TestPrometheusClientQueryWindow(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.FormValue("query"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[0,"0.25"]}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewPrometheusClient(server.URL, RetryConfig{})
+	require.NoError(t, err)
+	ctx := WithQueryWindow(context.Background(), 40*time.Second)
+
+	value, err := GetLatencyP99(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, value)
+	_, err = GetTokensPerSecond(ctx, c, `sum(rate(vllm:generation_tokens_total{model_name="llama"}[$__window]))`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`histogram_quantile(0.99, sum(rate(inference_request_duration_seconds_bucket[40s])) by (le))`,
+		`sum(rate(vllm:generation_tokens_total{model_name="llama"}[40s]))`,
+	}, queries)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration moves stored AIInferenceAutoscalerPolicies to the
+// storage version of the CRD, so versions no longer stored can be removed
+package migration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// CRDName is the name of the AIInferenceAutoscalerPolicy CRD
+	CRDName = "aiinferenceautoscalerpolicies.kubeai.io"
+
+	// StorageVersionAnnotation records the storage version a policy was last
+	// rewritten at by a migration
+	StorageVersionAnnotation = "kubeai.io/storage-version"
+)
+
+// StorageVersion is the version policies are stored at
+var StorageVersion = kubeaiv1alpha1.GroupVersion.Version
+
+// DeprecatedField is a spec or status field removed from stored policies by
+// a migration
+type DeprecatedField struct {
+	// Path is the field path, such as spec.metrics.oldQuery
+	Path []string
+	// Replacement names the field to use instead, shown when the field is removed
+	Replacement string
+}
+
+// DeprecatedFields lists the fields a migration removes. A field is added
+// here when it is deprecated and stays in the schema until every stored
+// policy has been migrated; v1alpha1 has none yet.
+var DeprecatedFields []DeprecatedField
+
+// StoredVersions returns the versions policies may be stored at in etcd, as
+// recorded in the status of the CRD
+func StoredVersions(ctx context.Context, reader client.Reader) ([]string, error) {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: CRDName}, crd); err != nil {
+		return nil, fmt.Errorf("failed to get CRD %s: %w", CRDName, err)
+	}
+	return crd.Status.StoredVersions, nil
+}
+
+// Pending reports whether stored versions other than StorageVersion remain,
+// in which case policies not yet migrated may still be stored at them
+func Pending(storedVersions []string) bool {
+	return slices.ContainsFunc(storedVersions, func(version string) bool { return version != StorageVersion })
+}
+
+// Outdated reports whether the policy may still be stored at an old version:
+// old versions remain and the policy has not been migrated since
+func Outdated(policy metav1.Object, storedVersions []string) bool {
+	return Pending(storedVersions) && policy.GetAnnotations()[StorageVersionAnnotation] != StorageVersion
+}
+
+// Migrate prepares the policy to be rewritten at StorageVersion: deprecated
+// fields are removed and the policy is annotated as migrated. It returns the
+// changes made, empty when the policy is already migrated.
+func Migrate(policy *unstructured.Unstructured, deprecated []DeprecatedField) []string {
+	var changes []string
+	for _, field := range deprecated {
+		if _, found, _ := unstructured.NestedFieldNoCopy(policy.Object, field.Path...); !found {
+			continue
+		}
+		unstructured.RemoveNestedField(policy.Object, field.Path...)
+		change := "remove " + strings.Join(field.Path, ".")
+		if field.Replacement != "" {
+			change += " (use " + field.Replacement + ")"
+		}
+		changes = append(changes, change)
+	}
+	if version := policy.GetAnnotations()[StorageVersionAnnotation]; version != StorageVersion || len(changes) > 0 {
+		annotations := policy.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[StorageVersionAnnotation] = StorageVersion
+		policy.SetAnnotations(annotations)
+		changes = append(changes, "rewrite at "+StorageVersion)
+	}
+	return changes
+}
+
+// Complete records in the CRD that policies are only stored at
+// StorageVersion. Call it once every policy has been rewritten; the old
+// versions can then be removed from the CRD.
+func Complete(ctx context.Context, c client.Client) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := c.Get(ctx, types.NamespacedName{Name: CRDName}, crd); err != nil {
+		return fmt.Errorf("failed to get CRD %s: %w", CRDName, err)
+	}
+	if !Pending(crd.Status.StoredVersions) {
+		return nil
+	}
+	crd.Status.StoredVersions = []string{StorageVersion}
+	if err := c.Status().Update(ctx, crd); err != nil {
+		return fmt.Errorf("failed to update stored versions of CRD %s: %w", CRDName, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestOutdated(t *testing.T) {
+	migrated := &metav1.ObjectMeta{Annotations: map[string]string{StorageVersionAnnotation: StorageVersion}}
+	unmigrated := &metav1.ObjectMeta{}
+
+	assert.False(t, Outdated(unmigrated, []string{StorageVersion}))
+	assert.False(t, Outdated(unmigrated, nil))
+	assert.True(t, Outdated(unmigrated, []string{"v1alpha0", StorageVersion}))
+	assert.False(t, Outdated(migrated, []string{"v1alpha0", StorageVersion}))
+}
+
+func TestMigrate(t *testing.T) {
+	policy := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"maxReplicas": int64(10),
+			"metrics":     map[string]any{"legacyQuery": "up"},
+		},
+	}}
+	deprecated := []DeprecatedField{
+		{Path: []string{"spec", "metrics", "legacyQuery"}, Replacement: "spec.metrics.customMetrics"},
+		{Path: []string{"spec", "unsetField"}},
+	}
+
+	changes := Migrate(policy, deprecated)
+	assert.Equal(t, []string{
+		"remove spec.metrics.legacyQuery (use spec.metrics.customMetrics)",
+		"rewrite at " + StorageVersion,
+	}, changes)
+	assert.Equal(t, map[string]any{"maxReplicas": int64(10), "metrics": map[string]any{}}, policy.Object["spec"])
+	assert.Equal(t, StorageVersion, policy.GetAnnotations()[StorageVersionAnnotation])
+
+	// A migrated policy needs no further changes
+	assert.Empty(t, Migrate(policy, deprecated))
+}
+
+func TestComplete(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: CRDName},
+		Status:     apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha0", StorageVersion}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).WithStatusSubresource(crd).Build()
+	ctx := context.Background()
+
+	require.NoError(t, Complete(ctx, c))
+	versions, err := StoredVersions(ctx, c)
+	require.NoError(t, err)
+	assert.Equal(t, []string{StorageVersion}, versions)
+}
@@ -19,7 +19,9 @@ package scaling
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"time"
 )
 
 // ScalingAlgorithm is the interface custom algorithms must implement
@@ -33,29 +35,92 @@ type ScalingAlgorithm interface {
 // ScalingInput contains the input parameters for scaling calculation
 type ScalingInput struct {
 	CurrentReplicas int32
-	MinReplicas     int32
-	MaxReplicas     int32
-	MetricRatios    []float64 // Ratios of current/target for each metric
-	Tolerance       float64
+	// MinReplicas is the floor for the recommendation. The controller passes 1
+	// and applies spec.minReplicas to the result, so it can report how far
+	// below minReplicas the algorithm would go.
+	MinReplicas  int32
+	MaxReplicas  int32
+	MetricRatios []float64 // Ratios of current/target for each metric
+	Tolerance    float64
 	// Policy identity for stateful algorithms to generate stable per-policy keys
 	PolicyName      string
 	PolicyNamespace string // Empty string for cluster-scoped policies
+	// Metrics holds the current value of each fetched metric, keyed by the
+	// Metric* names below or by the name of a custom metric. Algorithms that
+	// need absolute values rather than ratios read them from here.
+	Metrics map[string]float64
+	// MetricSamples describes each metric that contributes a ratio to
+	// MetricRatios, keyed like Metrics, so algorithms can weigh or ignore
+	// individual metrics
+	MetricSamples map[string]MetricSample
+	// ReadyReplicas is the number of replicas ready to serve
+	ReadyReplicas int32
+	// UnavailableReplicas is the number of replicas that exist but are not
+	// ready, such as pods still loading a model. Their metrics, like GPU
+	// utilization during the load, may not reflect serving load yet.
+	UnavailableReplicas int32
+}
+
+// MetricSample is the current value of a metric compared against its target.
+// Per-replica targets are multiplied by the current replicas, so Ratio is
+// always Current / Target.
+type MetricSample struct {
+	Current float64
+	Target  float64
+	Ratio   float64
+	// Timestamp is when the controller read the value
+	Timestamp time.Time
 }
 
+// Names of the built-in metrics in ScalingInput.Metrics
+const (
+	MetricLatencyP99Ms       = "latencyP99Ms"
+	MetricLatencyP95Ms       = "latencyP95Ms"
+	MetricGPUUtilization     = "gpuUtilizationPercent"
+	MetricCPUUtilization     = "cpuUtilizationPercent"    // of the pods' CPU requests
+	MetricMemoryUtilization  = "memoryUtilizationPercent" // of the pods' memory requests
+	MetricRequestQueueDepth  = "requestQueueDepth"
+	MetricTokensPerSecond    = "tokensPerSecond"
+	MetricInFlightRequests   = "inFlightRequests"
+	MetricSyntheticLatencyMs = "syntheticLatencyMs" // end-to-end latency of a synthetic request
+	MetricArrivalRate        = "arrivalRate"        // requests per second across all replicas
+	MetricServiceTimeSeconds = "serviceTimeSeconds" // mean time to serve a request
+	// Realized cost from OpenCost, for cost-aware algorithms to use in place
+	// of static per-replica estimates. Only set when the controller is
+	// connected to OpenCost.
+	MetricCostPerReplicaHour = "costPerReplicaHour"
+	MetricCostPer1kRequests  = "costPer1kRequests"
+)
+
 // ScalingResult contains the output of a scaling calculation
 type ScalingResult struct {
 	DesiredReplicas int32
 	Reason          string
+	// DrivingMetric names the metric, keyed like ScalingInput.MetricSamples,
+	// that determined the recommendation. The built-in ratio algorithms report
+	// the metric with the largest ratio. Empty when no metric did.
+	DrivingMetric string
+	// UnclampedReplicas is the recommendation before MinReplicas and
+	// MaxReplicas were applied. Zero when the algorithm does not report it, in
+	// which case the controller uses DesiredReplicas.
+	UnclampedReplicas int32
+	// Confidence is how much the algorithm trusts the recommendation, from 0
+	// to 1. Zero when the algorithm does not report one.
+	Confidence float64
 }
 
-// Algorithm defines the legacy interface for scaling algorithms (deprecated)
-// Use ScalingAlgorithm for new implementations
+// Algorithm defines the legacy interface for scaling algorithms.
+//
+// Deprecated: implement ScalingAlgorithm; wrap existing implementations with
+// LegacyAlgorithm to register them.
 type Algorithm interface {
 	// Calculate computes the desired replica count
 	Calculate(input AlgorithmInput) int32
 }
 
-// AlgorithmInput contains the input parameters for scaling calculation (legacy)
+// AlgorithmInput contains the input parameters for scaling calculation (legacy).
+//
+// Deprecated: use ScalingInput.
 type AlgorithmInput struct {
 	CurrentReplicas int32
 	MinReplicas     int32
@@ -63,10 +128,76 @@ type AlgorithmInput struct {
 	MetricRatios    []float64 // Ratios of current/target for each metric
 }
 
+// legacyAlgorithm adapts an Algorithm to ScalingAlgorithm
+type legacyAlgorithm struct {
+	name      string
+	algorithm Algorithm
+}
+
+// LegacyAlgorithm adapts an implementation of the deprecated Algorithm
+// interface to ScalingAlgorithm so it can be registered under name. Calculate
+// receives the replica bounds and metric ratios; the tolerance, policy and
+// metric values in ScalingInput are not available to it.
+func LegacyAlgorithm(name string, algorithm Algorithm) ScalingAlgorithm {
+	return &legacyAlgorithm{name: name, algorithm: algorithm}
+}
+
+// Name returns the name the algorithm was adapted under
+func (a *legacyAlgorithm) Name() string {
+	return a.name
+}
+
+// ComputeScale computes the desired replica count with Calculate
+func (a *legacyAlgorithm) ComputeScale(_ context.Context, input ScalingInput) (ScalingResult, error) {
+	desiredReplicas := a.algorithm.Calculate(AlgorithmInput{
+		CurrentReplicas: input.CurrentReplicas,
+		MinReplicas:     input.MinReplicas,
+		MaxReplicas:     input.MaxReplicas,
+		MetricRatios:    input.MetricRatios,
+	})
+	return ScalingResult{
+		DesiredReplicas: desiredReplicas,
+		Reason:          fmt.Sprintf("computed by %s", a.name),
+	}, nil
+}
+
+// calculate serves the deprecated Calculate method of the built-in algorithms
+// with their ComputeScale and the tolerance they were created with
+func calculate(algorithm ScalingAlgorithm, tolerance float64, input AlgorithmInput) int32 {
+	result, err := algorithm.ComputeScale(context.Background(), ScalingInput{
+		CurrentReplicas: input.CurrentReplicas,
+		MinReplicas:     input.MinReplicas,
+		MaxReplicas:     input.MaxReplicas,
+		MetricRatios:    input.MetricRatios,
+		Tolerance:       tolerance,
+	})
+	if err != nil {
+		return input.CurrentReplicas
+	}
+	return result.DesiredReplicas
+}
+
+// largestRatioMetric returns the metric in samples with the largest ratio,
+// the name sorting first on ties, or "" when there are no samples
+func largestRatioMetric(samples map[string]MetricSample) string {
+	name := ""
+	for metric, sample := range samples {
+		if name == "" || sample.Ratio > samples[name].Ratio ||
+			(sample.Ratio == samples[name].Ratio && metric < name) {
+			name = metric
+		}
+	}
+	return name
+}
+
 // MaxRatioAlgorithm scales based on the maximum ratio across all metrics
 type MaxRatioAlgorithm struct {
 	// Tolerance is the percentage tolerance before scaling (e.g., 0.1 = 10%)
 	Tolerance float64
+	// ScaleDownEnabled lets the algorithm recommend fewer replicas when every
+	// ratio is below 1. When false the maximum ratio is floored at 1.0, so the
+	// recommendation never drops below the current replicas.
+	ScaleDownEnabled bool
 }
 
 // NewMaxRatioAlgorithm creates a new MaxRatioAlgorithm
@@ -81,6 +212,31 @@ func (a *MaxRatioAlgorithm) Name() string {
 	return "MaxRatio"
 }
 
+// Description summarizes how the algorithm computes replicas
+func (a *MaxRatioAlgorithm) Description() string {
+	return "Scales by the largest ratio of current to target value across the metrics. " +
+		"Never scales down unless spec.algorithm.scaleDownEnabled is set."
+}
+
+// SetScaleDownEnabled allows updating whether the algorithm may scale down
+func (a *MaxRatioAlgorithm) SetScaleDownEnabled(enabled bool) {
+	a.ScaleDownEnabled = enabled
+}
+
+// maxRatio returns the largest of ratios, floored at 1.0 unless scale-down is enabled
+func (a *MaxRatioAlgorithm) maxRatio(ratios []float64) float64 {
+	maxRatio := ratios[0]
+	if !a.ScaleDownEnabled {
+		maxRatio = 1.0
+	}
+	for _, ratio := range ratios {
+		if ratio > maxRatio {
+			maxRatio = ratio
+		}
+	}
+	return maxRatio
+}
+
 // ComputeScale implements the ScalingAlgorithm interface
 func (a *MaxRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInput) (ScalingResult, error) {
 	tolerance := input.Tolerance
@@ -95,18 +251,14 @@ func (a *MaxRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInput)
 			desiredReplicas = input.MaxReplicas
 		}
 		return ScalingResult{
-			DesiredReplicas: desiredReplicas,
-			Reason:          "no metrics available",
+			DesiredReplicas:   desiredReplicas,
+			Reason:            "no metrics available",
+			UnclampedReplicas: input.CurrentReplicas,
 		}, nil
 	}
 
 	// Find the maximum ratio
-	maxRatio := 1.0
-	for _, ratio := range input.MetricRatios {
-		if ratio > maxRatio {
-			maxRatio = ratio
-		}
-	}
+	maxRatio := a.maxRatio(input.MetricRatios)
 
 	// Apply tolerance - don't scale if within tolerance
 	if maxRatio >= (1-tolerance) && maxRatio <= (1+tolerance) {
@@ -119,13 +271,16 @@ func (a *MaxRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInput)
 			desiredReplicas = input.MaxReplicas
 		}
 		return ScalingResult{
-			DesiredReplicas: desiredReplicas,
-			Reason:          "within tolerance",
+			DesiredReplicas:   desiredReplicas,
+			Reason:            "within tolerance",
+			DrivingMetric:     largestRatioMetric(input.MetricSamples),
+			UnclampedReplicas: input.CurrentReplicas,
 		}, nil
 	}
 
 	// Calculate desired replicas
 	desiredReplicas := int32(math.Ceil(float64(input.CurrentReplicas) * maxRatio))
+	unclamped := desiredReplicas
 
 	// Apply min/max constraints
 	if desiredReplicas < input.MinReplicas {
@@ -136,42 +291,18 @@ func (a *MaxRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInput)
 	}
 
 	return ScalingResult{
-		DesiredReplicas: desiredReplicas,
-		Reason:          "scaled based on max ratio",
+		DesiredReplicas:   desiredReplicas,
+		Reason:            "scaled based on max ratio",
+		DrivingMetric:     largestRatioMetric(input.MetricSamples),
+		UnclampedReplicas: unclamped,
 	}, nil
 }
 
-// Calculate implements the legacy Algorithm interface
+// Calculate implements the legacy Algorithm interface.
+//
+// Deprecated: use ComputeScale.
 func (a *MaxRatioAlgorithm) Calculate(input AlgorithmInput) int32 {
-	if len(input.MetricRatios) == 0 {
-		return input.CurrentReplicas
-	}
-
-	// Find the maximum ratio
-	maxRatio := 1.0
-	for _, ratio := range input.MetricRatios {
-		if ratio > maxRatio {
-			maxRatio = ratio
-		}
-	}
-
-	// Apply tolerance - don't scale if within tolerance
-	if maxRatio >= (1-a.Tolerance) && maxRatio <= (1+a.Tolerance) {
-		return input.CurrentReplicas
-	}
-
-	// Calculate desired replicas
-	desiredReplicas := int32(math.Ceil(float64(input.CurrentReplicas) * maxRatio))
-
-	// Apply min/max constraints
-	if desiredReplicas < input.MinReplicas {
-		desiredReplicas = input.MinReplicas
-	}
-	if desiredReplicas > input.MaxReplicas {
-		desiredReplicas = input.MaxReplicas
-	}
-
-	return desiredReplicas
+	return calculate(a, a.Tolerance, input)
 }
 
 // AverageRatioAlgorithm scales based on the average ratio across all metrics
@@ -191,6 +322,11 @@ func (a *AverageRatioAlgorithm) Name() string {
 	return "AverageRatio"
 }
 
+// Description summarizes how the algorithm computes replicas
+func (a *AverageRatioAlgorithm) Description() string {
+	return "Scales by the average ratio of current to target value across the metrics."
+}
+
 // ComputeScale implements the ScalingAlgorithm interface
 func (a *AverageRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInput) (ScalingResult, error) {
 	tolerance := input.Tolerance
@@ -205,8 +341,9 @@ func (a *AverageRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInp
 			desiredReplicas = input.MaxReplicas
 		}
 		return ScalingResult{
-			DesiredReplicas: desiredReplicas,
-			Reason:          "no metrics available",
+			DesiredReplicas:   desiredReplicas,
+			Reason:            "no metrics available",
+			UnclampedReplicas: input.CurrentReplicas,
 		}, nil
 	}
 
@@ -228,13 +365,16 @@ func (a *AverageRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInp
 			desiredReplicas = input.MaxReplicas
 		}
 		return ScalingResult{
-			DesiredReplicas: desiredReplicas,
-			Reason:          "within tolerance",
+			DesiredReplicas:   desiredReplicas,
+			Reason:            "within tolerance",
+			DrivingMetric:     largestRatioMetric(input.MetricSamples),
+			UnclampedReplicas: input.CurrentReplicas,
 		}, nil
 	}
 
 	// Calculate desired replicas
 	desiredReplicas := int32(math.Ceil(float64(input.CurrentReplicas) * avgRatio))
+	unclamped := desiredReplicas
 
 	// Apply min/max constraints
 	if desiredReplicas < input.MinReplicas {
@@ -245,41 +385,18 @@ func (a *AverageRatioAlgorithm) ComputeScale(_ context.Context, input ScalingInp
 	}
 
 	return ScalingResult{
-		DesiredReplicas: desiredReplicas,
-		Reason:          "scaled based on average ratio",
+		DesiredReplicas:   desiredReplicas,
+		Reason:            "scaled based on average ratio",
+		DrivingMetric:     largestRatioMetric(input.MetricSamples),
+		UnclampedReplicas: unclamped,
 	}, nil
 }
 
-// Calculate implements the legacy Algorithm interface
+// Calculate implements the legacy Algorithm interface.
+//
+// Deprecated: use ComputeScale.
 func (a *AverageRatioAlgorithm) Calculate(input AlgorithmInput) int32 {
-	if len(input.MetricRatios) == 0 {
-		return input.CurrentReplicas
-	}
-
-	// Calculate average ratio
-	sum := 0.0
-	for _, ratio := range input.MetricRatios {
-		sum += ratio
-	}
-	avgRatio := sum / float64(len(input.MetricRatios))
-
-	// Apply tolerance
-	if avgRatio >= (1-a.Tolerance) && avgRatio <= (1+a.Tolerance) {
-		return input.CurrentReplicas
-	}
-
-	// Calculate desired replicas
-	desiredReplicas := int32(math.Ceil(float64(input.CurrentReplicas) * avgRatio))
-
-	// Apply min/max constraints
-	if desiredReplicas < input.MinReplicas {
-		desiredReplicas = input.MinReplicas
-	}
-	if desiredReplicas > input.MaxReplicas {
-		desiredReplicas = input.MaxReplicas
-	}
-
-	return desiredReplicas
+	return calculate(a, a.Tolerance, input)
 }
 
 // WeightedRatioAlgorithm scales based on weighted ratios
@@ -301,6 +418,12 @@ func (a *WeightedRatioAlgorithm) Name() string {
 	return "WeightedRatio"
 }
 
+// Description summarizes how the algorithm computes replicas
+func (a *WeightedRatioAlgorithm) Description() string {
+	return "Scales by the weighted average ratio of current to target value, " +
+		"weighting the metrics in order by spec.algorithm.weights (1 when unset)."
+}
+
 // SetWeights allows updating weights for the algorithm
 func (a *WeightedRatioAlgorithm) SetWeights(weights []float64) {
 	a.Weights = weights
@@ -320,8 +443,9 @@ func (a *WeightedRatioAlgorithm) ComputeScale(_ context.Context, input ScalingIn
 			desiredReplicas = input.MaxReplicas
 		}
 		return ScalingResult{
-			DesiredReplicas: desiredReplicas,
-			Reason:          "no metrics available",
+			DesiredReplicas:   desiredReplicas,
+			Reason:            "no metrics available",
+			UnclampedReplicas: input.CurrentReplicas,
 		}, nil
 	}
 
@@ -348,8 +472,9 @@ func (a *WeightedRatioAlgorithm) ComputeScale(_ context.Context, input ScalingIn
 			desiredReplicas = input.MaxReplicas
 		}
 		return ScalingResult{
-			DesiredReplicas: desiredReplicas,
-			Reason:          "total weight is zero",
+			DesiredReplicas:   desiredReplicas,
+			Reason:            "total weight is zero",
+			UnclampedReplicas: input.CurrentReplicas,
 		}, nil
 	}
 
@@ -366,13 +491,16 @@ func (a *WeightedRatioAlgorithm) ComputeScale(_ context.Context, input ScalingIn
 			desiredReplicas = input.MaxReplicas
 		}
 		return ScalingResult{
-			DesiredReplicas: desiredReplicas,
-			Reason:          "within tolerance",
+			DesiredReplicas:   desiredReplicas,
+			Reason:            "within tolerance",
+			DrivingMetric:     largestRatioMetric(input.MetricSamples),
+			UnclampedReplicas: input.CurrentReplicas,
 		}, nil
 	}
 
 	// Calculate desired replicas
 	desiredReplicas := int32(math.Ceil(float64(input.CurrentReplicas) * weightedRatio))
+	unclamped := desiredReplicas
 
 	// Apply min/max constraints
 	if desiredReplicas < input.MinReplicas {
@@ -383,51 +511,16 @@ func (a *WeightedRatioAlgorithm) ComputeScale(_ context.Context, input ScalingIn
 	}
 
 	return ScalingResult{
-		DesiredReplicas: desiredReplicas,
-		Reason:          "scaled based on weighted ratio",
+		DesiredReplicas:   desiredReplicas,
+		Reason:            "scaled based on weighted ratio",
+		DrivingMetric:     largestRatioMetric(input.MetricSamples),
+		UnclampedReplicas: unclamped,
 	}, nil
 }
 
-// Calculate implements the legacy Algorithm interface
+// Calculate implements the legacy Algorithm interface.
+//
+// Deprecated: use ComputeScale.
 func (a *WeightedRatioAlgorithm) Calculate(input AlgorithmInput) int32 {
-	if len(input.MetricRatios) == 0 {
-		return input.CurrentReplicas
-	}
-
-	// Calculate weighted average
-	weightedSum := 0.0
-	totalWeight := 0.0
-
-	for i, ratio := range input.MetricRatios {
-		weight := 1.0
-		if i < len(a.Weights) {
-			weight = a.Weights[i]
-		}
-		weightedSum += ratio * weight
-		totalWeight += weight
-	}
-
-	if totalWeight == 0 {
-		return input.CurrentReplicas
-	}
-
-	weightedRatio := weightedSum / totalWeight
-
-	// Apply tolerance
-	if weightedRatio >= (1-a.Tolerance) && weightedRatio <= (1+a.Tolerance) {
-		return input.CurrentReplicas
-	}
-
-	// Calculate desired replicas
-	desiredReplicas := int32(math.Ceil(float64(input.CurrentReplicas) * weightedRatio))
-
-	// Apply min/max constraints
-	if desiredReplicas < input.MinReplicas {
-		desiredReplicas = input.MinReplicas
-	}
-	if desiredReplicas > input.MaxReplicas {
-		desiredReplicas = input.MaxReplicas
-	}
-
-	return desiredReplicas
+	return calculate(a, a.Tolerance, input)
 }
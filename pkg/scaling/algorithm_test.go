@@ -27,56 +27,61 @@ import (
 func TestMaxRatioAlgorithm(t *testing.T) {
 	tests := []struct {
 		name     string
-		input    AlgorithmInput
+		input    ScalingInput
 		expected int32
 	}{
 		{
 			name: "scale up when max ratio exceeds 1",
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 2,
 				MinReplicas:     1,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{1.5, 2.0, 1.2},
+				Tolerance:       0.1,
 			},
 			expected: 4, // 2 * 2.0 = 4
 		},
 		{
 			name: "no scaling within tolerance",
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 3,
 				MinReplicas:     1,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{1.05, 0.95, 1.0},
+				Tolerance:       0.1,
 			},
 			expected: 3, // within 10% tolerance
 		},
 		{
 			name: "respect max replicas",
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 5,
 				MinReplicas:     1,
 				MaxReplicas:     8,
 				MetricRatios:    []float64{3.0},
+				Tolerance:       0.1,
 			},
 			expected: 8, // capped at max
 		},
 		{
 			name: "respect min replicas",
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 1,
 				MinReplicas:     2,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{1.5}, // ratio > 1, scales to 2
+				Tolerance:       0.1,
 			},
 			expected: 2, // 1 * 1.5 = 1.5, ceil = 2, which equals min
 		},
 		{
 			name: "empty ratios returns current",
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 3,
 				MinReplicas:     1,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{},
+				Tolerance:       0.1,
 			},
 			expected: 3,
 		},
@@ -86,8 +91,9 @@ func TestMaxRatioAlgorithm(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := algo.Calculate(tt.input)
-			assert.Equal(t, tt.expected, result)
+			result, err := algo.ComputeScale(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.DesiredReplicas)
 		})
 	}
 }
@@ -95,26 +101,28 @@ func TestMaxRatioAlgorithm(t *testing.T) {
 func TestAverageRatioAlgorithm(t *testing.T) {
 	tests := []struct {
 		name     string
-		input    AlgorithmInput
+		input    ScalingInput
 		expected int32
 	}{
 		{
 			name: "scale based on average",
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 2,
 				MinReplicas:     1,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{2.0, 1.0, 1.5}, // avg = 1.5
+				Tolerance:       0.1,
 			},
 			expected: 3, // 2 * 1.5 = 3
 		},
 		{
 			name: "no scaling within tolerance",
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 4,
 				MinReplicas:     1,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{1.05, 0.95}, // avg = 1.0
+				Tolerance:       0.1,
 			},
 			expected: 4,
 		},
@@ -124,8 +132,9 @@ func TestAverageRatioAlgorithm(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := algo.Calculate(tt.input)
-			assert.Equal(t, tt.expected, result)
+			result, err := algo.ComputeScale(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.DesiredReplicas)
 		})
 	}
 }
@@ -134,28 +143,30 @@ func TestWeightedRatioAlgorithm(t *testing.T) {
 	tests := []struct {
 		name     string
 		weights  []float64
-		input    AlgorithmInput
+		input    ScalingInput
 		expected int32
 	}{
 		{
 			name:    "weighted scaling",
 			weights: []float64{2.0, 1.0}, // first metric has 2x weight
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 2,
 				MinReplicas:     1,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{2.0, 1.0}, // weighted avg = (2*2 + 1*1) / 3 = 1.67
+				Tolerance:       0.1,
 			},
 			expected: 4, // 2 * 1.67 = 3.34, ceil = 4
 		},
 		{
 			name:    "equal weights same as average",
 			weights: []float64{1.0, 1.0},
-			input: AlgorithmInput{
+			input: ScalingInput{
 				CurrentReplicas: 2,
 				MinReplicas:     1,
 				MaxReplicas:     10,
 				MetricRatios:    []float64{2.0, 1.0}, // avg = 1.5
+				Tolerance:       0.1,
 			},
 			expected: 3,
 		},
@@ -164,14 +175,13 @@ func TestWeightedRatioAlgorithm(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			algo := NewWeightedRatioAlgorithm(0.1, tt.weights)
-			result := algo.Calculate(tt.input)
-			assert.Equal(t, tt.expected, result)
+			result, err := algo.ComputeScale(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.DesiredReplicas)
 		})
 	}
 }
 
-// Tests for the new ScalingAlgorithm interface
-
 func TestMaxRatioAlgorithm_Name(t *testing.T) {
 	algo := NewMaxRatioAlgorithm(0.1)
 	assert.Equal(t, "MaxRatio", algo.Name())
@@ -251,6 +261,66 @@ func TestMaxRatioAlgorithm_ComputeScale(t *testing.T) {
 	}
 }
 
+func TestMaxRatioAlgorithm_ReportsDrivingMetric(t *testing.T) {
+	result, err := NewMaxRatioAlgorithm(0.1).ComputeScale(context.Background(), ScalingInput{
+		CurrentReplicas: 4,
+		MinReplicas:     1,
+		MaxReplicas:     6,
+		MetricRatios:    []float64{1.2, 2.0},
+		MetricSamples: map[string]MetricSample{
+			MetricLatencyP99Ms:      {Current: 600, Target: 500, Ratio: 1.2},
+			MetricRequestQueueDepth: {Current: 20, Target: 10, Ratio: 2.0},
+		},
+		Tolerance: 0.1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), result.DesiredReplicas)
+	assert.Equal(t, int32(8), result.UnclampedReplicas)
+	assert.Equal(t, MetricRequestQueueDepth, result.DrivingMetric)
+	assert.Zero(t, result.Confidence)
+}
+
+func TestMaxRatioAlgorithm_ScaleDown(t *testing.T) {
+	ctx := context.Background()
+	input := ScalingInput{
+		CurrentReplicas: 10,
+		MinReplicas:     2,
+		MaxReplicas:     20,
+		MetricRatios:    []float64{0.3, 0.5},
+		Tolerance:       0.1,
+	}
+
+	t.Run("floored at current replicas by default", func(t *testing.T) {
+		algo := NewMaxRatioAlgorithm(0.1)
+		result, err := algo.ComputeScale(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), result.DesiredReplicas)
+		assert.Equal(t, "within tolerance", result.Reason)
+	})
+
+	t.Run("scales down to the highest ratio when enabled", func(t *testing.T) {
+		algo := NewMaxRatioAlgorithm(0.1)
+		algo.SetScaleDownEnabled(true)
+		result, err := algo.ComputeScale(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), result.DesiredReplicas) // 10 * 0.5
+		assert.Equal(t, "scaled based on max ratio", result.Reason)
+
+		// The busiest metric still wins and minReplicas still applies
+		low := input
+		low.MetricRatios = []float64{0.05, 0.1}
+		result, err = algo.ComputeScale(ctx, low)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), result.DesiredReplicas)
+
+		up := input
+		up.MetricRatios = []float64{0.3, 1.5}
+		result, err = algo.ComputeScale(ctx, up)
+		require.NoError(t, err)
+		assert.Equal(t, int32(15), result.DesiredReplicas)
+	})
+}
+
 func TestAverageRatioAlgorithm_ComputeScale(t *testing.T) {
 	algo := NewAverageRatioAlgorithm(0.1)
 	ctx := context.Background()
@@ -326,3 +396,38 @@ func TestScalingAlgorithm_ImplementsInterface(_ *testing.T) {
 	var _ ScalingAlgorithm = (*AverageRatioAlgorithm)(nil)
 	var _ ScalingAlgorithm = (*WeightedRatioAlgorithm)(nil)
 }
+
+// halvingAlgorithm implements the deprecated Algorithm interface
+type halvingAlgorithm struct{}
+
+func (halvingAlgorithm) Calculate(input AlgorithmInput) int32 {
+	return max(input.CurrentReplicas/2, input.MinReplicas)
+}
+
+func TestLegacyAlgorithm(t *testing.T) {
+	algo := LegacyAlgorithm("Halving", halvingAlgorithm{})
+	assert.Equal(t, "Halving", algo.Name())
+
+	result, err := algo.ComputeScale(context.Background(), ScalingInput{CurrentReplicas: 8, MinReplicas: 1, MaxReplicas: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.DesiredReplicas)
+	assert.Equal(t, "computed by Halving", result.Reason)
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(algo))
+	assert.True(t, registry.Has("Halving"))
+}
+
+func TestCalculateDelegatesToComputeScale(t *testing.T) {
+	input := AlgorithmInput{CurrentReplicas: 2, MinReplicas: 3, MaxReplicas: 10, MetricRatios: []float64{1.05}}
+
+	// Within tolerance, the deprecated Calculate now applies the bounds like ComputeScale
+	assert.Equal(t, int32(3), NewMaxRatioAlgorithm(0.1).Calculate(input))
+	assert.Equal(t, int32(3), NewAverageRatioAlgorithm(0.1).Calculate(input))
+	assert.Equal(t, int32(3), NewWeightedRatioAlgorithm(0.1, nil).Calculate(input))
+
+	// The tolerance the algorithm was created with is used
+	assert.Equal(t, int32(4), NewMaxRatioAlgorithm(0.01).Calculate(AlgorithmInput{
+		CurrentReplicas: 3, MinReplicas: 1, MaxReplicas: 10, MetricRatios: []float64{1.05},
+	}))
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conformanceCase names an algorithm under test. New returns a fresh instance
+// so stateful algorithms start from empty state in every subtest.
+type conformanceCase struct {
+	name string
+	new  func() ScalingAlgorithm
+}
+
+// conformanceCases lists every built-in algorithm, alone and wrapped in the
+// smoothing decorator, which must satisfy the same contract
+func conformanceCases() []conformanceCase {
+	builtins := []func() ScalingAlgorithm{
+		func() ScalingAlgorithm { return NewMaxRatioAlgorithm(DefaultTolerance) },
+		func() ScalingAlgorithm { return NewAverageRatioAlgorithm(DefaultTolerance) },
+		func() ScalingAlgorithm { return NewWeightedRatioAlgorithm(DefaultTolerance, nil) },
+		func() ScalingAlgorithm { return NewPredictiveAlgorithm(NewStateStore()) },
+		func() ScalingAlgorithm { return NewLittlesLawAlgorithm() },
+	}
+	var cases []conformanceCase
+	for _, newBase := range builtins {
+		name := newBase().Name()
+		cases = append(cases,
+			conformanceCase{name: name, new: newBase},
+			conformanceCase{name: name + "/Smoothed", new: func() ScalingAlgorithm {
+				return NewSmoothingDecorator(newBase(), SmoothingConfig{Factor: 0.5, MaxUpPercent: 100, MaxDownPercent: 50}, NewStateStore())
+			}},
+		)
+	}
+	return cases
+}
+
+func TestAlgorithmConformance(t *testing.T) {
+	for _, tc := range conformanceCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("has a name", func(t *testing.T) {
+				assert.NotEmpty(t, tc.new().Name())
+			})
+
+			t.Run("keeps replicas without metrics", func(t *testing.T) {
+				result, err := tc.new().ComputeScale(context.Background(), conformanceInput(4, nil))
+				require.NoError(t, err)
+				assert.Equal(t, int32(4), result.DesiredReplicas)
+				assert.NotEmpty(t, result.Reason)
+			})
+
+			t.Run("keeps replicas on target", func(t *testing.T) {
+				result, err := tc.new().ComputeScale(context.Background(), conformanceInput(4, []float64{1.0, 1.05}))
+				require.NoError(t, err)
+				assert.Equal(t, int32(4), result.DesiredReplicas)
+			})
+
+			t.Run("stays within min and max", func(t *testing.T) {
+				for _, ratio := range []float64{0.01, 0.5, 2, 100} {
+					for _, current := range []int32{1, 2, 5, 10} {
+						input := conformanceInput(current, []float64{ratio})
+						result, err := tc.new().ComputeScale(context.Background(), input)
+						require.NoError(t, err)
+						assert.GreaterOrEqual(t, result.DesiredReplicas, input.MinReplicas, "ratio %v current %d", ratio, current)
+						assert.LessOrEqual(t, result.DesiredReplicas, input.MaxReplicas, "ratio %v current %d", ratio, current)
+					}
+				}
+			})
+
+			t.Run("moves in the direction of the load", func(t *testing.T) {
+				up, err := tc.new().ComputeScale(context.Background(), conformanceInput(4, []float64{2.0}))
+				require.NoError(t, err)
+				assert.Greater(t, up.DesiredReplicas, int32(4))
+
+				down, err := tc.new().ComputeScale(context.Background(), conformanceInput(4, []float64{0.25}))
+				require.NoError(t, err)
+				assert.LessOrEqual(t, down.DesiredReplicas, int32(4))
+			})
+
+			t.Run("is safe for concurrent policies", func(t *testing.T) {
+				algorithm := tc.new()
+				var wg sync.WaitGroup
+				for i := 0; i < 16; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						input := conformanceInput(4, []float64{2.0})
+						input.PolicyName = fmt.Sprintf("policy-%d", i%4)
+						_, err := algorithm.ComputeScale(context.Background(), input)
+						assert.NoError(t, err)
+					}(i)
+				}
+				wg.Wait()
+			})
+		})
+	}
+}
+
+func conformanceInput(current int32, ratios []float64) ScalingInput {
+	return ScalingInput{
+		CurrentReplicas: current,
+		MinReplicas:     1,
+		MaxReplicas:     20,
+		MetricRatios:    ratios,
+		Tolerance:       DefaultTolerance,
+		PolicyName:      "policy",
+		PolicyNamespace: "default",
+	}
+}
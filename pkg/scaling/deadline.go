@@ -0,0 +1,113 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultComputeTimeout is the deadline given to an algorithm when neither the
+// algorithm nor the caller specifies one
+const DefaultComputeTimeout = 2 * time.Second
+
+// ScalingAlgorithmV2 is the deadline-aware algorithm contract.
+//
+// ComputeScale is always called with a context that carries a firm deadline and
+// must return before it expires, checking ctx.Done() during any long-running work.
+// Algorithms that overrun are abandoned and the caller falls back to a default
+// algorithm, so a V2 implementation should never block on I/O without the context.
+type ScalingAlgorithmV2 interface {
+	ScalingAlgorithm
+	// Timeout returns the longest ComputeScale may run. Zero means the caller's default.
+	Timeout() time.Duration
+}
+
+// ErrComputeTimeout is returned when an algorithm does not return before its deadline
+type ErrComputeTimeout struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (e ErrComputeTimeout) Error() string {
+	return fmt.Sprintf("algorithm timed out: name=%q, timeout=%s", e.Name, e.Timeout)
+}
+
+// ErrComputePanic is returned when an algorithm panics during ComputeScale
+type ErrComputePanic struct {
+	Name  string
+	Value interface{}
+}
+
+func (e ErrComputePanic) Error() string {
+	return fmt.Sprintf("algorithm panicked: name=%q, panic=%v", e.Name, e.Value)
+}
+
+// EffectiveTimeout returns the deadline to enforce for the algorithm. V2 algorithms
+// may request a shorter deadline than the default, never a longer one.
+func EffectiveTimeout(algorithm ScalingAlgorithm, defaultTimeout time.Duration) time.Duration {
+	if defaultTimeout <= 0 {
+		defaultTimeout = DefaultComputeTimeout
+	}
+	if v2, ok := algorithm.(ScalingAlgorithmV2); ok {
+		if t := v2.Timeout(); t > 0 && t < defaultTimeout {
+			return t
+		}
+	}
+	return defaultTimeout
+}
+
+type computeOutcome struct {
+	result ScalingResult
+	err    error
+}
+
+// ComputeWithDeadline runs the algorithm with a firm deadline. If the algorithm does
+// not return in time its context is cancelled and ErrComputeTimeout is returned
+// immediately; the still-running call is abandoned. Panics are converted to ErrComputePanic.
+func ComputeWithDeadline(ctx context.Context, algorithm ScalingAlgorithm, input ScalingInput, timeout time.Duration) (ScalingResult, error) {
+	timeout = EffectiveTimeout(algorithm, timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Buffered so an abandoned algorithm can still complete its send and exit
+	done := make(chan computeOutcome, 1)
+	go func() {
+		defer func() {
+			if v := recover(); v != nil {
+				done <- computeOutcome{err: ErrComputePanic{Name: algorithm.Name(), Value: v}}
+			}
+		}()
+		result, err := algorithm.ComputeScale(ctx, input)
+		done <- computeOutcome{result: result, err: err}
+	}()
+
+	select {
+	case outcome := <-done:
+		if outcome.err != nil && errors.Is(outcome.err, context.DeadlineExceeded) {
+			return ScalingResult{}, ErrComputeTimeout{Name: algorithm.Name(), Timeout: timeout}
+		}
+		return outcome.result, outcome.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return ScalingResult{}, ErrComputeTimeout{Name: algorithm.Name(), Timeout: timeout}
+		}
+		return ScalingResult{}, ctx.Err()
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowAlgorithm blocks for delay, ignoring its context unless honorContext is set
+type slowAlgorithm struct {
+	delay        time.Duration
+	honorContext bool
+	timeout      time.Duration
+}
+
+func (s *slowAlgorithm) Name() string {
+	return "Slow"
+}
+
+func (s *slowAlgorithm) ComputeScale(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	if s.honorContext {
+		select {
+		case <-time.After(s.delay):
+		case <-ctx.Done():
+			return ScalingResult{}, ctx.Err()
+		}
+	} else {
+		time.Sleep(s.delay)
+	}
+	return ScalingResult{DesiredReplicas: input.CurrentReplicas + 1, Reason: "slow"}, nil
+}
+
+func (s *slowAlgorithm) Timeout() time.Duration {
+	return s.timeout
+}
+
+type panickingAlgorithm struct{}
+
+func (p *panickingAlgorithm) Name() string {
+	return "Panicking"
+}
+
+func (p *panickingAlgorithm) ComputeScale(_ context.Context, _ ScalingInput) (ScalingResult, error) {
+	panic("boom")
+}
+
+func TestComputeWithDeadline_Success(t *testing.T) {
+	result, err := ComputeWithDeadline(context.Background(), NewMaxRatioAlgorithm(0.1), ScalingInput{
+		CurrentReplicas: 2,
+		MinReplicas:     1,
+		MaxReplicas:     10,
+		MetricRatios:    []float64{2.0},
+		Tolerance:       0.1,
+	}, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.DesiredReplicas)
+}
+
+func TestComputeWithDeadline_RunawayAlgorithm(t *testing.T) {
+	start := time.Now()
+	_, err := ComputeWithDeadline(context.Background(), &slowAlgorithm{delay: time.Second}, ScalingInput{CurrentReplicas: 1}, 20*time.Millisecond)
+
+	var timeoutErr ErrComputeTimeout
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "Slow", timeoutErr.Name)
+	assert.Less(t, time.Since(start), 500*time.Millisecond, "caller must not wait for a runaway algorithm")
+}
+
+func TestComputeWithDeadline_ContextAwareAlgorithm(t *testing.T) {
+	_, err := ComputeWithDeadline(context.Background(), &slowAlgorithm{delay: time.Second, honorContext: true}, ScalingInput{}, 20*time.Millisecond)
+
+	var timeoutErr ErrComputeTimeout
+	assert.ErrorAs(t, err, &timeoutErr)
+}
+
+func TestComputeWithDeadline_Panic(t *testing.T) {
+	_, err := ComputeWithDeadline(context.Background(), &panickingAlgorithm{}, ScalingInput{}, time.Second)
+
+	var panicErr ErrComputePanic
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "Panicking", panicErr.Name)
+}
+
+func TestEffectiveTimeout(t *testing.T) {
+	assert.Equal(t, DefaultComputeTimeout, EffectiveTimeout(NewMaxRatioAlgorithm(0.1), 0))
+	assert.Equal(t, time.Second, EffectiveTimeout(NewMaxRatioAlgorithm(0.1), time.Second))
+	assert.Equal(t, 100*time.Millisecond, EffectiveTimeout(&slowAlgorithm{timeout: 100 * time.Millisecond}, time.Second))
+	// V2 algorithms cannot extend the controller deadline
+	assert.Equal(t, time.Second, EffectiveTimeout(&slowAlgorithm{timeout: time.Minute}, time.Second))
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// StateHandler serves the contents of store as JSON, keyed by policy
+// (namespace/name) and state name, so the internal state of stateful
+// algorithms can be inspected without adding logging. The policy query
+// parameter limits the output to one policy.
+func StateHandler(store *StateStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		policy := r.URL.Query().Get("policy")
+		snapshot := store.Snapshot(policy)
+		if policy != "" && len(snapshot) == 0 {
+			http.Error(w, fmt.Sprintf("no algorithm state for policy %s", policy), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(snapshot)
+	})
+}
+
+// AlgorithmsHandler serves the algorithms in registry as JSON with their
+// descriptions and parameters, so users can discover what a policy may set in
+// spec.algorithm. The name query parameter limits the output to one algorithm.
+func AlgorithmsHandler(registry *Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		infos := registry.Describe()
+		if name := r.URL.Query().Get("name"); name != "" {
+			algorithm, err := registry.Get(name)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			infos = []AlgorithmInfo{DescribeAlgorithm(algorithm)}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(infos)
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateHandler(t *testing.T) {
+	store := NewStateStore()
+	store.Set("default/llm", "smoothing", 5.4)
+	store.Set("default/llm", "integral", -0.2)
+	store.Set("prod/chat", "smoothing", 12)
+	handler := StateHandler(store)
+
+	get := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	rec := get("/debug/algorithm-state")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var all map[string]map[string]float64
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &all))
+	assert.Equal(t, map[string]map[string]float64{
+		"default/llm": {"smoothing": 5.4, "integral": -0.2},
+		"prod/chat":   {"smoothing": 12},
+	}, all)
+
+	rec = get("/debug/algorithm-state?policy=prod/chat")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var one map[string]map[string]float64
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &one))
+	assert.Equal(t, map[string]map[string]float64{"prod/chat": {"smoothing": 12}}, one)
+
+	assert.Equal(t, http.StatusNotFound, get("/debug/algorithm-state?policy=default/missing").Code)
+}
+
+func TestAlgorithmsHandler(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(NewAverageRatioAlgorithm(DefaultTolerance)))
+	require.NoError(t, registry.Register(&fixedAlgorithm{}))
+	handler := AlgorithmsHandler(registry)
+
+	get := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	rec := get("/debug/algorithms")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var all []AlgorithmInfo
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &all))
+	require.Len(t, all, 2)
+	assert.Equal(t, "AverageRatio", all[0].Name)
+	assert.NotEmpty(t, all[0].Description)
+	assert.Equal(t, []ParameterSpec{{Name: "replicas", Type: ParameterInt, Default: "1"}}, all[1].Parameters)
+
+	rec = get("/debug/algorithms?name=Fixed")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var one []AlgorithmInfo
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &one))
+	require.Len(t, one, 1)
+	assert.Equal(t, "Fixed", one[0].Name)
+
+	assert.Equal(t, http.StatusNotFound, get("/debug/algorithms?name=Missing").Code)
+}
+
+func TestStateSnapshotIsCopy(t *testing.T) {
+	store := NewStateStore()
+	store.Set("default/llm", "smoothing", 3)
+
+	snapshot := store.Snapshot("")
+	snapshot["default/llm"]["smoothing"] = 9
+
+	value, _ := store.Get("default/llm", "smoothing")
+	assert.Equal(t, 3.0, value)
+}
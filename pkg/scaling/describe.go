@@ -0,0 +1,46 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+// DescribedAlgorithm is implemented by algorithms that document what they do
+// for users choosing an algorithm
+type DescribedAlgorithm interface {
+	ScalingAlgorithm
+	// Description summarizes how the algorithm computes replicas
+	Description() string
+}
+
+// AlgorithmInfo describes a registered algorithm and the parameters it accepts
+// through spec.algorithm.parameters
+type AlgorithmInfo struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Parameters  []ParameterSpec `json:"parameters,omitempty"`
+}
+
+// DescribeAlgorithm returns the metadata algorithm exposes through
+// DescribedAlgorithm and ParameterizedAlgorithm
+func DescribeAlgorithm(algorithm ScalingAlgorithm) AlgorithmInfo {
+	info := AlgorithmInfo{Name: algorithm.Name()}
+	if described, ok := algorithm.(DescribedAlgorithm); ok {
+		info.Description = described.Description()
+	}
+	if parameterized, ok := algorithm.(ParameterizedAlgorithm); ok {
+		info.Parameters = parameterized.Parameters()
+	}
+	return info
+}
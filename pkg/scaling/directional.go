@@ -0,0 +1,82 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DirectionalAlgorithm wraps a scaling algorithm and hands the computation to
+// a different algorithm depending on the direction of the wrapped
+// recommendation, e.g. an aggressive algorithm for scale-up and a conservative
+// one for scale-down. The direction algorithm's recommendation is returned
+// as is, so it may also decide to keep the current replicas.
+type DirectionalAlgorithm struct {
+	base      ScalingAlgorithm
+	scaleUp   ScalingAlgorithm
+	scaleDown ScalingAlgorithm
+}
+
+// NewDirectionalAlgorithm wraps base; a nil scaleUp or scaleDown keeps the
+// recommendation of base in that direction
+func NewDirectionalAlgorithm(base, scaleUp, scaleDown ScalingAlgorithm) *DirectionalAlgorithm {
+	return &DirectionalAlgorithm{base: base, scaleUp: scaleUp, scaleDown: scaleDown}
+}
+
+// Name returns the name of the wrapped algorithm
+func (d *DirectionalAlgorithm) Name() string {
+	return d.base.Name()
+}
+
+// Timeout returns the shortest timeout requested by any of the algorithms
+func (d *DirectionalAlgorithm) Timeout() time.Duration {
+	var timeout time.Duration
+	for _, algorithm := range []ScalingAlgorithm{d.base, d.scaleUp, d.scaleDown} {
+		if v2, ok := algorithm.(ScalingAlgorithmV2); ok {
+			if t := v2.Timeout(); t > 0 && (timeout == 0 || t < timeout) {
+				timeout = t
+			}
+		}
+	}
+	return timeout
+}
+
+// ComputeScale computes the wrapped recommendation and, when it moves the
+// replicas, recomputes it with the algorithm configured for that direction
+func (d *DirectionalAlgorithm) ComputeScale(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	result, err := d.base.ComputeScale(ctx, input)
+	if err != nil {
+		return result, err
+	}
+
+	algorithm, direction := d.scaleUp, "up"
+	if result.DesiredReplicas < input.CurrentReplicas {
+		algorithm, direction = d.scaleDown, "down"
+	}
+	if result.DesiredReplicas == input.CurrentReplicas || algorithm == nil || algorithm.Name() == d.base.Name() {
+		return result, nil
+	}
+
+	directed, err := algorithm.ComputeScale(ctx, input)
+	if err != nil {
+		return directed, err
+	}
+	directed.Reason = fmt.Sprintf("%s (%s for scale-%s)", directed.Reason, algorithm.Name(), direction)
+	return directed, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectionalAlgorithm(t *testing.T) {
+	base := &MaxRatioAlgorithm{ScaleDownEnabled: true}
+	algorithm := NewDirectionalAlgorithm(base, nil, NewAverageRatioAlgorithm(DefaultTolerance))
+	ctx := context.Background()
+	assert.Equal(t, "MaxRatio", algorithm.Name())
+
+	// Scale-up keeps the recommendation of the base algorithm
+	result, err := algorithm.ComputeScale(ctx, conformanceInput(4, []float64{2.0, 0.5}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(8), result.DesiredReplicas)
+	assert.NotContains(t, result.Reason, "scale-down")
+
+	// Scale-down is recomputed with AverageRatio
+	result, err = algorithm.ComputeScale(ctx, conformanceInput(8, []float64{0.5, 0.3}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.DesiredReplicas) // 8 * 0.4 = 3.2, MaxRatio would say 4
+	assert.Contains(t, result.Reason, "AverageRatio for scale-down")
+
+	// Within tolerance no other algorithm is consulted
+	result, err = algorithm.ComputeScale(ctx, conformanceInput(4, []float64{1.0}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.DesiredReplicas)
+	assert.Equal(t, "within tolerance", result.Reason)
+}
+
+func TestDirectionalAlgorithm_Errors(t *testing.T) {
+	failing := &failingAlgorithm{err: errors.New("boom")}
+
+	_, err := NewDirectionalAlgorithm(failing, NewMaxRatioAlgorithm(DefaultTolerance), nil).
+		ComputeScale(context.Background(), conformanceInput(4, []float64{2.0}))
+	assert.EqualError(t, err, "boom")
+
+	_, err = NewDirectionalAlgorithm(NewMaxRatioAlgorithm(DefaultTolerance), failing, nil).
+		ComputeScale(context.Background(), conformanceInput(4, []float64{2.0}))
+	assert.EqualError(t, err, "boom")
+}
+
+// failingAlgorithm always returns err
+type failingAlgorithm struct {
+	err error
+}
+
+func (f *failingAlgorithm) Name() string {
+	return "Failing"
+}
+
+func (f *failingAlgorithm) ComputeScale(_ context.Context, _ ScalingInput) (ScalingResult, error) {
+	return ScalingResult{}, f.err
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Error types reported for failed computations, used as the error_type label of
+// kubeai_autoscaler_reconcile_errors_total
+const (
+	ErrorTypeInsufficientMetrics = "insufficient_metrics"
+	ErrorTypeInvalidParameters   = "invalid_parameters"
+	ErrorTypeStateCorrupt        = "state_corrupt"
+	ErrorTypeTimeout             = "algorithm_timeout"
+	ErrorTypePanic               = "algorithm_panic"
+	ErrorTypeUnknown             = "algorithm_error"
+)
+
+// ErrInsufficientMetrics is returned by algorithms that cannot compute a
+// recommendation from the metrics in ScalingInput
+type ErrInsufficientMetrics struct {
+	Name string
+	// Missing lists the metrics the algorithm needed but did not get
+	Missing []string
+}
+
+func (e ErrInsufficientMetrics) Error() string {
+	if len(e.Missing) == 0 {
+		return fmt.Sprintf("insufficient metrics for algorithm %q", e.Name)
+	}
+	return fmt.Sprintf("insufficient metrics for algorithm %q: missing %s", e.Name, strings.Join(e.Missing, ", "))
+}
+
+// ErrInvalidParameters is returned when an algorithm rejects its configuration
+type ErrInvalidParameters struct {
+	Name string
+	Err  error
+}
+
+func (e ErrInvalidParameters) Error() string {
+	return fmt.Sprintf("invalid parameters for algorithm %q: %v", e.Name, e.Err)
+}
+
+func (e ErrInvalidParameters) Unwrap() error {
+	return e.Err
+}
+
+// ErrStateCorrupt is returned when the state an algorithm kept for a policy can
+// no longer be used. The caller drops the policy's state so the algorithm
+// starts over on the next computation.
+type ErrStateCorrupt struct {
+	Name   string
+	Detail string
+}
+
+func (e ErrStateCorrupt) Error() string {
+	return fmt.Sprintf("corrupt state for algorithm %q: %s", e.Name, e.Detail)
+}
+
+// ErrorType classifies an error returned by ComputeScale or ComputeWithDeadline
+// into one of the ErrorType* values
+func ErrorType(err error) string {
+	var (
+		insufficient ErrInsufficientMetrics
+		invalid      ErrInvalidParameters
+		unsupported  ErrParametersNotSupported
+		corrupt      ErrStateCorrupt
+		timeout      ErrComputeTimeout
+		panicked     ErrComputePanic
+	)
+	switch {
+	case errors.As(err, &insufficient):
+		return ErrorTypeInsufficientMetrics
+	case errors.As(err, &invalid), errors.As(err, &unsupported):
+		return ErrorTypeInvalidParameters
+	case errors.As(err, &corrupt):
+		return ErrorTypeStateCorrupt
+	case errors.As(err, &timeout):
+		return ErrorTypeTimeout
+	case errors.As(err, &panicked):
+		return ErrorTypePanic
+	default:
+		return ErrorTypeUnknown
+	}
+}
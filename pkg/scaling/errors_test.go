@@ -0,0 +1,57 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorType(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{ErrInsufficientMetrics{Name: "LittlesLaw", Missing: []string{MetricArrivalRate}}, ErrorTypeInsufficientMetrics},
+		{fmt.Errorf("wrapped: %w", ErrInsufficientMetrics{Name: "LittlesLaw"}), ErrorTypeInsufficientMetrics},
+		{ErrInvalidParameters{Name: "Fixed", Err: errors.New("bad")}, ErrorTypeInvalidParameters},
+		{ErrParametersNotSupported{Name: "MaxRatio"}, ErrorTypeInvalidParameters},
+		{ErrStateCorrupt{Name: "Predictive", Detail: "level is NaN"}, ErrorTypeStateCorrupt},
+		{ErrComputeTimeout{Name: "Slow"}, ErrorTypeTimeout},
+		{ErrComputePanic{Name: "Broken", Value: "boom"}, ErrorTypePanic},
+		{errors.New("something else"), ErrorTypeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorType(tt.err))
+		})
+	}
+}
+
+func TestTypedErrorMessages(t *testing.T) {
+	assert.Equal(t, `insufficient metrics for algorithm "LittlesLaw": missing arrivalRate, serviceTimeSeconds`,
+		ErrInsufficientMetrics{Name: "LittlesLaw", Missing: []string{MetricArrivalRate, MetricServiceTimeSeconds}}.Error())
+	assert.Equal(t, `insufficient metrics for algorithm "LittlesLaw"`, ErrInsufficientMetrics{Name: "LittlesLaw"}.Error())
+
+	inner := errors.New(`unknown parameter "x"`)
+	err := ErrInvalidParameters{Name: "Fixed", Err: inner}
+	assert.ErrorIs(t, err, inner)
+	assert.Equal(t, `invalid parameters for algorithm "Fixed": unknown parameter "x"`, err.Error())
+}
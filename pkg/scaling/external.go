@@ -0,0 +1,189 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	externalv1 "github.com/pmady/kubeai-autoscaler/api/external/v1"
+)
+
+// DefaultExternalTimeout is how long an external algorithm service has to
+// answer before the fallback algorithm is used. It is kept below
+// DefaultComputeTimeout so the fallback still runs inside the compute deadline.
+const DefaultExternalTimeout = time.Second
+
+// ExternalAlgorithm computes scale by calling a ScalingAlgorithm gRPC service,
+// for algorithms that cannot be built as plugins matching the controller's
+// toolchain. When the call fails, times out or returns an invalid result the
+// fallback algorithm computes the recommendation instead.
+type ExternalAlgorithm struct {
+	name     string
+	client   externalv1.ScalingAlgorithmClient
+	timeout  time.Duration
+	fallback ScalingAlgorithm
+}
+
+// NewExternalAlgorithm creates an algorithm named name that calls the service
+// on conn, waiting at most timeout before computing with fallback
+func NewExternalAlgorithm(name string, conn grpc.ClientConnInterface, timeout time.Duration, fallback ScalingAlgorithm) *ExternalAlgorithm {
+	if timeout <= 0 {
+		timeout = DefaultExternalTimeout
+	}
+	return &ExternalAlgorithm{
+		name:     name,
+		client:   externalv1.NewScalingAlgorithmClient(conn),
+		timeout:  timeout,
+		fallback: fallback,
+	}
+}
+
+// Name returns the name the service is registered under
+func (a *ExternalAlgorithm) Name() string {
+	return a.name
+}
+
+// Description reports that the algorithm is served externally
+func (a *ExternalAlgorithm) Description() string {
+	return fmt.Sprintf("Computed by an external gRPC service within %s, falling back to %s.", a.timeout, a.fallback.Name())
+}
+
+// ComputeScale calls the external service, falling back to the built-in
+// algorithm on failure
+func (a *ExternalAlgorithm) ComputeScale(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	result, err := a.call(ctx, input)
+	if err == nil {
+		return result, nil
+	}
+	result, fallbackErr := a.fallback.ComputeScale(ctx, input)
+	if fallbackErr != nil {
+		return result, fallbackErr
+	}
+	result.Reason = fmt.Sprintf("%s (%s fallback for %s: %v)", result.Reason, a.fallback.Name(), a.name, err)
+	return result, nil
+}
+
+// externalMetricSamples converts metric samples to their gRPC form
+func externalMetricSamples(samples map[string]MetricSample) map[string]*externalv1.MetricSample {
+	if samples == nil {
+		return nil
+	}
+	converted := make(map[string]*externalv1.MetricSample, len(samples))
+	for name, sample := range samples {
+		converted[name] = &externalv1.MetricSample{
+			Current:   sample.Current,
+			Target:    sample.Target,
+			Ratio:     sample.Ratio,
+			Timestamp: timestamppb.New(sample.Timestamp),
+		}
+	}
+	return converted
+}
+
+// call sends input to the service under the algorithm's own timeout
+func (a *ExternalAlgorithm) call(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	resp, err := a.client.ComputeScale(ctx, &externalv1.ScalingInput{
+		Algorithm:           a.name,
+		CurrentReplicas:     input.CurrentReplicas,
+		MinReplicas:         input.MinReplicas,
+		MaxReplicas:         input.MaxReplicas,
+		MetricRatios:        input.MetricRatios,
+		Tolerance:           input.Tolerance,
+		PolicyName:          input.PolicyName,
+		PolicyNamespace:     input.PolicyNamespace,
+		Metrics:             input.Metrics,
+		MetricSamples:       externalMetricSamples(input.MetricSamples),
+		ReadyReplicas:       input.ReadyReplicas,
+		UnavailableReplicas: input.UnavailableReplicas,
+	})
+	if err != nil {
+		// Report the status message rather than the full "rpc error: code = ..." text
+		if s, ok := status.FromError(err); ok {
+			return ScalingResult{}, fmt.Errorf("%s: %s", s.Code(), s.Message())
+		}
+		return ScalingResult{}, err
+	}
+	if resp.GetDesiredReplicas() < 0 {
+		return ScalingResult{}, fmt.Errorf("invalid desired replicas %d", resp.GetDesiredReplicas())
+	}
+	return ScalingResult{
+		DesiredReplicas:   resp.GetDesiredReplicas(),
+		Reason:            resp.GetReason(),
+		DrivingMetric:     resp.GetDrivingMetric(),
+		UnclampedReplicas: resp.GetUnclampedReplicas(),
+		Confidence:        resp.GetConfidence(),
+	}, nil
+}
+
+// ExternalOptions configures the connections made by RegisterExternalAlgorithms
+type ExternalOptions struct {
+	// Timeout is how long each call may take. Zero means DefaultExternalTimeout.
+	Timeout time.Duration
+	// Fallback is the name of the registered algorithm used when a call fails
+	Fallback string
+	// TLS connects with TLS verified against the system roots instead of plaintext
+	TLS bool
+}
+
+// RegisterExternalAlgorithms registers an ExternalAlgorithm for each
+// "name=address" endpoint. Connections are made lazily on the first call, so
+// a service that is not up yet does not prevent the controller from starting.
+func RegisterExternalAlgorithms(registry *Registry, endpoints []string, opts ExternalOptions) error {
+	fallback, err := registry.Get(opts.Fallback)
+	if err != nil {
+		return fmt.Errorf("external algorithm fallback: %w", err)
+	}
+	creds := externalCredentials(opts.TLS)
+	for _, endpoint := range endpoints {
+		name, address, ok := strings.Cut(endpoint, "=")
+		name, address = strings.TrimSpace(name), strings.TrimSpace(address)
+		if !ok || name == "" || address == "" {
+			return fmt.Errorf("invalid external algorithm %q: want name=address", endpoint)
+		}
+		conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return fmt.Errorf("external algorithm %q: %w", name, err)
+		}
+		if err := registry.Register(NewExternalAlgorithm(name, conn, opts.Timeout, fallback)); err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+// externalCredentials returns TLS credentials verified against the system
+// roots, or plaintext credentials when useTLS is false
+func externalCredentials(useTLS bool) credentials.TransportCredentials {
+	if useTLS {
+		return credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	return insecure.NewCredentials()
+}
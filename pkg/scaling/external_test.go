@@ -0,0 +1,169 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	externalv1 "github.com/pmady/kubeai-autoscaler/api/external/v1"
+)
+
+// fakeExternalServer answers ComputeScale with compute
+type fakeExternalServer struct {
+	externalv1.UnimplementedScalingAlgorithmServer
+	compute func(ctx context.Context, in *externalv1.ScalingInput) (*externalv1.ScalingResult, error)
+}
+
+func (s *fakeExternalServer) ComputeScale(ctx context.Context, in *externalv1.ScalingInput) (*externalv1.ScalingResult, error) {
+	return s.compute(ctx, in)
+}
+
+// dialFakeExternal serves server in process and returns a connection to it
+func dialFakeExternal(t *testing.T, server *fakeExternalServer) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	externalv1.RegisterScalingAlgorithmServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestExternalAlgorithm(t *testing.T) {
+	input := ScalingInput{
+		CurrentReplicas: 2,
+		MinReplicas:     1,
+		MaxReplicas:     10,
+		MetricRatios:    []float64{2.0},
+		Tolerance:       DefaultTolerance,
+		PolicyName:      "llm",
+		PolicyNamespace: "default",
+		Metrics:         map[string]float64{MetricGPUUtilization: 90},
+		MetricSamples: map[string]MetricSample{
+			MetricGPUUtilization: {Current: 90, Target: 45, Ratio: 2.0, Timestamp: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+		},
+		ReadyReplicas:       1,
+		UnavailableReplicas: 1,
+	}
+
+	tests := []struct {
+		name        string
+		compute     func(ctx context.Context, in *externalv1.ScalingInput) (*externalv1.ScalingResult, error)
+		wantDesired int32
+		wantReason  string
+	}{
+		{
+			name: "service result",
+			compute: func(_ context.Context, in *externalv1.ScalingInput) (*externalv1.ScalingResult, error) {
+				return &externalv1.ScalingResult{DesiredReplicas: in.CurrentReplicas + 3, Reason: "remote"}, nil
+			},
+			wantDesired: 5,
+			wantReason:  "remote",
+		},
+		{
+			name: "service error falls back",
+			compute: func(context.Context, *externalv1.ScalingInput) (*externalv1.ScalingResult, error) {
+				return nil, status.Error(codes.Unavailable, "model not loaded")
+			},
+			wantDesired: 4,
+			wantReason:  "MaxRatio fallback for Remote: Unavailable: model not loaded",
+		},
+		{
+			name: "slow service falls back",
+			compute: func(ctx context.Context, _ *externalv1.ScalingInput) (*externalv1.ScalingResult, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			wantDesired: 4,
+			wantReason:  "MaxRatio fallback for Remote: DeadlineExceeded",
+		},
+		{
+			name: "invalid result falls back",
+			compute: func(context.Context, *externalv1.ScalingInput) (*externalv1.ScalingResult, error) {
+				return &externalv1.ScalingResult{DesiredReplicas: -1}, nil
+			},
+			wantDesired: 4,
+			wantReason:  "invalid desired replicas -1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := make(chan *externalv1.ScalingInput, 1)
+			conn := dialFakeExternal(t, &fakeExternalServer{
+				compute: func(ctx context.Context, in *externalv1.ScalingInput) (*externalv1.ScalingResult, error) {
+					received <- in
+					return tt.compute(ctx, in)
+				},
+			})
+			algorithm := NewExternalAlgorithm("Remote", conn, 50*time.Millisecond, NewMaxRatioAlgorithm(DefaultTolerance))
+			assert.Equal(t, "Remote", algorithm.Name())
+
+			result, err := algorithm.ComputeScale(context.Background(), input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDesired, result.DesiredReplicas)
+			assert.Contains(t, result.Reason, tt.wantReason)
+
+			sent := <-received
+			assert.Equal(t, "Remote", sent.Algorithm)
+			assert.Equal(t, "default/llm", sent.PolicyNamespace+"/"+sent.PolicyName)
+			assert.Equal(t, input.MetricRatios, sent.MetricRatios)
+			assert.Equal(t, input.Metrics, sent.Metrics)
+			sample := sent.MetricSamples[MetricGPUUtilization]
+			require.NotNil(t, sample)
+			assert.Equal(t, 2.0, sample.Ratio)
+			assert.Equal(t, input.MetricSamples[MetricGPUUtilization].Timestamp, sample.Timestamp.AsTime())
+			assert.Equal(t, int32(1), sent.ReadyReplicas)
+			assert.Equal(t, int32(1), sent.UnavailableReplicas)
+		})
+	}
+}
+
+func TestRegisterExternalAlgorithms(t *testing.T) {
+	registry := NewRegistry()
+	registry.MustRegister(NewMaxRatioAlgorithm(DefaultTolerance))
+
+	opts := ExternalOptions{Fallback: "MaxRatio"}
+	require.NoError(t, RegisterExternalAlgorithms(registry, []string{"Remote=localhost:9000"}, opts))
+	remote, err := registry.Get("Remote")
+	require.NoError(t, err)
+	assert.IsType(t, &ExternalAlgorithm{}, remote)
+
+	assert.Error(t, RegisterExternalAlgorithms(registry, []string{"localhost:9000"}, opts))
+	assert.Error(t, RegisterExternalAlgorithms(registry, []string{"Other="}, opts))
+	// Names must not clash with registered algorithms
+	assert.Error(t, RegisterExternalAlgorithms(registry, []string{"MaxRatio=localhost:9000"}, opts))
+	// The fallback must exist
+	assert.Error(t, RegisterExternalAlgorithms(registry, []string{"Other=localhost:9000"}, ExternalOptions{Fallback: "Missing"}))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import "strings"
+
+// AlgorithmFilter limits the algorithms policies may use, so tenants can be
+// kept off experimental plugins that run inside the shared controller.
+// Patterns match an algorithm name exactly or, ending in "*", by prefix.
+type AlgorithmFilter struct {
+	// Allow lists the algorithms that may be used; empty allows every algorithm
+	Allow []string
+	// Deny lists the algorithms that may not be used, even when allowed
+	Deny []string
+}
+
+// NewAlgorithmFilter builds a filter from comma-separated allow and deny lists
+func NewAlgorithmFilter(allow, deny string) AlgorithmFilter {
+	return AlgorithmFilter{Allow: splitPatterns(allow), Deny: splitPatterns(deny)}
+}
+
+// splitPatterns splits a comma-separated list, dropping empty entries
+func splitPatterns(list string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(list, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// Allows reports whether the filter allows the named algorithm
+func (f AlgorithmFilter) Allows(name string) bool {
+	if matchAny(f.Deny, name) {
+		return false
+	}
+	return len(f.Allow) == 0 || matchAny(f.Allow, name)
+}
+
+// matchAny reports whether name matches any of the patterns
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if pattern == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlgorithmFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter AlgorithmFilter
+		allow  []string
+		deny   []string
+	}{
+		{
+			name:  "empty filter allows everything",
+			allow: []string{"MaxRatio", "experimental-forecast"},
+		},
+		{
+			name:   "allow list",
+			filter: NewAlgorithmFilter("MaxRatio, LittlesLaw", ""),
+			allow:  []string{"MaxRatio", "LittlesLaw"},
+			deny:   []string{"Predictive", "Max"},
+		},
+		{
+			name:   "deny prefix",
+			filter: NewAlgorithmFilter("", "experimental-*"),
+			allow:  []string{"MaxRatio", "experimental"},
+			deny:   []string{"experimental-forecast"},
+		},
+		{
+			name:   "deny wins over allow",
+			filter: NewAlgorithmFilter("*", "Predictive"),
+			allow:  []string{"MaxRatio"},
+			deny:   []string{"Predictive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range tt.allow {
+				assert.True(t, tt.filter.Allows(name), name)
+			}
+			for _, name := range tt.deny {
+				assert.False(t, tt.filter.Allows(name), name)
+			}
+		})
+	}
+}
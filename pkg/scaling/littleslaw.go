@@ -0,0 +1,127 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// DefaultTargetUtilization is the share of each replica's concurrency LittlesLaw plans for when none is configured
+const DefaultTargetUtilization = 0.8
+
+// LittlesLawAlgorithm sizes replicas from the request arrival rate and the
+// mean service time instead of multiplying the current replicas by a ratio.
+// By Little's Law the requests in service equal the arrival rate times the
+// mean service time; the queued requests are added to them, and the total is
+// spread over replicas each serving ConcurrencyPerReplica requests at
+// TargetUtilization. Equivalently, each replica's service rate is
+// ConcurrencyPerReplica divided by the service time.
+//
+// Without an arrival rate and service time in ScalingInput.Metrics the
+// algorithm falls back to MaxRatio with scale-down enabled. A zero arrival
+// rate is a measurement rather than a missing one and needs no service time.
+type LittlesLawAlgorithm struct {
+	// ConcurrencyPerReplica is the number of requests a replica serves at once
+	ConcurrencyPerReplica int32
+	// TargetUtilization is the share (0-1] of each replica's concurrency to plan for
+	TargetUtilization float64
+}
+
+// NewLittlesLawAlgorithm creates a new LittlesLawAlgorithm
+func NewLittlesLawAlgorithm() *LittlesLawAlgorithm {
+	return &LittlesLawAlgorithm{
+		ConcurrencyPerReplica: 1,
+		TargetUtilization:     DefaultTargetUtilization,
+	}
+}
+
+// Name returns the algorithm name
+func (a *LittlesLawAlgorithm) Name() string {
+	return "LittlesLaw"
+}
+
+// Description summarizes how the algorithm computes replicas
+func (a *LittlesLawAlgorithm) Description() string {
+	return "Sizes replicas so the requests in service (arrival rate times service time) " +
+		"plus the queued requests fit spec.metrics.queueing concurrency at the target utilization. " +
+		"Falls back to MaxRatio without an arrival rate and service time."
+}
+
+// SetCapacity allows updating the per-replica concurrency and target
+// utilization; values out of range keep the defaults
+func (a *LittlesLawAlgorithm) SetCapacity(concurrencyPerReplica int32, targetUtilization float64) {
+	a.ConcurrencyPerReplica = max(concurrencyPerReplica, 1)
+	if targetUtilization <= 0 || targetUtilization > 1 {
+		targetUtilization = DefaultTargetUtilization
+	}
+	a.TargetUtilization = targetUtilization
+}
+
+// ComputeScale implements the ScalingAlgorithm interface
+func (a *LittlesLawAlgorithm) ComputeScale(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	arrivalRate, hasArrival := input.Metrics[MetricArrivalRate]
+	serviceTime, hasService := input.Metrics[MetricServiceTimeSeconds]
+	// Without arrivals no request is in service, so the service time, which
+	// cannot be measured then, is not needed
+	if hasArrival && arrivalRate == 0 {
+		hasService = true
+	}
+	if !hasArrival || !hasService {
+		fallback := &MaxRatioAlgorithm{Tolerance: input.Tolerance, ScaleDownEnabled: true}
+		result, err := fallback.ComputeScale(ctx, input)
+		if err == nil && len(input.MetricRatios) > 0 {
+			result.Reason += " (arrival rate or service time not available)"
+		}
+		return result, err
+	}
+
+	queued := input.Metrics[MetricRequestQueueDepth]
+	concurrency := arrivalRate*serviceTime + queued
+	perReplica := float64(max(a.ConcurrencyPerReplica, 1)) * a.utilization()
+	required := concurrency / perReplica
+
+	// Apply tolerance relative to the current replicas
+	replicas := float64(max(input.CurrentReplicas, 1))
+	if ratio := required / replicas; ratio >= (1-input.Tolerance) && ratio <= (1+input.Tolerance) {
+		return ScalingResult{
+			DesiredReplicas:   max(min(input.CurrentReplicas, input.MaxReplicas), input.MinReplicas),
+			Reason:            "within tolerance",
+			DrivingMetric:     MetricArrivalRate,
+			UnclampedReplicas: input.CurrentReplicas,
+		}, nil
+	}
+
+	unclamped := int32(math.Ceil(required))
+	desiredReplicas := max(min(unclamped, input.MaxReplicas), input.MinReplicas)
+	return ScalingResult{
+		DesiredReplicas: desiredReplicas,
+		Reason: fmt.Sprintf("scaled by Little's Law (%.1f req/s x %.2fs + %.0f queued = %.1f concurrent requests)",
+			arrivalRate, serviceTime, queued, concurrency),
+		DrivingMetric:     MetricArrivalRate,
+		UnclampedReplicas: unclamped,
+	}, nil
+}
+
+// utilization returns the configured target utilization or DefaultTargetUtilization
+func (a *LittlesLawAlgorithm) utilization() float64 {
+	if a.TargetUtilization <= 0 || a.TargetUtilization > 1 {
+		return DefaultTargetUtilization
+	}
+	return a.TargetUtilization
+}
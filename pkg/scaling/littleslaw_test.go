@@ -0,0 +1,108 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLittlesLawAlgorithm(t *testing.T) {
+	algo := NewLittlesLawAlgorithm()
+	algo.SetCapacity(8, 0.5)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		current  int32
+		metrics  map[string]float64
+		expected int32
+		reason   string
+	}{
+		{
+			// 20 req/s x 2s = 40 in service, + 8 queued = 48; 4 per replica at 50% of 8
+			name:     "sizes replicas from arrival rate, service time and queue",
+			current:  4,
+			metrics:  map[string]float64{MetricArrivalRate: 20, MetricServiceTimeSeconds: 2, MetricRequestQueueDepth: 8},
+			expected: 12,
+			reason:   "scaled by Little's Law (20.0 req/s x 2.00s + 8 queued = 48.0 concurrent requests)",
+		},
+		{
+			// 5 req/s x 1.5s = 7.5 in service fits in 2 replicas regardless of ratios
+			name:     "scales down when load drops",
+			current:  10,
+			metrics:  map[string]float64{MetricArrivalRate: 5, MetricServiceTimeSeconds: 1.5},
+			expected: 2,
+		},
+		{
+			name:     "within tolerance",
+			current:  10,
+			metrics:  map[string]float64{MetricArrivalRate: 10, MetricServiceTimeSeconds: 4.1},
+			expected: 10,
+			reason:   "within tolerance",
+		},
+		{
+			// Ratios of 1.0 would hold 10 replicas; an idle service needs MinReplicas
+			name:     "scales an idle service to min replicas without a service time",
+			current:  10,
+			metrics:  map[string]float64{MetricArrivalRate: 0},
+			expected: 1,
+			reason:   "scaled by Little's Law (0.0 req/s x 0.00s + 0 queued = 0.0 concurrent requests)",
+		},
+		{
+			name:     "capped at max replicas",
+			current:  4,
+			metrics:  map[string]float64{MetricArrivalRate: 1000, MetricServiceTimeSeconds: 2},
+			expected: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := conformanceInput(tt.current, []float64{1.0})
+			input.Metrics = tt.metrics
+			result, err := algo.ComputeScale(ctx, input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.DesiredReplicas)
+			if tt.reason != "" {
+				assert.Equal(t, tt.reason, result.Reason)
+			}
+		})
+	}
+}
+
+func TestLittlesLawAlgorithm_Fallback(t *testing.T) {
+	algo := NewLittlesLawAlgorithm()
+
+	// Without a service time the ratios are used, including for scale-down
+	input := conformanceInput(8, []float64{0.5, 0.25})
+	input.Metrics = map[string]float64{MetricArrivalRate: 12}
+	result, err := algo.ComputeScale(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.DesiredReplicas)
+	assert.Equal(t, "scaled based on max ratio (arrival rate or service time not available)", result.Reason)
+}
+
+func TestLittlesLawAlgorithm_SetCapacity(t *testing.T) {
+	algo := NewLittlesLawAlgorithm()
+	algo.SetCapacity(0, 1.5)
+	assert.Equal(t, int32(1), algo.ConcurrencyPerReplica)
+	assert.Equal(t, DefaultTargetUtilization, algo.TargetUtilization)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Plugin file extensions recognized in the plugin directory
+const (
+	NativePluginExtension = ".so"
+	WASMPluginExtension   = ".wasm"
+)
+
+// LoadPlugins loads all plugins from the given directory: Go plugins from .so
+// files and WASM modules from .wasm files.
+// Returns a slice of loaded algorithms and any errors encountered
+func LoadPlugins(dir string) ([]ScalingAlgorithm, error) {
+	// Check if directory exists
+	info, err := os.Stat(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("plugin directory not found: path=%q", dir)
+		}
+		return nil, fmt.Errorf("failed to stat plugin directory %q: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("plugin path is not a directory: path=%q", dir)
+	}
+
+	var matches []string
+	for _, ext := range []string{NativePluginExtension, WASMPluginExtension} {
+		found, err := filepath.Glob(filepath.Join(dir, "*"+ext))
+		if err != nil {
+			return nil, fmt.Errorf("failed to glob plugins: %w", err)
+		}
+		matches = append(matches, found...)
+	}
+
+	var algorithms []ScalingAlgorithm
+	var loadErrors []error
+
+	for _, path := range matches {
+		algorithm, err := LoadPluginFile(path)
+		if err != nil {
+			loadErrors = append(loadErrors, err)
+			continue
+		}
+		algorithms = append(algorithms, algorithm)
+	}
+
+	// Return combined error if any plugins failed to load
+	if len(loadErrors) > 0 {
+		return algorithms, fmt.Errorf("failed to load %d plugin(s): %v", len(loadErrors), loadErrors)
+	}
+
+	return algorithms, nil
+}
+
+// IsPluginFile reports whether path has a plugin file extension
+func IsPluginFile(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == NativePluginExtension || ext == WASMPluginExtension
+}
+
+// LoadPluginFile loads a single plugin, as a WASM module for .wasm files and as
+// a Go plugin otherwise
+func LoadPluginFile(path string) (ScalingAlgorithm, error) {
+	if filepath.Ext(path) == WASMPluginExtension {
+		algorithm, err := LoadWASMPlugin(path)
+		if err != nil {
+			return nil, err
+		}
+		return algorithm, nil
+	}
+	return LoadPlugin(path)
+}
+
+// LoadAndRegisterPlugins loads all plugins from the directory and registers them
+func LoadAndRegisterPlugins(dir string, registry *Registry) error {
+	algorithms, err := LoadPlugins(dir)
+	if err != nil {
+		// Log but don't fail if some plugins couldn't be loaded
+		// The successfully loaded plugins will still be registered
+		if len(algorithms) == 0 {
+			return err
+		}
+	}
+
+	var registrationErrors []error
+	for _, alg := range algorithms {
+		if err := registry.Register(alg); err != nil {
+			registrationErrors = append(registrationErrors, err)
+		}
+	}
+
+	if len(registrationErrors) > 0 {
+		return fmt.Errorf("failed to register %d algorithm(s): %v", len(registrationErrors), registrationErrors)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	externalv1 "github.com/pmady/kubeai-autoscaler/api/external/v1"
+)
+
+// DefaultOptimizerTimeout is how long an external optimizer has to answer
+// before the decision proceeds without fresh advice. Advice is optional, so
+// the optimizer gets a smaller share of the decision budget than an algorithm.
+const DefaultOptimizerTimeout = 500 * time.Millisecond
+
+// DecisionContext describes a scaling decision to an Optimizer
+type DecisionContext struct {
+	PolicyName      string
+	PolicyNamespace string
+	TargetKind      string
+	TargetName      string
+	// Algorithm is the algorithm that made the recommendation
+	Algorithm           string
+	CurrentReplicas     int32
+	RecommendedReplicas int32
+	MinReplicas         int32
+	MaxReplicas         int32
+	MetricSamples       map[string]MetricSample
+	// PreferredPool is the pool advised for the policy last time
+	PreferredPool string
+}
+
+// Advice holds the soft constraints an Optimizer returns for a decision
+type Advice struct {
+	// PreferredPool is the GPU pool the target should run on
+	PreferredPool string
+	// MaxReplicas bounds scale-ups; zero means no bound
+	MaxReplicas int32
+	Reason      string
+	// ExpiresAt is until when the advice may be reused if the optimizer
+	// fails. The zero time means it is not reused.
+	ExpiresAt time.Time
+}
+
+// Optimizer advises on scaling decisions. Advice is applied as soft
+// constraints: the controller never lets it override minReplicas, scale a
+// target down or block a decision.
+type Optimizer interface {
+	Advise(ctx context.Context, decision DecisionContext) (Advice, error)
+}
+
+// ExternalOptimizer calls an Optimizer gRPC service. When a call fails or
+// times out it reuses the policy's last advice until that advice expires.
+type ExternalOptimizer struct {
+	client  externalv1.OptimizerClient
+	timeout time.Duration
+	now     func() time.Time
+
+	mu         sync.Mutex
+	lastAdvice map[string]Advice
+}
+
+// NewExternalOptimizer creates an optimizer that calls the service on conn,
+// waiting at most timeout for each answer
+func NewExternalOptimizer(conn grpc.ClientConnInterface, timeout time.Duration) *ExternalOptimizer {
+	if timeout <= 0 {
+		timeout = DefaultOptimizerTimeout
+	}
+	return &ExternalOptimizer{
+		client:     externalv1.NewOptimizerClient(conn),
+		timeout:    timeout,
+		now:        time.Now,
+		lastAdvice: make(map[string]Advice),
+	}
+}
+
+// DialExternalOptimizer creates an ExternalOptimizer for the service at
+// address. Like RegisterExternalAlgorithms, the connection is made lazily on
+// the first call.
+func DialExternalOptimizer(address string, timeout time.Duration, useTLS bool) (*ExternalOptimizer, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(externalCredentials(useTLS)))
+	if err != nil {
+		return nil, fmt.Errorf("optimizer %q: %w", address, err)
+	}
+	return NewExternalOptimizer(conn, timeout), nil
+}
+
+// Advise calls the optimizer, falling back to the policy's unexpired last
+// advice on failure
+func (o *ExternalOptimizer) Advise(ctx context.Context, decision DecisionContext) (Advice, error) {
+	key := decision.PolicyNamespace + "/" + decision.PolicyName
+	advice, err := o.call(ctx, decision)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err == nil {
+		o.lastAdvice[key] = advice
+		return advice, nil
+	}
+	last, ok := o.lastAdvice[key]
+	if !ok || !o.now().Before(last.ExpiresAt) {
+		delete(o.lastAdvice, key)
+		return Advice{}, err
+	}
+	last.Reason = fmt.Sprintf("%s (reused after optimizer error: %v)", last.Reason, err)
+	return last, nil
+}
+
+// call sends the decision to the service under the optimizer's own timeout
+func (o *ExternalOptimizer) call(ctx context.Context, decision DecisionContext) (Advice, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	resp, err := o.client.Advise(ctx, &externalv1.DecisionContext{
+		PolicyName:          decision.PolicyName,
+		PolicyNamespace:     decision.PolicyNamespace,
+		TargetKind:          decision.TargetKind,
+		TargetName:          decision.TargetName,
+		Algorithm:           decision.Algorithm,
+		CurrentReplicas:     decision.CurrentReplicas,
+		RecommendedReplicas: decision.RecommendedReplicas,
+		MinReplicas:         decision.MinReplicas,
+		MaxReplicas:         decision.MaxReplicas,
+		MetricSamples:       externalMetricSamples(decision.MetricSamples),
+		PreferredPool:       decision.PreferredPool,
+	})
+	if err != nil {
+		// Report the status message rather than the full "rpc error: code = ..." text
+		if s, ok := status.FromError(err); ok {
+			return Advice{}, fmt.Errorf("%s: %s", s.Code(), s.Message())
+		}
+		return Advice{}, err
+	}
+	if resp.GetMaxReplicas() < 0 {
+		return Advice{}, fmt.Errorf("invalid max replicas %d", resp.GetMaxReplicas())
+	}
+	advice := Advice{
+		PreferredPool: resp.GetPreferredPool(),
+		MaxReplicas:   resp.GetMaxReplicas(),
+		Reason:        resp.GetReason(),
+	}
+	if resp.GetExpiresAt() != nil {
+		advice.ExpiresAt = resp.GetExpiresAt().AsTime()
+	}
+	return advice, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	externalv1 "github.com/pmady/kubeai-autoscaler/api/external/v1"
+)
+
+// fakeOptimizerServer answers Advise with advise
+type fakeOptimizerServer struct {
+	externalv1.UnimplementedOptimizerServer
+	advise func(ctx context.Context, in *externalv1.DecisionContext) (*externalv1.Advice, error)
+}
+
+func (s *fakeOptimizerServer) Advise(ctx context.Context, in *externalv1.DecisionContext) (*externalv1.Advice, error) {
+	return s.advise(ctx, in)
+}
+
+// dialFakeOptimizer serves server in process and returns a connection to it
+func dialFakeOptimizer(t *testing.T, server *fakeOptimizerServer) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	externalv1.RegisterOptimizerServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestExternalOptimizer(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	decision := DecisionContext{
+		PolicyName:          "llm",
+		PolicyNamespace:     "default",
+		TargetKind:          "Deployment",
+		TargetName:          "llm",
+		Algorithm:           "MaxRatio",
+		CurrentReplicas:     2,
+		RecommendedReplicas: 6,
+		MinReplicas:         1,
+		MaxReplicas:         10,
+		MetricSamples: map[string]MetricSample{
+			MetricGPUUtilization: {Current: 90, Target: 30, Ratio: 3, Timestamp: now},
+		},
+	}
+
+	var fail bool
+	received := make(chan *externalv1.DecisionContext, 4)
+	conn := dialFakeOptimizer(t, &fakeOptimizerServer{
+		advise: func(ctx context.Context, in *externalv1.DecisionContext) (*externalv1.Advice, error) {
+			received <- in
+			if fail {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return &externalv1.Advice{
+				PreferredPool: "a100",
+				MaxReplicas:   4,
+				Reason:        "hourly budget",
+				ExpiresAt:     timestamppb.New(now.Add(time.Hour)),
+			}, nil
+		},
+	})
+	optimizer := NewExternalOptimizer(conn, 50*time.Millisecond)
+	optimizer.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	advice, err := optimizer.Advise(ctx, decision)
+	require.NoError(t, err)
+	assert.Equal(t, Advice{PreferredPool: "a100", MaxReplicas: 4, Reason: "hourly budget", ExpiresAt: now.Add(time.Hour)}, advice)
+	in := <-received
+	assert.Equal(t, int32(6), in.RecommendedReplicas)
+	assert.Equal(t, "Deployment", in.TargetKind)
+	assert.Equal(t, 3.0, in.MetricSamples[MetricGPUUtilization].GetRatio())
+
+	// A slow optimizer falls back to the unexpired last advice
+	fail = true
+	advice, err = optimizer.Advise(ctx, decision)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), advice.MaxReplicas)
+	assert.Contains(t, advice.Reason, "reused after optimizer error: DeadlineExceeded")
+	<-received
+
+	// Expired advice is not reused
+	optimizer.now = func() time.Time { return now.Add(2 * time.Hour) }
+	_, err = optimizer.Advise(ctx, decision)
+	assert.ErrorContains(t, err, "DeadlineExceeded")
+	<-received
+}
+
+func TestExternalOptimizerRejectsInvalidAdvice(t *testing.T) {
+	conn := dialFakeOptimizer(t, &fakeOptimizerServer{
+		advise: func(context.Context, *externalv1.DecisionContext) (*externalv1.Advice, error) {
+			return &externalv1.Advice{MaxReplicas: -1}, nil
+		},
+	})
+	_, err := NewExternalOptimizer(conn, 0).Advise(context.Background(), DecisionContext{PolicyName: "llm"})
+	assert.ErrorContains(t, err, "invalid max replicas -1")
+
+	conn = dialFakeOptimizer(t, &fakeOptimizerServer{
+		advise: func(context.Context, *externalv1.DecisionContext) (*externalv1.Advice, error) {
+			return nil, status.Error(codes.Unavailable, "profiles not loaded")
+		},
+	})
+	_, err = NewExternalOptimizer(conn, 0).Advise(context.Background(), DecisionContext{PolicyName: "llm"})
+	assert.ErrorContains(t, err, "Unavailable: profiles not loaded")
+}
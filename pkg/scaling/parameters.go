@@ -0,0 +1,195 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parameter types an algorithm may declare
+const (
+	ParameterString   = "string"
+	ParameterInt      = "int"
+	ParameterFloat    = "float"
+	ParameterBool     = "bool"
+	ParameterDuration = "duration"
+)
+
+// ParameterSpec declares a parameter an algorithm accepts through
+// spec.algorithm.parameters
+type ParameterSpec struct {
+	// Name is the key of the parameter in spec.algorithm.parameters
+	Name string `json:"name"`
+	// Type is one of the Parameter* types; values are checked against it before
+	// they reach the algorithm
+	Type string `json:"type"`
+	// Default is the value used when the policy does not set the parameter
+	Default string `json:"default,omitempty"`
+	// Description documents the parameter for policy authors
+	Description string `json:"description,omitempty"`
+}
+
+// ParameterizedAlgorithm is implemented by algorithms that can be configured
+// per policy. Registered instances are shared by every policy, so
+// WithParameters must return a configured copy and leave the receiver
+// untouched. Per-policy state that must outlive a reconcile belongs in a
+// StateStore, not in the copy.
+type ParameterizedAlgorithm interface {
+	ScalingAlgorithm
+	// Parameters declares the parameters the algorithm accepts
+	Parameters() []ParameterSpec
+	// WithParameters returns a copy of the algorithm configured with params,
+	// which have already been checked against Parameters
+	WithParameters(params Parameters) (ScalingAlgorithm, error)
+}
+
+// ErrParametersNotSupported is returned when parameters are set for an
+// algorithm that does not implement ParameterizedAlgorithm
+type ErrParametersNotSupported struct {
+	Name string
+}
+
+func (e ErrParametersNotSupported) Error() string {
+	return fmt.Sprintf("algorithm does not accept parameters: name=%q", e.Name)
+}
+
+// Parameters holds the parameters of a policy by name. The typed getters
+// return the fallback when a parameter is not set.
+type Parameters map[string]string
+
+// String returns the named parameter or fallback
+func (p Parameters) String(name, fallback string) string {
+	if value, ok := p[name]; ok {
+		return value
+	}
+	return fallback
+}
+
+// Int returns the named parameter as an integer or fallback
+func (p Parameters) Int(name string, fallback int64) (int64, error) {
+	value, ok := p[name]
+	if !ok {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parameter %q: %q is not an integer", name, value)
+	}
+	return parsed, nil
+}
+
+// Float returns the named parameter as a float or fallback
+func (p Parameters) Float(name string, fallback float64) (float64, error) {
+	value, ok := p[name]
+	if !ok {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("parameter %q: %q is not a number", name, value)
+	}
+	return parsed, nil
+}
+
+// Bool returns the named parameter as a boolean or fallback
+func (p Parameters) Bool(name string, fallback bool) (bool, error) {
+	value, ok := p[name]
+	if !ok {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return false, fmt.Errorf("parameter %q: %q is not a boolean", name, value)
+	}
+	return parsed, nil
+}
+
+// Duration returns the named parameter as a duration such as "90s" or fallback
+func (p Parameters) Duration(name string, fallback time.Duration) (time.Duration, error) {
+	value, ok := p[name]
+	if !ok {
+		return fallback, nil
+	}
+	parsed, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("parameter %q: %q is not a duration", name, value)
+	}
+	return parsed, nil
+}
+
+// ValidateParameters checks that every parameter is declared in specs and
+// parses as its declared type
+func ValidateParameters(specs []ParameterSpec, params Parameters) error {
+	declared := make(map[string]ParameterSpec, len(specs))
+	for _, spec := range specs {
+		declared[spec.Name] = spec
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		spec, ok := declared[name]
+		if !ok {
+			return fmt.Errorf("unknown parameter %q", name)
+		}
+		var err error
+		switch spec.Type {
+		case ParameterInt:
+			_, err = params.Int(name, 0)
+		case ParameterFloat:
+			_, err = params.Float(name, 0)
+		case ParameterBool:
+			_, err = params.Bool(name, false)
+		case ParameterDuration:
+			_, err = params.Duration(name, 0)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Configure returns algorithm configured with params. Without params the
+// algorithm is returned unchanged; params for an algorithm that does not
+// implement ParameterizedAlgorithm return ErrParametersNotSupported, and
+// params it rejects return ErrInvalidParameters.
+func Configure(algorithm ScalingAlgorithm, params Parameters) (ScalingAlgorithm, error) {
+	if len(params) == 0 {
+		return algorithm, nil
+	}
+	parameterized, ok := algorithm.(ParameterizedAlgorithm)
+	if !ok {
+		return algorithm, ErrParametersNotSupported{Name: algorithm.Name()}
+	}
+	if err := ValidateParameters(parameterized.Parameters(), params); err != nil {
+		return algorithm, ErrInvalidParameters{Name: algorithm.Name(), Err: err}
+	}
+	configured, err := parameterized.WithParameters(params)
+	if err != nil {
+		return algorithm, ErrInvalidParameters{Name: algorithm.Name(), Err: err}
+	}
+	return configured, nil
+}
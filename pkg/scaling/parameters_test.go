@@ -0,0 +1,119 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedAlgorithm recommends a configurable replica count
+type fixedAlgorithm struct {
+	replicas int64
+}
+
+func (f *fixedAlgorithm) Name() string {
+	return "Fixed"
+}
+
+func (f *fixedAlgorithm) ComputeScale(_ context.Context, _ ScalingInput) (ScalingResult, error) {
+	return ScalingResult{DesiredReplicas: int32(f.replicas)}, nil
+}
+
+func (f *fixedAlgorithm) Parameters() []ParameterSpec {
+	return []ParameterSpec{{Name: "replicas", Type: ParameterInt, Default: "1"}}
+}
+
+func (f *fixedAlgorithm) WithParameters(params Parameters) (ScalingAlgorithm, error) {
+	replicas, err := params.Int("replicas", f.replicas)
+	if err != nil {
+		return nil, err
+	}
+	return &fixedAlgorithm{replicas: replicas}, nil
+}
+
+func TestParametersGetters(t *testing.T) {
+	params := Parameters{"name": "fast", "count": " 3 ", "factor": "0.5", "enabled": "true", "window": "90s", "bad": "x"}
+
+	assert.Equal(t, "fast", params.String("name", "slow"))
+	assert.Equal(t, "slow", params.String("missing", "slow"))
+
+	count, err := params.Int("count", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	factor, err := params.Float("factor", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, factor)
+
+	enabled, err := params.Bool("enabled", false)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	window, err := params.Duration("window", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, window)
+
+	fallback, err := params.Float("missing", 0.3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.3, fallback)
+
+	_, err = params.Int("bad", 1)
+	assert.ErrorContains(t, err, `parameter "bad"`)
+	_, err = params.Duration("bad", 0)
+	assert.Error(t, err)
+}
+
+func TestValidateParameters(t *testing.T) {
+	specs := []ParameterSpec{
+		{Name: "factor", Type: ParameterFloat},
+		{Name: "label", Type: ParameterString},
+	}
+
+	assert.NoError(t, ValidateParameters(specs, nil))
+	assert.NoError(t, ValidateParameters(specs, Parameters{"factor": "0.2", "label": "anything"}))
+	assert.ErrorContains(t, ValidateParameters(specs, Parameters{"factr": "0.2"}), `unknown parameter "factr"`)
+	assert.ErrorContains(t, ValidateParameters(specs, Parameters{"factor": "high"}), "is not a number")
+}
+
+func TestConfigure(t *testing.T) {
+	shared := &fixedAlgorithm{replicas: 1}
+
+	// Without parameters the algorithm is used as is
+	algo, err := Configure(shared, nil)
+	require.NoError(t, err)
+	assert.Same(t, shared, algo)
+
+	algo, err = Configure(shared, Parameters{"replicas": "4"})
+	require.NoError(t, err)
+	result, err := algo.ComputeScale(context.Background(), ScalingInput{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.DesiredReplicas)
+	assert.Equal(t, int64(1), shared.replicas)
+
+	algo, err = Configure(shared, Parameters{"replicas": "four"})
+	assert.ErrorContains(t, err, `invalid parameters for algorithm "Fixed"`)
+	assert.Same(t, shared, algo)
+
+	maxRatio := NewMaxRatioAlgorithm(DefaultTolerance)
+	_, err = Configure(maxRatio, Parameters{"replicas": "4"})
+	assert.ErrorAs(t, err, &ErrParametersNotSupported{})
+}
@@ -21,7 +21,6 @@ package scaling
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 	"plugin"
 )
 
@@ -99,70 +98,3 @@ func LoadPlugin(path string) (ScalingAlgorithm, error) {
 
 	return algorithm, nil
 }
-
-// LoadPlugins loads all plugins from the given directory
-// Returns a slice of loaded algorithms and any errors encountered
-func LoadPlugins(dir string) ([]ScalingAlgorithm, error) {
-	// Check if directory exists
-	info, err := os.Stat(dir)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("plugin directory not found: path=%q", dir)
-		}
-		return nil, fmt.Errorf("failed to stat plugin directory %q: %w", dir, err)
-	}
-	if !info.IsDir() {
-		return nil, fmt.Errorf("plugin path is not a directory: path=%q", dir)
-	}
-
-	// Find all .so files in the directory
-	pattern := filepath.Join(dir, "*.so")
-	matches, err := filepath.Glob(pattern)
-	if err != nil {
-		return nil, fmt.Errorf("failed to glob plugins: %w", err)
-	}
-
-	var algorithms []ScalingAlgorithm
-	var loadErrors []error
-
-	for _, path := range matches {
-		algorithm, err := LoadPlugin(path)
-		if err != nil {
-			loadErrors = append(loadErrors, err)
-			continue
-		}
-		algorithms = append(algorithms, algorithm)
-	}
-
-	// Return combined error if any plugins failed to load
-	if len(loadErrors) > 0 {
-		return algorithms, fmt.Errorf("failed to load %d plugin(s): %v", len(loadErrors), loadErrors)
-	}
-
-	return algorithms, nil
-}
-
-// LoadAndRegisterPlugins loads all plugins from the directory and registers them
-func LoadAndRegisterPlugins(dir string, registry *Registry) error {
-	algorithms, err := LoadPlugins(dir)
-	if err != nil {
-		// Log but don't fail if some plugins couldn't be loaded
-		// The successfully loaded plugins will still be registered
-		if len(algorithms) == 0 {
-			return err
-		}
-	}
-
-	var registrationErrors []error
-	for _, alg := range algorithms {
-		if err := registry.Register(alg); err != nil {
-			registrationErrors = append(registrationErrors, err)
-		}
-	}
-
-	if len(registrationErrors) > 0 {
-		return fmt.Errorf("failed to register %d algorithm(s): %v", len(registrationErrors), registrationErrors)
-	}
-
-	return nil
-}
@@ -70,13 +70,3 @@ func (e ErrPluginInterfaceMismatch) Error() string {
 func LoadPlugin(path string) (ScalingAlgorithm, error) {
 	return nil, ErrPluginsNotSupported
 }
-
-// LoadPlugins returns an error on unsupported platforms
-func LoadPlugins(dir string) ([]ScalingAlgorithm, error) {
-	return nil, ErrPluginsNotSupported
-}
-
-// LoadAndRegisterPlugins returns an error on unsupported platforms
-func LoadAndRegisterPlugins(dir string, registry *Registry) error {
-	return ErrPluginsNotSupported
-}
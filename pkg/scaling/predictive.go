@@ -0,0 +1,272 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultForecastHorizon is how far ahead the Predictive algorithm looks when none is configured
+	DefaultForecastHorizon = 5 * time.Minute
+	// PredictiveSeasonSlots is the number of seasonal components a seasonality period is divided into
+	PredictiveSeasonSlots = 24
+
+	// Default smoothing factors of the level, trend and seasonal components
+	predictiveLevelFactor  = 0.5
+	predictiveTrendFactor  = 0.3
+	predictiveSeasonFactor = 0.3
+)
+
+// StateStore entries holding the forecast of each policy
+const (
+	predictiveLevelState  = "predictive.level"
+	predictiveTrendState  = "predictive.trend"
+	predictiveTimeState   = "predictive.time"
+	predictiveSeasonState = "predictive.season."
+)
+
+// PredictiveAlgorithm scales ahead of anticipated load. It tracks the replicas
+// the current load needs (the current replicas times the maximum metric ratio)
+// per policy with Holt-Winters exponential smoothing: a level, a trend per
+// second and, when a seasonality period is set, an additive seasonal component
+// for each slot of the period. The recommendation is the larger of the current
+// need and the need forecast one horizon ahead, so the target grows before a
+// rise arrives and shrinks only once both the current and forecast load drop.
+type PredictiveAlgorithm struct {
+	// Horizon is how far ahead load is forecast
+	Horizon time.Duration
+	// SeasonalityPeriod is the length of a recurring load pattern, e.g. 24h;
+	// zero disables the seasonal component
+	SeasonalityPeriod time.Duration
+	// LevelSmoothing, TrendSmoothing and SeasonSmoothing are the weights (0-1]
+	// given to the newest observation by each component; zero keeps the default
+	LevelSmoothing  float64
+	TrendSmoothing  float64
+	SeasonSmoothing float64
+
+	state *StateStore
+	now   func() time.Time
+}
+
+// NewPredictiveAlgorithm creates a new PredictiveAlgorithm; a nil state uses DefaultStateStore
+func NewPredictiveAlgorithm(state *StateStore) *PredictiveAlgorithm {
+	if state == nil {
+		state = DefaultStateStore
+	}
+	return &PredictiveAlgorithm{
+		Horizon: DefaultForecastHorizon,
+		state:   state,
+		now:     time.Now,
+	}
+}
+
+// Name returns the algorithm name
+func (a *PredictiveAlgorithm) Name() string {
+	return "Predictive"
+}
+
+// Description summarizes how the algorithm computes replicas
+func (a *PredictiveAlgorithm) Description() string {
+	return "Forecasts the replicas needed spec.algorithm.forecastHorizonSeconds ahead with " +
+		"Holt-Winters smoothing, seasonal over spec.algorithm.seasonalityPeriodSeconds when set, " +
+		"and scales to the larger of the current and forecast need."
+}
+
+// SetForecast allows updating the forecast horizon and seasonality period;
+// a zero horizon keeps DefaultForecastHorizon
+func (a *PredictiveAlgorithm) SetForecast(horizon, seasonalityPeriod time.Duration) {
+	if horizon <= 0 {
+		horizon = DefaultForecastHorizon
+	}
+	a.Horizon = horizon
+	a.SeasonalityPeriod = seasonalityPeriod
+}
+
+// SetStateStore allows replacing the store the forecasts are kept in
+func (a *PredictiveAlgorithm) SetStateStore(state *StateStore) {
+	a.state = state
+}
+
+// Parameters declares the smoothing factors a policy can tune
+func (a *PredictiveAlgorithm) Parameters() []ParameterSpec {
+	return []ParameterSpec{
+		{Name: "levelSmoothing", Type: ParameterFloat, Default: "0.5", Description: "Weight (0-1] of the newest load in the level"},
+		{Name: "trendSmoothing", Type: ParameterFloat, Default: "0.3", Description: "Weight (0-1] of the newest change in the trend"},
+		{Name: "seasonSmoothing", Type: ParameterFloat, Default: "0.3", Description: "Weight (0-1] of the newest deviation in a seasonal component"},
+	}
+}
+
+// WithParameters returns a copy of the algorithm with the given smoothing factors
+func (a *PredictiveAlgorithm) WithParameters(params Parameters) (ScalingAlgorithm, error) {
+	algoCopy := *a
+	for _, factor := range []struct {
+		name  string
+		value *float64
+	}{
+		{"levelSmoothing", &algoCopy.LevelSmoothing},
+		{"trendSmoothing", &algoCopy.TrendSmoothing},
+		{"seasonSmoothing", &algoCopy.SeasonSmoothing},
+	} {
+		value, err := params.Float(factor.name, *factor.value)
+		if err != nil {
+			return nil, err
+		}
+		if _, set := params[factor.name]; set && (value <= 0 || value > 1) {
+			return nil, fmt.Errorf("parameter %q must be greater than 0 and at most 1", factor.name)
+		}
+		*factor.value = value
+	}
+	return &algoCopy, nil
+}
+
+// ComputeScale implements the ScalingAlgorithm interface
+func (a *PredictiveAlgorithm) ComputeScale(_ context.Context, input ScalingInput) (ScalingResult, error) {
+	if len(input.MetricRatios) == 0 {
+		return ScalingResult{
+			DesiredReplicas:   max(min(input.CurrentReplicas, input.MaxReplicas), input.MinReplicas),
+			Reason:            "no metrics available",
+			UnclampedReplicas: input.CurrentReplicas,
+		}, nil
+	}
+
+	maxRatio := input.MetricRatios[0]
+	for _, ratio := range input.MetricRatios[1:] {
+		maxRatio = max(maxRatio, ratio)
+	}
+	replicas := max(input.CurrentReplicas, 1)
+	needed := float64(replicas) * maxRatio
+
+	forecast, err := a.observe(StateKey(input), a.now(), needed)
+	if err != nil {
+		return ScalingResult{}, err
+	}
+	target := max(needed, forecast)
+	ratio := target / float64(replicas)
+
+	// Apply tolerance
+	if ratio >= (1-input.Tolerance) && ratio <= (1+input.Tolerance) {
+		return ScalingResult{
+			DesiredReplicas:   max(min(input.CurrentReplicas, input.MaxReplicas), input.MinReplicas),
+			Reason:            "within tolerance",
+			DrivingMetric:     largestRatioMetric(input.MetricSamples),
+			UnclampedReplicas: input.CurrentReplicas,
+		}, nil
+	}
+
+	unclamped := int32(math.Ceil(target))
+	desiredReplicas := max(min(unclamped, input.MaxReplicas), input.MinReplicas)
+	reason := "scaled based on current load"
+	if forecast > needed {
+		reason = fmt.Sprintf("scaled ahead of forecast load (%.1f replicas in %s)", forecast, a.horizon())
+	}
+	return ScalingResult{
+		DesiredReplicas:   desiredReplicas,
+		Reason:            reason,
+		DrivingMetric:     largestRatioMetric(input.MetricSamples),
+		UnclampedReplicas: unclamped,
+	}, nil
+}
+
+// Learned reports whether a forecast is stored for the policy under key
+func (a *PredictiveAlgorithm) Learned(key string) bool {
+	_, ok := a.state.Get(key, predictiveLevelState)
+	return ok
+}
+
+// Learn folds the replicas needed at a past time into the forecast of the
+// policy stored under key, e.g. to replay history recorded before a restart.
+// Observations must be learned oldest first.
+func (a *PredictiveAlgorithm) Learn(key string, at time.Time, needed float64) error {
+	_, err := a.observe(key, at, needed)
+	return err
+}
+
+// observe folds the replicas needed at the given time into the forecast of the
+// policy stored under key and returns the replicas forecast one horizon ahead.
+// Stored values that are not finite, e.g. from a restored snapshot, return
+// ErrStateCorrupt.
+func (a *PredictiveAlgorithm) observe(key string, at time.Time, needed float64) (float64, error) {
+	now := float64(at.UnixNano()) / float64(time.Second)
+	horizon := a.horizon().Seconds()
+
+	level, seen := a.state.Get(key, predictiveLevelState)
+	trend, _ := a.state.Get(key, predictiveTrendState)
+	last, _ := a.state.Get(key, predictiveTimeState)
+	seasonName := a.seasonState(now)
+	season, _ := a.state.Get(key, seasonName)
+	for name, value := range map[string]float64{predictiveLevelState: level, predictiveTrendState: trend, predictiveTimeState: last, seasonName: season} {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return 0, ErrStateCorrupt{Name: a.Name(), Detail: fmt.Sprintf("%s is %v", name, value)}
+		}
+	}
+
+	if !seen {
+		level = needed - season
+	} else {
+		elapsed := max(now-last, 0)
+		previous := level
+		levelFactor := smoothing(a.LevelSmoothing, predictiveLevelFactor)
+		level = levelFactor*(needed-season) + (1-levelFactor)*(previous+trend*elapsed)
+		if elapsed > 0 {
+			trendFactor := smoothing(a.TrendSmoothing, predictiveTrendFactor)
+			trend = trendFactor*(level-previous)/elapsed + (1-trendFactor)*trend
+		}
+	}
+	a.state.Set(key, predictiveLevelState, level)
+	a.state.Set(key, predictiveTrendState, trend)
+	a.state.Set(key, predictiveTimeState, now)
+
+	forecast := level + trend*horizon
+	if seasonName != "" {
+		seasonFactor := smoothing(a.SeasonSmoothing, predictiveSeasonFactor)
+		a.state.Set(key, seasonName, seasonFactor*(needed-level)+(1-seasonFactor)*season)
+		ahead, _ := a.state.Get(key, a.seasonState(now+horizon))
+		forecast += ahead
+	}
+	return max(forecast, 0), nil
+}
+
+// seasonState returns the StateStore entry of the seasonal component covering
+// the given Unix time, or "" when seasonality is disabled
+func (a *PredictiveAlgorithm) seasonState(at float64) string {
+	period := a.SeasonalityPeriod.Seconds()
+	if period <= 0 {
+		return ""
+	}
+	slot := int(math.Mod(at, period) / period * PredictiveSeasonSlots)
+	return fmt.Sprintf("%s%d", predictiveSeasonState, min(slot, PredictiveSeasonSlots-1))
+}
+
+// smoothing returns the configured smoothing factor or its default
+func smoothing(factor, fallback float64) float64 {
+	if factor <= 0 {
+		return fallback
+	}
+	return factor
+}
+
+// horizon returns the configured horizon or DefaultForecastHorizon
+func (a *PredictiveAlgorithm) horizon() time.Duration {
+	if a.Horizon <= 0 {
+		return DefaultForecastHorizon
+	}
+	return a.Horizon
+}
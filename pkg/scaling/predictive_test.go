@@ -0,0 +1,198 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNow returns a clock function and a func advancing it
+func fakeNow() (func() time.Time, func(time.Duration)) {
+	now := time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)
+	return func() time.Time { return now }, func(d time.Duration) { now = now.Add(d) }
+}
+
+func predictiveInput(current int32, ratio float64) ScalingInput {
+	return ScalingInput{
+		CurrentReplicas: current,
+		MinReplicas:     1,
+		MaxReplicas:     50,
+		MetricRatios:    []float64{ratio},
+		Tolerance:       DefaultTolerance,
+		PolicyName:      "llm",
+		PolicyNamespace: "default",
+	}
+}
+
+func TestPredictiveAlgorithm_ScalesAheadOfTrend(t *testing.T) {
+	algo := NewPredictiveAlgorithm(NewStateStore())
+	now, advance := fakeNow()
+	algo.now = now
+	algo.SetForecast(2*time.Minute, 0)
+
+	// Load grows by one replica's worth every 30s while the target keeps up
+	var result ScalingResult
+	for replicas := int32(4); replicas <= 10; replicas++ {
+		var err error
+		result, err = algo.ComputeScale(context.Background(), predictiveInput(replicas, float64(replicas+1)/float64(replicas)))
+		require.NoError(t, err)
+		advance(30 * time.Second)
+	}
+
+	// Eleven replicas are needed now; the trend adds several more within two minutes
+	assert.Greater(t, result.DesiredReplicas, int32(11))
+	assert.Contains(t, result.Reason, "scaled ahead of forecast load")
+}
+
+func TestPredictiveAlgorithm_SteadyLoad(t *testing.T) {
+	algo := NewPredictiveAlgorithm(NewStateStore())
+	now, advance := fakeNow()
+	algo.now = now
+
+	for i := 0; i < 10; i++ {
+		result, err := algo.ComputeScale(context.Background(), predictiveInput(4, 1.0))
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), result.DesiredReplicas)
+		advance(30 * time.Second)
+	}
+
+	// A drop is followed at once when no rise is forecast
+	result, err := algo.ComputeScale(context.Background(), predictiveInput(4, 0.5))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), result.DesiredReplicas)
+	assert.Equal(t, "scaled based on current load", result.Reason)
+}
+
+func TestPredictiveAlgorithm_Seasonality(t *testing.T) {
+	algo := NewPredictiveAlgorithm(NewStateStore())
+	now, advance := fakeNow()
+	algo.now = now
+	algo.SetForecast(time.Hour, 24*time.Hour)
+
+	// Two replicas are needed all day except for a peak of 10 from 12:00 to 14:00
+	needed := func() float64 {
+		if hour := now().Hour(); hour >= 12 && hour < 14 {
+			return 10
+		}
+		return 2
+	}
+	for day := 0; day < 5; day++ {
+		for step := 0; step < 24*6; step++ {
+			_, err := algo.ComputeScale(context.Background(), predictiveInput(2, needed()/2))
+			require.NoError(t, err)
+			advance(10 * time.Minute)
+		}
+	}
+
+	// At 11:00 the load is still low, but the peak an hour later is anticipated
+	advance(11 * time.Hour)
+	result, err := algo.ComputeScale(context.Background(), predictiveInput(2, 1.0))
+	require.NoError(t, err)
+	assert.Greater(t, result.DesiredReplicas, int32(3))
+
+	// A policy without seasonality does not anticipate the peak
+	flat := NewPredictiveAlgorithm(NewStateStore())
+	flat.now = now
+	flat.SetForecast(time.Hour, 0)
+	result, err = flat.ComputeScale(context.Background(), predictiveInput(2, 1.0))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), result.DesiredReplicas)
+}
+
+func TestPredictiveAlgorithm_StatePerPolicy(t *testing.T) {
+	state := NewStateStore()
+	algo := NewPredictiveAlgorithm(state)
+
+	_, err := algo.ComputeScale(context.Background(), predictiveInput(4, 2.0))
+	require.NoError(t, err)
+	level, ok := state.Get("default/llm", predictiveLevelState)
+	require.True(t, ok)
+	assert.Equal(t, 8.0, level)
+
+	state.Forget("default/llm")
+	_, ok = state.Get("default/llm", predictiveLevelState)
+	assert.False(t, ok)
+}
+
+func TestPredictiveAlgorithm_CorruptState(t *testing.T) {
+	state := NewStateStore()
+	algo := NewPredictiveAlgorithm(state)
+	state.Set("default/llm", predictiveLevelState, math.NaN())
+
+	_, err := algo.ComputeScale(context.Background(), predictiveInput(4, 1.5))
+	var corrupt ErrStateCorrupt
+	require.ErrorAs(t, err, &corrupt)
+	assert.Equal(t, "Predictive", corrupt.Name)
+	assert.Equal(t, ErrorTypeStateCorrupt, ErrorType(err))
+}
+
+func TestPredictiveAlgorithm_Learn(t *testing.T) {
+	algo := NewPredictiveAlgorithm(NewStateStore())
+	now, _ := fakeNow()
+	algo.now = now
+	algo.SetForecast(time.Hour, 24*time.Hour)
+	assert.False(t, algo.Learned("default/llm"))
+
+	// Replaying five days of history with a daily peak from 12:00 to 14:00
+	start := now().Add(-5 * 24 * time.Hour)
+	for at := start; at.Before(now()); at = at.Add(10 * time.Minute) {
+		needed := 2.0
+		if hour := at.Hour(); hour >= 12 && hour < 14 {
+			needed = 10
+		}
+		require.NoError(t, algo.Learn("default/llm", at, needed))
+	}
+	assert.True(t, algo.Learned("default/llm"))
+
+	// anticipates the peak at 11:00 on the first reconcile after the replay
+	algo.now = func() time.Time { return now().Add(11 * time.Hour) }
+	result, err := algo.ComputeScale(context.Background(), predictiveInput(2, 1.0))
+	require.NoError(t, err)
+	assert.Greater(t, result.DesiredReplicas, int32(3))
+}
+
+func TestPredictiveAlgorithm_Parameters(t *testing.T) {
+	store := NewStateStore()
+	algo := NewPredictiveAlgorithm(store)
+	now, _ := fakeNow()
+	algo.now = now
+	algo.SetForecast(time.Minute, 0)
+
+	configured, err := Configure(algo, Parameters{"levelSmoothing": "1"})
+	require.NoError(t, err)
+	predictive := configured.(*PredictiveAlgorithm)
+	assert.Equal(t, 1.0, predictive.LevelSmoothing)
+	assert.Equal(t, time.Minute, predictive.Horizon, "the copy keeps the forecast settings")
+	assert.Zero(t, algo.LevelSmoothing, "the registered instance is left untouched")
+
+	// With all the weight on the newest load the level follows it at once
+	key := StateKey(predictiveInput(4, 1))
+	require.NoError(t, predictive.Learn(key, now(), 4))
+	require.NoError(t, predictive.Learn(key, now(), 8))
+	level, _ := store.Get(key, predictiveLevelState)
+	assert.Equal(t, 8.0, level)
+
+	_, err = Configure(algo, Parameters{"trendSmoothing": "1.5"})
+	assert.ErrorAs(t, err, &ErrInvalidParameters{})
+	assert.ErrorContains(t, err, `parameter "trendSmoothing" must be greater than 0 and at most 1`)
+}
@@ -72,15 +72,50 @@ func (r *Registry) Register(algorithm ScalingAlgorithm) error {
 	if name == "" {
 		return ErrInvalidAlgorithmName{}
 	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	if _, exists := r.algorithms[name]; exists {
 		return ErrAlgorithmAlreadyRegistered{Name: name}
 	}
+	r.algorithms[name] = algorithm
+	return nil
+}
+
+// Replace adds an algorithm to the registry, replacing any algorithm registered
+// under the same name. It returns the replaced algorithm, or nil if there was none.
+// Reconciles that already looked up the replaced algorithm finish with it.
+func (r *Registry) Replace(algorithm ScalingAlgorithm) (ScalingAlgorithm, error) {
+	if algorithm == nil {
+		return nil, fmt.Errorf("cannot register nil algorithm")
+	}
+
+	name := strings.TrimSpace(algorithm.Name())
+	if name == "" {
+		return nil, ErrInvalidAlgorithmName{}
+	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	previous := r.algorithms[name]
 	r.algorithms[name] = algorithm
-	return nil
+	return previous, nil
+}
+
+// Unregister removes the named algorithm from the registry and returns it.
+// Returns ErrAlgorithmNotFound if no algorithm has that name.
+func (r *Registry) Unregister(name string) (ScalingAlgorithm, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	algorithm, exists := r.algorithms[name]
+	if !exists {
+		return nil, ErrAlgorithmNotFound{Name: name}
+	}
+	delete(r.algorithms, name)
+	return algorithm, nil
 }
 
 // MustRegister adds an algorithm to the registry and panics on error
@@ -125,6 +160,24 @@ func (r *Registry) Has(name string) bool {
 	return exists
 }
 
+// Describe returns the metadata of every registered algorithm sorted by name
+func (r *Registry) Describe() []AlgorithmInfo {
+	r.mu.RLock()
+	algorithms := make([]ScalingAlgorithm, 0, len(r.algorithms))
+	for _, algorithm := range r.algorithms {
+		algorithms = append(algorithms, algorithm)
+	}
+	r.mu.RUnlock()
+
+	// Algorithms may be plugins; describe them without holding the lock
+	infos := make([]AlgorithmInfo, 0, len(algorithms))
+	for _, algorithm := range algorithms {
+		infos = append(infos, DescribeAlgorithm(algorithm))
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
 // DefaultRegistry is the global algorithm registry
 var DefaultRegistry = NewRegistry()
 
@@ -136,6 +189,8 @@ func init() {
 	DefaultRegistry.MustRegister(NewMaxRatioAlgorithm(DefaultTolerance))
 	DefaultRegistry.MustRegister(NewAverageRatioAlgorithm(DefaultTolerance))
 	DefaultRegistry.MustRegister(NewWeightedRatioAlgorithm(DefaultTolerance, nil))
+	DefaultRegistry.MustRegister(NewPredictiveAlgorithm(nil))
+	DefaultRegistry.MustRegister(NewLittlesLawAlgorithm())
 }
 
 // Register adds an algorithm to the default registry
@@ -152,3 +207,8 @@ func Get(name string) (ScalingAlgorithm, error) {
 func List() []string {
 	return DefaultRegistry.List()
 }
+
+// Describe returns the metadata of every algorithm in the default registry
+func Describe() []AlgorithmInfo {
+	return DefaultRegistry.Describe()
+}
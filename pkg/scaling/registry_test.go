@@ -104,6 +104,62 @@ func TestRegistry_InvalidAlgorithmName(t *testing.T) {
 	assert.ErrorAs(t, err, &invalidNameErr)
 }
 
+func TestRegistry_Replace(t *testing.T) {
+	r := NewRegistry()
+
+	algo1 := &mockAlgorithm{name: "ReplacedAlgo"}
+	previous, err := r.Replace(algo1)
+	require.NoError(t, err)
+	assert.Nil(t, previous)
+
+	algo2 := &mockAlgorithm{name: "ReplacedAlgo"}
+	previous, err = r.Replace(algo2)
+	require.NoError(t, err)
+	assert.Same(t, algo1, previous)
+
+	got, err := r.Get("ReplacedAlgo")
+	require.NoError(t, err)
+	assert.Same(t, algo2, got)
+
+	_, err = r.Replace(nil)
+	assert.Error(t, err)
+	_, err = r.Replace(&mockAlgorithm{name: " "})
+	assert.ErrorAs(t, err, &ErrInvalidAlgorithmName{})
+}
+
+func TestRegistry_Unregister(t *testing.T) {
+	r := NewRegistry()
+	algo := &mockAlgorithm{name: "RemovedAlgo"}
+	require.NoError(t, r.Register(algo))
+
+	removed, err := r.Unregister("RemovedAlgo")
+	require.NoError(t, err)
+	assert.Same(t, algo, removed)
+	assert.False(t, r.Has("RemovedAlgo"))
+
+	_, err = r.Unregister("RemovedAlgo")
+	assert.ErrorAs(t, err, &ErrAlgorithmNotFound{})
+
+	// The name can be registered again
+	assert.NoError(t, r.Register(&mockAlgorithm{name: "RemovedAlgo"}))
+}
+
+func TestRegistry_Describe(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register(NewMaxRatioAlgorithm(DefaultTolerance)))
+	require.NoError(t, r.Register(&fixedAlgorithm{replicas: 3}))
+	require.NoError(t, r.Register(&mockAlgorithm{name: "Bare"}))
+
+	infos := r.Describe()
+	require.Len(t, infos, 3)
+	assert.Equal(t, AlgorithmInfo{Name: "Bare"}, infos[0])
+	assert.Equal(t, "Fixed", infos[1].Name)
+	assert.Equal(t, (&fixedAlgorithm{}).Parameters(), infos[1].Parameters)
+	assert.Equal(t, "MaxRatio", infos[2].Name)
+	assert.NotEmpty(t, infos[2].Description)
+	assert.Empty(t, infos[2].Parameters)
+}
+
 func TestRegistry_Get(t *testing.T) {
 	r := NewRegistry()
 	algo := &mockAlgorithm{name: "GetAlgo"}
@@ -209,7 +265,7 @@ func TestRegistry_ThreadSafety(t *testing.T) {
 
 func TestDefaultRegistry_BuiltInAlgorithms(t *testing.T) {
 	// Test that built-in algorithms are registered
-	algorithms := []string{"MaxRatio", "AverageRatio", "WeightedRatio"}
+	algorithms := []string{"MaxRatio", "AverageRatio", "WeightedRatio", "Predictive", "LittlesLaw"}
 
 	for _, name := range algorithms {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// DefaultSmoothingFactor is the weight of the newest recommendation when none is configured
+const DefaultSmoothingFactor = 0.3
+
+// smoothingStateName is the StateStore entry holding the moving average
+const smoothingStateName = "smoothing"
+
+// SmoothingConfig configures a SmoothingDecorator
+type SmoothingConfig struct {
+	// Factor is the weight of the newest recommendation in the exponential
+	// moving average (0-1]; lower values react more slowly
+	Factor float64
+	// MaxUpPercent caps the increase per computation as a percentage of the
+	// current replicas (0 disables the cap)
+	MaxUpPercent int32
+	// MaxDownPercent caps the decrease per computation as a percentage of the
+	// current replicas (0 disables the cap)
+	MaxDownPercent int32
+}
+
+// SmoothingDecorator wraps a scaling algorithm, smoothing its replica
+// recommendations with an exponential moving average and capping the change
+// per computation. The moving average of each policy is kept in a StateStore.
+type SmoothingDecorator struct {
+	base   ScalingAlgorithm
+	config SmoothingConfig
+	state  *StateStore
+}
+
+// NewSmoothingDecorator wraps base with smoothing; a nil state uses DefaultStateStore
+func NewSmoothingDecorator(base ScalingAlgorithm, config SmoothingConfig, state *StateStore) *SmoothingDecorator {
+	if config.Factor <= 0 || config.Factor > 1 {
+		config.Factor = DefaultSmoothingFactor
+	}
+	if state == nil {
+		state = DefaultStateStore
+	}
+	return &SmoothingDecorator{base: base, config: config, state: state}
+}
+
+// Name returns the name of the wrapped algorithm
+func (d *SmoothingDecorator) Name() string {
+	return d.base.Name()
+}
+
+// Timeout returns the timeout of the wrapped algorithm
+func (d *SmoothingDecorator) Timeout() time.Duration {
+	if v2, ok := d.base.(ScalingAlgorithmV2); ok {
+		return v2.Timeout()
+	}
+	return 0
+}
+
+// ComputeScale computes the wrapped recommendation and smooths it
+func (d *SmoothingDecorator) ComputeScale(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	result, err := d.base.ComputeScale(ctx, input)
+	if err != nil {
+		return result, err
+	}
+
+	key := StateKey(input)
+	smoothed := float64(result.DesiredReplicas)
+	if previous, ok := d.state.Get(key, smoothingStateName); ok {
+		smoothed = d.config.Factor*smoothed + (1-d.config.Factor)*previous
+	}
+	d.state.Set(key, smoothingStateName, smoothed)
+
+	// Round away from the current replicas so the average still converges
+	current := input.CurrentReplicas
+	var desired int32
+	if smoothed > float64(current) {
+		desired = int32(math.Ceil(smoothed))
+	} else {
+		desired = int32(math.Floor(smoothed))
+	}
+
+	if d.config.MaxUpPercent > 0 {
+		desired = min(desired, current+percentOf(current, d.config.MaxUpPercent))
+	}
+	if d.config.MaxDownPercent > 0 {
+		desired = max(desired, current-percentOf(current, d.config.MaxDownPercent))
+	}
+	desired = max(min(desired, input.MaxReplicas), input.MinReplicas)
+
+	if desired != result.DesiredReplicas {
+		result.Reason = fmt.Sprintf("%s (smoothed from %d to %d)", result.Reason, result.DesiredReplicas, desired)
+		result.DesiredReplicas = desired
+	}
+	return result, nil
+}
+
+// percentOf returns percent of replicas rounded up, so small targets can still move
+func percentOf(replicas, percent int32) int32 {
+	return int32(math.Ceil(float64(replicas) * float64(percent) / 100))
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmoothingDecorator(t *testing.T) {
+	state := NewStateStore()
+	algorithm := NewSmoothingDecorator(NewAverageRatioAlgorithm(DefaultTolerance),
+		SmoothingConfig{Factor: 0.5, MaxUpPercent: 50, MaxDownPercent: 25}, state)
+	ctx := context.Background()
+	assert.Equal(t, "AverageRatio", algorithm.Name())
+
+	compute := func(current int32, ratio float64) ScalingResult {
+		t.Helper()
+		result, err := algorithm.ComputeScale(ctx, conformanceInput(current, []float64{ratio}))
+		require.NoError(t, err)
+		return result
+	}
+
+	// The first recommendation seeds the average; the cap limits the jump to +50%
+	result := compute(4, 3.0)
+	assert.Equal(t, int32(6), result.DesiredReplicas)
+	assert.Contains(t, result.Reason, "smoothed from 12 to 6")
+	smoothed, ok := state.Get("default/policy", smoothingStateName)
+	require.True(t, ok)
+	assert.Equal(t, 12.0, smoothed)
+
+	// A brief dip is averaged away instead of scaling down
+	result = compute(6, 1.0)
+	assert.Equal(t, int32(9), result.DesiredReplicas) // (6 + 12) / 2 = 9
+
+	// A sustained drop is followed gradually
+	result = compute(9, 0.5)
+	assert.Equal(t, int32(7), result.DesiredReplicas) // (5 + 9) / 2 = 7
+
+	// and never faster than 25% per step
+	result = compute(7, 0.1)
+	assert.Equal(t, int32(5), result.DesiredReplicas) // (1 + 7) / 2 = 4, capped at 7 - 2
+
+	// Deleting the policy drops its state
+	state.Forget("default/policy")
+	_, ok = state.Get("default/policy", smoothingStateName)
+	assert.False(t, ok)
+}
+
+func TestSmoothingDecoratorDefaults(t *testing.T) {
+	algorithm := NewSmoothingDecorator(NewMaxRatioAlgorithm(DefaultTolerance), SmoothingConfig{}, nil)
+	assert.Equal(t, DefaultSmoothingFactor, algorithm.config.Factor)
+	assert.Same(t, DefaultStateStore, algorithm.state)
+
+	// Without caps the first recommendation passes through unchanged
+	input := conformanceInput(2, []float64{3.0})
+	input.PolicyName = "defaults"
+	result, err := algorithm.ComputeScale(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), result.DesiredReplicas)
+	DefaultStateStore.Forget(StateKey(input))
+}
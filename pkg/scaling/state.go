@@ -0,0 +1,102 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import "sync"
+
+// StateStore holds per-policy state for stateful algorithms and decorators in
+// one place, so it is shared across reconciles and can be dropped as a whole
+// when a policy is deleted. Values are keyed by policy and by the name of the
+// state within the policy.
+type StateStore struct {
+	mu     sync.Mutex
+	values map[string]map[string]float64
+}
+
+// NewStateStore creates an empty state store
+func NewStateStore() *StateStore {
+	return &StateStore{values: make(map[string]map[string]float64)}
+}
+
+// Get returns the named value stored for policy
+func (s *StateStore) Get(policy, name string) (float64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.values[policy][name]
+	return value, ok
+}
+
+// Set stores the named value for policy
+func (s *StateStore) Set(policy, name string, value float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.values[policy] == nil {
+		s.values[policy] = make(map[string]float64)
+	}
+	s.values[policy][name] = value
+}
+
+// Forget drops all state stored for policy
+func (s *StateStore) Forget(policy string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, policy)
+}
+
+// Snapshot returns a copy of the state of every policy, or of the single
+// policy when policy is not empty
+func (s *StateStore) Snapshot(policy string) map[string]map[string]float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make(map[string]map[string]float64)
+	for key, values := range s.values {
+		if policy != "" && key != policy {
+			continue
+		}
+		copied := make(map[string]float64, len(values))
+		for name, value := range values {
+			copied[name] = value
+		}
+		snapshot[key] = copied
+	}
+	return snapshot
+}
+
+// Restore replaces the state of every policy in state, e.g. with a snapshot
+// saved by a previous controller instance
+func (s *StateStore) Restore(state map[string]map[string]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for policy, values := range state {
+		copied := make(map[string]float64, len(values))
+		for name, value := range values {
+			copied[name] = value
+		}
+		s.values[policy] = copied
+	}
+}
+
+// StateKey returns the policy key under which state for input is stored
+func StateKey(input ScalingInput) string {
+	if input.PolicyNamespace != "" {
+		return input.PolicyNamespace + "/" + input.PolicyName
+	}
+	return input.PolicyName
+}
+
+// DefaultStateStore is the state store shared by the controller
+var DefaultStateStore = NewStateStore()
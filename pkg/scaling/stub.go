@@ -0,0 +1,73 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+)
+
+// StubAlgorithm registers a built-in algorithm under another name. It lets
+// policies that reference plugin algorithms be reconciled where the plugins
+// cannot be loaded, such as on Windows or a developer laptop.
+type StubAlgorithm struct {
+	name     string
+	delegate ScalingAlgorithm
+}
+
+// NewStubAlgorithm creates a stub named name that computes with delegate
+func NewStubAlgorithm(name string, delegate ScalingAlgorithm) *StubAlgorithm {
+	return &StubAlgorithm{name: name, delegate: delegate}
+}
+
+// Name returns the stubbed algorithm name
+func (a *StubAlgorithm) Name() string {
+	return a.name
+}
+
+// Description reports the built-in algorithm standing in for the plugin
+func (a *StubAlgorithm) Description() string {
+	return fmt.Sprintf("Stub computing with %s in place of a plugin algorithm that is not loaded.", a.delegate.Name())
+}
+
+// ComputeScale computes the desired replica count with the delegate
+func (a *StubAlgorithm) ComputeScale(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	result, err := a.delegate.ComputeScale(ctx, input)
+	if err != nil {
+		return result, err
+	}
+	result.Reason = fmt.Sprintf("%s (stub for %s)", result.Reason, a.name)
+	return result, nil
+}
+
+// RegisterStubs registers a stub delegating to the delegate algorithm for each
+// name that is not already registered
+func RegisterStubs(registry *Registry, names []string, delegate string) error {
+	algorithm, err := registry.Get(delegate)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if registry.Has(name) {
+			continue
+		}
+		if err := registry.Register(NewStubAlgorithm(name, algorithm)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
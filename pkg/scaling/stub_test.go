@@ -0,0 +1,54 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterStubs(t *testing.T) {
+	registry := NewRegistry()
+	registry.MustRegister(NewMaxRatioAlgorithm(DefaultTolerance))
+	registry.MustRegister(NewAverageRatioAlgorithm(DefaultTolerance))
+
+	// Registered algorithms are never replaced by stubs
+	require.NoError(t, RegisterStubs(registry, []string{"SmoothedRatio", "AverageRatio"}, "MaxRatio"))
+	assert.Equal(t, []string{"AverageRatio", "MaxRatio", "SmoothedRatio"}, registry.List())
+	average, err := registry.Get("AverageRatio")
+	require.NoError(t, err)
+	assert.IsType(t, &AverageRatioAlgorithm{}, average)
+
+	stub, err := registry.Get("SmoothedRatio")
+	require.NoError(t, err)
+	result, err := stub.ComputeScale(context.Background(), ScalingInput{
+		CurrentReplicas: 2,
+		MinReplicas:     1,
+		MaxReplicas:     10,
+		MetricRatios:    []float64{2.0},
+		Tolerance:       DefaultTolerance,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), result.DesiredReplicas)
+	assert.Contains(t, result.Reason, "stub for SmoothedRatio")
+
+	// The delegate must exist
+	assert.Error(t, RegisterStubs(registry, []string{"Other"}, "Missing"))
+}
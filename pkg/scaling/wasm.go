@@ -0,0 +1,321 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// WASMABIVersion is the version of the ABI between the controller and WASM
+// algorithm modules. A module must export kubeai_abi_version returning it.
+const WASMABIVersion = 1
+
+// WASMMemoryLimitPages caps the linear memory of a WASM algorithm module (256 MiB)
+const WASMMemoryLimitPages = 4096
+
+// Functions a WASM algorithm module exports. Buffers are passed as a 32-bit
+// address and length; results pack them into one i64 as address<<32 | length.
+const (
+	// wasmABIVersion() i32 returns WASMABIVersion
+	wasmABIVersion = "kubeai_abi_version"
+	// wasmAlloc(size i32) i32 returns a buffer of size bytes the host writes into
+	wasmAlloc = "kubeai_alloc"
+	// wasmFree(ptr i32, size i32) releases a buffer; optional
+	wasmFree = "kubeai_free"
+	// wasmName() i64 returns the algorithm name
+	wasmName = "kubeai_name"
+	// wasmComputeScale(ptr i32, size i32) i64 takes a JSON wasmInput and
+	// returns a JSON wasmResult
+	wasmComputeScale = "kubeai_compute_scale"
+)
+
+// wasmInput is the JSON form of ScalingInput passed to a WASM module
+type wasmInput struct {
+	CurrentReplicas     int32                       `json:"currentReplicas"`
+	MinReplicas         int32                       `json:"minReplicas"`
+	MaxReplicas         int32                       `json:"maxReplicas"`
+	MetricRatios        []float64                   `json:"metricRatios"`
+	Tolerance           float64                     `json:"tolerance"`
+	PolicyName          string                      `json:"policyName"`
+	PolicyNamespace     string                      `json:"policyNamespace"`
+	Metrics             map[string]float64          `json:"metrics"`
+	MetricSamples       map[string]wasmMetricSample `json:"metricSamples"`
+	ReadyReplicas       int32                       `json:"readyReplicas"`
+	UnavailableReplicas int32                       `json:"unavailableReplicas"`
+}
+
+// wasmMetricSample is the JSON form of MetricSample
+type wasmMetricSample struct {
+	Current   float64   `json:"current"`
+	Target    float64   `json:"target"`
+	Ratio     float64   `json:"ratio"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// wasmResult is the JSON form of ScalingResult returned by a WASM module. A
+// non-empty Error fails the computation; ErrorType takes the ErrorType* values
+// so the failure is reported like one from a native algorithm.
+type wasmResult struct {
+	DesiredReplicas   int32    `json:"desiredReplicas"`
+	Reason            string   `json:"reason"`
+	DrivingMetric     string   `json:"drivingMetric"`
+	UnclampedReplicas int32    `json:"unclampedReplicas"`
+	Confidence        float64  `json:"confidence"`
+	Error             string   `json:"error"`
+	ErrorType         string   `json:"errorType"`
+	Missing           []string `json:"missing"`
+}
+
+// WASMAlgorithm runs a scaling algorithm compiled to WebAssembly. Unlike Go
+// plugins, modules do not have to match the controller's toolchain and load on
+// every platform. Calls are serialized on one module instance, so a module may
+// keep state between them; an instance that traps or overruns its deadline is
+// discarded and a fresh one is started on the next call.
+type WASMAlgorithm struct {
+	name     string
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+
+	mu     sync.Mutex
+	module api.Module
+}
+
+// LoadWASMPlugin compiles and instantiates the WASM algorithm module at path
+func LoadWASMPlugin(path string) (*WASMAlgorithm, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrPluginNotFound{Path: path}
+		}
+		return nil, ErrPluginLoadFailed{Path: path, Cause: err}
+	}
+	algorithm, err := NewWASMAlgorithm(context.Background(), code)
+	if err != nil {
+		return nil, ErrPluginLoadFailed{Path: path, Cause: err}
+	}
+	return algorithm, nil
+}
+
+// NewWASMAlgorithm compiles and instantiates a WASM algorithm module
+func NewWASMAlgorithm(ctx context.Context, code []byte) (*WASMAlgorithm, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithMemoryLimitPages(WASMMemoryLimitPages))
+	algorithm, err := newWASMAlgorithm(ctx, runtime, code)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, err
+	}
+	return algorithm, nil
+}
+
+func newWASMAlgorithm(ctx context.Context, runtime wazero.Runtime, code []byte) (*WASMAlgorithm, error) {
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, err
+	}
+	compiled, err := runtime.CompileModule(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	exports := compiled.ExportedFunctions()
+	for _, name := range []string{wasmABIVersion, wasmAlloc, wasmName, wasmComputeScale} {
+		if _, ok := exports[name]; !ok {
+			return nil, fmt.Errorf("module does not export %s", name)
+		}
+	}
+
+	a := &WASMAlgorithm{runtime: runtime, compiled: compiled}
+	module, err := a.instantiate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := module.ExportedFunction(wasmABIVersion).Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if v := int32(version[0]); v != WASMABIVersion {
+		return nil, fmt.Errorf("module implements ABI version %d, controller supports %d", v, WASMABIVersion)
+	}
+	packed, err := module.ExportedFunction(wasmName).Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name, err := readPacked(ctx, module, packed[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(name) == 0 {
+		return nil, errors.New("module returned an empty algorithm name")
+	}
+	a.name = string(name)
+	a.module = module
+	return a, nil
+}
+
+// instantiate starts a new instance of the module. Reactor modules are
+// initialized through _initialize; _start is not called because it exits the
+// module when main returns.
+func (a *WASMAlgorithm) instantiate(ctx context.Context) (api.Module, error) {
+	return a.runtime.InstantiateModule(ctx, a.compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions("_initialize").
+		WithStderr(os.Stderr).
+		WithSysWalltime().
+		WithSysNanotime())
+}
+
+// Name returns the name reported by the module
+func (a *WASMAlgorithm) Name() string {
+	return a.name
+}
+
+// ComputeScale passes input to the module as JSON and decodes its result
+func (a *WASMAlgorithm) ComputeScale(ctx context.Context, input ScalingInput) (ScalingResult, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.module == nil {
+		module, err := a.instantiate(ctx)
+		if err != nil {
+			return ScalingResult{}, fmt.Errorf("failed to restart WASM algorithm %q: %w", a.name, err)
+		}
+		a.module = module
+	}
+
+	data, err := a.call(ctx, input)
+	if err != nil {
+		// A trapped or interrupted instance cannot be trusted with another call
+		_ = a.module.Close(ctx)
+		a.module = nil
+		return ScalingResult{}, fmt.Errorf("WASM algorithm %q failed: %w", a.name, err)
+	}
+
+	var result wasmResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return ScalingResult{}, fmt.Errorf("WASM algorithm %q returned an invalid result: %w", a.name, err)
+	}
+	if result.Error != "" {
+		return ScalingResult{}, a.resultError(result)
+	}
+	return ScalingResult{
+		DesiredReplicas:   result.DesiredReplicas,
+		Reason:            result.Reason,
+		DrivingMetric:     result.DrivingMetric,
+		UnclampedReplicas: result.UnclampedReplicas,
+		Confidence:        result.Confidence,
+	}, nil
+}
+
+// wasmMetricSamples converts metric samples to their JSON form
+func wasmMetricSamples(samples map[string]MetricSample) map[string]wasmMetricSample {
+	if samples == nil {
+		return nil
+	}
+	converted := make(map[string]wasmMetricSample, len(samples))
+	for name, sample := range samples {
+		converted[name] = wasmMetricSample(sample)
+	}
+	return converted
+}
+
+// call writes input into the module, runs kubeai_compute_scale and returns a
+// copy of the result
+func (a *WASMAlgorithm) call(ctx context.Context, input ScalingInput) ([]byte, error) {
+	data, err := json.Marshal(wasmInput{
+		CurrentReplicas:     input.CurrentReplicas,
+		MinReplicas:         input.MinReplicas,
+		MaxReplicas:         input.MaxReplicas,
+		MetricRatios:        input.MetricRatios,
+		Tolerance:           input.Tolerance,
+		PolicyName:          input.PolicyName,
+		PolicyNamespace:     input.PolicyNamespace,
+		Metrics:             input.Metrics,
+		MetricSamples:       wasmMetricSamples(input.MetricSamples),
+		ReadyReplicas:       input.ReadyReplicas,
+		UnavailableReplicas: input.UnavailableReplicas,
+	})
+	if err != nil {
+		return nil, err
+	}
+	allocated, err := a.module.ExportedFunction(wasmAlloc).Call(ctx, uint64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	ptr := uint32(allocated[0])
+	if !a.module.Memory().Write(ptr, data) {
+		return nil, fmt.Errorf("input buffer at %d is out of range", ptr)
+	}
+	packed, err := a.module.ExportedFunction(wasmComputeScale).Call(ctx, uint64(ptr), uint64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	freeWASMBuffer(ctx, a.module, ptr, uint32(len(data)))
+	return readPacked(ctx, a.module, packed[0])
+}
+
+// resultError converts an error reported by the module into the matching
+// typed error
+func (a *WASMAlgorithm) resultError(result wasmResult) error {
+	switch result.ErrorType {
+	case ErrorTypeInsufficientMetrics:
+		return ErrInsufficientMetrics{Name: a.name, Missing: result.Missing}
+	case ErrorTypeInvalidParameters:
+		return ErrInvalidParameters{Name: a.name, Err: errors.New(result.Error)}
+	case ErrorTypeStateCorrupt:
+		return ErrStateCorrupt{Name: a.name, Detail: result.Error}
+	default:
+		return fmt.Errorf("WASM algorithm %q: %s", a.name, result.Error)
+	}
+}
+
+// Close releases the module and its runtime once the call in progress, if any,
+// returns
+func (a *WASMAlgorithm) Close(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.runtime.Close(ctx)
+}
+
+// readPacked copies the buffer described by an address<<32 | length value out
+// of the module's memory and frees it
+func readPacked(ctx context.Context, module api.Module, packed uint64) ([]byte, error) {
+	ptr, size := uint32(packed>>32), uint32(packed)
+	view, ok := module.Memory().Read(ptr, size)
+	if !ok {
+		return nil, fmt.Errorf("result buffer at %d with length %d is out of range", ptr, size)
+	}
+	data := append([]byte(nil), view...)
+	freeWASMBuffer(ctx, module, ptr, size)
+	return data, nil
+}
+
+// freeWASMBuffer releases a buffer if the module exports kubeai_free
+func freeWASMBuffer(ctx context.Context, module api.Module, ptr, size uint32) {
+	if fn := module.ExportedFunction(wasmFree); fn != nil {
+		_, _ = fn.Call(ctx, uint64(ptr), uint64(size))
+	}
+}
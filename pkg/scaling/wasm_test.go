@@ -0,0 +1,218 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// WASM instructions used by testWASMModule
+var (
+	wasmUnreachable = []byte{0x00}
+	wasmLoopForever = []byte{0x03, 0x40, 0x0c, 0x00, 0x0b, 0x00} // loop; br 0; end; unreachable
+)
+
+// testWASMModule assembles a module implementing the ABI that reports name and
+// answers every kubeai_compute_scale with result. A non-nil compute replaces
+// the body of kubeai_compute_scale.
+func testWASMModule(abiVersion int32, name, result string, compute []byte) []byte {
+	const namePtr, resultPtr, allocPtr = 0x100, 0x400, 0x1000
+	section := func(id byte, items ...[]byte) []byte {
+		body := uleb(uint64(len(items)))
+		for _, item := range items {
+			body = append(body, item...)
+		}
+		return append(append([]byte{id}, uleb(uint64(len(body)))...), body...)
+	}
+	str := func(s string) []byte { return append(uleb(uint64(len(s))), s...) }
+	code := func(instructions ...byte) []byte {
+		body := append([]byte{0x00}, instructions...) // no locals
+		body = append(body, 0x0b)
+		return append(uleb(uint64(len(body))), body...)
+	}
+	data := func(offset int64, content string) []byte {
+		segment := append([]byte{0x00, 0x41}, sleb(offset)...)
+		return append(append(segment, 0x0b), str(content)...)
+	}
+	packed := func(ptr, size int) int64 { return int64(ptr)<<32 | int64(size) }
+	if compute == nil {
+		compute = append([]byte{0x42}, sleb(packed(resultPtr, len(result)))...)
+	}
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(1, // types
+		[]byte{0x60, 0x00, 0x01, 0x7f},             // () -> i32
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},       // (i32) -> i32
+		[]byte{0x60, 0x00, 0x01, 0x7e},             // () -> i64
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e}, // (i32, i32) -> i64
+	)...)
+	module = append(module, section(3, []byte{0}, []byte{1}, []byte{2}, []byte{3})...)
+	module = append(module, section(5, []byte{0x00, 0x01})...) // one page
+	module = append(module, section(7,
+		append(str("memory"), 0x02, 0x00),
+		append(str(wasmABIVersion), 0x00, 0x00),
+		append(str(wasmAlloc), 0x00, 0x01),
+		append(str(wasmName), 0x00, 0x02),
+		append(str(wasmComputeScale), 0x00, 0x03),
+	)...)
+	module = append(module, section(10,
+		code(append([]byte{0x41}, sleb(int64(abiVersion))...)...),
+		code(append([]byte{0x41}, sleb(allocPtr)...)...),
+		code(append([]byte{0x42}, sleb(packed(namePtr, len(name)))...)...),
+		code(compute...),
+	)...)
+	return append(module, section(11, data(namePtr, name), data(resultPtr, result))...)
+}
+
+func uleb(v uint64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			out = append(out, b|0x80)
+			continue
+		}
+		return append(out, b)
+	}
+}
+
+func sleb(v int64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func newTestWASMAlgorithm(t *testing.T, code []byte) *WASMAlgorithm {
+	t.Helper()
+	algorithm, err := NewWASMAlgorithm(context.Background(), code)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = algorithm.Close(context.Background()) })
+	return algorithm
+}
+
+func TestWASMAlgorithm(t *testing.T) {
+	input := ScalingInput{CurrentReplicas: 2, MinReplicas: 1, MaxReplicas: 10, MetricRatios: []float64{1.5}}
+
+	algorithm := newTestWASMAlgorithm(t, testWASMModule(WASMABIVersion, "Fixed",
+		`{"desiredReplicas":3,"reason":"fixed answer"}`, nil))
+	assert.Equal(t, "Fixed", algorithm.Name())
+	result, err := algorithm.ComputeScale(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, ScalingResult{DesiredReplicas: 3, Reason: "fixed answer"}, result)
+
+	// Errors reported by the module keep their type
+	algorithm = newTestWASMAlgorithm(t, testWASMModule(WASMABIVersion, "Picky",
+		`{"error":"no arrival rate","errorType":"insufficient_metrics","missing":["arrivalRate"]}`, nil))
+	_, err = algorithm.ComputeScale(context.Background(), input)
+	assert.Equal(t, ErrInsufficientMetrics{Name: "Picky", Missing: []string{MetricArrivalRate}}, err)
+
+	algorithm = newTestWASMAlgorithm(t, testWASMModule(WASMABIVersion, "Broken", `not json`, nil))
+	_, err = algorithm.ComputeScale(context.Background(), input)
+	assert.ErrorContains(t, err, "invalid result")
+}
+
+func TestWASMAlgorithmRestartsAfterFailure(t *testing.T) {
+	input := ScalingInput{CurrentReplicas: 2, MinReplicas: 1, MaxReplicas: 10, MetricRatios: []float64{1.5}}
+
+	// A trap discards the instance and the next call runs on a fresh one
+	algorithm := newTestWASMAlgorithm(t, testWASMModule(WASMABIVersion, "Trap", "", wasmUnreachable))
+	for range 2 {
+		_, err := algorithm.ComputeScale(context.Background(), input)
+		assert.ErrorContains(t, err, `WASM algorithm "Trap" failed`)
+	}
+
+	// So does a call interrupted at its deadline
+	algorithm = newTestWASMAlgorithm(t, testWASMModule(WASMABIVersion, "Spin", "", wasmLoopForever))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := algorithm.ComputeScale(ctx, input)
+	assert.Error(t, err)
+	assert.Nil(t, algorithm.module)
+}
+
+func TestNewWASMAlgorithmRejectsIncompatibleModules(t *testing.T) {
+	_, err := NewWASMAlgorithm(context.Background(), testWASMModule(WASMABIVersion+1, "Future", "{}", nil))
+	assert.ErrorContains(t, err, "ABI version 2")
+
+	_, err = NewWASMAlgorithm(context.Background(), testWASMModule(WASMABIVersion, "", "{}", nil))
+	assert.ErrorContains(t, err, "empty algorithm name")
+
+	_, err = NewWASMAlgorithm(context.Background(), []byte("not wasm"))
+	assert.Error(t, err)
+}
+
+func TestLoadPluginsWASM(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fixed.wasm"),
+		testWASMModule(WASMABIVersion, "Fixed", `{"desiredReplicas":3}`, nil), 0o600))
+
+	registry := NewRegistry()
+	require.NoError(t, LoadAndRegisterPlugins(dir, registry))
+	assert.Equal(t, []string{"Fixed"}, registry.List())
+
+	_, err := LoadWASMPlugin(filepath.Join(dir, "missing.wasm"))
+	assert.ErrorAs(t, err, &ErrPluginNotFound{})
+}
+
+// TestWASMExample builds examples/wasm-algorithm with the Go toolchain and runs it
+func TestWASMExample(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a WASM module")
+	}
+	out := filepath.Join(t.TempDir(), "headroom_ratio.wasm")
+	build := exec.Command("go", "build", "-buildmode=c-shared", "-o", out, ".")
+	build.Dir = filepath.Join("..", "..", "examples", "wasm-algorithm")
+	build.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+	output, err := build.CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	loaded, err := LoadWASMPlugin(out)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = loaded.Close(context.Background()) })
+	assert.Equal(t, "HeadroomRatio", loaded.Name())
+
+	input := ScalingInput{CurrentReplicas: 2, MinReplicas: 1, MaxReplicas: 10, Tolerance: DefaultTolerance}
+	// 20% headroom below a ratio of 1.2 needs 3 replicas
+	input.MetricRatios = []float64{1.2, 0.5}
+	result, err := loaded.ComputeScale(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), result.DesiredReplicas)
+
+	input.MetricRatios = []float64{0.8}
+	result, err = loaded.ComputeScale(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, ScalingResult{DesiredReplicas: 2, Reason: "within tolerance"}, result)
+
+	input.MetricRatios = nil
+	_, err = loaded.ComputeScale(context.Background(), input)
+	assert.Equal(t, ErrorTypeInsufficientMetrics, ErrorType(err))
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package synthetic measures the end-to-end latency of inference endpoints
+// by sending them synthetic requests, as a client would see it.
+package synthetic
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+const (
+	// DefaultTimeout bounds a synthetic request that sets no timeout
+	DefaultTimeout = 30 * time.Second
+
+	// maxResponseBytes caps how much of a response is read; the rest is not
+	// waited for, so responses longer than this are timed to the cap
+	maxResponseBytes = 16 << 20
+)
+
+// ErrNoResult is returned before the first synthetic request of a probe completes
+var ErrNoResult = errors.New("no synthetic request has completed yet")
+
+// Request is a synthetic inference request
+type Request struct {
+	URL string
+	// Method defaults to POST
+	Method string
+	// ContentType of the body; defaults to application/json
+	ContentType string
+	// Body returns the payload. It is called for every request, so edits to
+	// where it comes from take effect; nil sends no body.
+	Body func(ctx context.Context) ([]byte, error)
+	// Timeout bounds the request; DefaultTimeout when zero
+	Timeout time.Duration
+}
+
+// result is the outcome of a synthetic request
+type result struct {
+	latency time.Duration
+	err     error
+}
+
+// probe is the state of the synthetic requests sent for one key
+type probe struct {
+	last    *result
+	started time.Time
+	running bool
+}
+
+// Prober sends synthetic requests in the background and reports the latency
+// of the latest one, so that slow endpoints never hold up a reconcile
+type Prober struct {
+	HTTPClient *http.Client
+	// Clock decides when the next request is due; latencies are measured on the wall clock
+	Clock clock.PassiveClock
+
+	mu     sync.Mutex
+	probes map[string]*probe
+	// inFlight tracks the requests running in the background
+	inFlight sync.WaitGroup
+}
+
+// NewProber creates a Prober
+func NewProber() *Prober {
+	return &Prober{
+		HTTPClient: &http.Client{},
+		Clock:      clock.RealClock{},
+		probes:     make(map[string]*probe),
+	}
+}
+
+// Latency returns the end-to-end latency of the latest completed request of
+// the probe named key, and starts another in the background when interval has
+// passed since the previous one started. A request that times out counts as
+// taking the whole timeout; one that fails returns its error. Until the first
+// request completes, ErrNoResult is returned.
+func (p *Prober) Latency(key string, req Request, interval time.Duration) (time.Duration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.probes == nil {
+		p.probes = make(map[string]*probe)
+	}
+	state, ok := p.probes[key]
+	if !ok {
+		state = &probe{}
+		p.probes[key] = state
+	}
+
+	now := p.Clock.Now()
+	if !state.running && (state.started.IsZero() || now.Sub(state.started) >= interval) {
+		state.running = true
+		state.started = now
+		p.inFlight.Add(1)
+		go func() {
+			defer p.inFlight.Done()
+			latency, err := p.send(req)
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			state.running = false
+			state.last = &result{latency: latency, err: err}
+		}()
+	}
+
+	if state.last == nil {
+		return 0, ErrNoResult
+	}
+	return state.last.latency, state.last.err
+}
+
+// Forget drops the state of the probe named key, such as of a deleted policy
+func (p *Prober) Forget(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.probes, key)
+}
+
+// send sends req and times it until the whole response is read
+func (p *Prober) send(req Request) (time.Duration, error) {
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var body io.Reader
+	if req.Body != nil {
+		payload, err := req.Body(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("reading synthetic request payload: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+	method := req.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, req.URL, body)
+	if err != nil {
+		return 0, err
+	}
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	start := time.Now()
+	resp, err := httpClient.Do(httpReq)
+	if err == nil {
+		_, err = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+		_ = resp.Body.Close()
+	}
+	latency := time.Since(start)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return timeout, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("synthetic request failed: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return 0, fmt.Errorf("synthetic request returned status %d", resp.StatusCode)
+	}
+	return latency, nil
+}
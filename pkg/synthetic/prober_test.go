@@ -0,0 +1,120 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func newTestProber() (*Prober, *clocktesting.FakePassiveClock) {
+	clock := clocktesting.NewFakePassiveClock(time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC))
+	p := NewProber()
+	p.Clock = clock
+	return p, clock
+}
+
+func TestProberLatency(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"prompt": "ping"}`, string(body))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"choices": [{"text": "pong"}]}`))
+	}))
+	defer server.Close()
+	p, clock := newTestProber()
+	req := Request{
+		URL:  server.URL,
+		Body: func(context.Context) ([]byte, error) { return []byte(`{"prompt": "ping"}`), nil },
+	}
+
+	// The first request runs in the background
+	_, err := p.Latency("llm", req, time.Minute)
+	assert.ErrorIs(t, err, ErrNoResult)
+	p.inFlight.Wait()
+
+	latency, err := p.Latency("llm", req, time.Minute)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, latency, 20*time.Millisecond)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// The next request is sent once the interval has passed
+	clock.SetTime(clock.Now().Add(time.Minute))
+	_, err = p.Latency("llm", req, time.Minute)
+	require.NoError(t, err)
+	p.inFlight.Wait()
+	assert.Equal(t, int32(2), requests.Load())
+
+	p.Forget("llm")
+	_, err = p.Latency("llm", req, time.Minute)
+	assert.ErrorIs(t, err, ErrNoResult)
+	p.inFlight.Wait()
+}
+
+func TestProberTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	p, _ := newTestProber()
+	req := Request{URL: server.URL, Method: http.MethodGet, Timeout: 50 * time.Millisecond}
+
+	_, _ = p.Latency("llm", req, time.Minute)
+	p.inFlight.Wait()
+	// A request that times out counts as taking the whole timeout
+	latency, err := p.Latency("llm", req, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 50*time.Millisecond, latency)
+}
+
+func TestProberErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	p, _ := newTestProber()
+
+	_, _ = p.Latency("unavailable", Request{URL: server.URL}, time.Minute)
+	p.inFlight.Wait()
+	_, err := p.Latency("unavailable", Request{URL: server.URL}, time.Minute)
+	assert.ErrorContains(t, err, "status 503")
+
+	payloadErr := errors.New("configmap not found")
+	req := Request{URL: server.URL, Body: func(context.Context) ([]byte, error) { return nil, payloadErr }}
+	_, _ = p.Latency("no-payload", req, time.Minute)
+	p.inFlight.Wait()
+	_, err = p.Latency("no-payload", req, time.Minute)
+	assert.ErrorIs(t, err, payloadErr)
+}
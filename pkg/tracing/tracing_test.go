@@ -0,0 +1,43 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupValidation(t *testing.T) {
+	_, err := Setup(context.Background(), "", 1)
+	assert.Error(t, err)
+
+	_, err = Setup(context.Background(), "http://localhost:4318", 1.5)
+	assert.Error(t, err)
+}
+
+func TestSetupRecordsSampledSpans(t *testing.T) {
+	shutdown, err := Setup(context.Background(), "http://127.0.0.1:4318", 1)
+	require.NoError(t, err)
+	defer func() { _ = shutdown(context.Background()) }()
+
+	_, span := Tracer().Start(context.Background(), "Reconcile")
+	defer span.End()
+	assert.True(t, span.SpanContext().IsSampled())
+}
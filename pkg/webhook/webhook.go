@@ -23,21 +23,31 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/controller"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
 )
 
 // AIInferenceAutoscalerPolicyWebhook implements admission webhooks for AIInferenceAutoscalerPolicy
-type AIInferenceAutoscalerPolicyWebhook struct{}
+type AIInferenceAutoscalerPolicyWebhook struct {
+	// Algorithms limits the algorithms policies may use
+	Algorithms scaling.AlgorithmFilter
+	// Reader reads the algorithm annotations of namespaces; nil skips them
+	Reader client.Reader
+}
 
-// SetupWebhookWithManager sets up the webhook with the manager
-func SetupWebhookWithManager(mgr ctrl.Manager) error {
+// SetupWebhookWithManager sets up the webhook with the manager, rejecting
+// policies that select algorithms the filter or their namespace does not allow
+func SetupWebhookWithManager(mgr ctrl.Manager, algorithms scaling.AlgorithmFilter) error {
+	w := &AIInferenceAutoscalerPolicyWebhook{Algorithms: algorithms, Reader: mgr.GetClient()}
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}).
-		WithValidator(&AIInferenceAutoscalerPolicyWebhook{}).
-		WithDefaulter(&AIInferenceAutoscalerPolicyWebhook{}).
+		WithValidator(w).
+		WithDefaulter(w).
 		Complete()
 }
 
@@ -77,6 +87,9 @@ func (w *AIInferenceAutoscalerPolicyWebhook) ValidateCreate(ctx context.Context,
 	if err := policy.Validate(); err != nil {
 		return nil, err
 	}
+	if err := w.validateAlgorithms(ctx, policy); err != nil {
+		return nil, err
+	}
 
 	return nil, nil
 }
@@ -94,6 +107,9 @@ func (w *AIInferenceAutoscalerPolicyWebhook) ValidateUpdate(ctx context.Context,
 	if err := policy.Validate(); err != nil {
 		return nil, err
 	}
+	if err := w.validateAlgorithms(ctx, policy); err != nil {
+		return nil, err
+	}
 
 	// Check for immutable fields
 	oldPolicy, ok := oldObj.(*kubeaiv1alpha1.AIInferenceAutoscalerPolicy)
@@ -108,6 +124,23 @@ func (w *AIInferenceAutoscalerPolicyWebhook) ValidateUpdate(ctx context.Context,
 	return nil, nil
 }
 
+// validateAlgorithms rejects policies that select an algorithm the webhook's
+// filter or the policy's namespace does not allow
+func (w *AIInferenceAutoscalerPolicyWebhook) validateAlgorithms(ctx context.Context, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy) error {
+	filters := []scaling.AlgorithmFilter{w.Algorithms}
+	if w.Reader != nil {
+		namespaceFilter, err := controller.NamespaceAlgorithmFilter(ctx, w.Reader, policy.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed to read algorithm annotations of namespace %s: %w", policy.Namespace, err)
+		}
+		filters = append(filters, namespaceFilter)
+	}
+	if field, name := controller.DisallowedAlgorithm(policy, filters...); name != "" {
+		return fmt.Errorf("%s: algorithm %q is not allowed in namespace %s", field, name, policy.Namespace)
+	}
+	return nil
+}
+
 // ValidateDelete implements webhook.CustomValidator
 func (w *AIInferenceAutoscalerPolicyWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
 	// No validation needed for delete
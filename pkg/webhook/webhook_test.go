@@ -21,9 +21,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/controller"
+	"github.com/pmady/kubeai-autoscaler/pkg/scaling"
 )
 
 func TestWebhookDefault(t *testing.T) {
@@ -47,7 +54,7 @@ func TestWebhookDefault(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Check defaults were applied
-	assert.Equal(t, int32(1), policy.Spec.MinReplicas)
+	assert.Equal(t, ptr.To[int32](1), policy.Spec.MinReplicas)
 	assert.Equal(t, int32(300), policy.Spec.CooldownPeriod)
 	assert.Equal(t, "apps/v1", policy.Spec.TargetRef.APIVersion)
 }
@@ -72,7 +79,7 @@ func TestWebhookValidateCreate(t *testing.T) {
 					Metrics: kubeaiv1alpha1.MetricsSpec{
 						Latency: &kubeaiv1alpha1.LatencyMetric{
 							Enabled:     true,
-							TargetP99Ms: 500,
+							TargetP99Ms: ptr.To(resource.MustParse("500")),
 						},
 					},
 				},
@@ -120,7 +127,7 @@ func TestWebhookValidateUpdate(t *testing.T) {
 			Metrics: kubeaiv1alpha1.MetricsSpec{
 				Latency: &kubeaiv1alpha1.LatencyMetric{
 					Enabled:     true,
-					TargetP99Ms: 500,
+					TargetP99Ms: ptr.To(resource.MustParse("500")),
 				},
 			},
 		},
@@ -137,7 +144,7 @@ func TestWebhookValidateUpdate(t *testing.T) {
 			Metrics: kubeaiv1alpha1.MetricsSpec{
 				Latency: &kubeaiv1alpha1.LatencyMetric{
 					Enabled:     true,
-					TargetP99Ms: 500,
+					TargetP99Ms: ptr.To(resource.MustParse("500")),
 				},
 			},
 		},
@@ -157,3 +164,61 @@ func TestWebhookValidateDelete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, warnings)
 }
+
+func TestWebhookValidateAlgorithms(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tenant-a",
+			Annotations: map[string]string{controller.DeniedAlgorithmsAnnotation: "experimental-*"},
+		},
+	}
+	webhook := &AIInferenceAutoscalerPolicyWebhook{
+		Algorithms: scaling.NewAlgorithmFilter("", "Predictive"),
+		Reader:     fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(namespace).Build(),
+	}
+	policy := func(namespace, algorithm, scaleUp string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+		return &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace},
+			Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+				TargetRef:   kubeaiv1alpha1.TargetRef{Kind: "Deployment", Name: "test"},
+				MaxReplicas: 10,
+				Metrics: kubeaiv1alpha1.MetricsSpec{
+					Latency: &kubeaiv1alpha1.LatencyMetric{Enabled: true, TargetP99Ms: ptr.To(resource.MustParse("500"))},
+				},
+				Algorithm: &kubeaiv1alpha1.AlgorithmSpec{Name: algorithm},
+				ScaleUp:   &kubeaiv1alpha1.ScaleBehavior{Algorithm: scaleUp},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		policy   *kubeaiv1alpha1.AIInferenceAutoscalerPolicy
+		errorMsg string
+	}{
+		{name: "allowed", policy: policy("tenant-a", "LittlesLaw", "")},
+		{name: "denied by the controller", policy: policy("tenant-b", "Predictive", ""),
+			errorMsg: `spec.algorithm.name: algorithm "Predictive" is not allowed in namespace tenant-b`},
+		{name: "denied by the namespace", policy: policy("tenant-a", "LittlesLaw", "experimental-forecast"),
+			errorMsg: `spec.scaleUp.algorithm: algorithm "experimental-forecast" is not allowed in namespace tenant-a`},
+		{name: "other namespaces are unaffected", policy: policy("tenant-b", "experimental-forecast", "")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := webhook.ValidateCreate(context.Background(), tt.policy)
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+
+			_, err = webhook.ValidateUpdate(context.Background(), tt.policy, tt.policy)
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+//go:build e2e
+
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+	"github.com/pmady/kubeai-autoscaler/pkg/controller"
+)
+
+// TestPrometheusOutage kills Prometheus under a scaled-up target: the policy
+// must report the failed fetches, apply its fallback once the threshold is
+// reached, and recover on its own when Prometheus is back
+func TestPrometheusOutage(t *testing.T) {
+	ctx := context.Background()
+	ns := createNamespace(t, "e2e-prometheus-outage")
+	require.NoError(t, k8sClient.Create(ctx, newTarget(ns, 1)))
+	policy := newPolicy(ns)
+	policy.Spec.Fallback = &kubeaiv1alpha1.FallbackSpec{
+		Behavior:         controller.FallbackScaleToFixed,
+		Replicas:         2,
+		FailureThreshold: 2,
+	}
+	require.NoError(t, k8sClient.Create(ctx, policy))
+	restarts := controllerRestarts(t)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assertCondition(c, getPolicy(c, ns), controller.ConditionTypeReady, metav1.ConditionTrue, "Ready")
+		assert.Equal(c, int32(4), targetReplicas(c, ns))
+	}, waitTimeout, waitTick, "target did not scale up to maxReplicas")
+
+	scaleDeployment(t, "monitoring", "prometheus", 0)
+	t.Cleanup(func() {
+		scaleDeployment(t, "monitoring", "prometheus", 1)
+		kubectl(t, "-n", "monitoring", "rollout", "status", "deployment/prometheus", "--timeout=5m")
+	})
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		policy := getPolicy(c, ns)
+		assertCondition(c, policy, controller.ConditionTypeReady, metav1.ConditionFalse, controller.ReasonMetricsFailed)
+		assertCondition(c, policy, controller.ConditionTypeFallbackActive, metav1.ConditionTrue, "MetricsUnavailable")
+		assert.GreaterOrEqual(c, policy.Status.MetricsFailureCount, int32(2))
+		assert.Equal(c, int32(2), targetReplicas(c, ns))
+	}, waitTimeout, waitTick, "fallback was not applied while Prometheus was down")
+
+	scaleDeployment(t, "monitoring", "prometheus", 1)
+	kubectl(t, "-n", "monitoring", "rollout", "status", "deployment/prometheus", "--timeout=5m")
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		policy := getPolicy(c, ns)
+		assertCondition(c, policy, controller.ConditionTypeReady, metav1.ConditionTrue, "Ready")
+		assertCondition(c, policy, controller.ConditionTypeFallbackActive, metav1.ConditionFalse, "MetricsRecovered")
+		assert.Zero(c, policy.Status.MetricsFailureCount)
+		assert.Equal(c, int32(4), targetReplicas(c, ns))
+	}, waitTimeout, waitTick, "policy did not recover after Prometheus came back")
+	assert.Equal(t, restarts, controllerRestarts(t), "controller restarted during the outage")
+}
+
+// TestTargetDeletedMidScale deletes the target while a rate-limited scale-up
+// is in progress: the policy must report the missing target without crashing
+// the controller, and pick the target up again when it is recreated
+func TestTargetDeletedMidScale(t *testing.T) {
+	ctx := context.Background()
+	ns := createNamespace(t, "e2e-target-deleted")
+	target := newTarget(ns, 1)
+	require.NoError(t, k8sClient.Create(ctx, target))
+	policy := newPolicy(ns)
+	// One replica per minute, so the scale to maxReplicas is still under way
+	// when the target is deleted
+	policy.Spec.ScaleUp = &kubeaiv1alpha1.ScaleBehavior{Policies: []kubeaiv1alpha1.ScalingPolicy{
+		{Type: controller.ScalingPolicyPods, Value: 1, PeriodSeconds: 60},
+	}}
+	require.NoError(t, k8sClient.Create(ctx, policy))
+	restarts := controllerRestarts(t)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, int32(2), targetReplicas(c, ns))
+	}, waitTimeout, waitTick, "target did not start scaling up")
+
+	deleteIgnoringNotFound(t, target)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assertCondition(c, getPolicy(c, ns), controller.ConditionTypeReady, metav1.ConditionFalse, controller.ReasonTargetNotFound)
+	}, waitTimeout, waitTick, "missing target was not reported")
+	assert.Equal(t, restarts, controllerRestarts(t), "controller restarted after the target was deleted")
+
+	require.NoError(t, k8sClient.Create(ctx, newTarget(ns, 1)))
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assertCondition(c, getPolicy(c, ns), controller.ConditionTypeReady, metav1.ConditionTrue, "Ready")
+		assert.Greater(c, targetReplicas(c, ns), int32(1))
+	}, waitTimeout, waitTick, "recreated target was not scaled")
+}
+
+// TestControllerRestart restarts the controller during a cooldown: the new
+// controller must take over the policy and keep the cooldown recorded in its
+// status instead of scaling again at once
+func TestControllerRestart(t *testing.T) {
+	ctx := context.Background()
+	ns := createNamespace(t, "e2e-controller-restart")
+	require.NoError(t, k8sClient.Create(ctx, newTarget(ns, 1)))
+	policy := newPolicy(ns)
+	policy.Spec.CooldownPeriod = 600
+	require.NoError(t, k8sClient.Create(ctx, policy))
+
+	var lastScaleTime *metav1.Time
+	var remaining int32
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		policy := getPolicy(c, ns)
+		assert.Equal(c, int32(2), targetReplicas(c, ns))
+		assert.Positive(c, policy.Status.CooldownRemainingSeconds)
+		lastScaleTime, remaining = policy.Status.LastScaleTime, policy.Status.CooldownRemainingSeconds
+	}, waitTimeout, waitTick, "target did not scale into its cooldown")
+	require.NotNil(t, lastScaleTime)
+
+	kubectl(t, "-n", controllerNamespace, "delete", "pods", "-l", "app.kubernetes.io/component=controller", "--wait=true")
+	kubectl(t, "-n", controllerNamespace, "rollout", "status", "deployment/"+controllerDeployment, "--timeout=5m")
+
+	// The cooldown left is only written by reconciles, so a lower one shows
+	// the new controller has taken over the policy
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.True(c, newLeaderElected(c), "no running controller holds the leader election lease")
+		policy := getPolicy(c, ns)
+		assertCondition(c, policy, controller.ConditionTypeReady, metav1.ConditionTrue, "Ready")
+		assert.Positive(c, policy.Status.CooldownRemainingSeconds)
+		assert.Less(c, policy.Status.CooldownRemainingSeconds, remaining)
+	}, waitTimeout, waitTick, "restarted controller did not take over the policy")
+
+	key := types.NamespacedName{Namespace: ns, Name: "llm"}
+	assert.Never(t, func() bool {
+		policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+		target := &appsv1.Deployment{}
+		if k8sClient.Get(ctx, key, policy) != nil || k8sClient.Get(ctx, key, target) != nil {
+			return false
+		}
+		return !lastScaleTime.Equal(policy.Status.LastScaleTime) || *target.Spec.Replicas != 2
+	}, 3*pollingInterval*time.Second, waitTick, "restarted controller scaled within the cooldown")
+}
+
+// newLeaderElected reports whether the leader election Lease is held by a
+// running controller pod
+func newLeaderElected(c *assert.CollectT) bool {
+	lease := &coordinationv1.Lease{}
+	key := types.NamespacedName{Namespace: controllerNamespace, Name: leaderElectionLease}
+	if !assert.NoError(c, k8sClient.Get(context.Background(), key, lease)) || lease.Spec.HolderIdentity == nil {
+		return false
+	}
+	out, err := runKubectl("-n", controllerNamespace, "get", "pods", "-l", "app.kubernetes.io/component=controller",
+		"--field-selector=status.phase=Running", "-o", "name")
+	if !assert.NoError(c, err) {
+		return false
+	}
+	for _, pod := range strings.Fields(out) {
+		if strings.HasPrefix(*lease.Spec.HolderIdentity, strings.TrimPrefix(pod, "pod/")+"_") {
+			return true
+		}
+	}
+	return false
+}
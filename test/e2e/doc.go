@@ -0,0 +1,21 @@
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package e2e holds end-to-end tests that run the controller in a kind
+// cluster against a real Prometheus and inject failures: Prometheus going
+// away, targets deleted while scaling and controller restarts. They are
+// behind the e2e build tag; see "make test-e2e".
+package e2e
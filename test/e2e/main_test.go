@@ -0,0 +1,241 @@
+//go:build e2e
+
+/*
+Copyright 2026 KubeAI Autoscaler Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeaiv1alpha1 "github.com/pmady/kubeai-autoscaler/api/v1alpha1"
+)
+
+const (
+	// controllerNamespace and controllerDeployment are those of deploy/
+	controllerNamespace  = "kubeai-system"
+	controllerDeployment = "kubeai-autoscaler-controller"
+	// leaderElectionLease is the Lease of the controller's leader election
+	leaderElectionLease = "kubeai-autoscaler.kubeai.io"
+
+	// gpuUtilizationQuery is the recording rule of testdata/prometheus.yaml,
+	// a constant 90% utilization
+	gpuUtilizationQuery = "kubeai_e2e_gpu_utilization"
+
+	// pollingInterval of the test policies, short so that failures are
+	// noticed within a few seconds
+	pollingInterval = 5
+
+	// waitTimeout bounds how long a test waits for the controller to react
+	waitTimeout = 3 * time.Minute
+	// waitTick is how often a test checks whether it has
+	waitTick = 2 * time.Second
+)
+
+var k8sClient client.Client
+
+// TestMain deploys Prometheus and the controller image in $E2E_IMAGE to the
+// cluster of the current kubeconfig, then runs the tests
+func TestMain(m *testing.M) {
+	if err := setUp(); err != nil {
+		fmt.Fprintln(os.Stderr, "e2e setup failed:", err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
+// setUp installs the CRD, Prometheus and the controller and waits for them
+func setUp() error {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := kubeaiv1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return err
+	}
+	if k8sClient, err = client.New(cfg, client.Options{Scheme: scheme}); err != nil {
+		return err
+	}
+
+	image := os.Getenv("E2E_IMAGE")
+	if image == "" {
+		image = "kubeai-autoscaler:latest"
+	}
+	steps := [][]string{
+		{"apply", "--server-side", "-f", "../../crds/"},
+		{"apply", "-f", "testdata/prometheus.yaml"},
+		{"apply", "-f", "../../deploy/namespace.yaml", "-f", "../../deploy/rbac.yaml", "-f", "../../deploy/deployment.yaml"},
+		// Run the image loaded into kind rather than pulling the released one
+		{"-n", controllerNamespace, "patch", "deployment", controllerDeployment, "--type=json", "-p", fmt.Sprintf(
+			`[{"op": "replace", "path": "/spec/template/spec/containers/0/image", "value": %q},`+
+				`{"op": "replace", "path": "/spec/template/spec/containers/0/imagePullPolicy", "value": "IfNotPresent"}]`, image)},
+		{"-n", "monitoring", "rollout", "status", "deployment/prometheus", "--timeout=5m"},
+		{"-n", controllerNamespace, "rollout", "status", "deployment/" + controllerDeployment, "--timeout=5m"},
+	}
+	for _, args := range steps {
+		if _, err := runKubectl(args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runKubectl runs kubectl with args and returns its output
+func runKubectl(args ...string) (string, error) {
+	out, err := exec.Command("kubectl", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("kubectl %s: %w: %s", strings.Join(args, " "), err, out)
+	}
+	return string(out), nil
+}
+
+// kubectl runs kubectl with args, failing the test if it fails
+func kubectl(t *testing.T, args ...string) string {
+	t.Helper()
+	out, err := runKubectl(args...)
+	require.NoError(t, err)
+	return out
+}
+
+// createNamespace creates a namespace for one test, deleted when it ends
+func createNamespace(t *testing.T, name string) string {
+	t.Helper()
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	require.NoError(t, k8sClient.Create(context.Background(), ns))
+	t.Cleanup(func() {
+		_ = k8sClient.Delete(context.Background(), ns)
+	})
+	return name
+}
+
+// newTarget returns a Deployment of pause containers to scale
+func newTarget(namespace string, replicas int32) *appsv1.Deployment {
+	labels := map[string]string{"app": "llm"}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "llm", Image: "registry.k8s.io/pause:3.10"}},
+				},
+			},
+		},
+	}
+}
+
+// newPolicy returns a policy scaling the llm Deployment on the constant GPU
+// utilization of the e2e Prometheus, which is above its target
+func newPolicy(namespace string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	minReplicas := int32(1)
+	return &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: namespace},
+		Spec: kubeaiv1alpha1.AIInferenceAutoscalerPolicySpec{
+			TargetRef:       kubeaiv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "llm"},
+			MinReplicas:     &minReplicas,
+			MaxReplicas:     4,
+			CooldownPeriod:  1,
+			PollingInterval: pollingInterval,
+			Metrics: kubeaiv1alpha1.MetricsSpec{
+				GPUUtilization: &kubeaiv1alpha1.GPUUtilizationMetric{
+					Enabled:          true,
+					TargetPercentage: 50,
+					PrometheusQuery:  gpuUtilizationQuery,
+				},
+			},
+		},
+	}
+}
+
+// getPolicy reads the current state of a policy
+func getPolicy(c *assert.CollectT, namespace string) *kubeaiv1alpha1.AIInferenceAutoscalerPolicy {
+	policy := &kubeaiv1alpha1.AIInferenceAutoscalerPolicy{}
+	require.NoError(c, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: "llm"}, policy))
+	return policy
+}
+
+// targetReplicas reads the replicas of the llm Deployment
+func targetReplicas(c *assert.CollectT, namespace string) int32 {
+	target := &appsv1.Deployment{}
+	require.NoError(c, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: "llm"}, target))
+	require.NotNil(c, target.Spec.Replicas)
+	return *target.Spec.Replicas
+}
+
+// assertCondition asserts that the policy has the condition with status and reason
+func assertCondition(c *assert.CollectT, policy *kubeaiv1alpha1.AIInferenceAutoscalerPolicy, conditionType string, status metav1.ConditionStatus, reason string) {
+	condition := meta.FindStatusCondition(policy.Status.Conditions, conditionType)
+	if !assert.NotNil(c, condition, "condition %s", conditionType) {
+		return
+	}
+	assert.Equal(c, status, condition.Status, "status of %s: %s", conditionType, condition.Message)
+	assert.Equal(c, reason, condition.Reason, "reason of %s: %s", conditionType, condition.Message)
+}
+
+// scaleDeployment sets the replicas of a Deployment outside the test namespaces
+func scaleDeployment(t *testing.T, namespace, name string, replicas int) {
+	t.Helper()
+	kubectl(t, "-n", namespace, "scale", "deployment/"+name, fmt.Sprintf("--replicas=%d", replicas))
+}
+
+// controllerRestarts returns the container restarts of the controller pods,
+// which stay unchanged as long as no failure crashes the controller
+func controllerRestarts(t *testing.T) int32 {
+	t.Helper()
+	pods := &corev1.PodList{}
+	require.NoError(t, k8sClient.List(context.Background(), pods, client.InNamespace(controllerNamespace),
+		client.MatchingLabels{"app.kubernetes.io/component": "controller"}))
+	var restarts int32
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+	}
+	return restarts
+}
+
+// deleteIgnoringNotFound deletes obj, which may already be gone
+func deleteIgnoringNotFound(t *testing.T, obj client.Object) {
+	t.Helper()
+	if err := k8sClient.Delete(context.Background(), obj); err != nil && !apierrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+}